@@ -19,8 +19,13 @@ package mysql
 import (
 	"bytes"
 	"crypto/rand"
+	"crypto/rsa"
 	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
 	"encoding/hex"
+	"encoding/pem"
+	"io/ioutil"
 	"net"
 	"strings"
 
@@ -47,7 +52,10 @@ type AuthServer interface {
 	// given user. If this returns MysqlNativePassword
 	// (mysql_native_password), then ValidateHash() will be
 	// called, and no further roundtrip with the client is
-	// expected. If anything else is returned, Negotiate()
+	// expected. If this returns MysqlCachingSha2Password
+	// (caching_sha2_password), the AuthServer must also implement
+	// CachingSha2AuthServer, and the framework handles the
+	// packets. If anything else is returned, Negotiate()
 	// will be called on the connection, and the AuthServer
 	// needs to handle the packets.
 	AuthMethod(user string) (string, error)
@@ -79,6 +87,44 @@ type AuthServer interface {
 	Negotiate(c *Conn, user string, remoteAddr net.Addr) (Getter, error)
 }
 
+// CachingSha2AuthServer is the interface that AuthServer implementations
+// supporting caching_sha2_password must also implement. The negotiation
+// is done in two steps:
+//
+// 1. fast authentication: the client sends a SHA256 scramble of its
+// password using the salt. If the server knows the SHA256 hash of the
+// password (usually because it cached it after a previous full
+// authentication), it can validate it without any more roundtrip.
+//
+// 2. full authentication: the client sends its password, either in the
+// clear over TLS, or encrypted with the server RSA public key.
+type CachingSha2AuthServer interface {
+	AuthServer
+
+	// ValidateCachingSha2Hash validates the scramble sent by the
+	// client, and returns the user data. If the server cannot tell
+	// if the scramble is right, it should return a nil Getter and
+	// no error, and the full authentication will happen.
+	ValidateCachingSha2Hash(salt []byte, user string, authResponse []byte, remoteAddr net.Addr) (Getter, error)
+
+	// ValidateCachingSha2Password validates the password sent by
+	// the client during the full authentication, and returns the
+	// user data. Implementations should cache what they need so the
+	// next connections for that user can use the fast authentication.
+	ValidateCachingSha2Password(user, password string, remoteAddr net.Addr) (Getter, error)
+}
+
+// ConnRequirements is the interface the Getter returned by an
+// AuthServer can implement, to restrict the connections its user can
+// use, for instance to TLS connections. The server checks it once the
+// user is authenticated, during the handshake and ComChangeUser.
+type ConnRequirements interface {
+	// CheckConn returns an error if the connection doesn't meet
+	// the requirements of the user (should be a SQLError if
+	// possible). The connection is then closed.
+	CheckConn(c *Conn, user string) error
+}
+
 // authServers is a registry of AuthServer implementations.
 var authServers = make(map[string]AuthServer)
 
@@ -189,6 +235,170 @@ func isPassScrambleMysqlNativePassword(reply, salt []byte, mysqlNativePassword s
 	return bytes.Equal(candidateHash2, hash)
 }
 
+// ScrambleCachingSha2Password computes the hash of the password using
+// the caching_sha2_password method:
+// XOR(SHA256(password), SHA256(SHA256(SHA256(password)), salt))
+func ScrambleCachingSha2Password(salt, password []byte) []byte {
+	if len(password) == 0 {
+		return nil
+	}
+
+	// stage1Hash = SHA256(password)
+	crypt := sha256.New()
+	crypt.Write(password)
+	stage1 := crypt.Sum(nil)
+
+	// scrambleHash = SHA256(SHA256(stage1Hash) + salt)
+	crypt.Reset()
+	crypt.Write(stage1)
+	hash := crypt.Sum(nil)
+	crypt.Reset()
+	crypt.Write(hash)
+	crypt.Write(salt)
+	scramble := crypt.Sum(nil)
+
+	// token = stage1Hash XOR scrambleHash
+	for i := range scramble {
+		scramble[i] ^= stage1[i]
+	}
+	return scramble
+}
+
+// CachingSha2Hash returns SHA256(SHA256(password)), which is what a
+// server needs to know to validate a caching_sha2_password scramble.
+func CachingSha2Hash(password []byte) []byte {
+	hash := sha256.Sum256(password)
+	hash = sha256.Sum256(hash[:])
+	return hash[:]
+}
+
+func isPassScrambleCachingSha2Password(reply, salt, hash []byte) bool {
+	/*
+		SERVER:  recv(reply)
+				 hash_stage1=xor(reply, sha256(hash,salt))
+				 candidate_hash2=sha256(hash_stage1)
+				 check(candidate_hash2==hash)
+	*/
+	if len(reply) != sha256.Size || len(hash) != sha256.Size {
+		return false
+	}
+
+	// scramble = SHA256(hash+salt)
+	crypt := sha256.New()
+	crypt.Write(hash)
+	crypt.Write(salt)
+	scramble := crypt.Sum(nil)
+
+	// token = scramble XOR stage1Hash
+	for i := range scramble {
+		scramble[i] ^= reply[i]
+	}
+	hashStage1 := scramble
+
+	crypt.Reset()
+	crypt.Write(hashStage1)
+	candidateHash2 := crypt.Sum(nil)
+
+	return bytes.Equal(candidateHash2, hash)
+}
+
+// Constants for the caching_sha2_password plugin. They are sent
+// as the payload of AuthMoreDataPacket, or by the client.
+const (
+	// cachingSha2RequestPublicKey is sent by the client to ask for
+	// the server RSA public key.
+	cachingSha2RequestPublicKey = 0x02
+
+	// cachingSha2FastAuth means the fast authentication worked,
+	// and the OK packet follows.
+	cachingSha2FastAuth = 0x03
+
+	// cachingSha2FullAuth means the server needs the password.
+	cachingSha2FullAuth = 0x04
+)
+
+// EncryptPasswordWithPublicKey encrypts the password for the
+// caching_sha2_password full authentication over an insecure
+// transport. The password is zero terminated, XORed with the salt,
+// and encrypted with RSA OAEP.
+func EncryptPasswordWithPublicKey(salt, password []byte, pub *rsa.PublicKey) ([]byte, error) {
+	plain := make([]byte, len(password)+1)
+	copy(plain, password)
+	for i := range plain {
+		plain[i] ^= salt[i%len(salt)]
+	}
+	return rsa.EncryptOAEP(sha1.New(), rand.Reader, pub, plain, nil)
+}
+
+// decryptPasswordWithPrivateKey is the server side counterpart of
+// EncryptPasswordWithPublicKey.
+func decryptPasswordWithPrivateKey(salt, data []byte, priv *rsa.PrivateKey) (string, error) {
+	plain, err := rsa.DecryptOAEP(sha1.New(), rand.Reader, priv, data, nil)
+	if err != nil {
+		return "", err
+	}
+	for i := range plain {
+		plain[i] ^= salt[i%len(salt)]
+	}
+	if len(plain) == 0 || plain[len(plain)-1] != 0 {
+		return "", vterrors.Errorf(vtrpc.Code_INTERNAL, "received invalid encrypted password, datalen=%v", len(plain))
+	}
+	return string(plain[:len(plain)-1]), nil
+}
+
+// marshalPublicKey returns the PEM encoding of the public key, as
+// sent by the server to the caching_sha2_password client plugin.
+func marshalPublicKey(pub *rsa.PublicKey) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
+
+// parsePublicKey parses a PEM encoded RSA public key.
+func parsePublicKey(data []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "cannot decode PEM public key")
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaPub, ok := pub.(*rsa.PublicKey)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "public key is not a RSA key: %T", pub)
+	}
+	return rsaPub, nil
+}
+
+// LoadRSAPrivateKey reads a PEM encoded RSA private key from a file,
+// in PKCS #1 or PKCS #8 form. It can be used to set
+// Listener.CachingSha2PrivateKey.
+func LoadRSAPrivateKey(file string) (*rsa.PrivateKey, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "cannot decode PEM private key in %v", file)
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "private key in %v is not a RSA key: %T", file, key)
+	}
+	return rsaKey, nil
+}
+
 // Constants for the dialog plugin.
 const (
 	mysqlDialogMessage = "Enter password: "
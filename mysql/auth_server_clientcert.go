@@ -0,0 +1,131 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"crypto/x509"
+	"flag"
+	"net"
+
+	"gopkg.in/src-d/go-vitess.v1/vt/log"
+)
+
+var (
+	mysqlClientCertAuthMethod = flag.String("mysql_clientcert_auth_method", MysqlClearPassword, "Client-side authentication method of the clientcert auth server: mysql_clear_password or dialog.")
+)
+
+// AuthServerClientCert implements AuthServer by trusting the verified
+// TLS client certificate of the connection, without any password.
+// The MySQL user must be the common name of the certificate, or one of
+// its subject alternative names (DNS name, email address or URI). The
+// organizational units of the certificate subject are the groups of
+// the user.
+//
+// The server TLS config must verify the client certificates, which
+// vttls.ServerConfig does when it is given a CA. Connections without a
+// verified certificate are always rejected.
+type AuthServerClientCert struct {
+	// Method can be set to:
+	// - MysqlClearPassword
+	// - MysqlDialog
+	// It defaults to MysqlClearPassword. The password the client
+	// sends is ignored.
+	Method string
+}
+
+// InitAuthServerClientCert is public so it can be called from a
+// plugin, and registers the AuthServerClientCert as "clientcert".
+func InitAuthServerClientCert() {
+	if *mysqlClientCertAuthMethod != MysqlClearPassword && *mysqlClientCertAuthMethod != MysqlDialog {
+		log.Exitf("Invalid mysql_clientcert_auth_method value: only support mysql_clear_password or dialog")
+	}
+	RegisterAuthServerImpl("clientcert", &AuthServerClientCert{
+		Method: *mysqlClientCertAuthMethod,
+	})
+}
+
+// NewAuthServerClientCert returns a new AuthServerClientCert.
+func NewAuthServerClientCert() *AuthServerClientCert {
+	return &AuthServerClientCert{
+		Method: MysqlClearPassword,
+	}
+}
+
+// AuthMethod is part of the AuthServer interface.
+func (a *AuthServerClientCert) AuthMethod(user string) (string, error) {
+	return a.Method, nil
+}
+
+// Salt is part of the AuthServer interface.
+func (a *AuthServerClientCert) Salt() ([]byte, error) {
+	return NewSalt()
+}
+
+// ValidateHash is part of the AuthServer interface.
+// It will never be called.
+func (a *AuthServerClientCert) ValidateHash(salt []byte, user string, authResponse []byte, remoteAddr net.Addr) (Getter, error) {
+	panic("ValidateHash should not be called as AuthMethod returned mysql_clear_password or dialog")
+}
+
+// Negotiate is part of the AuthServer interface.
+func (a *AuthServerClientCert) Negotiate(c *Conn, user string, remoteAddr net.Addr) (Getter, error) {
+	// Finish the negotiation, the password is not used.
+	if _, err := AuthServerNegotiateClearOrDialog(c, a.Method); err != nil {
+		return nil, err
+	}
+
+	// Only trust certificates that were verified with the CA.
+	state, ok := c.TLSConnectionState()
+	if !ok || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return nil, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v': a verified client certificate is required", user)
+	}
+	cert := state.VerifiedChains[0][0]
+	if !matchClientCert(cert, user) {
+		return nil, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v': the client certificate '%v' is not for this user", user, cert.Subject)
+	}
+	return &StaticUserData{
+		username: user,
+		groups:   cert.Subject.OrganizationalUnit,
+	}, nil
+}
+
+// matchClientCert returns true if user is the common name, or one of
+// the subject alternative names, of cert.
+func matchClientCert(cert *x509.Certificate, user string) bool {
+	if user == "" {
+		return false
+	}
+	if cert.Subject.CommonName == user {
+		return true
+	}
+	for _, name := range cert.DNSNames {
+		if name == user {
+			return true
+		}
+	}
+	for _, email := range cert.EmailAddresses {
+		if email == user {
+			return true
+		}
+	}
+	for _, uri := range cert.URIs {
+		if uri.String() == user {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/src-d/go-vitess.v1/vt/tlstest"
+	"gopkg.in/src-d/go-vitess.v1/vt/vttls"
+)
+
+func TestClientCertAuth(t *testing.T) {
+	th := &testHandler{}
+
+	authServer := NewAuthServerClientCert()
+	l, err := NewListener("tcp", "127.0.0.1:0", authServer, th, 0, 0)
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	defer l.Close()
+	port := l.Addr().(*net.TCPAddr).Port
+
+	// Create the certs.
+	root, err := ioutil.TempDir("", "TestClientCertAuth")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+	tlstest.CreateCA(root)
+	tlstest.CreateSignedCert(root, tlstest.CA, "01", "server", "localhost")
+	tlstest.CreateSignedCert(root, tlstest.CA, "02", "client", "user1")
+
+	// Create the server with TLS config, that verifies the client
+	// certificates.
+	serverConfig, err := vttls.ServerConfig(
+		path.Join(root, "server-cert.pem"),
+		path.Join(root, "server-key.pem"),
+		path.Join(root, "ca-cert.pem"))
+	if err != nil {
+		t.Fatalf("TLSServerConfig failed: %v", err)
+	}
+	l.TLSConfig = serverConfig
+	go l.Accept()
+
+	// The user is the common name of the certificate, and no
+	// password is needed.
+	params := &ConnParams{
+		Host:    "localhost",
+		Port:    port,
+		Uname:   "user1",
+		Flags:   CapabilityClientSSL,
+		SslCa:   path.Join(root, "ca-cert.pem"),
+		SslCert: path.Join(root, "client-cert.pem"),
+		SslKey:  path.Join(root, "client-key.pem"),
+	}
+	conn, err := Connect(context.Background(), params)
+	if err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	result, err := conn.ExecuteFetch("userData echo", 1, false)
+	if err != nil {
+		t.Fatalf("ExecuteFetch failed: %v", err)
+	}
+	if got := result.Rows[0][1].ToString(); got != "user1" {
+		t.Errorf("Unexpected user data: %v", got)
+	}
+	conn.Close()
+
+	// Any other user is rejected.
+	params.Uname = "user2"
+	if _, err := Connect(context.Background(), params); err == nil {
+		t.Errorf("Connect as a user that is not in the certificate should have failed")
+	}
+
+	// And so are connections without a certificate.
+	params.Uname = "user1"
+	params.SslCert = ""
+	params.SslKey = ""
+	if _, err := Connect(context.Background(), params); err == nil {
+		t.Errorf("Connect without a client certificate should have failed")
+	}
+}
+
+func TestMatchClientCert(t *testing.T) {
+	cert := &x509.Certificate{
+		DNSNames:       []string{"client.example.com"},
+		EmailAddresses: []string{"client@example.com"},
+	}
+	cert.Subject.CommonName = "client"
+	cert.Subject.OrganizationalUnit = []string{"group1", "group2"}
+
+	tests := []struct {
+		user string
+		want bool
+	}{
+		{"client", true},
+		{"client.example.com", true},
+		{"client@example.com", true},
+		{"other", false},
+		{"", false},
+	}
+	for _, tcase := range tests {
+		if got := matchClientCert(cert, tcase.user); got != tcase.want {
+			t.Errorf("matchClientCert(%v) = %v, want %v", tcase.user, got, tcase.want)
+		}
+	}
+
+	// The groups are the organizational units.
+	userData := &StaticUserData{username: "client", groups: cert.Subject.OrganizationalUnit}
+	if got, want := userData.Get().Groups, []string{"group1", "group2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Groups = %v, want %v", got, want)
+	}
+}
@@ -18,12 +18,15 @@ package mysql
 
 import (
 	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
 	"encoding/json"
 	"flag"
 	"io/ioutil"
 	"net"
 	"os"
 	"os/signal"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -37,7 +40,8 @@ import (
 var (
 	mysqlAuthServerStaticFile           = flag.String("mysql_auth_server_static_file", "", "JSON File to read the users/passwords from.")
 	mysqlAuthServerStaticString         = flag.String("mysql_auth_server_static_string", "", "JSON representation of the users/passwords config.")
-	mysqlAuthServerStaticReloadInterval = flag.Duration("mysql_auth_static_reload_interval", 0, "Ticker to reload credentials")
+	mysqlAuthServerStaticReloadInterval = flag.Duration("mysql_auth_static_reload_interval", 0, "If set, interval at which mysql_auth_server_static_file is checked for changes, and reloaded if it changed.")
+	mysqlAuthServerStaticMethod         = flag.String("mysql_auth_static_method", MysqlNativePassword, "Authentication method of the static auth server: mysql_native_password, caching_sha2_password, mysql_clear_password or dialog.")
 )
 
 const (
@@ -50,12 +54,20 @@ type AuthServerStatic struct {
 	// - MysqlNativePassword
 	// - MysqlClearPassword
 	// - MysqlDialog
+	// - MysqlCachingSha2Password
 	// It defaults to MysqlNativePassword.
 	Method string
 	// This mutex helps us prevent data races between the multiple updates of Entries.
 	mu sync.Mutex
 	// Entries contains the users, passwords and user data.
 	Entries map[string][]*AuthServerStaticEntry
+	// cachingSha2Cache contains the SHA256(SHA256(password)) of the
+	// entries that only have a MysqlNativePassword, once a
+	// caching_sha2_password full authentication succeeded for them.
+	cachingSha2Cache map[*AuthServerStaticEntry][]byte
+	// configData is the content of the configuration file the
+	// last time it was read, to only reload it when it changes.
+	configData []byte
 }
 
 // AuthServerStaticEntry stores the values for a given user.
@@ -72,10 +84,25 @@ type AuthServerStaticEntry struct {
 	// MysqlNativePassword's format looks like "*6C8989366EAF75BB670AD8EA7A7FC1176A95CEF4", it store a hashing value.
 	// Use MysqlNativePassword in auth config, maybe more secure. After all, it is cryptographic storage.
 	MysqlNativePassword string
-	Password            string
-	UserData            string
-	SourceHost          string
-	Groups              []string
+	// MysqlCachingSha2Password is the hex encoded SHA256(SHA256(password)),
+	// used by the caching_sha2_password method. It can be computed with:
+	// mysql> SELECT SHA2(UNHEX(SHA2('mypass', 256)), 256);
+	MysqlCachingSha2Password string
+	Password                 string
+	UserData                 string
+	SourceHost               string
+	Groups                   []string
+	// SourceCIDRs restricts the entry to the clients connecting
+	// from one of these networks, for instance "10.0.0.0/8".
+	SourceCIDRs []string
+	// RequireTLS restricts the entry to the clients connecting
+	// over TLS.
+	RequireTLS bool
+	// RequireClientCertSubject restricts the entry to the clients
+	// connecting over TLS with a verified client certificate that
+	// has this subject, for instance "CN=client,O=example". It
+	// implies RequireTLS.
+	RequireClientCertSubject string
 }
 
 // InitAuthServerStatic Handles initializing the AuthServerStatic if necessary.
@@ -110,6 +137,13 @@ func NewAuthServerStatic() *AuthServerStatic {
 func RegisterAuthServerStaticFromParams(file, str string) {
 	authServerStatic := NewAuthServerStatic()
 
+	switch *mysqlAuthServerStaticMethod {
+	case MysqlNativePassword, MysqlCachingSha2Password, MysqlClearPassword, MysqlDialog:
+		authServerStatic.Method = *mysqlAuthServerStaticMethod
+	default:
+		log.Exitf("Invalid mysql_auth_static_method: %v", *mysqlAuthServerStaticMethod)
+	}
+
 	authServerStatic.loadConfigFromParams(file, str)
 
 	if len(authServerStatic.Entries) <= 0 {
@@ -130,6 +164,12 @@ func (a *AuthServerStatic) loadConfigFromParams(file, str string) {
 			return
 		}
 		jsonConfig = data
+
+		// Remember what was read even if it is invalid, so it is
+		// not reloaded again until it changes.
+		a.mu.Lock()
+		a.configData = data
+		a.mu.Unlock()
 	}
 
 	entries := make(map[string][]*AuthServerStaticEntry)
@@ -140,6 +180,7 @@ func (a *AuthServerStatic) loadConfigFromParams(file, str string) {
 
 	a.mu.Lock()
 	a.Entries = entries
+	a.cachingSha2Cache = nil
 	a.mu.Unlock()
 }
 
@@ -156,7 +197,8 @@ func (a *AuthServerStatic) installSignalHandlers() {
 		}
 	}()
 
-	// If duration is set, it will reload configuration every interval
+	// If duration is set, it will check the file every interval,
+	// and reload the configuration if it changed.
 	if *mysqlAuthServerStaticReloadInterval > 0 {
 		ticker := time.NewTicker(*mysqlAuthServerStaticReloadInterval)
 		go func() {
@@ -167,13 +209,28 @@ func (a *AuthServerStatic) installSignalHandlers() {
 						ticker.Stop()
 						return
 					}
-					sigChan <- syscall.SIGHUP
+					if a.configFileChanged(*mysqlAuthServerStaticFile) {
+						sigChan <- syscall.SIGHUP
+					}
 				}
 			}
 		}()
 	}
 }
 
+// configFileChanged returns true if the content of file is not the
+// one of the last configuration loaded from it.
+func (a *AuthServerStatic) configFileChanged(file string) bool {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		// Let loadConfigFromParams log the error.
+		return true
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return !bytes.Equal(data, a.configData)
+}
+
 func parseConfig(jsonConfig []byte, config *map[string][]*AuthServerStaticEntry) error {
 	decoder := json.NewDecoder(bytes.NewReader(jsonConfig))
 	decoder.DisallowUnknownFields()
@@ -205,6 +262,16 @@ func validateConfig(config map[string][]*AuthServerStaticEntry) error {
 			if entry.SourceHost != "" && entry.SourceHost != localhostName {
 				return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid SourceHost found (only localhost is supported): %v", entry.SourceHost)
 			}
+			for _, cidr := range entry.SourceCIDRs {
+				if _, _, err := net.ParseCIDR(cidr); err != nil {
+					return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid SourceCIDRs found: %v", cidr)
+				}
+			}
+			if entry.MysqlCachingSha2Password != "" {
+				if hash, err := hex.DecodeString(entry.MysqlCachingSha2Password); err != nil || len(hash) != 32 {
+					return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid MysqlCachingSha2Password found (must be a hex encoded SHA256 hash): %v", entry.MysqlCachingSha2Password)
+				}
+			}
 		}
 	}
 	return nil
@@ -233,14 +300,14 @@ func (a *AuthServerStatic) ValidateHash(salt []byte, user string, authResponse [
 	for _, entry := range entries {
 		if entry.MysqlNativePassword != "" {
 			isPass := isPassScrambleMysqlNativePassword(authResponse, salt, entry.MysqlNativePassword)
-			if matchSourceHost(remoteAddr, entry.SourceHost) && isPass {
-				return &StaticUserData{entry.UserData, entry.Groups}, nil
+			if entry.matchSource(remoteAddr) && isPass {
+				return entry.userData(), nil
 			}
 		} else {
 			computedAuthResponse := ScramblePassword(salt, []byte(entry.Password))
 			// Validate the password.
-			if matchSourceHost(remoteAddr, entry.SourceHost) && bytes.Equal(authResponse, computedAuthResponse) {
-				return &StaticUserData{entry.UserData, entry.Groups}, nil
+			if entry.matchSource(remoteAddr) && bytes.Equal(authResponse, computedAuthResponse) {
+				return entry.userData(), nil
 			}
 		}
 	}
@@ -266,13 +333,123 @@ func (a *AuthServerStatic) Negotiate(c *Conn, user string, remoteAddr net.Addr)
 	}
 	for _, entry := range entries {
 		// Validate the password.
-		if matchSourceHost(remoteAddr, entry.SourceHost) && entry.Password == password {
-			return &StaticUserData{entry.UserData, entry.Groups}, nil
+		if entry.matchSource(remoteAddr) && entry.Password == password {
+			return entry.userData(), nil
+		}
+	}
+	return &StaticUserData{}, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
+}
+
+// ValidateCachingSha2Hash is part of the CachingSha2AuthServer interface.
+func (a *AuthServerStatic) ValidateCachingSha2Hash(salt []byte, user string, authResponse []byte, remoteAddr net.Addr) (Getter, error) {
+	a.mu.Lock()
+	entries, ok := a.Entries[user]
+	cache := a.cachingSha2Cache
+	a.mu.Unlock()
+
+	if !ok {
+		return &StaticUserData{}, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
+	}
+
+	fullAuth := false
+	for _, entry := range entries {
+		if !entry.matchSource(remoteAddr) {
+			continue
+		}
+		switch {
+		case entry.MysqlCachingSha2Password != "":
+			hash, err := hex.DecodeString(entry.MysqlCachingSha2Password)
+			if err == nil && isPassScrambleCachingSha2Password(authResponse, salt, hash) {
+				return entry.userData(), nil
+			}
+		case entry.MysqlNativePassword != "":
+			// The SHA256 hash cannot be computed from the native
+			// one, it is only known after a full authentication.
+			hash, ok := cache[entry]
+			if !ok {
+				fullAuth = true
+				continue
+			}
+			if isPassScrambleCachingSha2Password(authResponse, salt, hash) {
+				return entry.userData(), nil
+			}
+		default:
+			computedAuthResponse := ScrambleCachingSha2Password(salt, []byte(entry.Password))
+			if bytes.Equal(authResponse, computedAuthResponse) {
+				return entry.userData(), nil
+			}
 		}
 	}
+	if fullAuth {
+		return nil, nil
+	}
 	return &StaticUserData{}, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
 }
 
+// ValidateCachingSha2Password is part of the CachingSha2AuthServer interface.
+func (a *AuthServerStatic) ValidateCachingSha2Password(user, password string, remoteAddr net.Addr) (Getter, error) {
+	a.mu.Lock()
+	entries, ok := a.Entries[user]
+	a.mu.Unlock()
+
+	if !ok {
+		return &StaticUserData{}, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
+	}
+
+	for _, entry := range entries {
+		if !entry.matchSource(remoteAddr) {
+			continue
+		}
+		switch {
+		case entry.MysqlCachingSha2Password != "":
+			hash, err := hex.DecodeString(entry.MysqlCachingSha2Password)
+			if err == nil && bytes.Equal(hash, CachingSha2Hash([]byte(password))) {
+				return entry.userData(), nil
+			}
+		case entry.MysqlNativePassword != "":
+			if strings.EqualFold(strings.TrimPrefix(entry.MysqlNativePassword, "*"), mysqlNativePasswordHash(password)) {
+				// Remember the SHA256 hash for the fast authentication.
+				a.mu.Lock()
+				if a.cachingSha2Cache == nil {
+					a.cachingSha2Cache = make(map[*AuthServerStaticEntry][]byte)
+				}
+				a.cachingSha2Cache[entry] = CachingSha2Hash([]byte(password))
+				a.mu.Unlock()
+				return entry.userData(), nil
+			}
+		default:
+			if entry.Password == password {
+				return entry.userData(), nil
+			}
+		}
+	}
+	return &StaticUserData{}, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
+}
+
+// mysqlNativePasswordHash returns the hex encoded SHA1(SHA1(password)),
+// which is what the PASSWORD() function returns, without the '*'.
+func mysqlNativePasswordHash(password string) string {
+	hash := sha1.Sum([]byte(password))
+	hash = sha1.Sum(hash[:])
+	return hex.EncodeToString(hash[:])
+}
+
+// matchSource returns true if the entry can be used by a client
+// connecting from remoteAddr.
+func (e *AuthServerStaticEntry) matchSource(remoteAddr net.Addr) bool {
+	return matchSourceHost(remoteAddr, e.SourceHost) && matchSourceCIDRs(remoteAddr, e.SourceCIDRs)
+}
+
+// userData returns the Getter of a client authenticated with the entry.
+func (e *AuthServerStaticEntry) userData() *StaticUserData {
+	return &StaticUserData{
+		username:                 e.UserData,
+		groups:                   e.Groups,
+		requireTLS:               e.RequireTLS,
+		requireClientCertSubject: e.RequireClientCertSubject,
+	}
+}
+
 func matchSourceHost(remoteAddr net.Addr, targetSourceHost string) bool {
 	// Legacy support, there was not matcher defined default to true
 	if targetSourceHost == "" {
@@ -287,13 +464,51 @@ func matchSourceHost(remoteAddr net.Addr, targetSourceHost string) bool {
 	return false
 }
 
-// StaticUserData holds the username and groups
+func matchSourceCIDRs(remoteAddr net.Addr, targetSourceCIDRs []string) bool {
+	if len(targetSourceCIDRs) == 0 {
+		return true
+	}
+	addr, ok := remoteAddr.(*net.TCPAddr)
+	if !ok {
+		return false
+	}
+	for _, cidr := range targetSourceCIDRs {
+		if _, ipNet, err := net.ParseCIDR(cidr); err == nil && ipNet.Contains(addr.IP) {
+			return true
+		}
+	}
+	return false
+}
+
+// StaticUserData holds the username and groups, and the connection
+// requirements of the user.
 type StaticUserData struct {
 	username string
 	groups   []string
+
+	requireTLS               bool
+	requireClientCertSubject string
 }
 
 // Get returns the wrapped username and groups
 func (sud *StaticUserData) Get() *querypb.VTGateCallerID {
 	return &querypb.VTGateCallerID{Username: sud.username, Groups: sud.groups}
 }
+
+// CheckConn is part of the ConnRequirements interface.
+func (sud *StaticUserData) CheckConn(c *Conn, user string) error {
+	if !sud.requireTLS && sud.requireClientCertSubject == "" {
+		return nil
+	}
+	state, ok := c.TLSConnectionState()
+	if !ok {
+		return NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v': a TLS connection is required", user)
+	}
+	if sud.requireClientCertSubject == "" {
+		return nil
+	}
+	if len(state.VerifiedChains) == 0 || state.VerifiedChains[0][0].Subject.String() != sud.requireClientCertSubject {
+		return NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v': a client certificate with subject '%v' is required", user, sud.requireClientCertSubject)
+	}
+	return nil
+}
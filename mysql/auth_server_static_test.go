@@ -292,3 +292,191 @@ func TestStaticPasswords(t *testing.T) {
 		})
 	}
 }
+
+func TestStaticCachingSha2Passwords(t *testing.T) {
+	jsonConfig := `
+{
+	"user01": [{ "Password": "user01" }],
+	"user04": [{ "MysqlNativePassword": "*668425423DB5193AF921380129F465A6425216D0" }],
+	"user05": [{
+		"MysqlCachingSha2Password": "C62C2467CD4430CB0C387207E8D28A8A9AA432EEC7F799ED88AF3D6AD4233F68"
+	}]
+}`
+
+	tests := []struct {
+		user     string
+		password string
+		fullAuth bool
+		success  bool
+	}{
+		{"user01", "user01", false, true},
+		{"user01", "password", false, false},
+		{"user04", "password1", true, true},
+		// The hash is now cached.
+		{"user04", "password1", false, true},
+		{"user04", "password", false, false},
+		{"user05", "user05", false, true},
+		{"user05", "password", false, false},
+		{"userXX", "password", false, false},
+	}
+
+	auth := NewAuthServerStatic()
+	auth.loadConfigFromParams("", jsonConfig)
+	ip := net.ParseIP("127.0.0.1")
+	addr := &net.IPAddr{IP: ip, Zone: ""}
+
+	for _, c := range tests {
+		salt, err := NewSalt()
+		if err != nil {
+			t.Fatalf("error generating salt: %v", err)
+		}
+
+		scrambled := ScrambleCachingSha2Password(salt, []byte(c.password))
+		getter, err := auth.ValidateCachingSha2Hash(salt, c.user, scrambled, addr)
+		if c.fullAuth {
+			if getter != nil || err != nil {
+				t.Fatalf("%v/%v: fast authentication should have been inconclusive, got %v %v", c.user, c.password, getter, err)
+			}
+			_, err = auth.ValidateCachingSha2Password(c.user, c.password, addr)
+		}
+
+		if c.success && err != nil {
+			t.Fatalf("%v/%v: authentication should have succeeded: %v", c.user, c.password, err)
+		}
+		if !c.success && err == nil {
+			t.Fatalf("%v/%v: authentication should have failed", c.user, c.password)
+		}
+	}
+
+	// Reloading the config clears the cache.
+	auth.loadConfigFromParams("", jsonConfig)
+	salt, err := NewSalt()
+	if err != nil {
+		t.Fatalf("error generating salt: %v", err)
+	}
+	getter, err := auth.ValidateCachingSha2Hash(salt, "user04", ScrambleCachingSha2Password(salt, []byte("password1")), addr)
+	if getter != nil || err != nil {
+		t.Fatalf("fast authentication should have been inconclusive after reload, got %v %v", getter, err)
+	}
+}
+
+func TestStaticSourceCIDRs(t *testing.T) {
+	jsonConfig := `
+{
+	"user01": [{ "Password": "user01", "SourceCIDRs": ["10.0.0.0/8", "192.168.1.0/24"] }],
+	"user02": [
+		{ "Password": "password1", "SourceCIDRs": ["10.0.0.0/8"] },
+		{ "Password": "password2" }
+	]
+}`
+
+	tests := []struct {
+		user     string
+		password string
+		ip       string
+		success  bool
+	}{
+		{"user01", "user01", "10.1.2.3", true},
+		{"user01", "user01", "192.168.1.10", true},
+		{"user01", "user01", "192.168.2.10", false},
+		{"user01", "user01", "127.0.0.1", false},
+		{"user02", "password1", "10.1.2.3", true},
+		{"user02", "password1", "127.0.0.1", false},
+		{"user02", "password2", "127.0.0.1", true},
+	}
+
+	auth := NewAuthServerStatic()
+	auth.loadConfigFromParams("", jsonConfig)
+
+	for _, c := range tests {
+		salt, err := NewSalt()
+		if err != nil {
+			t.Fatalf("error generating salt: %v", err)
+		}
+
+		addr := &net.TCPAddr{IP: net.ParseIP(c.ip), Port: 9999}
+		_, err = auth.ValidateHash(salt, c.user, ScramblePassword(salt, []byte(c.password)), addr)
+		if c.success && err != nil {
+			t.Errorf("%v/%v from %v: authentication should have succeeded: %v", c.user, c.password, c.ip, err)
+		}
+		if !c.success && err == nil {
+			t.Errorf("%v/%v from %v: authentication should have failed", c.user, c.password, c.ip)
+		}
+	}
+
+	// A unix socket doesn't match any network.
+	socket := &net.UnixAddr{Name: "unixSocket", Net: "1"}
+	if matchSourceCIDRs(socket, []string{"0.0.0.0/0"}) {
+		t.Errorf("Should not match socket when target is a network")
+	}
+
+	config := make(map[string][]*AuthServerStaticEntry)
+	if err := parseConfig([]byte(`{"user01": [{"Password": "user01", "SourceCIDRs": ["10.0.0.0"]}]}`), &config); err == nil {
+		t.Errorf("Invalid SourceCIDRs should have errored, but didn't")
+	}
+}
+
+func TestStaticConnRequirements(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+
+	tests := []struct {
+		entry   AuthServerStaticEntry
+		success bool
+	}{
+		{AuthServerStaticEntry{}, true},
+		{AuthServerStaticEntry{RequireTLS: true}, false},
+		{AuthServerStaticEntry{RequireClientCertSubject: "CN=client"}, false},
+	}
+	for _, c := range tests {
+		var userData Getter = c.entry.userData()
+		requirements, ok := userData.(ConnRequirements)
+		if !ok {
+			t.Fatalf("StaticUserData doesn't implement ConnRequirements")
+		}
+		err := requirements.CheckConn(sConn, "user01")
+		if c.success && err != nil {
+			t.Errorf("CheckConn(%+v) on a connection without TLS failed: %v", c.entry, err)
+		}
+		if !c.success && err == nil {
+			t.Errorf("CheckConn(%+v) on a connection without TLS should have failed", c.entry)
+		}
+	}
+}
+
+func TestStaticConfigFileChanged(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "mysql_auth_server_static_file.json")
+	if err != nil {
+		t.Fatalf("couldn't create temp file: %v", err)
+	}
+	defer os.Remove(tmpFile.Name())
+	if err := ioutil.WriteFile(tmpFile.Name(), []byte(`{"user01": [{"Password": "user01"}]}`), 0600); err != nil {
+		t.Fatalf("couldn't write temp file: %v", err)
+	}
+
+	auth := NewAuthServerStatic()
+	auth.loadConfigFromParams(tmpFile.Name(), "")
+	if auth.configFileChanged(tmpFile.Name()) {
+		t.Errorf("configFileChanged() = true right after loading the file")
+	}
+
+	// An invalid file doesn't replace the entries, and is only
+	// reloaded once it changes again.
+	if err := ioutil.WriteFile(tmpFile.Name(), []byte(`{"user01": [{"Password": `), 0600); err != nil {
+		t.Fatalf("couldn't overwrite temp file: %v", err)
+	}
+	if !auth.configFileChanged(tmpFile.Name()) {
+		t.Errorf("configFileChanged() = false after the file changed")
+	}
+	auth.loadConfigFromParams(tmpFile.Name(), "")
+	if auth.configFileChanged(tmpFile.Name()) {
+		t.Errorf("configFileChanged() = true right after loading the invalid file")
+	}
+	if len(auth.Entries["user01"]) != 1 {
+		t.Errorf("Entries should not have changed after loading an invalid file: %v", auth.Entries)
+	}
+}
@@ -195,6 +195,20 @@ type TableMap struct {
 	// - If the metadata is one byte, only the lower 8 bits are used.
 	// - If the metadata is two bytes, all 16 bits are used.
 	Metadata []uint16
+
+	// The following fields come from the optional metadata MySQL 8.0
+	// adds to the event, depending on binlog_row_metadata. They are
+	// nil if the server didn't send them.
+
+	// ColumnNames has the name of each column.
+	ColumnNames []string
+
+	// Unsigned is true for each numeric column that is unsigned.
+	Unsigned []bool
+
+	// Charsets has the collation ID of each character column, and 0
+	// for the other columns.
+	Charsets []uint32
 }
 
 // Rows contains data from a {WRITE,UPDATE,DELETE}_ROWS_EVENT.
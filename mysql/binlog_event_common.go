@@ -101,6 +101,12 @@ func (ev binlogEvent) Length() uint32 {
 	return binary.LittleEndian.Uint32(ev.Bytes()[9 : 9+4])
 }
 
+// NextPosition returns the next_position field from the header, which
+// is the position of the next event in the binlog file.
+func (ev binlogEvent) NextPosition() uint32 {
+	return binary.LittleEndian.Uint32(ev.Bytes()[13 : 13+4])
+}
+
 // IsFormatDescription implements BinlogEvent.IsFormatDescription().
 func (ev binlogEvent) IsFormatDescription() bool {
 	return ev.Type() == eFormatDescriptionEvent
@@ -316,6 +322,18 @@ func (ev binlogEvent) Rand(f BinlogFormat) (seed1 uint64, seed2 uint64, err erro
 	return seed1, seed2, nil
 }
 
+// Rotate returns the position and the name of the binlog file the
+// ROTATE_EVENT points to.
+//
+// Expected format (L = total length of event data):
+//   # bytes   field
+//   8         position
+//   L-8       file name
+func (ev binlogEvent) Rotate(f BinlogFormat) (uint64, string) {
+	data := ev.Bytes()[f.HeaderLength:]
+	return binary.LittleEndian.Uint64(data[:8]), string(data[8:])
+}
+
 func (ev binlogEvent) TableID(f BinlogFormat) uint64 {
 	typ := ev.Type()
 	pos := f.HeaderLength
@@ -18,6 +18,7 @@ package mysql
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/binary"
 	"fmt"
 	"math"
@@ -357,26 +358,46 @@ func printJSONString(data []byte, toplevel bool, result *bytes.Buffer) {
 func printJSONOpaque(data []byte, toplevel bool, result *bytes.Buffer) error {
 	typ := data[0]
 	size, pos := readVariableInt(data, 1)
+	if pos+size > len(data) {
+		return vterrors.Errorf(vtrpc.Code_INTERNAL, "not enough data for opaque value of type %v, have %v bytes need %v", typ, len(data)-pos, size)
+	}
+	data = data[pos : pos+size]
 
 	// A few types have special encoding.
 	switch typ {
+	case TypeDate, TypeTime, TypeDateTime, TypeTimestamp:
+		if len(data) < 8 {
+			return vterrors.Errorf(vtrpc.Code_INTERNAL, "not enough data for opaque value of type %v, have %v bytes need 8", typ, len(data))
+		}
+	case TypeNewDecimal:
+		if len(data) < 2 {
+			return vterrors.Errorf(vtrpc.Code_INTERNAL, "not enough data for opaque value of type %v, have %v bytes need at least 2", typ, len(data))
+		}
+	}
+	switch typ {
 	case TypeDate:
-		return printJSONDate(data[pos:pos+size], toplevel, result)
+		return printJSONDate(data, toplevel, result)
 	case TypeTime:
-		return printJSONTime(data[pos:pos+size], toplevel, result)
-	case TypeDateTime:
-		return printJSONDateTime(data[pos:pos+size], toplevel, result)
+		return printJSONTime(data, toplevel, result)
+	case TypeDateTime, TypeTimestamp:
+		return printJSONDateTime(data, toplevel, result)
 	case TypeNewDecimal:
-		return printJSONDecimal(data[pos:pos+size], toplevel, result)
+		return printJSONDecimal(data, toplevel, result)
 	}
 
-	// Other types are encoded in somewhat weird ways. Since we
-	// have no metadata, it seems some types first provide the
-	// metadata, and then the values. But even that metadata is
-	// not straightforward (for instance, a bit field seems to
-	// have one byte as metadata, not two as would be expected).
-	// To be on the safer side, we just reject these cases for now.
-	return vterrors.Errorf(vtrpc.Code_INTERNAL, "opaque type %v is not supported yet, with data %v", typ, data[1:])
+	// Other types (BIT, binary strings, ...) are the raw value
+	// of the column, with no metadata. MySQL itself can only
+	// print them as a string with the type and the base64
+	// encoded value, so we do the same.
+	value := fmt.Sprintf("base64:type%d:%s", typ, base64.StdEncoding.EncodeToString(data))
+	if toplevel {
+		result.WriteString("'\"")
+		result.WriteString(value)
+		result.WriteString("\"'")
+		return nil
+	}
+	sqltypes.MakeTrusted(sqltypes.VarBinary, []byte(value)).EncodeSQL(result)
+	return nil
 }
 
 func printJSONDate(data []byte, toplevel bool, result *bytes.Buffer) error {
@@ -398,7 +419,12 @@ func printJSONDate(data []byte, toplevel bool, result *bytes.Buffer) error {
 }
 
 func printJSONTime(data []byte, toplevel bool, result *bytes.Buffer) error {
-	raw := binary.LittleEndian.Uint64(data[:8])
+	// Negative times are stored as the opposite of the positive ones.
+	raw := int64(binary.LittleEndian.Uint64(data[:8]))
+	negative := raw < 0
+	if negative {
+		raw = -raw
+	}
 	value := raw >> 24
 	hour := (value >> 12) & 0x03ff // 10 bits starting at 12th
 	minute := (value >> 6) & 0x3f  // 6 bits starting at 6th
@@ -409,7 +435,7 @@ func printJSONTime(data []byte, toplevel bool, result *bytes.Buffer) error {
 		result.WriteString("CAST(")
 	}
 	result.WriteString("CAST('")
-	if value&0x8000000000 != 0 {
+	if negative {
 		result.WriteByte('-')
 	}
 	fmt.Fprintf(result, "%02d:%02d:%02d", hour, minute, second)
@@ -483,14 +509,16 @@ func readOffsetOrSize(data []byte, pos int, large bool) (int, int) {
 		int(data[pos+1])<<8, pos + 2
 }
 
+// readVariableInt reads the length of a string or opaque value. It is
+// stored in 7 bits per byte, starting with the lowest bits, the high bit
+// of each byte being set if more bytes follow.
 func readVariableInt(data []byte, pos int) (int, int) {
-	var b int8
 	var result int
-	for {
-		b = int8(data[pos])
+	for shift := uint(0); ; shift += 7 {
+		b := data[pos]
 		pos++
-		result = (result << 7) + int(b&0x7f)
-		if b >= 0 {
+		result |= int(b&0x7f) << shift
+		if b&0x80 == 0 {
 			break
 		}
 	}
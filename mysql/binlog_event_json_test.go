@@ -48,8 +48,8 @@ func TestJSON(t *testing.T) {
 		expected: `'"scalar string"'`,
 	}, {
 		// repeat the same string 10 times, to test readVariableInt when length of string
-		// requires 2 bytes to store
-		data: []byte{12, 129, 2,
+		// requires 2 bytes to store: 130 is 2 + 1<<7, lowest bits first.
+		data: []byte{12, 130, 1,
 			115, 99, 97, 108, 97, 114, 32, 115, 116, 114, 105, 110, 103,
 			115, 99, 97, 108, 97, 114, 32, 115, 116, 114, 105, 110, 103,
 			115, 99, 97, 108, 97, 114, 32, 115, 116, 114, 105, 110, 103,
@@ -136,9 +136,33 @@ func TestJSON(t *testing.T) {
 		data:     []byte{15, 246, 8, 13, 4, 135, 91, 205, 21, 4, 210},
 		expected: `CAST(CAST('123456789.1234' AS DECIMAL(13,4)) AS JSON)`,
 	}, {
-		// opaque, bit field. Not yet implemented.
+		// opaque, timestamp
+		data:     []byte{15, 7, 8, 0, 0, 0, 25, 118, 31, 149, 25},
+		expected: `CAST(CAST('2015-01-15 23:24:25' AS DATETIME(6)) AS JSON)`,
+	}, {
+		// opaque, negative time
+		data:     []byte{15, 11, 8, 0, 0, 0, 231, 137, 254, 255, 255},
+		expected: `CAST(CAST('-23:24:25' AS TIME(6)) AS JSON)`,
+	}, {
+		// opaque, bit field
 		data:     []byte{15, 16, 2, 202, 254},
-		expected: `opaque type 16 is not supported yet, with data [2 202 254]`,
+		expected: `'"base64:type16:yv4="'`,
+	}, {
+		// opaque, binary string
+		data:     []byte{15, 253, 3, 97, 98, 99},
+		expected: `'"base64:type253:YWJj"'`,
+	}, {
+		// opaque values in an array: bit field and decimal
+		data:     []byte{2, 2, 0, 24, 0, 15, 10, 0, 15, 14, 0, 16, 2, 202, 254, 246, 8, 13, 4, 135, 91, 205, 21, 4, 210},
+		expected: `JSON_ARRAY('base64:type16:yv4=',CAST('123456789.1234' AS DECIMAL(13,4)))`,
+	}, {
+		// opaque, truncated datetime
+		data:     []byte{15, 12, 4, 0, 0, 0, 25},
+		expected: `not enough data for opaque value of type 12, have 4 bytes need 8`,
+	}, {
+		// opaque, length past the end of the data
+		data:     []byte{15, 16, 3, 202, 254},
+		expected: `not enough data for opaque value of type 16, have 2 bytes need 3`,
 	}}
 
 	for _, tcase := range testcases {
@@ -157,6 +157,7 @@ func NewRotateEvent(f BinlogFormat, s *FakeBinlogStream, position uint64, filena
 		len(filename)
 	data := make([]byte, length)
 	binary.LittleEndian.PutUint64(data[0:8], position)
+	copy(data[8:], filename)
 
 	ev := s.Packetize(f, eRotateEvent, 0, data)
 	ev[0] = 0
@@ -287,6 +288,7 @@ func NewTableMapEvent(f BinlogFormat, s *FakeBinlogStream, tableID uint64, tm *T
 	}
 
 	metadataLength := metadataTotalLength(tm.Types)
+	optionalMetadata := tableMapOptionalMetadata(tm)
 
 	length := 6 + // table_id
 		2 + // flags
@@ -300,7 +302,8 @@ func NewTableMapEvent(f BinlogFormat, s *FakeBinlogStream, tableID uint64, tm *T
 		len(tm.Types) +
 		1 + // lenenc-str column-meta-def FIXME(alainjobart) len enc
 		metadataLength +
-		len(tm.CanBeNull.data)
+		len(tm.CanBeNull.data) +
+		len(optionalMetadata)
 	data := make([]byte, length)
 
 	data[0] = byte(tableID)
@@ -334,6 +337,7 @@ func NewTableMapEvent(f BinlogFormat, s *FakeBinlogStream, tableID uint64, tm *T
 	}
 
 	pos += copy(data[pos:], tm.CanBeNull.data)
+	pos += copy(data[pos:], optionalMetadata)
 	if pos != len(data) {
 		panic("bad encoding")
 	}
@@ -342,6 +346,71 @@ func NewTableMapEvent(f BinlogFormat, s *FakeBinlogStream, tableID uint64, tm *T
 	return NewMariadbBinlogEvent(ev)
 }
 
+// tableMapOptionalMetadata returns the optional metadata fields for the
+// Unsigned, Charsets and ColumnNames of a TableMap, if they are set.
+// Like MySQL, it picks the shortest of the two charset fields.
+func tableMapOptionalMetadata(tm *TableMap) []byte {
+	var data []byte
+	if tm.Unsigned != nil {
+		var bitmap []byte
+		n := 0
+		for c, typ := range tm.Types {
+			if !isNumericType(typ) {
+				continue
+			}
+			if n%8 == 0 {
+				bitmap = append(bitmap, 0)
+			}
+			if tm.Unsigned[c] {
+				bitmap[n/8] |= 0x80 >> uint(n%8)
+			}
+			n++
+		}
+		data = appendOptionalMetadata(data, tmSignedness, bitmap)
+	}
+	if columns := tm.characterColumns(); tm.Charsets != nil && len(columns) > 0 {
+		defaultCollation := tm.Charsets[columns[0]]
+		defaultCharset := appendLenEncInt(nil, uint64(defaultCollation))
+		var columnCharset []byte
+		for i, c := range columns {
+			if tm.Charsets[c] != defaultCollation {
+				defaultCharset = appendLenEncInt(defaultCharset, uint64(i))
+				defaultCharset = appendLenEncInt(defaultCharset, uint64(tm.Charsets[c]))
+			}
+			columnCharset = appendLenEncInt(columnCharset, uint64(tm.Charsets[c]))
+		}
+		if len(columnCharset) < len(defaultCharset) {
+			data = appendOptionalMetadata(data, tmColumnCharset, columnCharset)
+		} else {
+			data = appendOptionalMetadata(data, tmDefaultCharset, defaultCharset)
+		}
+	}
+	if tm.ColumnNames != nil {
+		var names []byte
+		for _, name := range tm.ColumnNames {
+			names = appendLenEncInt(names, uint64(len(name)))
+			names = append(names, name...)
+		}
+		data = appendOptionalMetadata(data, tmColumnName, names)
+	}
+	return data
+}
+
+// appendOptionalMetadata appends a TABLE_MAP_EVENT optional metadata
+// field to data.
+func appendOptionalMetadata(data []byte, typ byte, value []byte) []byte {
+	data = append(data, typ)
+	data = appendLenEncInt(data, uint64(len(value)))
+	return append(data, value...)
+}
+
+// appendLenEncInt appends a var-len encoded integer to data.
+func appendLenEncInt(data []byte, i uint64) []byte {
+	buf := make([]byte, lenEncIntSize(i))
+	writeLenEncInt(buf, 0, i)
+	return append(data, buf...)
+}
+
 // NewWriteRowsEvent returns a WriteRows event. Uses v2.
 func NewWriteRowsEvent(f BinlogFormat, s *FakeBinlogStream, tableID uint64, rows Rows) BinlogEvent {
 	return newRowsEvent(f, s, eWriteRowsEventV2, tableID, rows)
@@ -18,9 +18,13 @@ package mysql
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
+	"gopkg.in/src-d/go-vitess.v1/sqltypes"
+
 	binlogdatapb "gopkg.in/src-d/go-vitess.v1/vt/proto/binlogdata"
+	querypb "gopkg.in/src-d/go-vitess.v1/vt/proto/query"
 )
 
 // TestFormatDescriptionEvent tests both MySQL 5.6 and MariaDB 10.0
@@ -303,6 +307,81 @@ func TestTableMapEvent(t *testing.T) {
 	}
 }
 
+func TestTableMapEventOptionalMetadata(t *testing.T) {
+	f := NewMySQL56BinlogFormat()
+	s := NewFakeBinlogStream()
+
+	tm := &TableMap{
+		Database: "my_database",
+		Name:     "my_table",
+		Types: []byte{
+			TypeLongLong,
+			TypeVarchar,
+			TypeLong,
+			TypeString,
+			TypeBlob,
+			TypeString,
+			TypeDouble,
+			TypeJSON,
+		},
+		CanBeNull: NewServerBitmap(8),
+		Metadata: []uint16{
+			0,
+			384,
+			0,
+			uint16(TypeEnum)<<8 | 1,
+			2,
+			0xfe<<8 | 30,
+			8,
+			4,
+		},
+		ColumnNames: []string{"id", "name", "count", "kind", "data", "code", "price", "doc"},
+		Unsigned:    []bool{true, false, false, false, false, false, true, false},
+		Charsets:    []uint32{0, 33, 0, 0, 63, 33, 0, 0},
+	}
+
+	// The charsets are sent as DEFAULT_CHARSET for the first table
+	// map, and as COLUMN_CHARSET for the second.
+	for _, charsets := range [][]uint32{tm.Charsets, {0, 33, 0, 0, 63, 8, 0, 0}} {
+		tm.Charsets = charsets
+		event := NewTableMapEvent(f, s, 0x102030405060, tm)
+		event, _, err := event.StripChecksum(f)
+		if err != nil {
+			t.Fatalf("StripChecksum failed: %v", err)
+		}
+		gotTm, err := event.TableMap(f)
+		if err != nil {
+			t.Fatalf("NewTableMapEvent().TableMapEvent() returned error: %v", err)
+		}
+		if !reflect.DeepEqual(gotTm, tm) {
+			t.Errorf("NewTableMapEvent().TableMapEvent() got TableMap:\n%v\nexpected:\n%v", gotTm, tm)
+		}
+	}
+
+	// Unknown fields are skipped, truncated ones are an error.
+	event := NewTableMapEvent(f, s, 0x102030405060, tm)
+	data, _, err := event.StripChecksum(f)
+	if err != nil {
+		t.Fatalf("StripChecksum failed: %v", err)
+	}
+	for _, extra := range []struct {
+		data []byte
+		err  string
+	}{
+		{[]byte{tmColumnVisibility, 1, 0xff}, ""},
+		{[]byte{tmColumnVisibility, 2, 0xff}, "truncated optional metadata field 12"},
+	} {
+		buf := append(append([]byte{}, data.(mariadbBinlogEvent).Bytes()...), extra.data...)
+		_, err := NewMysql56BinlogEvent(buf).TableMap(f)
+		if extra.err == "" && err != nil {
+			t.Errorf("TableMap() with %v returned error: %v", extra.data, err)
+		}
+		if extra.err != "" && (err == nil || !strings.Contains(err.Error(), extra.err)) {
+			t.Errorf("TableMap() with %v returned %v, expected %v", extra.data, err, extra.err)
+		}
+	}
+}
+
 func TestRowsEvent(t *testing.T) {
 	f := NewMySQL56BinlogFormat()
 	s := NewFakeBinlogStream()
@@ -390,3 +469,98 @@ func TestRowsEvent(t *testing.T) {
 		t.Fatalf("NewRowsEvent().Rows() got Rows:\n%v\nexpected:\n%v", gotRows, rows)
 	}
 }
+
+func TestRowsEventJSON(t *testing.T) {
+	f := NewMySQL56BinlogFormat()
+	s := NewFakeBinlogStream()
+
+	tm := &TableMap{
+		Database: "my_database",
+		Name:     "my_table",
+		Types: []byte{
+			TypeLong,
+			TypeJSON,
+		},
+		CanBeNull: NewServerBitmap(2),
+		Metadata: []uint16{
+			0,
+			2,
+		},
+	}
+
+	// One row per opaque type, the JSON values are preceded by
+	// their length in 2 bytes.
+	rows := Rows{
+		DataColumns: NewServerBitmap(2),
+		Rows: []Row{{
+			NullColumns: NewServerBitmap(2),
+			Data: []byte{
+				0x01, 0x00, 0x00, 0x00,
+				0x0b, 0x00,
+				15, 12, 8, 0, 0, 0, 25, 118, 31, 149, 25,
+			},
+		}, {
+			NullColumns: NewServerBitmap(2),
+			Data: []byte{
+				0x02, 0x00, 0x00, 0x00,
+				0x0b, 0x00,
+				15, 10, 8, 0, 0, 0, 0, 0, 30, 149, 25,
+			},
+		}, {
+			NullColumns: NewServerBitmap(2),
+			Data: []byte{
+				0x03, 0x00, 0x00, 0x00,
+				0x0b, 0x00,
+				15, 11, 8, 192, 212, 1, 25, 118, 1, 0, 0,
+			},
+		}, {
+			NullColumns: NewServerBitmap(2),
+			Data: []byte{
+				0x04, 0x00, 0x00, 0x00,
+				0x19, 0x00,
+				2, 2, 0, 24, 0, 15, 10, 0, 15, 14, 0, 16, 2, 202, 254, 246, 8, 13, 4, 135, 91, 205, 21, 4, 210,
+			},
+		}, {
+			NullColumns: NewServerBitmap(2),
+			Data: []byte{
+				0x05, 0x00, 0x00, 0x00,
+				0x06, 0x00,
+				15, 253, 3, 97, 98, 99,
+			},
+		}},
+	}
+	rows.DataColumns.Set(0, true)
+	rows.DataColumns.Set(1, true)
+
+	event := NewWriteRowsEvent(f, s, 0x102030405060, rows)
+	if !event.IsValid() {
+		t.Fatalf("NewWriteRowsEvent().IsValid() is false")
+	}
+	event, _, err := event.StripChecksum(f)
+	if err != nil {
+		t.Fatalf("StripChecksum failed: %v", err)
+	}
+	gotRows, err := event.Rows(f, tm)
+	if err != nil {
+		t.Fatalf("NewWriteRowsEvent().Rows() returned error: %v", err)
+	}
+
+	// The JSON values are SQL expressions, which
+	// StringValuesForTests doesn't print.
+	expected := []string{
+		"CAST(CAST('2015-01-15 23:24:25' AS DATETIME(6)) AS JSON)",
+		"CAST(CAST('2015-01-15' AS DATE) AS JSON)",
+		"CAST(CAST('23:24:25.120000' AS TIME(6)) AS JSON)",
+		"JSON_ARRAY('base64:type16:yv4=',CAST('123456789.1234' AS DECIMAL(13,4)))",
+		`'"base64:type253:YWJj"'`,
+	}
+	for i, row := range gotRows.Rows {
+		value, _, err := CellValue(row.Data, 4, TypeJSON, 2, querypb.Type_JSON)
+		if err != nil {
+			t.Fatalf("CellValue(row %v) returned error: %v", i, err)
+		}
+		if got := string(value.Raw()); value.Type() != sqltypes.Expression || got != expected[i] {
+			t.Errorf("row %v: got %v %v expected %v", i, value.Type(), got, expected[i])
+		}
+	}
+}
@@ -50,6 +50,7 @@ var ZeroTimestamp = []byte("0000-00-00 00:00:00")
 //  cc        column-def, one byte per column
 //  <var>     column-meta-def (var-len encoded string)
 //  n         NULL-bitmask, length: (cc + 7) / 8
+//  <var>     optional metadata fields (MySQL 8.0), until the end
 func (ev binlogEvent) TableMap(f BinlogFormat) (*TableMap, error) {
 	data := ev.Bytes()[f.HeaderLength:]
 
@@ -95,11 +96,166 @@ func (ev binlogEvent) TableMap(f BinlogFormat) (*TableMap, error) {
 	}
 
 	// A bit array that says if each colum can be NULL.
-	result.CanBeNull, _ = newBitmap(data, pos, columnCount)
+	result.CanBeNull, pos = newBitmap(data, pos, columnCount)
 
+	if err := result.readOptionalMetadata(data, pos); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
 
+// readOptionalMetadata parses the optional metadata fields that
+// follow the NULL-bitmask. Each of them is:
+//  1         field type
+//  <var>     field length l (var-len encoded)
+//  l         field value
+// The fields we don't use are skipped.
+func (tm *TableMap) readOptionalMetadata(data []byte, pos int) error {
+	for pos < len(data) {
+		typ := data[pos]
+		l, start, ok := readLenEncInt(data, pos+1)
+		if !ok || start+int(l) > len(data) {
+			return vterrors.Errorf(vtrpc.Code_INTERNAL, "truncated optional metadata field %v at %v (data=%v)", typ, pos, data)
+		}
+		value := data[start : start+int(l)]
+		pos = start + int(l)
+
+		var err error
+		switch typ {
+		case tmSignedness:
+			tm.Unsigned = tm.readSignedness(value)
+		case tmDefaultCharset:
+			tm.Charsets, err = tm.readDefaultCharset(value)
+		case tmColumnCharset:
+			tm.Charsets, err = tm.readColumnCharset(value)
+		case tmColumnName:
+			tm.ColumnNames, err = tm.readColumnNames(value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readSignedness parses a SIGNEDNESS field: a bitmap with one bit per
+// numeric column, most significant bit first, set if it is unsigned.
+func (tm *TableMap) readSignedness(value []byte) []bool {
+	unsigned := make([]bool, len(tm.Types))
+	n := 0
+	for c, typ := range tm.Types {
+		if !isNumericType(typ) {
+			continue
+		}
+		if n/8 < len(value) && value[n/8]&(0x80>>uint(n%8)) != 0 {
+			unsigned[c] = true
+		}
+		n++
+	}
+	return unsigned
+}
+
+// readDefaultCharset parses a DEFAULT_CHARSET field: the collation
+// of most character columns, followed by the (index, collation) pairs
+// of the others. Indexes only count the character columns.
+func (tm *TableMap) readDefaultCharset(value []byte) ([]uint32, error) {
+	columns := tm.characterColumns()
+	collation, pos, ok := readLenEncInt(value, 0)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "cannot read default charset in optional metadata (data=%v)", value)
+	}
+	charsets := make([]uint32, len(tm.Types))
+	for _, c := range columns {
+		charsets[c] = uint32(collation)
+	}
+	for pos < len(value) {
+		var index uint64
+		index, pos, ok = readLenEncInt(value, pos)
+		if ok {
+			collation, pos, ok = readLenEncInt(value, pos)
+		}
+		if !ok || index >= uint64(len(columns)) {
+			return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "cannot read column charset in optional metadata (data=%v)", value)
+		}
+		charsets[columns[index]] = uint32(collation)
+	}
+	return charsets, nil
+}
+
+// readColumnCharset parses a COLUMN_CHARSET field: the collation of
+// each character column.
+func (tm *TableMap) readColumnCharset(value []byte) ([]uint32, error) {
+	charsets := make([]uint32, len(tm.Types))
+	pos := 0
+	for _, c := range tm.characterColumns() {
+		collation, next, ok := readLenEncInt(value, pos)
+		if !ok {
+			return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "cannot read column charset in optional metadata (data=%v)", value)
+		}
+		charsets[c] = uint32(collation)
+		pos = next
+	}
+	return charsets, nil
+}
+
+// readColumnNames parses a COLUMN_NAME field: the var-len encoded
+// name of each column.
+func (tm *TableMap) readColumnNames(value []byte) ([]string, error) {
+	names := make([]string, len(tm.Types))
+	pos := 0
+	for c := range tm.Types {
+		name, next, ok := readLenEncString(value, pos)
+		if !ok {
+			return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "cannot read column name in optional metadata (data=%v)", value)
+		}
+		names[c] = name
+		pos = next
+	}
+	return names, nil
+}
+
+// characterColumns returns the indexes of the columns that have a
+// character set, in the order the optional metadata lists them.
+func (tm *TableMap) characterColumns() []int {
+	var columns []int
+	for c, typ := range tm.Types {
+		if isCharacterType(typ, tm.Metadata[c]) {
+			columns = append(columns, c)
+		}
+	}
+	return columns
+}
+
+// isNumericType returns true for the types that have a signedness
+// in the optional metadata.
+func isNumericType(typ byte) bool {
+	switch typ {
+	case TypeTiny, TypeShort, TypeInt24, TypeLong, TypeLongLong, TypeFloat, TypeDouble, TypeNewDecimal:
+		return true
+	}
+	return false
+}
+
+// isCharacterType returns true for the types that have a character
+// set in the optional metadata. ENUM and SET columns are sent as
+// TypeString, with their real type in the metadata. ENUM and SET
+// charsets are in separate fields.
+func isCharacterType(typ byte, metadata uint16) bool {
+	switch typ {
+	case TypeString:
+		// The upper bits of the length of a long CHAR column are
+		// stored in the real type, with their value XOR'ed.
+		realType := byte(metadata >> 8)
+		if realType&0x30 != 0x30 {
+			realType |= 0x30
+		}
+		return realType != TypeEnum && realType != TypeSet
+	case TypeVarchar, TypeVarString, TypeBlob, TypeTinyBlob, TypeMediumBlob, TypeLongBlob:
+		return true
+	}
+	return false
+}
+
 // metadataLength returns how many bytes are used for metadata, based on a type.
 func metadataLength(typ byte) int {
 	switch typ {
@@ -17,9 +17,11 @@ limitations under the License.
 package mysql
 
 import (
+	"bytes"
 	"crypto/tls"
 	"fmt"
 	"net"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -171,7 +173,7 @@ func Connect(ctx context.Context, params *ConnParams) (*Conn, error) {
 // Ping implements mysql ping command.
 func (c *Conn) Ping() error {
 	// This is a new command, need to reset the sequence.
-	c.sequence = 0
+	c.resetSequence()
 
 	if err := c.writePacket([]byte{ComPing}); err != nil {
 		return NewSQLError(CRServerGone, SSUnknownSQLState, "%v", err)
@@ -222,10 +224,11 @@ func (c *Conn) clientHandshake(characterSet uint8, params *ConnParams) error {
 	if err != nil {
 		return NewSQLError(CRServerLost, "", "initial packet read failed: %v", err)
 	}
-	capabilities, salt, err := c.parseInitialHandshakePacket(data)
+	capabilities, salt, authPluginName, err := c.parseInitialHandshakePacket(data)
 	if err != nil {
 		return err
 	}
+	c.fillFlavor(params)
 
 	// Sanity check.
 	if capabilities&CapabilityClientProtocol41 == 0 {
@@ -238,6 +241,9 @@ func (c *Conn) clientHandshake(characterSet uint8, params *ConnParams) error {
 	if !params.DisableClientDeprecateEOF {
 		c.Capabilities = capabilities & (CapabilityClientDeprecateEOF)
 	}
+	if params.Flags&CapabilityClientCompress > 0 {
+		c.Capabilities |= capabilities & CapabilityClientCompress
+	}
 
 	// Handle switch to SSL if necessary.
 	if params.Flags&CapabilityClientSSL > 0 {
@@ -282,11 +288,11 @@ func (c *Conn) clientHandshake(characterSet uint8, params *ConnParams) error {
 	}
 
 	// Password encryption.
-	scrambledPassword := ScramblePassword(salt, []byte(params.Pass))
+	scrambledPassword := scramblePassword(authPluginName, salt, []byte(params.Pass))
 
 	// Build and send our handshake response 41.
 	// Note this one will never have SSL flag on.
-	if err := c.writeHandshakeResponse41(capabilities, scrambledPassword, characterSet, params); err != nil {
+	if err := c.writeHandshakeResponse41(capabilities, authPluginName, scrambledPassword, characterSet, params); err != nil {
 		return err
 	}
 
@@ -295,46 +301,58 @@ func (c *Conn) clientHandshake(characterSet uint8, params *ConnParams) error {
 	if err != nil {
 		return NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
 	}
-	switch response[0] {
-	case OKPacket:
-		// OK packet, we are authenticated. Save the user, keep going.
-		c.User = params.Uname
-	case AuthSwitchRequestPacket:
+	if response[0] == AuthSwitchRequestPacket {
 		// Server is asking to use a different auth method. We
-		// only support cleartext plugin.
-		pluginName, _, err := parseAuthSwitchRequest(response)
+		// support cleartext, native and caching_sha2 plugins.
+		var pluginData []byte
+		authPluginName, pluginData, err = parseAuthSwitchRequest(response)
 		if err != nil {
 			return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "cannot parse auth switch request: %v", err)
 		}
-		if pluginName != MysqlClearPassword {
-			return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "server asked for unsupported auth method: %v", pluginName)
-		}
 
 		// Write the password packet.
-		if err := c.writeClearTextPassword(params); err != nil {
+		switch authPluginName {
+		case MysqlClearPassword:
+			err = c.writeClearTextPassword(params)
+		case MysqlNativePassword, MysqlCachingSha2Password:
+			// The salt is 0 terminated.
+			salt = bytes.TrimSuffix(pluginData, []byte{0})
+			err = c.writeAuthResponse(scramblePassword(authPluginName, salt, []byte(params.Pass)))
+		default:
+			return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "server asked for unsupported auth method: %v", authPluginName)
+		}
+		if err != nil {
 			return err
 		}
 
-		// Wait for OK packet.
+		// Wait for the next packet.
 		response, err = c.readPacket()
 		if err != nil {
 			return NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
 		}
-		switch response[0] {
-		case OKPacket:
-			// OK packet, we are authenticated. Save the user, keep going.
-			c.User = params.Uname
-		case ErrPacket:
-			return ParseErrorPacket(response)
-		default:
-			return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "initial server response cannot be parsed: %v", response)
+	}
+	if response[0] == AuthMoreDataPacket && authPluginName == MysqlCachingSha2Password {
+		// Server is telling us how caching_sha2_password goes on.
+		response, err = c.handleCachingSha2AuthMoreData(salt, params, response)
+		if err != nil {
+			return err
 		}
+	}
+	switch response[0] {
+	case OKPacket:
+		// OK packet, we are authenticated. Save the user, keep going.
+		c.User = params.Uname
 	case ErrPacket:
 		return ParseErrorPacket(response)
 	default:
 		return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "initial server response cannot be parsed: %v", response)
 	}
 
+	// Switch to the compressed protocol if it was negotiated.
+	if c.Capabilities&CapabilityClientCompress != 0 {
+		c.enableCompression()
+	}
+
 	// If the server didn't support DbName in its handshake, set
 	// it now. This is what the 'mysql' client does.
 	if capabilities&CapabilityClientConnectWithDB == 0 && params.DbName != "" {
@@ -364,73 +382,73 @@ func (c *Conn) clientHandshake(characterSet uint8, params *ConnParams) error {
 }
 
 // parseInitialHandshakePacket parses the initial handshake from the server.
+// It returns the capabilities, the salt and the auth method.
 // It returns a SQLError with the right code.
-func (c *Conn) parseInitialHandshakePacket(data []byte) (uint32, []byte, error) {
+func (c *Conn) parseInitialHandshakePacket(data []byte) (uint32, []byte, string, error) {
 	pos := 0
 
 	// Protocol version.
 	pver, pos, ok := readByte(data, pos)
 	if !ok {
-		return 0, nil, NewSQLError(CRVersionError, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no protocol version")
+		return 0, nil, "", NewSQLError(CRVersionError, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no protocol version")
 	}
 	if pver != protocolVersion {
-		return 0, nil, NewSQLError(CRVersionError, SSUnknownSQLState, "bad protocol version: %v", pver)
+		return 0, nil, "", NewSQLError(CRVersionError, SSUnknownSQLState, "bad protocol version: %v", pver)
 	}
 
 	// Read the server version.
 	c.ServerVersion, pos, ok = readNullString(data, pos)
 	if !ok {
-		return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no server version")
+		return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no server version")
 	}
-	c.fillFlavor()
 
 	// Read the connection id.
 	c.ConnectionID, pos, ok = readUint32(data, pos)
 	if !ok {
-		return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no conneciton id")
+		return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no conneciton id")
 	}
 
 	// Read the first part of the auth-plugin-data
 	authPluginData, pos, ok := readBytes(data, pos, 8)
 	if !ok {
-		return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no auth-plugin-data-part-1")
+		return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no auth-plugin-data-part-1")
 	}
 
 	// One byte filler, 0. We don't really care about the value.
 	_, pos, ok = readByte(data, pos)
 	if !ok {
-		return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no filler")
+		return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no filler")
 	}
 
 	// Lower 2 bytes of the capability flags.
 	capLower, pos, ok := readUint16(data, pos)
 	if !ok {
-		return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no capability flags (lower 2 bytes)")
+		return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no capability flags (lower 2 bytes)")
 	}
 	var capabilities = uint32(capLower)
 
 	// The packet can end here.
 	if pos == len(data) {
-		return capabilities, authPluginData, nil
+		return capabilities, authPluginData, MysqlNativePassword, nil
 	}
 
 	// Character set.
 	characterSet, pos, ok := readByte(data, pos)
 	if !ok {
-		return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no character set")
+		return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no character set")
 	}
 	c.CharacterSet = characterSet
 
 	// Status flags. Ignored.
 	_, pos, ok = readUint16(data, pos)
 	if !ok {
-		return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no status flags")
+		return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no status flags")
 	}
 
 	// Upper 2 bytes of the capability flags.
 	capUpper, pos, ok := readUint16(data, pos)
 	if !ok {
-		return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no capability flags (upper 2 bytes)")
+		return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no capability flags (upper 2 bytes)")
 	}
 	capabilities += uint32(capUpper) << 16
 
@@ -440,13 +458,13 @@ func (c *Conn) parseInitialHandshakePacket(data []byte) (uint32, []byte, error)
 	if capabilities&CapabilityClientPluginAuth != 0 {
 		authPluginDataLength, pos, ok = readByte(data, pos)
 		if !ok {
-			return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no length of auth-plugin-data")
+			return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no length of auth-plugin-data")
 		}
 	} else {
 		// One byte filler, 0. We don't really care about the value.
 		_, pos, ok = readByte(data, pos)
 		if !ok {
-			return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no length of auth-plugin-data filler")
+			return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no length of auth-plugin-data filler")
 		}
 	}
 
@@ -463,31 +481,32 @@ func (c *Conn) parseInitialHandshakePacket(data []byte) (uint32, []byte, error)
 		var authPluginDataPart2 []byte
 		authPluginDataPart2, pos, ok = readBytes(data, pos, l)
 		if !ok {
-			return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no auth-plugin-data-part-2")
+			return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no auth-plugin-data-part-2")
 		}
 
 		// The last byte has to be 0, and is not part of the data.
 		if authPluginDataPart2[l-1] != 0 {
-			return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: auth-plugin-data-part-2 is not 0 terminated")
+			return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: auth-plugin-data-part-2 is not 0 terminated")
 		}
 		authPluginData = append(authPluginData, authPluginDataPart2[0:l-1]...)
 	}
 
 	// Auth-plugin name.
+	authPluginName := MysqlNativePassword
 	if capabilities&CapabilityClientPluginAuth != 0 {
-		authPluginName, _, ok := readNullString(data, pos)
+		authPluginName, _, ok = readNullString(data, pos)
 		if !ok {
 			// Fallback for versions prior to 5.5.10 and
 			// 5.6.2 that don't have a null terminated string.
 			authPluginName = string(data[pos : len(data)-1])
 		}
 
-		if authPluginName != MysqlNativePassword {
-			return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: only support %v auth plugin name, but got %v", MysqlNativePassword, authPluginName)
+		if authPluginName != MysqlNativePassword && authPluginName != MysqlCachingSha2Password {
+			return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: only support %v and %v auth plugin names, but got %v", MysqlNativePassword, MysqlCachingSha2Password, authPluginName)
 		}
 	}
 
-	return capabilities, authPluginData, nil
+	return capabilities, authPluginData, authPluginName, nil
 }
 
 // writeSSLRequest writes the SSLRequest packet. It's just a truncated
@@ -507,8 +526,13 @@ func (c *Conn) writeSSLRequest(capabilities uint32, characterSet uint8, params *
 		// If the server supported
 		// CapabilityClientDeprecateEOF, we also support it.
 		c.Capabilities&CapabilityClientDeprecateEOF |
+		// If the server supported CapabilityClientCompress,
+		// and we asked for it.
+		c.Capabilities&CapabilityClientCompress |
 		// Pass-through ClientFoundRows flag.
-		CapabilityClientFoundRows&uint32(params.Flags)
+		CapabilityClientFoundRows&uint32(params.Flags) |
+		// Pass-through ClientLocalFiles flag.
+		CapabilityClientLocalFiles&uint32(params.Flags)
 
 	length :=
 		4 + // Client capability flags.
@@ -542,7 +566,7 @@ func (c *Conn) writeSSLRequest(capabilities uint32, characterSet uint8, params *
 
 // writeHandshakeResponse41 writes the handshake response.
 // Returns a SQLError.
-func (c *Conn) writeHandshakeResponse41(capabilities uint32, scrambledPassword []byte, characterSet uint8, params *ConnParams) error {
+func (c *Conn) writeHandshakeResponse41(capabilities uint32, authPluginName string, scrambledPassword []byte, characterSet uint8, params *ConnParams) error {
 	// Build our flags.
 	var flags uint32 = CapabilityClientLongPassword |
 		CapabilityClientLongFlag |
@@ -556,8 +580,13 @@ func (c *Conn) writeHandshakeResponse41(capabilities uint32, scrambledPassword [
 		// If the server supported
 		// CapabilityClientDeprecateEOF, we also support it.
 		c.Capabilities&CapabilityClientDeprecateEOF |
+		// If the server supported CapabilityClientCompress,
+		// and we asked for it.
+		c.Capabilities&CapabilityClientCompress |
 		// Pass-through ClientFoundRows flag.
-		CapabilityClientFoundRows&uint32(params.Flags)
+		CapabilityClientFoundRows&uint32(params.Flags) |
+		// Pass-through ClientLocalFiles flag.
+		CapabilityClientLocalFiles&uint32(params.Flags)
 
 	// FIXME(alainjobart) add multi statement.
 
@@ -569,8 +598,7 @@ func (c *Conn) writeHandshakeResponse41(capabilities uint32, scrambledPassword [
 			lenNullString(params.Uname) +
 			// length of scrambled passsword is handled below.
 			len(scrambledPassword) +
-			21 + // "mysql_native_password" string.
-			1 // terminating zero.
+			lenNullString(authPluginName)
 
 	// Add the DB name if the server supports it.
 	if params.DbName != "" && (capabilities&CapabilityClientConnectWithDB != 0) {
@@ -584,6 +612,21 @@ func (c *Conn) writeHandshakeResponse41(capabilities uint32, scrambledPassword [
 		length++
 	}
 
+	// Connection attributes, only if the server supports them.
+	var attrs []string
+	attrsLength := 0
+	if len(params.ConnAttrs) > 0 && (capabilities&CapabilityClientConnAttr != 0) {
+		flags |= CapabilityClientConnAttr
+		for key := range params.ConnAttrs {
+			attrs = append(attrs, key)
+		}
+		sort.Strings(attrs)
+		for _, key := range attrs {
+			attrsLength += lenEncStringSize(key) + lenEncStringSize(params.ConnAttrs[key])
+		}
+		length += lenEncIntSize(uint64(attrsLength)) + attrsLength
+	}
+
 	data := c.startEphemeralPacket(length)
 	pos := 0
 
@@ -618,8 +661,17 @@ func (c *Conn) writeHandshakeResponse41(capabilities uint32, scrambledPassword [
 		c.SchemaName = params.DbName
 	}
 
-	// Assume native client during response
-	pos = writeNullString(data, pos, MysqlNativePassword)
+	// Auth plugin we used to scramble the password.
+	pos = writeNullString(data, pos, authPluginName)
+
+	// Connection attributes.
+	if flags&CapabilityClientConnAttr != 0 {
+		pos = writeLenEncInt(data, pos, uint64(attrsLength))
+		for _, key := range attrs {
+			pos = writeLenEncString(data, pos, key)
+			pos = writeLenEncString(data, pos, params.ConnAttrs[key])
+		}
+	}
 
 	// Sanity-check the length.
 	if pos != len(data) {
@@ -642,6 +694,83 @@ func parseAuthSwitchRequest(data []byte) (string, []byte, error) {
 	return pluginName, data[pos:], nil
 }
 
+// scramblePassword computes the hash of the password for the
+// given auth method, MysqlNativePassword or MysqlCachingSha2Password.
+func scramblePassword(authPluginName string, salt, password []byte) []byte {
+	if authPluginName == MysqlCachingSha2Password {
+		return ScrambleCachingSha2Password(salt, password)
+	}
+	return ScramblePassword(salt, password)
+}
+
+// handleCachingSha2AuthMoreData handles the AuthMoreDataPacket sent
+// by the server during the caching_sha2_password negotiation, and
+// returns the server response that follows it.
+// Returns a SQLError.
+func (c *Conn) handleCachingSha2AuthMoreData(salt []byte, params *ConnParams, data []byte) ([]byte, error) {
+	if len(data) != 2 {
+		return nil, NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "cannot parse caching_sha2_password auth more data: %v", data)
+	}
+	switch data[1] {
+	case cachingSha2FastAuth:
+		// Fast authentication worked, the OK packet follows.
+	case cachingSha2FullAuth:
+		// The server needs the password. Send it in the clear
+		// over a secure transport, or encrypt it with the server
+		// public key otherwise.
+		if c.Capabilities&CapabilityClientSSL != 0 || params.UnixSocket != "" {
+			if err := c.writeClearTextPassword(params); err != nil {
+				return nil, err
+			}
+			break
+		}
+		if err := c.writeAuthResponse([]byte{cachingSha2RequestPublicKey}); err != nil {
+			return nil, err
+		}
+		response, err := c.readPacket()
+		if err != nil {
+			return nil, NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
+		}
+		if response[0] == ErrPacket {
+			return response, nil
+		}
+		if response[0] != AuthMoreDataPacket {
+			return nil, NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "cannot parse public key response: %v", response)
+		}
+		pub, err := parsePublicKey(response[1:])
+		if err != nil {
+			return nil, NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "cannot parse public key: %v", err)
+		}
+		encrypted, err := EncryptPasswordWithPublicKey(salt, []byte(params.Pass), pub)
+		if err != nil {
+			return nil, NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "cannot encrypt password: %v", err)
+		}
+		if err := c.writeAuthResponse(encrypted); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "cannot parse caching_sha2_password auth more data: %v", data)
+	}
+
+	response, err := c.readPacket()
+	if err != nil {
+		return nil, NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
+	}
+	return response, nil
+}
+
+// writeAuthResponse writes a packet with the provided auth data,
+// during the auth negotiation.
+// Returns a SQLError.
+func (c *Conn) writeAuthResponse(authData []byte) error {
+	data := c.startEphemeralPacket(len(authData))
+	copy(data, authData)
+	if err := c.writeEphemeralPacket(); err != nil {
+		return NewSQLError(CRServerLost, SSUnknownSQLState, "cannot send auth response: %v", err)
+	}
+	return nil
+}
+
 // writeClearTextPassword writes the clear text password.
 // Returns a SQLError.
 func (c *Conn) writeClearTextPassword(params *ConnParams) error {
@@ -0,0 +1,247 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+
+	"gopkg.in/src-d/go-vitess.v1/vt/proto/vtrpc"
+	"gopkg.in/src-d/go-vitess.v1/vt/vterrors"
+)
+
+// This file implements the compressed protocol, used when both sides
+// agree on CapabilityClientCompress. After the handshake, the stream
+// of regular packets is cut into compressed packets, each having a
+// 7 bytes header:
+// - 3 bytes: length of the (maybe compressed) payload.
+// - 1 byte: sequence number, separate from the regular packets one.
+// - 3 bytes: length of the payload before compression, or 0 if the
+// payload is not compressed.
+// The payload is compressed with zlib. A regular packet may span more
+// than one compressed packet, and a compressed packet may contain
+// more than one regular packet.
+
+const (
+	// compressedPacketHeaderSize is the size of the header of a
+	// compressed packet.
+	compressedPacketHeaderSize = 7
+
+	// minCompressLength is the payload size under which we don't
+	// compress the data. This is MIN_COMPRESS_LENGTH in MySQL.
+	minCompressLength = 50
+)
+
+// compressedReader reads compressed packets from the underlying
+// reader, and returns their uncompressed payload.
+type compressedReader struct {
+	c *Conn
+	r io.Reader
+
+	// data is the uncompressed payload not yet returned by Read.
+	data []byte
+
+	// payload and buf are re-used across compressed packets.
+	payload []byte
+	buf     []byte
+	zr      io.ReadCloser
+}
+
+func newCompressedReader(c *Conn, r io.Reader) *compressedReader {
+	return &compressedReader{
+		c: c,
+		r: r,
+	}
+}
+
+// Read is part of the io.Reader interface.
+func (cr *compressedReader) Read(p []byte) (int, error) {
+	for len(cr.data) == 0 {
+		if err := cr.readCompressedPacket(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, cr.data)
+	cr.data = cr.data[n:]
+	return n, nil
+}
+
+// readCompressedPacket reads the next compressed packet into cr.data.
+// io.EOF is returned as is, so the callers can recognize it.
+func (cr *compressedReader) readCompressedPacket() error {
+	var header [compressedPacketHeaderSize]byte
+	if _, err := io.ReadFull(cr.r, header[:]); err != nil {
+		return err
+	}
+
+	sequence := uint8(header[3])
+	if sequence != cr.c.compressedSequence {
+		return vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid compressed sequence, expected %v got %v", cr.c.compressedSequence, sequence)
+	}
+	cr.c.compressedSequence++
+
+	length := int(uint32(header[0]) | uint32(header[1])<<8 | uint32(header[2])<<16)
+	uncompressedLength := int(uint32(header[4]) | uint32(header[5])<<8 | uint32(header[6])<<16)
+
+	if cap(cr.payload) < length {
+		cr.payload = make([]byte, length)
+	}
+	cr.payload = cr.payload[:length]
+	if _, err := io.ReadFull(cr.r, cr.payload); err != nil {
+		return vterrors.Wrapf(err, "io.ReadFull(compressed packet body of length %v) failed", length)
+	}
+
+	// The payload was small enough to be sent as is.
+	if uncompressedLength == 0 {
+		cr.data = cr.payload
+		return nil
+	}
+
+	if cr.zr == nil {
+		zr, err := zlib.NewReader(bytes.NewReader(cr.payload))
+		if err != nil {
+			return vterrors.Wrapf(err, "zlib.NewReader failed")
+		}
+		cr.zr = zr
+	} else if err := cr.zr.(zlib.Resetter).Reset(bytes.NewReader(cr.payload), nil); err != nil {
+		return vterrors.Wrapf(err, "zlib reset failed")
+	}
+	if cap(cr.buf) < uncompressedLength {
+		cr.buf = make([]byte, uncompressedLength)
+	}
+	cr.buf = cr.buf[:uncompressedLength]
+	if _, err := io.ReadFull(cr.zr, cr.buf); err != nil {
+		return vterrors.Wrapf(err, "uncompressing packet of length %v failed", uncompressedLength)
+	}
+	cr.data = cr.buf
+	return nil
+}
+
+// compressedWriter accumulates the regular packets written to it, and
+// writes them as compressed packets to the underlying writer, when
+// enough data is pending or when Flush is called.
+type compressedWriter struct {
+	c *Conn
+	w io.Writer
+
+	// pending is the data not yet sent.
+	pending []byte
+
+	// compressed and zw are re-used across compressed packets.
+	compressed bytes.Buffer
+	zw         *zlib.Writer
+}
+
+func newCompressedWriter(c *Conn, w io.Writer) *compressedWriter {
+	cw := &compressedWriter{
+		c: c,
+		w: w,
+	}
+	cw.zw = zlib.NewWriter(&cw.compressed)
+	return cw
+}
+
+// Write is part of the io.Writer interface.
+func (cw *compressedWriter) Write(p []byte) (int, error) {
+	cw.pending = append(cw.pending, p...)
+	if len(cw.pending) >= connBufferSize {
+		if err := cw.writePending(); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush sends all the pending data.
+func (cw *compressedWriter) Flush() error {
+	if err := cw.writePending(); err != nil {
+		return err
+	}
+
+	// As MySQL does in net_flush(), the sequence of the regular
+	// packets continues from the compressed one.
+	cw.c.sequence = cw.c.compressedSequence
+	return nil
+}
+
+// writePending writes the pending data as compressed packets.
+func (cw *compressedWriter) writePending() error {
+	data := cw.pending
+	for len(data) > 0 {
+		packetLength := len(data)
+		if packetLength > MaxPacketSize {
+			packetLength = MaxPacketSize
+		}
+		if err := cw.writeCompressedPacket(data[:packetLength]); err != nil {
+			return err
+		}
+		data = data[packetLength:]
+	}
+	cw.pending = cw.pending[:0]
+	return nil
+}
+
+// writeCompressedPacket writes a single compressed packet. The data
+// is sent as is if it is small, or if it doesn't compress.
+func (cw *compressedWriter) writeCompressedPacket(data []byte) error {
+	payload := data
+	uncompressedLength := 0
+	if len(data) >= minCompressLength {
+		cw.compressed.Reset()
+		cw.zw.Reset(&cw.compressed)
+		if _, err := cw.zw.Write(data); err != nil {
+			return vterrors.Wrapf(err, "compressing packet of length %v failed", len(data))
+		}
+		if err := cw.zw.Close(); err != nil {
+			return vterrors.Wrapf(err, "compressing packet of length %v failed", len(data))
+		}
+		if cw.compressed.Len() < len(data) {
+			payload = cw.compressed.Bytes()
+			uncompressedLength = len(data)
+		}
+	}
+
+	var header [compressedPacketHeaderSize]byte
+	header[0] = byte(len(payload))
+	header[1] = byte(len(payload) >> 8)
+	header[2] = byte(len(payload) >> 16)
+	header[3] = cw.c.compressedSequence
+	header[4] = byte(uncompressedLength)
+	header[5] = byte(uncompressedLength >> 8)
+	header[6] = byte(uncompressedLength >> 16)
+	if n, err := cw.w.Write(header[:]); err != nil {
+		return vterrors.Wrapf(err, "Write(compressed header) failed")
+	} else if n != compressedPacketHeaderSize {
+		return vterrors.Errorf(vtrpc.Code_INTERNAL, "Write(compressed header) returned a short write: %v < %v", n, compressedPacketHeaderSize)
+	}
+	if n, err := cw.w.Write(payload); err != nil {
+		return vterrors.Wrapf(err, "Write(compressed packet) failed")
+	} else if n != len(payload) {
+		return vterrors.Errorf(vtrpc.Code_INTERNAL, "Write(compressed packet) returned a short write: %v < %v", n, len(payload))
+	}
+	cw.c.compressedSequence++
+	return nil
+}
+
+// enableCompression switches the connection to the compressed
+// protocol. It is called by both sides right after the handshake
+// if they agreed on CapabilityClientCompress.
+func (c *Conn) enableCompression() {
+	c.compressedReader = newCompressedReader(c, c.getReader())
+	c.compressedWriter = newCompressedWriter(c, c.conn)
+}
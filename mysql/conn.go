@@ -18,6 +18,7 @@ package mysql
 
 import (
 	"bufio"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
@@ -26,6 +27,7 @@ import (
 	"sync"
 	"time"
 
+	"golang.org/x/net/context"
 	"gopkg.in/src-d/go-vitess.v1/bucketpool"
 	"gopkg.in/src-d/go-vitess.v1/sqltypes"
 	"gopkg.in/src-d/go-vitess.v1/sync2"
@@ -108,6 +110,11 @@ type Conn struct {
 	// It is set during the initial handshake.
 	UserData Getter
 
+	// ConnAttrs are the connection attributes sent by the client
+	// during the initial handshake, like ConnAttrProgramName. It is
+	// nil if the client sent none. It must not be modified.
+	ConnAttrs map[string]string
+
 	// SchemaName is the default database name to use. It is set
 	// during handshake, and by ComInitDb packets. Both client and
 	// servers maintain it.
@@ -122,6 +129,10 @@ type Conn struct {
 	// connection. It is unused for server-side connections.
 	flavor flavor
 
+	// semiSync is set on client replication connections that
+	// acknowledge events to a semi-sync master, see replication.go.
+	semiSync *semiSyncSlave
+
 	// StatusFlags are the status flags we will base our returned flags on.
 	// This is a bit field, with values documented in constants.go.
 	// An interesting value here would be ServerStatusAutocommit.
@@ -139,6 +150,12 @@ type Conn struct {
 	bufferedWriter *bufio.Writer
 	sequence       uint8
 
+	// Compressed protocol variables. The reader and writer are
+	// only set if compression is in use, see enableCompression.
+	compressedReader   *compressedReader
+	compressedWriter   *compressedWriter
+	compressedSequence uint8
+
 	// fields contains the fields definitions for an on-going
 	// streaming query. It is set by ExecuteStreamFetch, and
 	// cleared by the last FetchNext().  It is nil if no streaming
@@ -155,6 +172,72 @@ type Conn struct {
 	// currentEphemeralBuffer for tracking allocated temporary buffer for writes and reads respectively.
 	// It can be allocated from bufPool or heap and should be recycled in the same manner.
 	currentEphemeralBuffer *[]byte
+
+	// StatementID is the ID of the last prepared statement. It is
+	// incremented for each ComPrepare. It is only used by the server.
+	StatementID uint32
+
+	// PrepareData contains the prepared statements of this
+	// connection, indexed by statement ID. It is only used by the server.
+	PrepareData map[uint32]*PrepareData
+
+	// salt is the salt sent in the initial handshake. ComChangeUser
+	// uses it to validate the new user. It is only used by the server.
+	salt []byte
+
+	// sessionState contains the session state changes to send with
+	// the next OK packet, see session_track.go. It is only used by
+	// the server.
+	sessionState []byte
+
+	// connectTime is when the connection was accepted. It is only
+	// used by the server.
+	connectTime time.Time
+
+	// processMu protects the process list state below, which other
+	// go routines read and change, see processlist.go. It is only
+	// used by the server.
+	processMu    sync.Mutex
+	processQuery string
+	processSince time.Time
+	processUser  string
+	processDB    string
+	processAttrs map[string]string
+	// cancelQuery cancels the context of the running query, it is
+	// set by the Handler with SetCancelFunc.
+	cancelQuery context.CancelFunc
+	// queryKilled is set if the running query was killed before
+	// the Handler called SetCancelFunc.
+	queryKilled bool
+}
+
+// PrepareData contains the state of a server-side prepared statement.
+type PrepareData struct {
+	// StatementID is the ID returned to the client by ComPrepare.
+	StatementID uint32
+
+	// PrepareStmt is the query that was prepared. Its '?'
+	// placeholders are parsed as the bind variables v1, v2, ...
+	PrepareStmt string
+
+	// ParamsCount is the number of placeholders in PrepareStmt.
+	ParamsCount uint16
+
+	// ParamsType contains the type of each parameter, as sent by
+	// the client with the first ComStmtExecute.
+	ParamsType []int32
+
+	// ColumnNames contains the names of the result set columns
+	// returned to the client by ComPrepare.
+	ColumnNames []string
+
+	// BindVars contains the values of the parameters for the next
+	// execution. It is filled by ComStmtSendLongData and ComStmtExecute.
+	BindVars map[string]*querypb.BindVariable
+
+	// cursor has the rows not fetched yet by ComStmtFetch, if the
+	// last execution opened a cursor.
+	cursor *sqltypes.Result
 }
 
 // bufPool is used to allocate and free buffers in an efficient way.
@@ -180,9 +263,11 @@ func newConn(conn net.Conn) *Conn {
 // size for reads.
 func newServerConn(conn net.Conn, listener *Listener) *Conn {
 	c := &Conn{
-		conn:     conn,
-		listener: listener,
-		closed:   sync2.NewAtomicBool(false),
+		conn:        conn,
+		listener:    listener,
+		closed:      sync2.NewAtomicBool(false),
+		PrepareData: make(map[uint32]*PrepareData),
+		connectTime: time.Now(),
 	}
 	if listener.connReadBufferSize > 0 {
 		c.bufferedReader = bufio.NewReaderSize(conn, listener.connReadBufferSize)
@@ -194,7 +279,11 @@ func newServerConn(conn net.Conn, listener *Listener) *Conn {
 // be terminated by a call to flush.
 func (c *Conn) startWriterBuffering() {
 	c.bufferedWriter = writersPool.Get().(*bufio.Writer)
-	c.bufferedWriter.Reset(c.conn)
+	if c.compressedWriter != nil {
+		c.bufferedWriter.Reset(c.compressedWriter)
+	} else {
+		c.bufferedWriter.Reset(c.conn)
+	}
 }
 
 // flush flushes the written data to the socket.
@@ -210,7 +299,13 @@ func (c *Conn) flush() error {
 		c.bufferedWriter = nil
 	}()
 
-	return c.bufferedWriter.Flush()
+	if err := c.bufferedWriter.Flush(); err != nil {
+		return err
+	}
+	if c.compressedWriter != nil {
+		return c.compressedWriter.Flush()
+	}
+	return nil
 }
 
 // getWriter returns the current writer. It may be either
@@ -219,18 +314,32 @@ func (c *Conn) getWriter() io.Writer {
 	if c.bufferedWriter != nil {
 		return c.bufferedWriter
 	}
+	if c.compressedWriter != nil {
+		return c.compressedWriter
+	}
 	return c.conn
 }
 
 // getReader returns reader for connection. It can be *bufio.Reader or net.Conn
-// depending on which buffer size was passed to newServerConn.
+// depending on which buffer size was passed to newServerConn, or a
+// compressedReader on top of them if compression is in use.
 func (c *Conn) getReader() io.Reader {
+	if c.compressedReader != nil {
+		return c.compressedReader
+	}
 	if c.bufferedReader != nil {
 		return c.bufferedReader
 	}
 	return c.conn
 }
 
+// resetSequence resets the packet sequences. It is called at the
+// start of each new command.
+func (c *Conn) resetSequence() {
+	c.sequence = 0
+	c.compressedSequence = 0
+}
+
 func (c *Conn) readHeaderFrom(r io.Reader) (int, error) {
 	var header [4]byte
 	// Note io.ReadFull will return two different types of errors:
@@ -253,7 +362,12 @@ func (c *Conn) readHeaderFrom(r io.Reader) (int, error) {
 	}
 
 	sequence := uint8(header[3])
-	if sequence != c.sequence {
+	if c.compressedReader != nil {
+		// With compression, the compressed packets carry the
+		// sequence that is checked. As MySQL does, we don't
+		// check the regular packets one, and just follow it.
+		c.sequence = sequence
+	} else if sequence != c.sequence {
 		return 0, vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid sequence, expected %v got %v", c.sequence, sequence)
 	}
 
@@ -500,6 +614,11 @@ func (c *Conn) writePacket(data []byte) error {
 				}
 				c.sequence++
 			}
+			if c.bufferedWriter == nil && c.compressedWriter != nil {
+				// Not buffering, the packet needs to be
+				// sent right away.
+				return c.compressedWriter.Flush()
+			}
 			return nil
 		}
 		index += packetLength
@@ -554,7 +673,7 @@ func (c *Conn) recycleWritePacket() {
 // Returns SQLError(CRServerGone) if it can't.
 func (c *Conn) writeComQuit() error {
 	// This is a new command, need to reset the sequence.
-	c.sequence = 0
+	c.resetSequence()
 
 	data := c.startEphemeralPacket(1)
 	data[0] = ComQuit
@@ -569,6 +688,15 @@ func (c *Conn) RemoteAddr() net.Addr {
 	return c.conn.RemoteAddr()
 }
 
+// TLSConnectionState returns the state of the TLS connection, and
+// false if the connection doesn't use TLS.
+func (c *Conn) TLSConnectionState() (tls.ConnectionState, bool) {
+	if conn, ok := c.conn.(*tls.Conn); ok {
+		return conn.ConnectionState(), true
+	}
+	return tls.ConnectionState{}, false
+}
+
 // ID returns the MySQL connection ID for this connection.
 func (c *Conn) ID() int64 {
 	return int64(c.ConnectionID)
@@ -602,18 +730,21 @@ func (c *Conn) IsClosed() bool {
 // Server -> Client.
 // This method returns a generic error, not a SQLError.
 func (c *Conn) writeOKPacket(affectedRows, lastInsertID uint64, flags uint16, warnings uint16) error {
+	flags, sessionState := c.okPacketSessionState(flags)
 	length := 1 + // OKPacket
 		lenEncIntSize(affectedRows) +
 		lenEncIntSize(lastInsertID) +
 		2 + // flags
-		2 // warnings
+		2 + // warnings
+		len(sessionState)
 	data := c.startEphemeralPacket(length)
 	pos := 0
 	pos = writeByte(data, pos, OKPacket)
 	pos = writeLenEncInt(data, pos, affectedRows)
 	pos = writeLenEncInt(data, pos, lastInsertID)
 	pos = writeUint16(data, pos, flags)
-	_ = writeUint16(data, pos, warnings)
+	pos = writeUint16(data, pos, warnings)
+	copy(data[pos:], sessionState)
 
 	return c.writeEphemeralPacket()
 }
@@ -624,18 +755,21 @@ func (c *Conn) writeOKPacket(affectedRows, lastInsertID uint64, flags uint16, wa
 // Server -> Client.
 // This method returns a generic error, not a SQLError.
 func (c *Conn) writeOKPacketWithEOFHeader(affectedRows, lastInsertID uint64, flags uint16, warnings uint16) error {
+	flags, sessionState := c.okPacketSessionState(flags)
 	length := 1 + // EOFPacket
 		lenEncIntSize(affectedRows) +
 		lenEncIntSize(lastInsertID) +
 		2 + // flags
-		2 // warnings
+		2 + // warnings
+		len(sessionState)
 	data := c.startEphemeralPacket(length)
 	pos := 0
 	pos = writeByte(data, pos, EOFPacket)
 	pos = writeLenEncInt(data, pos, affectedRows)
 	pos = writeLenEncInt(data, pos, lastInsertID)
 	pos = writeUint16(data, pos, flags)
-	_ = writeUint16(data, pos, warnings)
+	pos = writeUint16(data, pos, warnings)
+	copy(data[pos:], sessionState)
 
 	return c.writeEphemeralPacket()
 }
@@ -689,7 +823,7 @@ func (c *Conn) writeEOFPacket(flags uint16, warnings uint16) error {
 // handleNextCommand is called in the server loop to process
 // incoming packets.
 func (c *Conn) handleNextCommand(handler Handler) error {
-	c.sequence = 0
+	c.resetSequence()
 	data, err := c.readEphemeralPacket()
 	if err != nil {
 		// Don't log EOF errors. They cause too much spam.
@@ -713,6 +847,8 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 		db := c.parseComInitDB(data)
 		c.recycleReadPacket()
 		c.SchemaName = db
+		c.TrackSchema(db)
+		c.setProcessState("")
 		if err := c.writeOKPacket(0, 0, c.StatusFlags, 0); err != nil {
 			log.Errorf("Error writing ComInitDB result to %s: %v", c, err)
 			return err
@@ -746,9 +882,19 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 			if index != len(queries)-1 {
 				more = true
 			}
-			if err := c.execQuery(sql, handler, more); err != nil {
+			if len(queries) > 1 {
+				// The statements after the first one start
+				// with the blanks that follow the ';'.
+				sql = strings.TrimSpace(sql)
+			}
+			ok, err := c.execQuery(sql, handler, more)
+			if err != nil {
 				return err
 			}
+			if !ok {
+				// As MySQL does, stop at the first error.
+				break
+			}
 		}
 
 		timings.Record(queryTimingKey, queryStart)
@@ -799,6 +945,181 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 				return err
 			}
 		}
+	case ComPrepare:
+		query := c.parseComPrepare(data)
+		c.recycleReadPacket()
+
+		// flush is called at the end of this block.
+		c.startWriterBuffering()
+		if err := c.handleComPrepare(handler, query); err != nil {
+			return err
+		}
+		if err := c.flush(); err != nil {
+			log.Errorf("Conn %v: Flush() failed: %v", c.ID(), err)
+			return err
+		}
+	case ComStmtExecute:
+		// flush is called at the end of this block.
+		c.startWriterBuffering()
+
+		queryStart := time.Now()
+		stmtID, cursorType, err := c.parseComStmtExecute(data)
+		c.recycleReadPacket()
+
+		if err != nil {
+			// Drop the partially decoded parameters.
+			if prepare, ok := c.PrepareData[stmtID]; ok {
+				prepare.BindVars = make(map[string]*querypb.BindVariable, prepare.ParamsCount)
+			}
+			if werr := c.writeErrorPacketFromError(err); werr != nil {
+				// If we can't even write the error, we're done.
+				log.Errorf("Conn %v: Error writing statement execute error: %v", c, werr)
+				return werr
+			}
+		} else {
+			if err := c.execPrepareStatement(stmtID, cursorType, handler); err != nil {
+				return err
+			}
+			timings.Record(queryTimingKey, queryStart)
+		}
+
+		if err := c.flush(); err != nil {
+			log.Errorf("Conn %v: Flush() failed: %v", c.ID(), err)
+			return err
+		}
+	case ComStmtSendLongData:
+		stmtID, paramID, chunkData, ok := c.parseComStmtSendLongData(data)
+		if !ok {
+			log.Errorf("Got invalid ComStmtSendLongData packet from %s: %v", c, data)
+			c.recycleReadPacket()
+			return errors.New("invalid ComStmtSendLongData packet")
+		}
+		// There is no response to this command, errors are ignored.
+		if prepare, ok := c.PrepareData[stmtID]; ok && paramID < prepare.ParamsCount {
+			name := fmt.Sprintf("v%d", paramID+1)
+			if bv, ok := prepare.BindVars[name]; ok {
+				bv.Value = append(bv.Value, chunkData...)
+			} else {
+				prepare.BindVars[name] = sqltypes.BytesBindVariable(append([]byte(nil), chunkData...))
+			}
+		} else {
+			log.Warningf("Got ComStmtSendLongData for unknown statement %v or parameter %v from %s", stmtID, paramID, c)
+		}
+		c.recycleReadPacket()
+	case ComStmtClose:
+		stmtID, ok := c.parseComStmtClose(data)
+		c.recycleReadPacket()
+		// There is no response to this command.
+		if ok {
+			delete(c.PrepareData, stmtID)
+		}
+	case ComStmtReset:
+		stmtID, ok := c.parseComStmtReset(data)
+		c.recycleReadPacket()
+		prepare, found := c.PrepareData[stmtID]
+		if !ok || !found {
+			if err := c.writeErrorPacket(ERUnknownStmtHandler, SSUnknownSQLState, "unknown prepared statement handler (%v) given to mysqld_stmt_reset", stmtID); err != nil {
+				log.Errorf("Error writing error packet to %s: %s", c, err)
+				return err
+			}
+			return nil
+		}
+		prepare.BindVars = make(map[string]*querypb.BindVariable, prepare.ParamsCount)
+		prepare.cursor = nil
+		if err := c.writeOKPacket(0, 0, c.StatusFlags, 0); err != nil {
+			log.Errorf("Error writing ComStmtReset OK packet to %s: %v", c, err)
+			return err
+		}
+	case ComStmtFetch:
+		stmtID, numRows, ok := c.parseComStmtFetch(data)
+		c.recycleReadPacket()
+		if !ok {
+			log.Errorf("Got invalid ComStmtFetch packet from %s", c)
+			return errors.New("invalid ComStmtFetch packet")
+		}
+
+		// flush is called at the end of this block.
+		c.startWriterBuffering()
+		if err := c.fetchCursor(stmtID, numRows, handler); err != nil {
+			return err
+		}
+		if err := c.flush(); err != nil {
+			log.Errorf("Conn %v: Flush() failed: %v", c.ID(), err)
+			return err
+		}
+	case ComResetConnection:
+		c.recycleReadPacket()
+		c.resetConnection(handler)
+		if err := c.writeOKPacket(0, 0, c.StatusFlags, 0); err != nil {
+			log.Errorf("Error writing ComResetConnection result to %s: %v", c, err)
+			return err
+		}
+	case ComChangeUser:
+		user, authMethod, authResponse, dbName, ok := c.parseComChangeUser(data)
+		c.recycleReadPacket()
+		if !ok {
+			log.Errorf("Got unhandled packet (ComChangeUser) from %s, returning error: %v", c, data)
+			if err := c.writeErrorPacket(ERUnknownComError, SSUnknownComError, "error handling packet: %v", data); err != nil {
+				log.Errorf("Error writing error packet to %s: %s", c, err)
+				return err
+			}
+			return nil
+		}
+
+		// Authenticate the new user against the handshake salt.
+		// On failure, the error was sent, and as MySQL does,
+		// the connection is closed.
+		oldUser := c.User
+		if !c.listener.authenticate(c, c.salt, user, authMethod, authResponse) {
+			return errors.New("ComChangeUser failed")
+		}
+		if oldUser != "" {
+			connCountPerUser.Add(oldUser, -1)
+		}
+		if c.User != "" {
+			connCountPerUser.Add(c.User, 1)
+		}
+		c.SchemaName = dbName
+		c.resetConnection(handler)
+		c.setProcessState("")
+		if err := c.writeOKPacket(0, 0, c.StatusFlags, 0); err != nil {
+			log.Errorf("Error writing ComChangeUser result to %s: %v", c, err)
+			return err
+		}
+	case ComProcessKill:
+		connectionID, ok := c.parseComProcessKill(data)
+		c.recycleReadPacket()
+		if !ok {
+			log.Errorf("Got unhandled packet (ComProcessKill) from %s, returning error: %v", c, data)
+			if err := c.writeErrorPacket(ERUnknownComError, SSUnknownComError, "error handling packet: %v", data); err != nil {
+				log.Errorf("Error writing error packet to %s: %s", c, err)
+				return err
+			}
+			return nil
+		}
+
+		// As MySQL does, this kills the connection, not just
+		// its current query.
+		target := c.listener.Conn(connectionID)
+		if target == nil {
+			if err := c.writeErrorPacket(ERNoSuchThread, SSUnknownSQLState, "Unknown thread id: %v", connectionID); err != nil {
+				log.Errorf("Error writing error packet to %s: %s", c, err)
+				return err
+			}
+			return nil
+		}
+		if !c.canKill(target) {
+			if err := c.writeErrorPacket(ERKillDenied, SSUnknownSQLState, "You are not owner of thread %v", connectionID); err != nil {
+				log.Errorf("Error writing error packet to %s: %s", c, err)
+				return err
+			}
+			return nil
+		}
+		target.Kill()
+		if err := c.writeOKPacket(0, 0, c.StatusFlags, 0); err != nil {
+			log.Errorf("Error writing ComProcessKill result to %s: %v", c, err)
+			return err
+		}
 	default:
 		log.Errorf("Got unhandled packet (default) from %s, returning error: %v", c, data)
 		c.recycleReadPacket()
@@ -811,7 +1132,23 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 	return nil
 }
 
-func (c *Conn) execQuery(query string, handler Handler, more bool) error {
+// resetConnection drops the session state of the connection, for
+// ComResetConnection and ComChangeUser: the prepared statements, and
+// what the handler keeps in ClientData.
+func (c *Conn) resetConnection(handler Handler) {
+	c.PrepareData = make(map[uint32]*PrepareData)
+	handler.ResetConnection(c)
+}
+
+// execQuery runs a query, and sends its results to the client. If more
+// is set, ServerMoreResultsExists is set in the final packet. It returns
+// false if the query failed and an error packet was sent, in which case
+// the next statements of a multi-statement query must not be run. The
+// returned error is set if the connection is no longer usable.
+func (c *Conn) execQuery(query string, handler Handler, more bool) (bool, error) {
+	c.setProcessState(query)
+	defer c.setProcessState("")
+
 	fieldSent := false
 	// sendFinished is set if the response should just be an OK packet.
 	sendFinished := false
@@ -857,30 +1194,244 @@ func (c *Conn) execQuery(query string, handler Handler, more bool) error {
 		if werr := c.writeErrorPacketFromError(err); werr != nil {
 			// If we can't even write the error, we're done.
 			log.Errorf("Error writing query error to %s: %v", c, werr)
+			return false, werr
+		}
+		return false, nil
+	}
+
+	if err != nil {
+		// We can't send an error in the middle of a stream.
+		// All we can do is abort the send, which will cause a 2013.
+		log.Errorf("Error in the middle of a stream to %s: %v", c, err)
+		return false, err
+	}
+
+	// Send the end packet only sendFinished is false (results were streamed).
+	// In this case the affectedRows and lastInsertID are always 0 since it
+	// was a read operation.
+	if !sendFinished {
+		if err := c.writeEndResult(more, 0, 0, handler.WarningCount(c)); err != nil {
+			log.Errorf("Error writing result to %s: %v", c, err)
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// handleComPrepare registers a new prepared statement for query,
+// and sends its description back to the client.
+func (c *Conn) handleComPrepare(handler Handler, query string) error {
+	// The parser turns the '?' placeholders into the bind
+	// variables :v1, :v2, ...
+	paramsCount := uint16(sqlparser.CountPositionalArgs(query))
+	bindVars := make(map[string]*querypb.BindVariable, paramsCount)
+	for i := uint16(0); i < paramsCount; i++ {
+		bindVars[fmt.Sprintf("v%d", i+1)] = &querypb.BindVariable{}
+	}
+
+	fields, err := handler.ComPrepare(c, query, bindVars)
+	if err != nil {
+		if werr := c.writeErrorPacketFromError(NewSQLErrorFromError(err)); werr != nil {
+			// If we can't even write the error, we're done.
+			log.Errorf("Conn %v: Error writing prepare error: %v", c, werr)
 			return werr
 		}
-	} else {
-		if err != nil {
-			// We can't send an error in the middle of a stream.
-			// All we can do is abort the send, which will cause a 2013.
-			log.Errorf("Error in the middle of a stream to %s: %v", c, err)
-			return err
+		return nil
+	}
+
+	c.StatementID++
+	prepare := &PrepareData{
+		StatementID: c.StatementID,
+		PrepareStmt: query,
+		ParamsCount: paramsCount,
+		ParamsType:  make([]int32, paramsCount),
+		BindVars:    make(map[string]*querypb.BindVariable, paramsCount),
+	}
+	c.PrepareData[prepare.StatementID] = prepare
+
+	if err := c.writePrepare(fields, prepare); err != nil {
+		log.Errorf("Conn %v: Error writing prepare result: %v", c, err)
+		return err
+	}
+	return nil
+}
+
+// execPrepareStatement executes a prepared statement whose bind
+// variables were filled in by parseComStmtExecute, and sends the
+// result using the binary protocol. If the client asked for a
+// read-only cursor, the rows are kept for ComStmtFetch instead.
+func (c *Conn) execPrepareStatement(stmtID uint32, cursorType byte, handler Handler) error {
+	prepare := c.PrepareData[stmtID]
+	defer func() {
+		// Allocate a new bind variable map for the next execution,
+		// as the handler may mutate the current one.
+		prepare.BindVars = make(map[string]*querypb.BindVariable, prepare.ParamsCount)
+	}()
+
+	c.setProcessState(prepare.PrepareStmt)
+	defer c.setProcessState("")
+
+	// A new execution closes the previous cursor.
+	prepare.cursor = nil
+	if cursorType&CursorTypeReadOnly != 0 {
+		return c.openCursor(prepare, handler)
+	}
+
+	fieldSent := false
+	// sendFinished is set if the response should just be an OK packet.
+	sendFinished := false
+	var fields []*querypb.Field
+
+	err := handler.ComStmtExecute(c, prepare, func(qr *sqltypes.Result) error {
+		if sendFinished {
+			// Failsafe: Unreachable if server is well-behaved.
+			return io.EOF
 		}
 
-		// Send the end packet only sendFinished is false (results were streamed).
-		// In this case the affectedRows and lastInsertID are always 0 since it
-		// was a read operation.
-		if !sendFinished {
-			if err := c.writeEndResult(more, 0, 0, handler.WarningCount(c)); err != nil {
-				log.Errorf("Error writing result to %s: %v", c, err)
+		if !fieldSent {
+			fieldSent = true
+
+			if len(qr.Fields) == 0 {
+				sendFinished = true
+				// A successful callback with no fields means that this was a
+				// DML or other write-only operation.
+				return c.writeOKPacket(qr.RowsAffected, qr.InsertID, c.StatusFlags, handler.WarningCount(c))
+			}
+			fields = qr.Fields
+			if err := c.writeFields(qr); err != nil {
 				return err
 			}
 		}
+
+		return c.writeBinaryRows(&sqltypes.Result{Fields: fields, Rows: qr.Rows})
+	})
+
+	// If no field was sent, we expect an error.
+	if !fieldSent {
+		// This is just a failsafe. Should never happen.
+		if err == nil || err == io.EOF {
+			err = NewSQLErrorFromError(errors.New("unexpected: query ended without no results and no error"))
+		}
+		if werr := c.writeErrorPacketFromError(err); werr != nil {
+			// If we can't even write the error, we're done.
+			log.Errorf("Error writing query error to %s: %v", c, werr)
+			return werr
+		}
+		return nil
+	}
+
+	if err != nil {
+		// We can't send an error in the middle of a stream.
+		// All we can do is abort the send, which will cause a 2013.
+		log.Errorf("Error in the middle of a stream to %s: %v", c, err)
+		return err
+	}
+
+	// Send the end packet only sendFinished is false (results were streamed).
+	if !sendFinished {
+		if err := c.writeEndResult(false, 0, 0, handler.WarningCount(c)); err != nil {
+			log.Errorf("Error writing result to %s: %v", c, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// openCursor executes a prepared statement for which the client asked
+// for a read-only cursor. The column definitions are sent right away,
+// and the rows are kept in the statement until ComStmtFetch asks for
+// them. Statements that don't return a result set get an OK packet,
+// as there is no cursor to open.
+func (c *Conn) openCursor(prepare *PrepareData, handler Handler) error {
+	var result *sqltypes.Result
+	err := handler.ComStmtExecute(c, prepare, func(qr *sqltypes.Result) error {
+		if result == nil {
+			result = &sqltypes.Result{
+				Fields:       qr.Fields,
+				RowsAffected: qr.RowsAffected,
+				InsertID:     qr.InsertID,
+			}
+		}
+		result.Rows = append(result.Rows, qr.Rows...)
+		return nil
+	})
+	if err == nil && result == nil {
+		// This is just a failsafe. Should never happen.
+		err = NewSQLErrorFromError(errors.New("unexpected: query ended without no results and no error"))
+	}
+	if err != nil {
+		if werr := c.writeErrorPacketFromError(err); werr != nil {
+			// If we can't even write the error, we're done.
+			log.Errorf("Error writing query error to %s: %v", c, werr)
+			return werr
+		}
+		return nil
+	}
+
+	if len(result.Fields) == 0 {
+		return c.writeOKPacket(result.RowsAffected, result.InsertID, c.StatusFlags, handler.WarningCount(c))
+	}
+	prepare.cursor = result
+	if err := c.sendColumnCount(uint64(len(result.Fields))); err != nil {
+		return err
+	}
+	for _, field := range result.Fields {
+		if err := c.writeColumnDefinition(field); err != nil {
+			return err
+		}
+	}
+	// The end of the column definitions tells the client that the
+	// cursor is open, so it is sent even with CapabilityClientDeprecateEOF.
+	return c.writeCursorEnd(c.StatusFlags|ServerStatusCursorExists, handler.WarningCount(c))
+}
+
+// fetchCursor sends up to numRows rows of the cursor of a prepared
+// statement, for a ComStmtFetch. The cursor is closed after its last
+// row was sent.
+func (c *Conn) fetchCursor(stmtID, numRows uint32, handler Handler) error {
+	prepare, ok := c.PrepareData[stmtID]
+	if !ok || prepare.cursor == nil {
+		if err := c.writeErrorPacket(ERStmtHasNoOpenCursor, SSUnknownSQLState, "The statement (%v) has no open cursor.", stmtID); err != nil {
+			log.Errorf("Error writing error packet to %s: %s", c, err)
+			return err
+		}
+		return nil
 	}
 
+	cursor := prepare.cursor
+	n := len(cursor.Rows)
+	if uint64(n) > uint64(numRows) {
+		n = int(numRows)
+	}
+	if err := c.writeBinaryRows(&sqltypes.Result{Fields: cursor.Fields, Rows: cursor.Rows[:n]}); err != nil {
+		log.Errorf("Error writing rows to %s: %v", c, err)
+		return err
+	}
+	cursor.Rows = cursor.Rows[n:]
+
+	flags := c.StatusFlags | ServerStatusCursorExists
+	if len(cursor.Rows) == 0 {
+		flags |= ServerStatusLastRowSent
+		prepare.cursor = nil
+	}
+	if err := c.writeCursorEnd(flags, handler.WarningCount(c)); err != nil {
+		log.Errorf("Error writing result to %s: %v", c, err)
+		return err
+	}
 	return nil
 }
 
+// writeCursorEnd writes the packet that ends the column definitions
+// of a cursor, or the rows sent for a ComStmtFetch: an EOF packet, or
+// an OK packet with an EOF header with CapabilityClientDeprecateEOF.
+func (c *Conn) writeCursorEnd(flags, warnings uint16) error {
+	if c.Capabilities&CapabilityClientDeprecateEOF == 0 {
+		return c.writeEOFPacket(flags, warnings)
+	}
+	return c.writeOKPacketWithEOFHeader(0, 0, flags, warnings)
+}
+
 //
 // Packet parsing methods, for generic packets.
 //
@@ -27,6 +27,16 @@ type ConnParams struct {
 	Charset    string `json:"charset"`
 	Flags      uint64 `json:"flags"`
 
+	// Flavor is the replication flavor to use, if it can't be
+	// detected from the server version. Only FilePosFlavorID can be
+	// set, for the servers that don't run with GTIDs.
+	Flavor string `json:"flavor"`
+
+	// ConnAttrs are the connection attributes sent to the server
+	// in the handshake, like ConnAttrProgramName, if it supports
+	// them.
+	ConnAttrs map[string]string `json:"conn_attrs"`
+
 	// The following SSL flags are only used when flags |= 2048
 	// is set (CapabilityClientSSL).
 	SslCa      string `json:"ssl_ca"`
@@ -54,6 +64,17 @@ func (cp *ConnParams) SslEnabled() bool {
 	return (cp.Flags & CapabilityClientSSL) > 0
 }
 
+// EnableCompression will set the flag to use the compressed protocol,
+// if the server supports it.
+func (cp *ConnParams) EnableCompression() {
+	cp.Flags |= CapabilityClientCompress
+}
+
+// CompressionEnabled returns if compression is enabled.
+func (cp *ConnParams) CompressionEnabled() bool {
+	return (cp.Flags & CapabilityClientCompress) > 0
+}
+
 // EnableClientFoundRows sets the flag for CLIENT_FOUND_ROWS.
 func (cp *ConnParams) EnableClientFoundRows() {
 	cp.Flags |= CapabilityClientFoundRows
@@ -196,6 +196,58 @@ func TestPackets(t *testing.T) {
 	verifyPacketComms(t, cConn, sConn, data)
 }
 
+// Write a packet on one side, read it on the other, check it's
+// correct, using the compressed protocol. readEphemeralPacketDirect
+// is not used, as it reads from the socket directly.
+func verifyCompressedPacketComms(t *testing.T, cConn, sConn *Conn, data []byte) {
+	verifyPacketCommsSpecific(t, cConn, data, useWritePacket, sConn.ReadPacket)
+	verifyPacketCommsSpecific(t, cConn, data, useWriteEphemeralPacketBuffered, sConn.ReadPacket)
+	verifyPacketCommsSpecific(t, cConn, data, useWriteEphemeralPacketDirect, sConn.ReadPacket)
+
+	verifyPacketCommsSpecific(t, cConn, data, useWritePacket, sConn.readEphemeralPacket)
+	sConn.recycleReadPacket()
+	verifyPacketCommsSpecific(t, cConn, data, useWriteEphemeralPacketBuffered, sConn.readEphemeralPacket)
+	sConn.recycleReadPacket()
+	verifyPacketCommsSpecific(t, cConn, data, useWriteEphemeralPacketDirect, sConn.readEphemeralPacket)
+	sConn.recycleReadPacket()
+}
+
+func TestCompressedPackets(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+	sConn.enableCompression()
+	cConn.enableCompression()
+
+	// Small one, not compressed.
+	data := []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	verifyCompressedPacketComms(t, cConn, sConn, data)
+
+	// 0 length packet
+	data = []byte{}
+	verifyCompressedPacketComms(t, cConn, sConn, data)
+
+	// Compressible data, bigger than the compressed writer buffer.
+	data = make([]byte, 3*connBufferSize)
+	data[0] = 0xab
+	data[3*connBufferSize-1] = 0xef
+	verifyCompressedPacketComms(t, cConn, sConn, data)
+
+	// Random data, that doesn't compress.
+	data = make([]byte, 1000)
+	crypto_rand.Read(data)
+	verifyCompressedPacketComms(t, cConn, sConn, data)
+
+	// Exactly the limit, two packets, in two compressed packets.
+	data = make([]byte, MaxPacketSize)
+	data[0] = 0xab
+	data[MaxPacketSize-1] = 0xef
+	verifyCompressedPacketComms(t, cConn, sConn, data)
+}
+
 func TestBasicPackets(t *testing.T) {
 	listener, sConn, cConn := createSocketPair(t)
 	defer func() {
@@ -267,6 +319,69 @@ func TestBasicPackets(t *testing.T) {
 }
 
 // Mostly a sanity check.
+func TestSessionTrackPackets(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+
+	// Without CapabilityClientSessionTrack, nothing is tracked.
+	sConn.TrackSchema("ks")
+	if err := sConn.writeOKPacket(0, 0, 2, 0); err != nil {
+		t.Fatalf("writeOKPacket failed: %v", err)
+	}
+	data, err := cConn.ReadPacket()
+	if err != nil {
+		t.Fatalf("cConn.ReadPacket failed: %v", err)
+	}
+	if want := []byte{OKPacket, 0, 0, 2, 0, 0, 0}; !bytes.Equal(data, want) {
+		t.Errorf("OK packet without session tracking: got %v, want %v", data, want)
+	}
+
+	// With it, an empty info string is always sent.
+	sConn.Capabilities |= CapabilityClientSessionTrack
+	if err := sConn.writeOKPacket(0, 0, 2, 0); err != nil {
+		t.Fatalf("writeOKPacket failed: %v", err)
+	}
+	data, err = cConn.ReadPacket()
+	if err != nil {
+		t.Fatalf("cConn.ReadPacket failed: %v", err)
+	}
+	if want := []byte{OKPacket, 0, 0, 2, 0, 0, 0, 0}; !bytes.Equal(data, want) {
+		t.Errorf("OK packet with no session state change: got %v, want %v", data, want)
+	}
+
+	// And the changes are sent once, with the status flag.
+	sConn.TrackSchema("ks")
+	sConn.TrackSystemVariable("autocommit", "OFF")
+	sConn.TrackTransactionState("T_______")
+	if err := sConn.writeOKPacketWithEOFHeader(0, 0, 2, 0); err != nil {
+		t.Fatalf("writeOKPacketWithEOFHeader failed: %v", err)
+	}
+	data, err = cConn.ReadPacket()
+	if err != nil {
+		t.Fatalf("cConn.ReadPacket failed: %v", err)
+	}
+	want := []byte{EOFPacket, 0, 0, 0x02, 0x40, 0, 0, 0,
+		33,
+		SessionTrackSchema, 3, 2, 'k', 's',
+		SessionTrackSystemVariables, 15, 10, 'a', 'u', 't', 'o', 'c', 'o', 'm', 'm', 'i', 't', 3, 'O', 'F', 'F',
+		SessionTrackTransactionState, 9, 8, 'T', '_', '_', '_', '_', '_', '_', '_',
+	}
+	if !bytes.Equal(data, want) {
+		t.Errorf("OK packet with session state changes: got %v, want %v", data, want)
+	}
+	_, _, statusFlags, _, err := parseOKPacket(data)
+	if err != nil || statusFlags != 2|ServerSessionStateChanged {
+		t.Errorf("parseOKPacket returned unexpected data: %v %v", statusFlags, err)
+	}
+	if sConn.sessionState != nil {
+		t.Errorf("session state changes were not cleared: %v", sConn.sessionState)
+	}
+}
+
 func TestEOFOrLengthEncodedIntFuzz(t *testing.T) {
 	for i := 0; i < 100; i++ {
 		bytes := make([]byte, rand.Intn(16)+1)
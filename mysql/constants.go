@@ -37,6 +37,11 @@ const (
 	// MysqlDialog uses the dialog plugin on the client side.
 	// It transmits data in the clear.
 	MysqlDialog = "dialog"
+
+	// MysqlCachingSha2Password uses a salt and transmits a SHA256 hash
+	// on the wire. If the server cannot validate the hash, the password
+	// is then sent over TLS, or encrypted with the server RSA key.
+	MysqlCachingSha2Password = "caching_sha2_password"
 )
 
 // Capability flags.
@@ -62,15 +67,18 @@ const (
 	// CLIENT_NO_SCHEMA 1 << 4
 	// Do not permit database.table.column. We do permit it.
 
-	// CLIENT_COMPRESS 1 << 5
-	// We do not support compression. CPU is usually our bottleneck.
+	// CapabilityClientCompress is CLIENT_COMPRESS.
+	// Use the compressed protocol. As CPU is usually our bottleneck,
+	// the server only advertises it if Listener.AllowCompression is set.
+	CapabilityClientCompress = 1 << 5
 
 	// CLIENT_ODBC 1 << 6
 	// No special behavior since 3.22.
 
-	// CLIENT_LOCAL_FILES 1 << 7
-	// Client can use LOCAL INFILE request of LOAD DATA|XML.
-	// We do not set it.
+	// CapabilityClientLocalFiles is CLIENT_LOCAL_FILES.
+	// Client can use LOCAL INFILE request of LOAD DATA|XML. The
+	// server only advertises it if Listener.AllowLocalInfile is set.
+	CapabilityClientLocalFiles = 1 << 7
 
 	// CLIENT_IGNORE_SPACE 1 << 8
 	// Parser can ignore spaces before '('.
@@ -125,16 +133,33 @@ const (
 	// Announces support for expired password extension.
 	// Not yet supported.
 
-	// CLIENT_SESSION_TRACK 1 << 23
+	// CapabilityClientSessionTrack is CLIENT_SESSION_TRACK.
 	// Can set SERVER_SESSION_STATE_CHANGED in the Status Flags
 	// and send session-state change data after a OK packet.
-	// Not yet supported.
+	CapabilityClientSessionTrack = 1 << 23
 
 	// CapabilityClientDeprecateEOF is CLIENT_DEPRECATE_EOF
 	// Expects an OK (instead of EOF) after the resultset rows of a Text Resultset.
 	CapabilityClientDeprecateEOF = 1 << 24
 )
 
+// Connection attributes commonly sent by the clients, when they
+// support CapabilityClientConnAttr.
+const (
+	// ConnAttrProgramName is the name of the client program. It is
+	// set by the application, not the driver.
+	ConnAttrProgramName = "program_name"
+
+	// ConnAttrPid is the process ID of the client program.
+	ConnAttrPid = "_pid"
+
+	// ConnAttrClientName is the name of the client driver.
+	ConnAttrClientName = "_client_name"
+
+	// ConnAttrClientVersion is the version of the client driver.
+	ConnAttrClientVersion = "_client_version"
+)
+
 // Packet types.
 // Originally found in include/mysql/mysql_com.h
 const (
@@ -147,18 +172,45 @@ const (
 	// ComQuery is COM_QUERY.
 	ComQuery = 0x03
 
+	// ComProcessKill is COM_PROCESS_KILL.
+	ComProcessKill = 0x0c
+
 	// ComPing is COM_PING.
 	ComPing = 0x0e
 
+	// ComChangeUser is COM_CHANGE_USER.
+	ComChangeUser = 0x11
+
 	// ComBinlogDump is COM_BINLOG_DUMP.
 	ComBinlogDump = 0x12
 
+	// ComPrepare is COM_PREPARE.
+	ComPrepare = 0x16
+
+	// ComStmtExecute is COM_STMT_EXECUTE.
+	ComStmtExecute = 0x17
+
+	// ComStmtSendLongData is COM_STMT_SEND_LONG_DATA.
+	ComStmtSendLongData = 0x18
+
+	// ComStmtClose is COM_STMT_CLOSE.
+	ComStmtClose = 0x19
+
+	// ComStmtReset is COM_STMT_RESET.
+	ComStmtReset = 0x1a
+
 	// ComSetOption is COM_SET_OPTION
 	ComSetOption = 0x1b
 
+	// ComStmtFetch is COM_STMT_FETCH.
+	ComStmtFetch = 0x1c
+
 	// ComBinlogDumpGTID is COM_BINLOG_DUMP_GTID.
 	ComBinlogDumpGTID = 0x1e
 
+	// ComResetConnection is COM_RESET_CONNECTION.
+	ComResetConnection = 0x1f
+
 	// OKPacket is the header of the OK packet.
 	OKPacket = 0x00
 
@@ -168,13 +220,27 @@ const (
 	// AuthSwitchRequestPacket is used to switch auth method.
 	AuthSwitchRequestPacket = 0xfe
 
+	// AuthMoreDataPacket is sent by the server during the auth
+	// negotiation to pass extra data to the client plugin.
+	AuthMoreDataPacket = 0x01
+
 	// ErrPacket is the header of the error packet.
 	ErrPacket = 0xff
 
+	// LocalInfilePacket is the header of the LOCAL INFILE request.
+	LocalInfilePacket = 0xfb
+
 	// NullValue is the encoded value of NULL.
 	NullValue = 0xfb
 )
 
+// Cursor type flags of ComStmtExecute.
+// Originally found in include/mysql/mysql_com.h
+const (
+	// CursorTypeReadOnly is CURSOR_TYPE_READ_ONLY.
+	CursorTypeReadOnly = 0x01
+)
+
 // Error codes for client-side errors.
 // Originally found in include/mysql/errmsg.h and
 // https://dev.mysql.com/doc/refman/5.7/en/error-messages-client.html
@@ -401,6 +467,7 @@ const (
 	ERIncorrectGlobalLocalVar      = 1238
 	ERWrongFKDef                   = 1239
 	ERKeyRefDoNotMatchTableRef     = 1240
+	ERUnknownStmtHandler           = 1243
 	ERCyclicReference              = 1245
 	ERCollationCharsetMismatch     = 1253
 	ERCantAggregate2Collations     = 1267
@@ -422,6 +489,7 @@ const (
 	ERQueryInterrupted             = 1317
 	ERTruncatedWrongValueForField  = 1366
 	ERDataTooLong                  = 1406
+	ERStmtHasNoOpenCursor          = 1421
 	ERDataOutOfRange               = 1690
 )
 
@@ -465,8 +533,14 @@ const (
 	// SSAccessDeniedError is ER_ACCESS_DENIED_ERROR
 	SSAccessDeniedError = "28000"
 
+	// SSQueryInterrupted is ER_QUERY_INTERRUPTED
+	SSQueryInterrupted = "70100"
+
 	// SSLockDeadlock is ER_LOCK_DEADLOCK
 	SSLockDeadlock = "40001"
+
+	// SSSyntaxErrorOrAccessViolation is ER_PARSE_ERROR
+	SSSyntaxErrorOrAccessViolation = "42000"
 )
 
 // Status flags. They are returned by the server in a few cases.
@@ -478,6 +552,39 @@ const (
 
 	// ServerMoreResultsExists is SERVER_MORE_RESULTS_EXISTS
 	ServerMoreResultsExists = 0x0008
+
+	// ServerStatusCursorExists is SERVER_STATUS_CURSOR_EXISTS.
+	ServerStatusCursorExists = 0x0040
+
+	// ServerStatusLastRowSent is SERVER_STATUS_LAST_ROW_SENT.
+	ServerStatusLastRowSent = 0x0080
+
+	// ServerSessionStateChanged is SERVER_SESSION_STATE_CHANGED.
+	ServerSessionStateChanged = 0x4000
+)
+
+// Session state change types, sent in OK packets if
+// CapabilityClientSessionTrack is set.
+// Originally found in include/mysql/mysql_com.h
+const (
+	// SessionTrackSystemVariables is SESSION_TRACK_SYSTEM_VARIABLES.
+	SessionTrackSystemVariables = 0x00
+
+	// SessionTrackSchema is SESSION_TRACK_SCHEMA.
+	SessionTrackSchema = 0x01
+
+	// SessionTrackStateChange is SESSION_TRACK_STATE_CHANGE.
+	SessionTrackStateChange = 0x02
+
+	// SessionTrackGtids is SESSION_TRACK_GTIDS.
+	SessionTrackGtids = 0x03
+
+	// SessionTrackTransactionCharacteristics is
+	// SESSION_TRACK_TRANSACTION_CHARACTERISTICS.
+	SessionTrackTransactionCharacteristics = 0x04
+
+	// SessionTrackTransactionState is SESSION_TRACK_TRANSACTION_STATE.
+	SessionTrackTransactionState = 0x05
 )
 
 // A few interesting character set values.
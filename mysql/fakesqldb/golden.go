@@ -0,0 +1,401 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakesqldb
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"testing"
+	"unicode/utf8"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/src-d/go-vitess.v1/mysql"
+	"gopkg.in/src-d/go-vitess.v1/sqltypes"
+
+	querypb "gopkg.in/src-d/go-vitess.v1/vt/proto/query"
+)
+
+// This file implements golden files: a Recorder runs the queries on a
+// real MySQL, and saves them with their results in a golden file. A
+// Replayer then serves the golden file, so the tests don't need to
+// register every query with AddQuery. The golden files of a test are
+// regenerated by running it with the -fakesqldb_record_socket flag.
+
+var (
+	recordSocket   = flag.String("fakesqldb_record_socket", "", "If set, the fakesqldb golden files are recorded from the MySQL listening on this unix socket, instead of being replayed.")
+	recordUser     = flag.String("fakesqldb_record_user", "root", "User to record the fakesqldb golden files with.")
+	recordPassword = flag.String("fakesqldb_record_password", "", "Password to record the fakesqldb golden files with.")
+)
+
+// recordMaxRows is the maximum number of rows of a recorded result.
+const recordMaxRows = 100000
+
+// goldenEntry is a query of a golden file, with its result or error.
+type goldenEntry struct {
+	Query        string           `json:"query"`
+	Fields       []*querypb.Field `json:"fields,omitempty"`
+	Rows         [][]goldenValue  `json:"rows,omitempty"`
+	RowsAffected uint64           `json:"rows_affected,omitempty"`
+	InsertID     uint64           `json:"insert_id,omitempty"`
+	Error        *mysql.SQLError  `json:"error,omitempty"`
+}
+
+// newGoldenEntry returns the entry of a query, which either returned
+// result or failed with sqlErr.
+func newGoldenEntry(query string, result *sqltypes.Result, sqlErr *mysql.SQLError) goldenEntry {
+	entry := goldenEntry{Query: query}
+	if sqlErr != nil {
+		entry.Error = mysql.NewSQLError(sqlErr.Number(), sqlErr.SQLState(), "%s", sqlErr.Message)
+		return entry
+	}
+	entry.Fields = result.Fields
+	entry.RowsAffected = result.RowsAffected
+	entry.InsertID = result.InsertID
+	for _, row := range result.Rows {
+		goldenRow := make([]goldenValue, len(row))
+		for i, v := range row {
+			goldenRow[i] = goldenValue{null: v.IsNull(), val: v.ToBytes()}
+		}
+		entry.Rows = append(entry.Rows, goldenRow)
+	}
+	return entry
+}
+
+// result returns the result of the query, or its error.
+func (entry *goldenEntry) result() (*sqltypes.Result, error) {
+	if entry.Error != nil {
+		return nil, entry.Error
+	}
+	result := &sqltypes.Result{
+		Fields:       entry.Fields,
+		RowsAffected: entry.RowsAffected,
+		InsertID:     entry.InsertID,
+	}
+	for _, goldenRow := range entry.Rows {
+		if len(goldenRow) != len(entry.Fields) {
+			return nil, fmt.Errorf("golden file entry for '%s' has a row of %v values for %v fields", entry.Query, len(goldenRow), len(entry.Fields))
+		}
+		row := make([]sqltypes.Value, len(goldenRow))
+		for i, v := range goldenRow {
+			if v.null {
+				row[i] = sqltypes.NULL
+				continue
+			}
+			row[i] = sqltypes.MakeTrusted(entry.Fields[i].Type, v.val)
+		}
+		result.Rows = append(result.Rows, row)
+	}
+	return result, nil
+}
+
+// goldenValue is a value of a golden file row. It is stored as null for
+// NULL, as a string if it is valid UTF-8, and as {"base64": "..."}
+// otherwise.
+type goldenValue struct {
+	null bool
+	val  []byte
+}
+
+// MarshalJSON is part of the json.Marshaler interface.
+func (v goldenValue) MarshalJSON() ([]byte, error) {
+	switch {
+	case v.null:
+		return []byte("null"), nil
+	case utf8.Valid(v.val):
+		return json.Marshal(string(v.val))
+	}
+	return json.Marshal(struct {
+		Base64 []byte `json:"base64"`
+	}{v.val})
+}
+
+// UnmarshalJSON is part of the json.Unmarshaler interface.
+func (v *goldenValue) UnmarshalJSON(data []byte) error {
+	switch {
+	case string(data) == "null":
+		*v = goldenValue{null: true}
+		return nil
+	case len(data) > 0 && data[0] == '{':
+		var encoded struct {
+			Base64 []byte `json:"base64"`
+		}
+		if err := json.Unmarshal(data, &encoded); err != nil {
+			return err
+		}
+		*v = goldenValue{val: encoded.Base64}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*v = goldenValue{val: []byte(s)}
+	return nil
+}
+
+// Recorder is a QueryHandler that runs the queries on a real MySQL, and
+// records them with their results, so they can be saved in a golden
+// file. Each connection to the DB uses its own MySQL connection, so
+// the session state, like transactions, is the same.
+type Recorder struct {
+	params *mysql.ConnParams
+
+	// mu protects the following fields.
+	mu      sync.Mutex
+	conns   map[uint32]*mysql.Conn
+	entries []goldenEntry
+}
+
+// NewRecorder returns a Recorder that runs the queries on the MySQL
+// params connects to.
+func NewRecorder(params *mysql.ConnParams) *Recorder {
+	return &Recorder{
+		params: params,
+		conns:  make(map[uint32]*mysql.Conn),
+	}
+}
+
+// HandleQuery is part of the QueryHandler interface.
+func (r *Recorder) HandleQuery(c *mysql.Conn, query string, callback func(*sqltypes.Result) error) error {
+	conn, err := r.conn(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := conn.ExecuteFetch(query, recordMaxRows, true /* wantfields */)
+	var sqlErr *mysql.SQLError
+	if err != nil {
+		// Only record the errors of the query, not the ones of
+		// the connection.
+		var ok bool
+		if sqlErr, ok = err.(*mysql.SQLError); !ok || mysql.IsConnErr(err) {
+			return err
+		}
+	}
+
+	r.mu.Lock()
+	r.entries = append(r.entries, newGoldenEntry(query, result, sqlErr))
+	r.mu.Unlock()
+
+	if sqlErr != nil {
+		return sqlErr
+	}
+	return callback(result)
+}
+
+// conn returns the MySQL connection of c, and opens it if needed.
+func (r *Recorder) conn(c *mysql.Conn) (*mysql.Conn, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if conn, ok := r.conns[c.ConnectionID]; ok {
+		return conn, nil
+	}
+	params := *r.params
+	if c.SchemaName != "" {
+		params.DbName = c.SchemaName
+	}
+	conn, err := mysql.Connect(context.Background(), &params)
+	if err != nil {
+		return nil, err
+	}
+	r.conns[c.ConnectionID] = conn
+	return conn, nil
+}
+
+// Save writes the recorded queries to a golden file.
+func (r *Recorder) Save(file string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	data, err := json.MarshalIndent(r.entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, append(data, '\n'), 0644)
+}
+
+// Close closes the MySQL connections.
+func (r *Recorder) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, conn := range r.conns {
+		conn.Close()
+		delete(r.conns, id)
+	}
+}
+
+// ReplayOptions sets how strictly a Replayer matches the queries with
+// the golden file.
+type ReplayOptions struct {
+	// OrderMatters requires the queries to be executed in the
+	// order they were recorded. Otherwise, a query gets the
+	// results recorded for it in order, and then the last one.
+	OrderMatters bool
+
+	// AllowUnexpected returns an empty result for the queries
+	// that are not in the golden file, or out of order. Otherwise,
+	// they return an error, and fail the test.
+	AllowUnexpected bool
+}
+
+// Replayer is a QueryHandler that serves the queries of a golden file
+// saved by a Recorder.
+type Replayer struct {
+	t    *testing.T
+	opts ReplayOptions
+
+	// mu protects the following fields.
+	mu      sync.Mutex
+	entries []goldenEntry
+	// next is the index of the next entry, if the order matters.
+	next int
+	// byQuery are the indexes of the entries of each lower case
+	// query, and used how many of them were used, if the order
+	// doesn't matter.
+	byQuery map[string][]int
+	used    map[string]int
+}
+
+// NewReplayer loads a golden file in a new Replayer. t is failed on
+// unexpected queries, unless opts allows them. It can be nil if
+// VerifyAllExecutedOrFail is not used.
+func NewReplayer(t *testing.T, file string, opts ReplayOptions) (*Replayer, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	r := &Replayer{
+		t:       t,
+		opts:    opts,
+		byQuery: make(map[string][]int),
+		used:    make(map[string]int),
+	}
+	if err := json.Unmarshal(data, &r.entries); err != nil {
+		return nil, fmt.Errorf("cannot parse golden file %v: %v", file, err)
+	}
+	for i, entry := range r.entries {
+		key := strings.ToLower(entry.Query)
+		r.byQuery[key] = append(r.byQuery[key], i)
+	}
+	return r, nil
+}
+
+// HandleQuery is part of the QueryHandler interface.
+func (r *Replayer) HandleQuery(c *mysql.Conn, query string, callback func(*sqltypes.Result) error) error {
+	entry, err := r.find(query)
+	if err != nil {
+		return err
+	}
+	result, err := entry.result()
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+// find returns the golden file entry to answer query with.
+func (r *Replayer) find(query string) (*goldenEntry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.opts.OrderMatters {
+		if r.next < len(r.entries) && r.entries[r.next].Query == query {
+			r.next++
+			return &r.entries[r.next-1], nil
+		}
+		expected := "nothing"
+		if r.next < len(r.entries) {
+			expected = r.entries[r.next].Query
+		}
+		return r.unexpected(query, fmt.Sprintf("(index=%v, expected %v)", r.next, expected))
+	}
+
+	key := strings.ToLower(query)
+	indexes := r.byQuery[key]
+	if len(indexes) == 0 {
+		return r.unexpected(query, "")
+	}
+	n := r.used[key]
+	r.used[key]++
+	if n >= len(indexes) {
+		n = len(indexes) - 1
+	}
+	return &r.entries[indexes[n]], nil
+}
+
+// unexpected returns what to answer a query that doesn't match the
+// golden file with.
+func (r *Replayer) unexpected(query, details string) (*goldenEntry, error) {
+	if r.opts.AllowUnexpected {
+		return &goldenEntry{Query: query}, nil
+	}
+	if r.t != nil {
+		r.t.Errorf("fakesqldb: got unexpected query %v: %v", details, query)
+	}
+	return nil, fmt.Errorf("query: '%s' is not in the golden file", query)
+}
+
+// VerifyAllExecutedOrFail checks that all the queries of the golden file
+// were executed. If not, it will let the test fail.
+func (r *Replayer) VerifyAllExecutedOrFail() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.opts.OrderMatters {
+		if r.next != len(r.entries) {
+			r.t.Errorf("fakesqldb: not all golden file queries were executed, next one: %v", r.entries[r.next].Query)
+		}
+		return
+	}
+	for key, indexes := range r.byQuery {
+		if r.used[key] < len(indexes) {
+			r.t.Errorf("fakesqldb: golden file query executed %v times, recorded %v times: %v", r.used[key], len(indexes), r.entries[indexes[0]].Query)
+		}
+	}
+}
+
+// UseGoldenFile makes db answer the queries with the golden file, see
+// Replayer. If the -fakesqldb_record_socket flag is set, db runs them on
+// that MySQL instead, and saves them to the golden file when it is
+// closed, see Recorder.
+func (db *DB) UseGoldenFile(file string, opts ReplayOptions) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if *recordSocket != "" {
+		db.recorder = NewRecorder(&mysql.ConnParams{
+			UnixSocket: *recordSocket,
+			Uname:      *recordUser,
+			Pass:       *recordPassword,
+			Charset:    "utf8",
+		})
+		db.goldenFile = file
+		db.Handler = db.recorder
+		return
+	}
+
+	replayer, err := NewReplayer(db.t, file, opts)
+	if err != nil {
+		db.t.Fatalf("NewReplayer failed: %v", err)
+	}
+	db.replayer = replayer
+	db.Handler = replayer
+}
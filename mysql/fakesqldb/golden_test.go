@@ -0,0 +1,116 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakesqldb
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/src-d/go-vitess.v1/mysql"
+	"gopkg.in/src-d/go-vitess.v1/sqltypes"
+)
+
+var goldenResult = sqltypes.MakeTestResult(
+	sqltypes.MakeTestFields("id|name|data", "int64|varchar|varbinary"),
+	"1|one|abc",
+	"2|null|\xff\xfe",
+)
+
+// goldenQueries runs the queries of the golden file tests on db, and
+// checks their results.
+func goldenQueries(t *testing.T, db *DB) {
+	conn, err := mysql.Connect(context.Background(), db.ConnParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	result, err := conn.ExecuteFetch("select * from t", 10, true)
+	if err != nil {
+		t.Fatalf("select failed: %v", err)
+	}
+	if !reflect.DeepEqual(result.Rows, goldenResult.Rows) || len(result.Fields) != 3 || result.Fields[2].Type != sqltypes.VarBinary {
+		t.Errorf("select returned:\n%v\nwant:\n%v", result, goldenResult)
+	}
+
+	_, err = conn.ExecuteFetch("insert into t values (3)", 10, true)
+	if sqlErr, ok := err.(*mysql.SQLError); !ok || sqlErr.Number() != mysql.ERDupEntry {
+		t.Errorf("insert returned %v, want a duplicate entry error", err)
+	}
+}
+
+func TestGoldenFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fakesqldb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := path.Join(dir, "golden.json")
+
+	// The MySQL to record from is another fakesqldb.
+	source := New(t)
+	defer source.Close()
+	source.AddQuery("select * from t", goldenResult)
+	source.AddRejectedQuery("insert into t values (3)", mysql.NewSQLError(mysql.ERDupEntry, mysql.SSDupKey, "Duplicate entry '3' for key 'PRIMARY'"))
+
+	db := New(t)
+	recorder := NewRecorder(source.ConnParams())
+	db.Handler = recorder
+	goldenQueries(t, db)
+	db.Close()
+	recorder.Close()
+	if err := recorder.Save(file); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	// Replay it, in order.
+	db = New(t)
+	replayer, err := NewReplayer(t, file, ReplayOptions{OrderMatters: true})
+	if err != nil {
+		t.Fatalf("NewReplayer failed: %v", err)
+	}
+	db.Handler = replayer
+	goldenQueries(t, db)
+	replayer.VerifyAllExecutedOrFail()
+	db.Close()
+
+	// Unexpected queries fail, unless they are allowed.
+	db = New(t)
+	defer db.Close()
+	replayer, err = NewReplayer(nil, file, ReplayOptions{})
+	if err != nil {
+		t.Fatalf("NewReplayer failed: %v", err)
+	}
+	db.Handler = replayer
+	conn, err := mysql.Connect(context.Background(), db.ConnParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if _, err := conn.ExecuteFetch("select unexpected", 10, true); err == nil {
+		t.Errorf("unexpected query didn't fail")
+	}
+	replayer.opts.AllowUnexpected = true
+	if _, err := conn.ExecuteFetch("select unexpected", 10, true); err != nil {
+		t.Errorf("allowed unexpected query failed: %v", err)
+	}
+}
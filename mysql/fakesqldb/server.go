@@ -31,6 +31,9 @@ import (
 
 	"gopkg.in/src-d/go-vitess.v1/mysql"
 	"gopkg.in/src-d/go-vitess.v1/sqltypes"
+	"gopkg.in/src-d/go-vitess.v1/vt/sqlparser"
+
+	querypb "gopkg.in/src-d/go-vitess.v1/vt/proto/query"
 )
 
 const appendEntry = -1
@@ -109,6 +112,15 @@ type DB struct {
 	// connections tracks all open connections.
 	// The key for the map is the value of mysql.Conn.ConnectionID.
 	connections map[uint32]*mysql.Conn
+
+	// This next set of fields is used with golden files, see
+	// UseGoldenFile.
+
+	// recorder is set when recording goldenFile.
+	recorder   *Recorder
+	goldenFile string
+	// replayer is set when replaying a golden file.
+	replayer *Replayer
 }
 
 // QueryHandler is the interface used by the DB to simulate executed queries
@@ -205,6 +217,16 @@ func (db *DB) Close() {
 
 	db.CloseAllConnections()
 
+	// Save the golden file, if recording it.
+	db.mu.Lock()
+	if db.recorder != nil {
+		if err := db.recorder.Save(db.goldenFile); err != nil {
+			db.t.Errorf("cannot save golden file %v: %v", db.goldenFile, err)
+		}
+		db.recorder.Close()
+	}
+	db.mu.Unlock()
+
 	tmpDir := path.Dir(db.socketFile)
 	os.RemoveAll(tmpDir)
 }
@@ -315,11 +337,38 @@ func (db *DB) ConnectionClosed(c *mysql.Conn) {
 	delete(db.connections, c.ConnectionID)
 }
 
+// ResetConnection is part of the mysql.Handler interface.
+func (db *DB) ResetConnection(c *mysql.Conn) {
+	if db.t != nil {
+		db.t.Logf("ResetConnection(%v): client %v", db.name, c.ConnectionID)
+	}
+}
+
 // ComQuery is part of the mysql.Handler interface.
 func (db *DB) ComQuery(c *mysql.Conn, query string, callback func(*sqltypes.Result) error) error {
 	return db.Handler.HandleQuery(c, query, callback)
 }
 
+// ComPrepare is part of the mysql.Handler interface.
+func (db *DB) ComPrepare(c *mysql.Conn, query string, bindVars map[string]*querypb.BindVariable) ([]*querypb.Field, error) {
+	return nil, nil
+}
+
+// ComStmtExecute is part of the mysql.Handler interface.
+// The bind variables are substituted in the prepared statement, and
+// the resulting query is handled like a ComQuery.
+func (db *DB) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
+	stmt, err := sqlparser.Parse(prepare.PrepareStmt)
+	if err != nil {
+		return err
+	}
+	query, err := sqlparser.NewParsedQuery(stmt).GenerateQuery(prepare.BindVars, nil)
+	if err != nil {
+		return err
+	}
+	return db.Handler.HandleQuery(c, query, callback)
+}
+
 // WarningCount is part of the mysql.Handler interface.
 func (db *DB) WarningCount(c *mysql.Conn) uint16 {
 	return 0
@@ -650,4 +699,7 @@ func (db *DB) VerifyAllExecutedOrFail() {
 	if db.expectedExecuteFetchIndex != len(db.expectedExecuteFetch) {
 		db.t.Errorf("%v: not all expected queries were executed. leftovers: %v", db.name, db.expectedExecuteFetch[db.expectedExecuteFetchIndex:])
 	}
+	if db.replayer != nil {
+		db.replayer.VerifyAllExecutedOrFail()
+	}
 }
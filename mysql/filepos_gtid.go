@@ -0,0 +1,166 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/src-d/go-vitess.v1/vt/proto/vtrpc"
+	"gopkg.in/src-d/go-vitess.v1/vt/vterrors"
+)
+
+// FilePosFlavorID is the string identifier for the file/position flavor,
+// used by servers that don't run with GTIDs. It is also the value to use
+// in ConnParams.Flavor to select it, as it cannot be auto-detected.
+const FilePosFlavorID = "FilePos"
+
+// parseFilePosGTID is registered as a GTID parser.
+func parseFilePosGTID(s string) (GTID, error) {
+	// Split into parts. The file name is not supposed to contain
+	// ':', but only the last one matters anyway.
+	i := strings.LastIndexByte(s, ':')
+	if i == -1 {
+		return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid FilePos GTID (%v): expecting file:pos", s)
+	}
+
+	pos, err := strconv.ParseUint(s[i+1:], 10, 32)
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid FilePos GTID (%v): expecting pos to be an integer", s)
+	}
+
+	return filePosGTID{
+		file: s[:i],
+		pos:  uint32(pos),
+	}, nil
+}
+
+// parseFilePosGTIDSet is registered as a GTIDSet parser.
+func parseFilePosGTIDSet(s string) (GTIDSet, error) {
+	gtid, err := parseFilePosGTID(s)
+	if err != nil {
+		return nil, err
+	}
+	return gtid.(filePosGTID), nil
+}
+
+// filePosGTID is a binlog file and a position in it. It is used both
+// as the GTID of a transaction, the position right after it, and as
+// the set of all the transactions up to that position.
+type filePosGTID struct {
+	file string
+	pos  uint32
+}
+
+// String implements GTID.String().
+func (gtid filePosGTID) String() string {
+	return fmt.Sprintf("%s:%d", gtid.file, gtid.pos)
+}
+
+// Flavor implements GTID.Flavor().
+func (gtid filePosGTID) Flavor() string {
+	return FilePosFlavorID
+}
+
+// SequenceDomain implements GTID.SequenceDomain().
+func (gtid filePosGTID) SequenceDomain() interface{} {
+	return nil
+}
+
+// SourceServer implements GTID.SourceServer().
+func (gtid filePosGTID) SourceServer() interface{} {
+	return nil
+}
+
+// SequenceNumber implements GTID.SequenceNumber().
+func (gtid filePosGTID) SequenceNumber() interface{} {
+	return nil
+}
+
+// GTIDSet implements GTID.GTIDSet().
+func (gtid filePosGTID) GTIDSet() GTIDSet {
+	return gtid
+}
+
+// ContainsGTID implements GTIDSet.ContainsGTID(). The binlog file
+// names only differ by their sequence number, see binlogFileLess.
+func (gtid filePosGTID) ContainsGTID(other GTID) bool {
+	if other == nil {
+		return true
+	}
+	filePosOther, ok := other.(filePosGTID)
+	if !ok {
+		return false
+	}
+	if filePosOther.file != gtid.file {
+		return binlogFileLess(filePosOther.file, gtid.file)
+	}
+	return filePosOther.pos <= gtid.pos
+}
+
+// binlogFileLess returns true if the binlog file a comes before b. The
+// sequence number after the last '.' is padded to 6 digits only, so
+// mysql-bin.1000000 comes after mysql-bin.999999: the numbers are
+// compared as such. Other names are compared as strings.
+func binlogFileLess(a, b string) bool {
+	i, j := strings.LastIndexByte(a, '.'), strings.LastIndexByte(b, '.')
+	if i != -1 && j != -1 && a[:i] == b[:j] {
+		na, erra := strconv.ParseUint(a[i+1:], 10, 64)
+		nb, errb := strconv.ParseUint(b[j+1:], 10, 64)
+		if erra == nil && errb == nil {
+			return na < nb
+		}
+	}
+	return a < b
+}
+
+// Contains implements GTIDSet.Contains().
+func (gtid filePosGTID) Contains(other GTIDSet) bool {
+	if other == nil {
+		return true
+	}
+	filePosOther, ok := other.(filePosGTID)
+	if !ok {
+		return false
+	}
+	return gtid.ContainsGTID(filePosOther)
+}
+
+// Equal implements GTIDSet.Equal().
+func (gtid filePosGTID) Equal(other GTIDSet) bool {
+	filePosOther, ok := other.(filePosGTID)
+	if !ok {
+		return false
+	}
+	return gtid == filePosOther
+}
+
+// AddGTID implements GTIDSet.AddGTID(). As the position of a transaction
+// is after all the previous ones, the result is the latest of the two.
+func (gtid filePosGTID) AddGTID(other GTID) GTIDSet {
+	filePosOther, ok := other.(filePosGTID)
+	if !ok || gtid.ContainsGTID(filePosOther) {
+		return gtid
+	}
+	return filePosOther
+}
+
+func init() {
+	gtidParsers[FilePosFlavorID] = parseFilePosGTID
+	gtidSetParsers[FilePosFlavorID] = parseFilePosGTIDSet
+}
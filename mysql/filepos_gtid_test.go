@@ -0,0 +1,122 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"testing"
+)
+
+func TestParseFilePosGTID(t *testing.T) {
+	input := "mysql-bin.000012:4567"
+	want := filePosGTID{file: "mysql-bin.000012", pos: 4567}
+
+	got, err := parseFilePosGTID(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != want {
+		t.Errorf("parseFilePosGTID(%#v) = %#v, want %#v", input, got, want)
+	}
+	if got.String() != input {
+		t.Errorf("%#v.String() = %#v, want %#v", got, got.String(), input)
+	}
+}
+
+func TestParseFilePosGTIDInvalid(t *testing.T) {
+	table := []string{
+		"",
+		"mysql-bin.000012",
+		"mysql-bin.000012:",
+		"mysql-bin.000012:x",
+		"mysql-bin.000012:-1",
+		"mysql-bin.000012:4294967296",
+	}
+
+	for _, input := range table {
+		_, err := parseFilePosGTID(input)
+		if err == nil {
+			t.Errorf("parseFilePosGTID(%#v): expected error, got none", input)
+		}
+	}
+}
+
+func TestFilePosGTIDContains(t *testing.T) {
+	gtid := filePosGTID{file: "mysql-bin.000012", pos: 4567}
+	table := []struct {
+		other GTIDSet
+		want  bool
+	}{
+		{nil, true},
+		{filePosGTID{file: "mysql-bin.000012", pos: 4567}, true},
+		{filePosGTID{file: "mysql-bin.000012", pos: 4566}, true},
+		{filePosGTID{file: "mysql-bin.000012", pos: 4568}, false},
+		{filePosGTID{file: "mysql-bin.000011", pos: 9999}, true},
+		{filePosGTID{file: "mysql-bin.000013", pos: 4}, false},
+		{filePosGTID{file: "mysql-bin.999999", pos: 4}, false},
+		{MariadbGTIDSet{{Domain: 0, Server: 1, Sequence: 1}}, false},
+	}
+
+	for _, tcase := range table {
+		if got := gtid.Contains(tcase.other); got != tcase.want {
+			t.Errorf("%v.Contains(%v) = %v, want %v", gtid, tcase.other, got, tcase.want)
+		}
+	}
+}
+
+func TestFilePosGTIDAddGTID(t *testing.T) {
+	gtid := filePosGTID{file: "mysql-bin.000012", pos: 4567}
+
+	later := filePosGTID{file: "mysql-bin.000013", pos: 4}
+	if got := gtid.AddGTID(later); !got.Equal(later) {
+		t.Errorf("%v.AddGTID(%v) = %v, want %v", gtid, later, got, later)
+	}
+	earlier := filePosGTID{file: "mysql-bin.000012", pos: 120}
+	if got := gtid.AddGTID(earlier); !got.Equal(gtid) {
+		t.Errorf("%v.AddGTID(%v) = %v, want %v", gtid, earlier, got, gtid)
+	}
+	// The sequence numbers are compared as numbers, not strings.
+	gtid = filePosGTID{file: "mysql-bin.999999", pos: 4567}
+	later = filePosGTID{file: "mysql-bin.1000000", pos: 4}
+	if got := gtid.AddGTID(later); !got.Equal(later) {
+		t.Errorf("%v.AddGTID(%v) = %v, want %v", gtid, later, got, later)
+	}
+	if got := later.AddGTID(gtid); !got.Equal(later) {
+		t.Errorf("%v.AddGTID(%v) = %v, want %v", later, gtid, got, later)
+	}
+	other := MariadbGTID{Domain: 0, Server: 1, Sequence: 1}
+	if got := gtid.AddGTID(other); !got.Equal(gtid) {
+		t.Errorf("%v.AddGTID(%v) = %v, want %v", gtid, other, got, gtid)
+	}
+}
+
+func TestFilePosGTIDDecodePosition(t *testing.T) {
+	input := "FilePos/mysql-bin.000012:4567"
+	pos, err := DecodePosition(input)
+	if err != nil {
+		t.Fatalf("DecodePosition(%#v) failed: %v", input, err)
+	}
+	want := Position{GTIDSet: filePosGTID{file: "mysql-bin.000012", pos: 4567}}
+	if !pos.Equal(want) {
+		t.Errorf("DecodePosition(%#v) = %v, want %v", input, pos, want)
+	}
+	if got := EncodePosition(pos); got != input {
+		t.Errorf("EncodePosition(%v) = %#v, want %#v", pos, got, input)
+	}
+	if !pos.AtLeast(Position{GTIDSet: filePosGTID{file: "mysql-bin.000011", pos: 4567}}) {
+		t.Errorf("%v.AtLeast() of a previous file returned false", pos)
+	}
+}
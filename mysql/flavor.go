@@ -46,8 +46,9 @@ const (
 // Flavors are auto-detected upon connection using the server version.
 // We have two major implementations (the main difference is the GTID
 // handling):
-// 1. Oracle MySQL 5.6, 5.7, 8.0, ...
+// 1. Oracle MySQL 5.6, 5.7, 8.0, ... with a variant for 8.0
 // 2. MariaDB 10.X
+// A third one, for servers without GTIDs, has to be selected explicitly.
 type flavor interface {
 	// masterGTIDSet returns the current GTIDSet of a server.
 	masterGTIDSet(c *Conn) (GTIDSet, error)
@@ -77,9 +78,9 @@ type flavor interface {
 	// replication position at which the slave will resume.
 	setSlavePositionCommands(pos Position) []string
 
-	// changeMasterArg returns the specific parameter to add to
+	// changeMasterArgs returns the specific parameters to add to
 	// a change master command.
-	changeMasterArg() string
+	changeMasterArgs() []string
 
 	// status returns the result of 'SHOW SLAVE STATUS',
 	// with parsed replication position.
@@ -99,9 +100,11 @@ type flavor interface {
 	disableBinlogPlaybackCommand() string
 }
 
-// fillFlavor fills in c.Flavor based on c.ServerVersion.
-// This is the same logic as the ConnectorJ java client. We try to recognize
-// MariaDB as much as we can, but default to MySQL.
+// fillFlavor fills in c.Flavor based on params.Flavor and c.ServerVersion.
+// The file/position flavor can't be detected, so it is only used if
+// params.Flavor asks for it. Otherwise, this is the same logic as the
+// ConnectorJ java client. We try to recognize MariaDB as much as we can,
+// but default to MySQL, using the 8.0 flavor for 8.0 and up.
 //
 // MariaDB note: the server version returned here might look like:
 // 5.5.5-10.0.21-MariaDB-...
@@ -109,7 +112,12 @@ type flavor interface {
 // Note on such servers, 'select version()' would return 10.0.21-MariaDB-...
 // as well (not matching what c.ServerVersion is, but matching after we remove
 // the prefix).
-func (c *Conn) fillFlavor() {
+func (c *Conn) fillFlavor(params *ConnParams) {
+	if params.Flavor == FilePosFlavorID {
+		c.flavor = newFilePosFlavor()
+		return
+	}
+
 	if strings.HasPrefix(c.ServerVersion, mariaDBReplicationHackPrefix) {
 		c.ServerVersion = c.ServerVersion[len(mariaDBReplicationHackPrefix):]
 		c.flavor = mariadbFlavor{}
@@ -121,9 +129,38 @@ func (c *Conn) fillFlavor() {
 		return
 	}
 
+	if serverVersionAtLeast(c.ServerVersion, 8, 0, 0) {
+		c.flavor = mysql80Flavor{
+			replicaKeywords: serverVersionAtLeast(c.ServerVersion, 8, 0, 22),
+		}
+		return
+	}
+
 	c.flavor = mysqlFlavor{}
 }
 
+// serverVersionAtLeast returns true if the numeric prefix of a server
+// version, like 8.0.22 in 8.0.22-log, is at least the given parts.
+func serverVersionAtLeast(version string, parts ...int) bool {
+	if i := strings.IndexFunc(version, func(r rune) bool { return r != '.' && (r < '0' || r > '9') }); i != -1 {
+		version = version[:i]
+	}
+	versionParts := strings.Split(version, ".")
+	for i, part := range parts {
+		if i >= len(versionParts) {
+			return false
+		}
+		n, err := strconv.Atoi(versionParts[i])
+		if err != nil {
+			return false
+		}
+		if n != part {
+			return n > part
+		}
+	}
+	return true
+}
+
 //
 // The following methods are dependent on the flavor.
 // Only valid for client connections (will panic for server connections).
@@ -216,7 +253,7 @@ func (c *Conn) SetMasterCommand(params *ConnParams, masterHost string, masterPor
 	if params.SslKey != "" {
 		args = append(args, fmt.Sprintf("MASTER_SSL_KEY = '%s'", params.SslKey))
 	}
-	args = append(args, c.flavor.changeMasterArg())
+	args = append(args, c.flavor.changeMasterArgs()...)
 	return "CHANGE MASTER TO\n  " + strings.Join(args, ",\n  ")
 }
 
@@ -240,18 +277,25 @@ func resultToMap(qr *sqltypes.Result) (map[string]string, error) {
 	return result, nil
 }
 
-// parseSlaveStatus parses the common fields of SHOW SLAVE STATUS.
+// parseSlaveStatus parses the common fields of SHOW SLAVE STATUS,
+// or of SHOW REPLICA STATUS which renames some of them.
 func parseSlaveStatus(fields map[string]string) SlaveStatus {
+	field := func(name, replicaName string) string {
+		if value, ok := fields[replicaName]; ok {
+			return value
+		}
+		return fields[name]
+	}
 	status := SlaveStatus{
-		MasterHost:      fields["Master_Host"],
-		SlaveIORunning:  fields["Slave_IO_Running"] == "Yes",
-		SlaveSQLRunning: fields["Slave_SQL_Running"] == "Yes",
+		MasterHost:      field("Master_Host", "Source_Host"),
+		SlaveIORunning:  field("Slave_IO_Running", "Replica_IO_Running") == "Yes",
+		SlaveSQLRunning: field("Slave_SQL_Running", "Replica_SQL_Running") == "Yes",
 	}
-	parseInt, _ := strconv.ParseInt(fields["Master_Port"], 10, 0)
+	parseInt, _ := strconv.ParseInt(field("Master_Port", "Source_Port"), 10, 0)
 	status.MasterPort = int(parseInt)
 	parseInt, _ = strconv.ParseInt(fields["Connect_Retry"], 10, 0)
 	status.MasterConnectRetry = int(parseInt)
-	parseUint, _ := strconv.ParseUint(fields["Seconds_Behind_Master"], 10, 0)
+	parseUint, _ := strconv.ParseUint(field("Seconds_Behind_Master", "Seconds_Behind_Source"), 10, 0)
 	status.SecondsBehindMaster = uint(parseUint)
 	return status
 }
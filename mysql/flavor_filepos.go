@@ -0,0 +1,378 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+	"gopkg.in/src-d/go-vitess.v1/vt/proto/vtrpc"
+	"gopkg.in/src-d/go-vitess.v1/vt/vterrors"
+)
+
+// filePosFlavor implements the Flavor interface for MySQL servers that
+// don't run with GTIDs. The positions are binlog file names and offsets,
+// see filePosGTID. It has to be selected with ConnParams.Flavor.
+//
+// The binlog of such servers has no GTID events, so readBinlogEvent
+// makes them up: it sends one, with the position right after the
+// event, before each event that ends a transaction (XID_EVENT or
+// COMMIT), and before the statements run outside of a transaction
+// (DDLs). The binlog consumers can then track the position as usual.
+//
+// Replication can only be started and stopped, not repointed: the
+// commands that depend on GTIDs return "unsupported", which fails
+// when executed.
+type filePosFlavor struct {
+	// The following fields are the state of the binlog stream, used
+	// by readBinlogEvent.
+	format        BinlogFormat
+	file          string
+	inTransaction bool
+	savedEvent    BinlogEvent
+	savedAck      semiSyncAck
+}
+
+// newFilePosFlavor returns a new filePosFlavor.
+func newFilePosFlavor() flavor {
+	return &filePosFlavor{}
+}
+
+// masterGTIDSet is part of the Flavor interface.
+func (flv *filePosFlavor) masterGTIDSet(c *Conn) (GTIDSet, error) {
+	qr, err := c.ExecuteFetch("SHOW MASTER STATUS", 100, true /* wantfields */)
+	if err != nil {
+		return nil, err
+	}
+	resultMap, err := resultToMap(qr)
+	if err != nil {
+		return nil, err
+	}
+	if resultMap == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "SHOW MASTER STATUS returned no rows, is the binlog enabled?")
+	}
+	return parseFilePosGTIDSet(resultMap["File"] + ":" + resultMap["Position"])
+}
+
+func (flv *filePosFlavor) startSlaveCommand() string {
+	return "START SLAVE"
+}
+
+func (flv *filePosFlavor) startSlaveUntilAfter(pos Position) string {
+	gtid, ok := pos.GTIDSet.(filePosGTID)
+	if !ok {
+		return "unsupported"
+	}
+	return fmt.Sprintf("START SLAVE UNTIL MASTER_LOG_FILE = '%s', MASTER_LOG_POS = %d", gtid.file, gtid.pos)
+}
+
+func (flv *filePosFlavor) stopSlaveCommand() string {
+	return "STOP SLAVE"
+}
+
+// sendBinlogDumpCommand is part of the Flavor interface.
+func (flv *filePosFlavor) sendBinlogDumpCommand(c *Conn, slaveID uint32, startPos Position) error {
+	gtid, ok := startPos.GTIDSet.(filePosGTID)
+	if !ok {
+		return vterrors.Errorf(vtrpc.Code_INTERNAL, "startPos.GTIDSet is wrong type - expected filePosGTID, got: %#v", startPos.GTIDSet)
+	}
+
+	// The stream starts in this file, the ROTATE_EVENT the server
+	// sends first can't be parsed before the FORMAT_DESCRIPTION_EVENT.
+	flv.format = BinlogFormat{}
+	flv.file = gtid.file
+	flv.inTransaction = false
+	flv.savedEvent = nil
+	flv.savedAck = semiSyncAck{}
+	if c.semiSync != nil {
+		c.semiSync.ack = semiSyncAck{}
+	}
+	return c.WriteComBinlogDump(slaveID, gtid.file, gtid.pos, 0)
+}
+
+// readBinlogEvent is part of the Flavor interface.
+func (flv *filePosFlavor) readBinlogEvent(c *Conn) (BinlogEvent, error) {
+	if ev := flv.savedEvent; ev != nil {
+		flv.savedEvent = nil
+		if c.semiSync != nil {
+			c.semiSync.ack = flv.savedAck
+		}
+		return ev, nil
+	}
+
+	data, err := c.readBinlogEventPacket()
+	if err != nil {
+		return nil, err
+	}
+	ev := NewMysql56BinlogEvent(data)
+	if !ev.IsValid() {
+		// Let the caller deal with it.
+		return ev, nil
+	}
+
+	switch {
+	case ev.IsFormatDescription():
+		if flv.format, err = ev.Format(); err != nil {
+			return nil, err
+		}
+	case flv.format.IsZero():
+		// Only the first ROTATE_EVENT comes before the
+		// FORMAT_DESCRIPTION_EVENT.
+	case ev.IsRotate():
+		stripped, _, err := ev.StripChecksum(flv.format)
+		if err != nil {
+			return nil, err
+		}
+		_, flv.file = stripped.(mysql56BinlogEvent).Rotate(flv.format)
+	case ev.IsXID():
+		flv.inTransaction = false
+		return flv.gtidEvent(c, ev), nil
+	case ev.IsQuery():
+		stripped, _, err := ev.StripChecksum(flv.format)
+		if err != nil {
+			return nil, err
+		}
+		q, err := stripped.Query(flv.format)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case strings.EqualFold(q.SQL, "BEGIN"):
+			flv.inTransaction = true
+		case strings.EqualFold(q.SQL, "ROLLBACK"):
+			flv.inTransaction = false
+		case strings.EqualFold(q.SQL, "COMMIT"), !flv.inTransaction:
+			flv.inTransaction = false
+			return flv.gtidEvent(c, ev), nil
+		}
+	}
+	return ev, nil
+}
+
+// gtidEvent saves ev to be returned by the next readBinlogEvent, and
+// returns the made up GTID event that comes before it. A semi-sync ACK
+// requested by ev is only reported with ev.
+func (flv *filePosFlavor) gtidEvent(c *Conn, ev BinlogEvent) BinlogEvent {
+	flv.savedEvent = ev
+	if c.semiSync != nil {
+		flv.savedAck = c.semiSync.ack
+		c.semiSync.ack = semiSyncAck{}
+	}
+	return filePosGTIDEvent{
+		timestamp: ev.Timestamp(),
+		gtid: filePosGTID{
+			file: flv.file,
+			pos:  ev.(mysql56BinlogEvent).NextPosition(),
+		},
+	}
+}
+
+// resetReplicationCommands is part of the Flavor interface.
+func (flv *filePosFlavor) resetReplicationCommands() []string {
+	return []string{
+		"STOP SLAVE",
+		"RESET SLAVE ALL", // "ALL" makes it forget master host:port.
+		"RESET MASTER",
+	}
+}
+
+// setSlavePositionCommands is part of the Flavor interface.
+// The position can only be set when pointing to a master.
+func (flv *filePosFlavor) setSlavePositionCommands(pos Position) []string {
+	return []string{
+		"unsupported",
+	}
+}
+
+// changeMasterArgs is part of the Flavor interface.
+func (flv *filePosFlavor) changeMasterArgs() []string {
+	return []string{"unsupported"}
+}
+
+// status is part of the Flavor interface.
+func (flv *filePosFlavor) status(c *Conn) (SlaveStatus, error) {
+	qr, err := c.ExecuteFetch("SHOW SLAVE STATUS", 100, true /* wantfields */)
+	if err != nil {
+		return SlaveStatus{}, err
+	}
+	if len(qr.Rows) == 0 {
+		// The query returned no data, meaning the server
+		// is not configured as a slave.
+		return SlaveStatus{}, ErrNotSlave
+	}
+
+	resultMap, err := resultToMap(qr)
+	if err != nil {
+		return SlaveStatus{}, err
+	}
+
+	status := parseSlaveStatus(resultMap)
+	status.Position.GTIDSet, err = parseFilePosGTIDSet(resultMap["Relay_Master_Log_File"] + ":" + resultMap["Exec_Master_Log_Pos"])
+	if err != nil {
+		return SlaveStatus{}, vterrors.Wrapf(err, "SlaveStatus can't parse the file position (Relay_Master_Log_File: %#v, Exec_Master_Log_Pos: %#v)", resultMap["Relay_Master_Log_File"], resultMap["Exec_Master_Log_Pos"])
+	}
+	return status, nil
+}
+
+// waitUntilPositionCommand is part of the Flavor interface.
+func (flv *filePosFlavor) waitUntilPositionCommand(ctx context.Context, pos Position) (string, error) {
+	gtid, ok := pos.GTIDSet.(filePosGTID)
+	if !ok {
+		return "", vterrors.Errorf(vtrpc.Code_INTERNAL, "pos.GTIDSet is wrong type - expected filePosGTID, got: %#v", pos.GTIDSet)
+	}
+
+	// A timeout of 0 means wait indefinitely.
+	timeoutSeconds := 0
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout := time.Until(deadline)
+		if timeout <= 0 {
+			return "", vterrors.Errorf(vtrpc.Code_DEADLINE_EXCEEDED, "timed out waiting for position %v", pos)
+		}
+
+		// Only whole numbers of seconds are supported.
+		timeoutSeconds = int(timeout.Seconds())
+		if timeoutSeconds == 0 {
+			// We don't want a timeout <1.0s to truncate down to become infinite.
+			timeoutSeconds = 1
+		}
+	}
+
+	return fmt.Sprintf("SELECT MASTER_POS_WAIT('%s', %d, %d)", gtid.file, gtid.pos, timeoutSeconds), nil
+}
+
+// enableBinlogPlaybackCommand is part of the Flavor interface.
+func (flv *filePosFlavor) enableBinlogPlaybackCommand() string {
+	return ""
+}
+
+// disableBinlogPlaybackCommand is part of the Flavor interface.
+func (flv *filePosFlavor) disableBinlogPlaybackCommand() string {
+	return ""
+}
+
+// filePosGTIDEvent is the GTID event made up by the filePosFlavor.
+// It implements BinlogEvent, but is only a GTID event.
+type filePosGTIDEvent struct {
+	timestamp uint32
+	gtid      filePosGTID
+}
+
+// IsValid implements BinlogEvent.IsValid().
+func (ev filePosGTIDEvent) IsValid() bool { return true }
+
+// IsFormatDescription implements BinlogEvent.IsFormatDescription().
+func (ev filePosGTIDEvent) IsFormatDescription() bool { return false }
+
+// IsQuery implements BinlogEvent.IsQuery().
+func (ev filePosGTIDEvent) IsQuery() bool { return false }
+
+// IsXID implements BinlogEvent.IsXID().
+func (ev filePosGTIDEvent) IsXID() bool { return false }
+
+// IsGTID implements BinlogEvent.IsGTID().
+func (ev filePosGTIDEvent) IsGTID() bool { return true }
+
+// IsRotate implements BinlogEvent.IsRotate().
+func (ev filePosGTIDEvent) IsRotate() bool { return false }
+
+// IsIntVar implements BinlogEvent.IsIntVar().
+func (ev filePosGTIDEvent) IsIntVar() bool { return false }
+
+// IsRand implements BinlogEvent.IsRand().
+func (ev filePosGTIDEvent) IsRand() bool { return false }
+
+// IsPreviousGTIDs implements BinlogEvent.IsPreviousGTIDs().
+func (ev filePosGTIDEvent) IsPreviousGTIDs() bool { return false }
+
+// IsTableMap implements BinlogEvent.IsTableMap().
+func (ev filePosGTIDEvent) IsTableMap() bool { return false }
+
+// IsWriteRows implements BinlogEvent.IsWriteRows().
+func (ev filePosGTIDEvent) IsWriteRows() bool { return false }
+
+// IsUpdateRows implements BinlogEvent.IsUpdateRows().
+func (ev filePosGTIDEvent) IsUpdateRows() bool { return false }
+
+// IsDeleteRows implements BinlogEvent.IsDeleteRows().
+func (ev filePosGTIDEvent) IsDeleteRows() bool { return false }
+
+// IsPseudo implements BinlogEvent.IsPseudo().
+func (ev filePosGTIDEvent) IsPseudo() bool { return false }
+
+// Timestamp implements BinlogEvent.Timestamp(). It is the one of the
+// event that follows.
+func (ev filePosGTIDEvent) Timestamp() uint32 { return ev.timestamp }
+
+// GTID implements BinlogEvent.GTID(). The GTID event never starts a
+// transaction.
+func (ev filePosGTIDEvent) GTID(BinlogFormat) (GTID, bool, error) {
+	return ev.gtid, false, nil
+}
+
+// StripChecksum implements BinlogEvent.StripChecksum(). There is no
+// checksum.
+func (ev filePosGTIDEvent) StripChecksum(BinlogFormat) (BinlogEvent, []byte, error) {
+	return ev, nil, nil
+}
+
+// Format implements BinlogEvent.Format().
+func (ev filePosGTIDEvent) Format() (BinlogFormat, error) {
+	return BinlogFormat{}, errNotFilePosGTIDEvent("FORMAT_DESCRIPTION_EVENT")
+}
+
+// Query implements BinlogEvent.Query().
+func (ev filePosGTIDEvent) Query(BinlogFormat) (Query, error) {
+	return Query{}, errNotFilePosGTIDEvent("QUERY_EVENT")
+}
+
+// IntVar implements BinlogEvent.IntVar().
+func (ev filePosGTIDEvent) IntVar(BinlogFormat) (byte, uint64, error) {
+	return 0, 0, errNotFilePosGTIDEvent("INTVAR_EVENT")
+}
+
+// Rand implements BinlogEvent.Rand().
+func (ev filePosGTIDEvent) Rand(BinlogFormat) (uint64, uint64, error) {
+	return 0, 0, errNotFilePosGTIDEvent("RAND_EVENT")
+}
+
+// PreviousGTIDs implements BinlogEvent.PreviousGTIDs().
+func (ev filePosGTIDEvent) PreviousGTIDs(BinlogFormat) (Position, error) {
+	return Position{}, errNotFilePosGTIDEvent("PREVIOUS_GTIDS_EVENT")
+}
+
+// TableID implements BinlogEvent.TableID().
+func (ev filePosGTIDEvent) TableID(BinlogFormat) uint64 {
+	return 0
+}
+
+// TableMap implements BinlogEvent.TableMap().
+func (ev filePosGTIDEvent) TableMap(BinlogFormat) (*TableMap, error) {
+	return nil, errNotFilePosGTIDEvent("TABLE_MAP_EVENT")
+}
+
+// Rows implements BinlogEvent.Rows().
+func (ev filePosGTIDEvent) Rows(BinlogFormat, *TableMap) (Rows, error) {
+	return Rows{}, errNotFilePosGTIDEvent("ROWS_EVENT")
+}
+
+// errNotFilePosGTIDEvent returns the error for accessing a made up GTID
+// event as another type of event.
+func errNotFilePosGTIDEvent(typ string) error {
+	return vterrors.Errorf(vtrpc.Code_INTERNAL, "made up FilePos GTID event is not a %s", typ)
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+func TestFilePosCommands(t *testing.T) {
+	flv := newFilePosFlavor()
+	pos := Position{GTIDSet: filePosGTID{file: "mysql-bin.000012", pos: 4567}}
+
+	want := "START SLAVE UNTIL MASTER_LOG_FILE = 'mysql-bin.000012', MASTER_LOG_POS = 4567"
+	if got := flv.startSlaveUntilAfter(pos); got != want {
+		t.Errorf("startSlaveUntilAfter(%v) = %#v, want %#v", pos, got, want)
+	}
+
+	want = "SELECT MASTER_POS_WAIT('mysql-bin.000012', 4567, 0)"
+	got, err := flv.waitUntilPositionCommand(context.Background(), pos)
+	if err != nil || got != want {
+		t.Errorf("waitUntilPositionCommand(%v) = %#v, %v, want %#v", pos, got, err, want)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	want = "SELECT MASTER_POS_WAIT('mysql-bin.000012', 4567, 9)"
+	got, err = flv.waitUntilPositionCommand(ctx, pos)
+	if err != nil || got != want {
+		t.Errorf("waitUntilPositionCommand(%v) = %#v, %v, want %#v", pos, got, err, want)
+	}
+
+	mariadbPos := Position{GTIDSet: MariadbGTIDSet{{Domain: 0, Server: 1, Sequence: 1}}}
+	if _, err := flv.waitUntilPositionCommand(context.Background(), mariadbPos); err == nil {
+		t.Errorf("waitUntilPositionCommand(%v) returned no error", mariadbPos)
+	}
+}
+
+func TestFilePosReadBinlogEvent(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+
+	f := NewMySQL56BinlogFormat()
+	s := NewFakeBinlogStream()
+	write := func(ev BinlogEvent) {
+		if err := sConn.writePacket(append([]byte{0}, ev.(mysql56BinlogEvent).Bytes()...)); err != nil {
+			t.Errorf("writePacket failed: %v", err)
+		}
+	}
+	query := func(sql string) Query {
+		return Query{Database: "vt_test", SQL: sql}
+	}
+
+	flv := newFilePosFlavor()
+	startPos := Position{GTIDSet: filePosGTID{file: "mysql-bin.000001", pos: 4}}
+	if err := flv.sendBinlogDumpCommand(cConn, 1, startPos); err != nil {
+		t.Fatalf("sendBinlogDumpCommand failed: %v", err)
+	}
+	if _, err := sConn.ReadPacket(); err != nil {
+		t.Fatalf("ReadPacket failed: %v", err)
+	}
+	go func() {
+		// The first ROTATE_EVENT is sent before the format is known,
+		// and points to where the stream starts.
+		s.LogPosition = 0
+		write(NewRotateEvent(f, s, 4, "mysql-bin.000001"))
+		write(NewFormatDescriptionEvent(f, s))
+		s.LogPosition = 200
+		write(NewQueryEvent(f, s, query("BEGIN")))
+		s.LogPosition = 300
+		write(NewQueryEvent(f, s, query("insert into t values(1)")))
+		s.LogPosition = 400
+		write(NewXIDEvent(f, s))
+		s.LogPosition = 500
+		write(NewQueryEvent(f, s, query("create table t2(id int)")))
+		s.LogPosition = 600
+		write(NewQueryEvent(f, s, query("BEGIN")))
+		s.LogPosition = 700
+		write(NewQueryEvent(f, s, query("ROLLBACK")))
+		s.LogPosition = 800
+		write(NewRotateEvent(f, s, 4, "mysql-bin.000002"))
+		s.LogPosition = 200
+		write(NewQueryEvent(f, s, query("BEGIN")))
+		s.LogPosition = 300
+		write(NewQueryEvent(f, s, query("COMMIT")))
+	}()
+
+	want := []string{
+		"rotate",
+		"format",
+		"query BEGIN",
+		"query insert into t values(1)",
+		"gtid mysql-bin.000001:400",
+		"xid",
+		"gtid mysql-bin.000001:500",
+		"query create table t2(id int)",
+		"query BEGIN",
+		"query ROLLBACK",
+		"rotate",
+		"query BEGIN",
+		"gtid mysql-bin.000002:300",
+		"query COMMIT",
+	}
+	var got []string
+	for len(got) < len(want) {
+		ev, err := flv.readBinlogEvent(cConn)
+		if err != nil {
+			t.Fatalf("readBinlogEvent failed: %v", err)
+		}
+		switch {
+		case ev.IsGTID():
+			gtid, hasBegin, err := ev.GTID(f)
+			if err != nil || hasBegin {
+				t.Fatalf("GTID() = %v, %v, %v", gtid, hasBegin, err)
+			}
+			got = append(got, fmt.Sprintf("gtid %v", gtid))
+		case ev.IsRotate():
+			got = append(got, "rotate")
+		case ev.IsFormatDescription():
+			got = append(got, "format")
+		case ev.IsXID():
+			got = append(got, "xid")
+		case ev.IsQuery():
+			ev, _, err = ev.StripChecksum(f)
+			if err != nil {
+				t.Fatalf("StripChecksum failed: %v", err)
+			}
+			q, err := ev.Query(f)
+			if err != nil {
+				t.Fatalf("Query failed: %v", err)
+			}
+			got = append(got, "query "+q.SQL)
+		default:
+			t.Fatalf("unexpected event: %v", ev)
+		}
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("readBinlogEvent returned:\n%v\nwant:\n%v", got, want)
+	}
+}
@@ -19,7 +19,6 @@ package mysql
 
 import (
 	"fmt"
-	"io"
 	"time"
 
 	"golang.org/x/net/context"
@@ -116,9 +115,9 @@ func (mariadbFlavor) setSlavePositionCommands(pos Position) []string {
 	}
 }
 
-// setSlavePositionCommands is part of the Flavor interface.
-func (mariadbFlavor) changeMasterArg() string {
-	return "MASTER_USE_GTID = current_pos"
+// changeMasterArgs is part of the Flavor interface.
+func (mariadbFlavor) changeMasterArgs() []string {
+	return []string{"MASTER_USE_GTID = current_pos"}
 }
 
 // status is part of the Flavor interface.
@@ -166,15 +165,9 @@ func (mariadbFlavor) waitUntilPositionCommand(ctx context.Context, pos Position)
 
 // readBinlogEvent is part of the Flavor interface.
 func (mariadbFlavor) readBinlogEvent(c *Conn) (BinlogEvent, error) {
-	result, err := c.ReadPacket()
+	data, err := c.readBinlogEventPacket()
 	if err != nil {
 		return nil, err
 	}
-	switch result[0] {
-	case EOFPacket:
-		return nil, NewSQLError(CRServerLost, SSUnknownSQLState, "%v", io.EOF)
-	case ErrPacket:
-		return nil, ParseErrorPacket(result)
-	}
-	return NewMariadbBinlogEvent(result[1:]), nil
+	return NewMariadbBinlogEvent(data), nil
 }
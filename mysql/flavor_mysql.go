@@ -18,7 +18,6 @@ package mysql
 
 import (
 	"fmt"
-	"io"
 	"time"
 
 	"golang.org/x/net/context"
@@ -83,14 +82,20 @@ func (mysqlFlavor) setSlavePositionCommands(pos Position) []string {
 	}
 }
 
-// setSlavePositionCommands is part of the Flavor interface.
-func (mysqlFlavor) changeMasterArg() string {
-	return "MASTER_AUTO_POSITION = 1"
+// changeMasterArgs is part of the Flavor interface.
+func (mysqlFlavor) changeMasterArgs() []string {
+	return []string{"MASTER_AUTO_POSITION = 1"}
 }
 
 // status is part of the Flavor interface.
 func (mysqlFlavor) status(c *Conn) (SlaveStatus, error) {
-	qr, err := c.ExecuteFetch("SHOW SLAVE STATUS", 100, true /* wantfields */)
+	return mysqlStatus(c, "SHOW SLAVE STATUS")
+}
+
+// mysqlStatus runs the given SHOW SLAVE STATUS or SHOW REPLICA STATUS
+// query, and parses its result.
+func mysqlStatus(c *Conn, query string) (SlaveStatus, error) {
+	qr, err := c.ExecuteFetch(query, 100, true /* wantfields */)
 	if err != nil {
 		return SlaveStatus{}, err
 	}
@@ -136,17 +141,11 @@ func (mysqlFlavor) waitUntilPositionCommand(ctx context.Context, pos Position) (
 
 // readBinlogEvent is part of the Flavor interface.
 func (mysqlFlavor) readBinlogEvent(c *Conn) (BinlogEvent, error) {
-	result, err := c.ReadPacket()
+	data, err := c.readBinlogEventPacket()
 	if err != nil {
 		return nil, err
 	}
-	switch result[0] {
-	case EOFPacket:
-		return nil, NewSQLError(CRServerLost, SSUnknownSQLState, "%v", io.EOF)
-	case ErrPacket:
-		return nil, ParseErrorPacket(result)
-	}
-	return NewMysql56BinlogEvent(result[1:]), nil
+	return NewMysql56BinlogEvent(data), nil
 }
 
 // enableBinlogPlaybackCommand is part of the Flavor interface.
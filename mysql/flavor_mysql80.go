@@ -0,0 +1,86 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"fmt"
+)
+
+// mysql80Flavor implements the Flavor interface for MySQL 8.0 and up.
+// It has the same GTIDs as MySQL 5.6, but:
+// - caching_sha2_password is the default auth plugin. Slaves that
+//   don't use SSL need the master public key to send their password.
+// - from 8.0.22, the SLAVE statements are renamed to REPLICA, and
+//   SHOW REPLICA STATUS renames its Master and Slave columns.
+type mysql80Flavor struct {
+	mysqlFlavor
+
+	// replicaKeywords is set if the server understands the REPLICA
+	// statements.
+	replicaKeywords bool
+}
+
+func (flv mysql80Flavor) startSlaveCommand() string {
+	if flv.replicaKeywords {
+		return "START REPLICA"
+	}
+	return "START SLAVE"
+}
+
+func (flv mysql80Flavor) startSlaveUntilAfter(pos Position) string {
+	if flv.replicaKeywords {
+		return fmt.Sprintf("START REPLICA UNTIL SQL_AFTER_GTIDS = '%s'", pos)
+	}
+	return fmt.Sprintf("START SLAVE UNTIL SQL_AFTER_GTIDS = '%s'", pos)
+}
+
+func (flv mysql80Flavor) stopSlaveCommand() string {
+	if flv.replicaKeywords {
+		return "STOP REPLICA"
+	}
+	return "STOP SLAVE"
+}
+
+// resetReplicationCommands is part of the Flavor interface.
+func (flv mysql80Flavor) resetReplicationCommands() []string {
+	resetSlave := "RESET SLAVE ALL"
+	if flv.replicaKeywords {
+		resetSlave = "RESET REPLICA ALL"
+	}
+	return []string{
+		flv.stopSlaveCommand(),
+		resetSlave,     // "ALL" makes it forget master host:port.
+		"RESET MASTER", // This will also clear gtid_executed and gtid_purged.
+		"SET GLOBAL rpl_semi_sync_master_enabled = false, GLOBAL rpl_semi_sync_slave_enabled = false", // semi-sync will be enabled if needed when slave is started.
+	}
+}
+
+// changeMasterArgs is part of the Flavor interface.
+func (mysql80Flavor) changeMasterArgs() []string {
+	return []string{
+		"MASTER_AUTO_POSITION = 1",
+		"GET_MASTER_PUBLIC_KEY = 1",
+	}
+}
+
+// status is part of the Flavor interface.
+func (flv mysql80Flavor) status(c *Conn) (SlaveStatus, error) {
+	if flv.replicaKeywords {
+		return mysqlStatus(c, "SHOW REPLICA STATUS")
+	}
+	return mysqlStatus(c, "SHOW SLAVE STATUS")
+}
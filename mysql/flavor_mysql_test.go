@@ -16,7 +16,10 @@ limitations under the License.
 
 package mysql
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 func TestMysql56SetMasterCommands(t *testing.T) {
 	params := &ConnParams{
@@ -73,3 +76,103 @@ func TestMysql56SetMasterCommandsSSL(t *testing.T) {
 		t.Errorf("mysqlFlavor.SetMasterCommands(%#v, %#v, %#v, %#v) = %#v, want %#v", params, masterHost, masterPort, masterConnectRetry, got, want)
 	}
 }
+
+func TestMysql80SetMasterCommands(t *testing.T) {
+	params := &ConnParams{
+		Uname: "username",
+		Pass:  "password",
+	}
+	masterHost := "localhost"
+	masterPort := 123
+	masterConnectRetry := 1234
+	want := `CHANGE MASTER TO
+  MASTER_HOST = 'localhost',
+  MASTER_PORT = 123,
+  MASTER_USER = 'username',
+  MASTER_PASSWORD = 'password',
+  MASTER_CONNECT_RETRY = 1234,
+  MASTER_AUTO_POSITION = 1,
+  GET_MASTER_PUBLIC_KEY = 1`
+
+	conn := &Conn{flavor: mysql80Flavor{}}
+	got := conn.SetMasterCommand(params, masterHost, masterPort, masterConnectRetry)
+	if got != want {
+		t.Errorf("mysql80Flavor.SetMasterCommand(%#v, %#v, %#v, %#v) = %#v, want %#v", params, masterHost, masterPort, masterConnectRetry, got, want)
+	}
+}
+
+func TestMysql80ReplicaCommands(t *testing.T) {
+	conn := &Conn{flavor: mysql80Flavor{replicaKeywords: true}}
+	if got, want := conn.StartSlaveCommand(), "START REPLICA"; got != want {
+		t.Errorf("StartSlaveCommand() = %#v, want %#v", got, want)
+	}
+	if got, want := conn.StopSlaveCommand(), "STOP REPLICA"; got != want {
+		t.Errorf("StopSlaveCommand() = %#v, want %#v", got, want)
+	}
+	want := []string{
+		"STOP REPLICA",
+		"RESET REPLICA ALL",
+		"RESET MASTER",
+		"SET GLOBAL rpl_semi_sync_master_enabled = false, GLOBAL rpl_semi_sync_slave_enabled = false",
+	}
+	if got := conn.ResetReplicationCommands(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ResetReplicationCommands() = %#v, want %#v", got, want)
+	}
+
+	conn = &Conn{flavor: mysql80Flavor{}}
+	if got, want := conn.StartSlaveCommand(), "START SLAVE"; got != want {
+		t.Errorf("StartSlaveCommand() = %#v, want %#v", got, want)
+	}
+}
+
+func TestMysqlFillFlavor(t *testing.T) {
+	table := []struct {
+		version string
+		want    flavor
+	}{
+		{"5.6.40-log", mysqlFlavor{}},
+		{"5.7.26", mysqlFlavor{}},
+		{"8.0.16", mysql80Flavor{}},
+		{"8.0.22-0ubuntu0.20.04.2", mysql80Flavor{replicaKeywords: true}},
+		{"8.4.0", mysql80Flavor{replicaKeywords: true}},
+		{"10.0.21-MariaDB", mariadbFlavor{}},
+		{"5.5.5-10.3.13-MariaDB-log", mariadbFlavor{}},
+	}
+	for _, tcase := range table {
+		conn := &Conn{ServerVersion: tcase.version}
+		conn.fillFlavor(&ConnParams{})
+		if !reflect.DeepEqual(conn.flavor, tcase.want) {
+			t.Errorf("fillFlavor(%v) = %#v, want %#v", tcase.version, conn.flavor, tcase.want)
+		}
+	}
+}
+
+func TestParseSlaveStatusReplicaNames(t *testing.T) {
+	want := SlaveStatus{
+		MasterHost:          "master-host",
+		MasterPort:          3306,
+		MasterConnectRetry:  10,
+		SlaveIORunning:      true,
+		SlaveSQLRunning:     true,
+		SecondsBehindMaster: 12,
+	}
+	for _, fields := range []map[string]string{{
+		"Master_Host":           "master-host",
+		"Master_Port":           "3306",
+		"Connect_Retry":         "10",
+		"Slave_IO_Running":      "Yes",
+		"Slave_SQL_Running":     "Yes",
+		"Seconds_Behind_Master": "12",
+	}, {
+		"Source_Host":           "master-host",
+		"Source_Port":           "3306",
+		"Connect_Retry":         "10",
+		"Replica_IO_Running":    "Yes",
+		"Replica_SQL_Running":   "Yes",
+		"Seconds_Behind_Source": "12",
+	}} {
+		if got := parseSlaveStatus(fields); !reflect.DeepEqual(got, want) {
+			t.Errorf("parseSlaveStatus(%v) = %#v, want %#v", fields, got, want)
+		}
+	}
+}
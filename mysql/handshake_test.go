@@ -17,6 +17,8 @@ limitations under the License.
 package mysql
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
 	"io/ioutil"
 	"net"
 	"os"
@@ -90,6 +92,161 @@ func TestClearTextClientAuth(t *testing.T) {
 	conn.writeComQuit()
 }
 
+// TestCompressedConnection tests a client and a server negotiating
+// the compressed protocol.
+func TestCompressedConnection(t *testing.T) {
+	th := &testHandler{}
+
+	authServer := NewAuthServerStatic()
+	authServer.Entries["user1"] = []*AuthServerStaticEntry{
+		{Password: "password1"},
+	}
+
+	// Create the listener.
+	l, err := NewListener("tcp", ":0", authServer, th, 0, 0)
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	defer l.Close()
+	host := l.Addr().(*net.TCPAddr).IP.String()
+	port := l.Addr().(*net.TCPAddr).Port
+	go func() {
+		l.Accept()
+	}()
+
+	// Setup the right parameters.
+	params := &ConnParams{
+		Host:  host,
+		Port:  port,
+		Uname: "user1",
+		Pass:  "password1",
+	}
+	params.EnableCompression()
+
+	// The server doesn't allow compression, the client shouldn't use it.
+	ctx := context.Background()
+	conn, err := Connect(ctx, params)
+	if err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	if conn.Capabilities&CapabilityClientCompress != 0 {
+		t.Errorf("Connection is compressed but server doesn't allow it")
+	}
+	conn.writeComQuit()
+	conn.Close()
+
+	// Allow compression on the server side.
+	l.AllowCompression = true
+	conn, err = Connect(ctx, params)
+	if err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	defer conn.Close()
+	if conn.Capabilities&CapabilityClientCompress == 0 {
+		t.Errorf("Connection is not compressed")
+	}
+
+	// Run a few commands, with results.
+	for i := 0; i < 3; i++ {
+		result, err := conn.ExecuteFetch("select rows", 10000, true)
+		if err != nil {
+			t.Fatalf("ExecuteFetch failed: %v", err)
+		}
+		if !reflect.DeepEqual(result, selectRowsResult) {
+			t.Errorf("Got wrong result from ExecuteFetch(select rows): %v", result)
+		}
+		if err := conn.Ping(); err != nil {
+			t.Fatalf("Ping failed: %v", err)
+		}
+	}
+
+	// Send a ComQuit to avoid the error message on the server side.
+	conn.writeComQuit()
+}
+
+// TestCachingSha2ClientAuth tests the caching_sha2_password fast and
+// full authentications, using the RSA key exchange.
+func TestCachingSha2ClientAuth(t *testing.T) {
+	th := &testHandler{}
+
+	authServer := NewAuthServerStatic()
+	authServer.Method = MysqlCachingSha2Password
+	authServer.Entries["user1"] = []*AuthServerStaticEntry{
+		{MysqlNativePassword: "*668425423DB5193AF921380129F465A6425216D0"},
+	}
+
+	// Create the listener.
+	l, err := NewListener("tcp", ":0", authServer, th, 0, 0)
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	defer l.Close()
+	host := l.Addr().(*net.TCPAddr).IP.String()
+	port := l.Addr().(*net.TCPAddr).Port
+	go func() {
+		l.Accept()
+	}()
+
+	// Setup the right parameters.
+	params := &ConnParams{
+		Host:  host,
+		Port:  port,
+		Uname: "user1",
+		Pass:  "password1",
+	}
+
+	// Connection should fail, as the server needs the password
+	// and has no RSA key.
+	ctx := context.Background()
+	_, err = Connect(ctx, params)
+	if err == nil || !strings.Contains(err.Error(), "Cannot use caching_sha2_password full authentication over non-SSL connections") {
+		t.Fatalf("unexpected connection error: %v", err)
+	}
+
+	// Give the server a RSA key, full authentication should work.
+	l.CachingSha2PrivateKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey failed: %v", err)
+	}
+	testCachingSha2Connection(t, params)
+
+	// Now the server has cached the hash, and the fast
+	// authentication works, even without the RSA key.
+	l.CachingSha2PrivateKey = nil
+	testCachingSha2Connection(t, params)
+
+	// A wrong password fails the fast authentication.
+	params.Pass = "password2"
+	_, err = Connect(ctx, params)
+	if err == nil || !strings.Contains(err.Error(), "Access denied for user 'user1'") {
+		t.Fatalf("unexpected connection error: %v", err)
+	}
+}
+
+func testCachingSha2Connection(t *testing.T, params *ConnParams) {
+	ctx := context.Background()
+	conn, err := Connect(ctx, params)
+	if err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	defer conn.Close()
+	if conn.User != "user1" {
+		t.Errorf("Invalid conn.User, got %v was expecting user1", conn.User)
+	}
+
+	// Run a 'select rows' command with results.
+	result, err := conn.ExecuteFetch("select rows", 10000, true)
+	if err != nil {
+		t.Fatalf("ExecuteFetch failed: %v", err)
+	}
+	if !reflect.DeepEqual(result, selectRowsResult) {
+		t.Errorf("Got wrong result from ExecuteFetch(select rows): %v", result)
+	}
+
+	// Send a ComQuit to avoid the error message on the server side.
+	conn.writeComQuit()
+}
+
 // TestSSLConnection creates a server with TLS support, a client that
 // also has SSL support, and connects them.
 func TestSSLConnection(t *testing.T) {
@@ -155,6 +312,16 @@ func TestSSLConnection(t *testing.T) {
 		authServer.Method = MysqlClearPassword
 		testSSLConnectionClearText(t, params)
 	})
+
+	// Make sure caching_sha2_password full auth works over SSL.
+	t.Run("CachingSha2", func(t *testing.T) {
+		authServer.Method = MysqlCachingSha2Password
+		authServer.Entries["user1"] = []*AuthServerStaticEntry{
+			{MysqlNativePassword: "*668425423DB5193AF921380129F465A6425216D0"},
+		}
+		params.Pass = "password1"
+		testSSLConnectionBasics(t, params)
+	})
 }
 
 func testSSLConnectionClearText(t *testing.T, params *ConnParams) {
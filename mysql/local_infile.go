@@ -0,0 +1,117 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"io"
+
+	"gopkg.in/src-d/go-vitess.v1/vt/log"
+)
+
+// This file implements the server side of LOAD DATA LOCAL INFILE. When
+// the Handler runs such a statement for ComQuery, it asks the client
+// for the file with a LOCAL INFILE request:
+// - 1 byte: LocalInfilePacket.
+// - string<EOF>: the file name, as written in the statement.
+// The client then sends the content of the file, split in as many
+// packets as needed, followed by an empty packet. If the client cannot
+// read the file, it only sends the empty packet. The Handler then
+// returns the result of the statement, as usual.
+
+// RequestLocalInfile asks the client for the content of filename, and
+// returns a reader for it. It can only be called by the Handler while
+// running a ComQuery, and only if the client supports
+// CapabilityClientLocalFiles. The reader must be closed before the
+// Handler returns: Close consumes what the client still has to send,
+// so the connection can be used for the result.
+func (c *Conn) RequestLocalInfile(filename string) (io.ReadCloser, error) {
+	if c.Capabilities&CapabilityClientLocalFiles == 0 {
+		return nil, NewSQLError(ERNotAllowedCommand, SSUnknownSQLState, "The used command is not allowed with this MySQL version")
+	}
+
+	data := c.startEphemeralPacket(1 + len(filename))
+	pos := writeByte(data, 0, LocalInfilePacket)
+	writeEOFString(data, pos, filename)
+	if err := c.writeEphemeralPacket(); err != nil {
+		return nil, NewSQLError(CRServerGone, SSUnknownSQLState, "%v", err)
+	}
+
+	// The client won't send anything before it gets the request,
+	// which may still be buffered.
+	if c.bufferedWriter != nil {
+		if err := c.flush(); err != nil {
+			return nil, NewSQLError(CRServerGone, SSUnknownSQLState, "%v", err)
+		}
+		c.startWriterBuffering()
+	}
+	return &localInfileReader{c: c}, nil
+}
+
+// localInfileReader reads the content of a file sent by the client,
+// after a LOCAL INFILE request.
+type localInfileReader struct {
+	c *Conn
+
+	// data is what is left of the last packet.
+	data []byte
+
+	// err is set after the last packet, or on error. It is
+	// io.EOF if the whole file was read.
+	err error
+}
+
+// Read is part of the io.Reader interface.
+func (r *localInfileReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		r.next()
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+// Close is part of the io.Closer interface. It drops the rest of the
+// file, up to the final empty packet.
+func (r *localInfileReader) Close() error {
+	r.data = nil
+	for r.err == nil {
+		r.next()
+		r.data = nil
+	}
+	if r.err != io.EOF {
+		log.Errorf("Error reading LOCAL INFILE data from %s: %v", r.c, r.err)
+		return r.err
+	}
+	return nil
+}
+
+// next reads the next packet of the file into data. It sets err after
+// the final empty packet, or if the read failed.
+func (r *localInfileReader) next() {
+	data, err := r.c.readPacket()
+	switch {
+	case err != nil:
+		r.err = NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
+	case len(data) == 0:
+		r.err = io.EOF
+	default:
+		r.data = data
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestLocalInfile(t *testing.T) {
+	th := &testHandler{
+		localInfileRead: 5,
+	}
+
+	l, err := NewListener("tcp", ":0", &AuthServerNone{}, th, 0, 0)
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	defer l.Close()
+	l.AllowLocalInfile = true
+	go l.Accept()
+
+	host, port := getHostPort(t, l.Addr())
+	params := &ConnParams{
+		Host:  host,
+		Port:  port,
+		Flags: CapabilityClientLocalFiles,
+	}
+	c, err := Connect(context.Background(), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	// The server asks for the file.
+	if err := c.WriteComQuery("load local"); err != nil {
+		t.Fatalf("WriteComQuery failed: %v", err)
+	}
+	data, err := c.readPacket()
+	if err != nil {
+		t.Fatalf("readPacket failed: %v", err)
+	}
+	if got, want := string(data), "\xfbdata.csv"; got != want {
+		t.Fatalf("LOCAL INFILE request = %q, want %q", got, want)
+	}
+
+	// Send it in two packets, the handler reads part of it.
+	for _, packet := range []string{"a,b\n", "c,d\n", ""} {
+		if err := c.writePacket([]byte(packet)); err != nil {
+			t.Fatalf("writePacket(%q) failed: %v", packet, err)
+		}
+	}
+	result, _, _, err := c.ReadQueryResult(10, true)
+	if err != nil {
+		t.Fatalf("ReadQueryResult failed: %v", err)
+	}
+	if got, want := result.Rows[0][0].ToString(), "a,b\nc"; got != want {
+		t.Errorf("handler read %q, want %q", got, want)
+	}
+
+	// The connection is still in sync.
+	result, err = c.ExecuteFetch("select rows", 10000, true)
+	if err != nil || len(result.Rows) != 2 {
+		t.Fatalf("ExecuteFetch(select rows) returned %v %v", result, err)
+	}
+
+	// Without CapabilityClientLocalFiles, the handler gets an error.
+	params.Flags = 0
+	c2, err := Connect(context.Background(), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c2.Close()
+	_, err = c2.ExecuteFetch("load local", 10, true)
+	if sqlErr, ok := err.(*SQLError); !ok || sqlErr.Number() != ERNotAllowedCommand {
+		t.Errorf("load local without CapabilityClientLocalFiles returned %v, want ERNotAllowedCommand", err)
+	}
+}
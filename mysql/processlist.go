@@ -0,0 +1,229 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// This file implements the process list of the server. The Listener
+// keeps track of its open connections, so they can be listed, as
+// SHOW PROCESSLIST does, and killed, as KILL and COM_PROCESS_KILL do.
+// Killing a query cancels the context the Handler uses to run it,
+// see SetCancelFunc.
+
+// lastConnectionID is the last ID given to a server connection. It is
+// shared by all the Listeners, so the IDs are unique in the process,
+// even when listening on multiple sockets.
+var lastConnectionID uint32
+
+// nextConnectionID returns the ID to use for a new server connection.
+func nextConnectionID() uint32 {
+	return atomic.AddUint32(&lastConnectionID, 1)
+}
+
+const (
+	// ProcessCommandSleep is the command of an idle connection.
+	ProcessCommandSleep = "Sleep"
+
+	// ProcessCommandQuery is the command of a connection running
+	// a query.
+	ProcessCommandQuery = "Query"
+)
+
+// ProcessInfo describes what a server connection is doing, in the
+// terms of SHOW PROCESSLIST.
+type ProcessInfo struct {
+	// ID is the connection ID.
+	ID uint32
+
+	// User is the authenticated user, empty during the handshake.
+	User string
+
+	// Host is the address of the client.
+	Host string
+
+	// DB is the default database.
+	DB string
+
+	// Command is ProcessCommandQuery if a query is running,
+	// and ProcessCommandSleep otherwise.
+	Command string
+
+	// Since is when the connection entered its current state, that
+	// is when the current query started, or when the last one ended.
+	Since time.Time
+
+	// Query is the query being executed, if any.
+	Query string
+
+	// ConnAttrs are the connection attributes sent by the client,
+	// see Conn.ConnAttrs. It must not be modified.
+	ConnAttrs map[string]string
+}
+
+// setProcessState records the query the connection is executing, or
+// that it is idle if query is empty. The user, database and connection
+// attributes are saved at the same time, so ProcessInfo can report them
+// without racing with the connection go routine. Going idle clears the
+// cancel func of the last query.
+func (c *Conn) setProcessState(query string) {
+	c.processMu.Lock()
+	defer c.processMu.Unlock()
+	c.processQuery = query
+	c.processSince = time.Now()
+	c.processUser = c.User
+	c.processDB = c.SchemaName
+	c.processAttrs = c.ConnAttrs
+	c.cancelQuery = nil
+	c.queryKilled = false
+}
+
+// SetCancelFunc is called by the Handler with the function that cancels
+// the context of the query it is running, so KillQuery can interrupt
+// it. If the query was already killed, cancel is called right away.
+// The function is forgotten when the query ends.
+func (c *Conn) SetCancelFunc(cancel context.CancelFunc) {
+	c.processMu.Lock()
+	defer c.processMu.Unlock()
+	if c.queryKilled {
+		cancel()
+		return
+	}
+	c.cancelQuery = cancel
+}
+
+// ProcessInfo returns what the connection is doing. It can be called
+// from any go routine.
+func (c *Conn) ProcessInfo() ProcessInfo {
+	c.processMu.Lock()
+	defer c.processMu.Unlock()
+	command := ProcessCommandSleep
+	if c.processQuery != "" {
+		command = ProcessCommandQuery
+	}
+	since := c.processSince
+	if since.IsZero() {
+		// Still in the handshake.
+		since = c.connectTime
+	}
+	return ProcessInfo{
+		ID:      c.ConnectionID,
+		User:    c.processUser,
+		Host:    c.RemoteAddr().String(),
+		DB:      c.processDB,
+		Command: command,
+		Since:   since,
+		Query:   c.processQuery,
+
+		ConnAttrs: c.processAttrs,
+	}
+}
+
+// KillQuery interrupts the query the connection is executing, if any,
+// by cancelling its context. The connection stays open. It can be
+// called from any go routine.
+func (c *Conn) KillQuery() {
+	c.processMu.Lock()
+	defer c.processMu.Unlock()
+	if c.processQuery == "" {
+		return
+	}
+	if c.cancelQuery == nil {
+		// The Handler didn't start running the query yet.
+		c.queryKilled = true
+		return
+	}
+	c.cancelQuery()
+	c.cancelQuery = nil
+}
+
+// Kill interrupts the query the connection is executing, and closes
+// the connection. It can be called from any go routine.
+func (c *Conn) Kill() {
+	c.KillQuery()
+	c.Close()
+}
+
+// IsProcessAdmin returns true if a user in one of the given groups can
+// list and kill the connections of the other users, that is if one of
+// the groups is in adminGroups.
+func IsProcessAdmin(groups, adminGroups []string) bool {
+	for _, group := range groups {
+		for _, adminGroup := range adminGroups {
+			if group == adminGroup {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// canKill returns true if the user of the connection can kill target,
+// which must be one of its own connections, unless the user is in one
+// of the ProcessAdminGroups of the Listener.
+func (c *Conn) canKill(target *Conn) bool {
+	if target.ProcessInfo().User == c.User {
+		return true
+	}
+	if c.UserData == nil {
+		return false
+	}
+	return IsProcessAdmin(c.UserData.Get().GetGroups(), c.listener.ProcessAdminGroups)
+}
+
+// addConn registers a new server connection.
+func (l *Listener) addConn(c *Conn) {
+	l.connsMu.Lock()
+	defer l.connsMu.Unlock()
+	l.conns[c.ConnectionID] = c
+}
+
+// removeConn unregisters a closed server connection.
+func (l *Listener) removeConn(c *Conn) {
+	l.connsMu.Lock()
+	defer l.connsMu.Unlock()
+	delete(l.conns, c.ConnectionID)
+}
+
+// Conns returns the open connections of the Listener, sorted by
+// connection ID.
+func (l *Listener) Conns() []*Conn {
+	l.connsMu.Lock()
+	conns := make([]*Conn, 0, len(l.conns))
+	for _, c := range l.conns {
+		conns = append(conns, c)
+	}
+	l.connsMu.Unlock()
+
+	sort.Slice(conns, func(i, j int) bool {
+		return conns[i].ConnectionID < conns[j].ConnectionID
+	})
+	return conns
+}
+
+// Conn returns the open connection with the given ID, or nil if the
+// Listener has no such connection.
+func (l *Listener) Conn(connectionID uint32) *Conn {
+	l.connsMu.Lock()
+	defer l.connsMu.Unlock()
+	return l.conns[connectionID]
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// waitForQuery waits until the connection with the given ID runs query.
+func waitForQuery(t *testing.T, l *Listener, connectionID uint32, query string) {
+	for i := 0; i < 100; i++ {
+		if c := l.Conn(connectionID); c != nil && c.ProcessInfo().Query == query {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("connection %v never ran %q", connectionID, query)
+}
+
+func TestProcessList(t *testing.T) {
+	th := &testHandler{}
+
+	authServer := NewAuthServerStatic()
+	authServer.Entries["user1"] = []*AuthServerStaticEntry{{
+		Password: "password1",
+	}}
+	authServer.Entries["user2"] = []*AuthServerStaticEntry{{
+		Password: "password2",
+		Groups:   []string{"admins"},
+	}}
+	l, err := NewListener("tcp", ":0", authServer, th, 0, 0)
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	defer l.Close()
+	go l.Accept()
+
+	host, port := getHostPort(t, l.Addr())
+	params := &ConnParams{
+		Host:   host,
+		Port:   port,
+		Uname:  "user1",
+		Pass:   "password1",
+		DbName: "db1",
+	}
+	ctx := context.Background()
+	c1, err := Connect(ctx, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c1.Close()
+	c2, err := Connect(ctx, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c2.Close()
+
+	// Both connections are listed, and idle.
+	conns := l.Conns()
+	if len(conns) != 2 || conns[0].ConnectionID != c1.ConnectionID || conns[1].ConnectionID != c2.ConnectionID {
+		t.Fatalf("Conns() returned %v, want connections %v and %v", conns, c1.ConnectionID, c2.ConnectionID)
+	}
+	info := conns[0].ProcessInfo()
+	if info.ID != c1.ConnectionID || info.User != "user1" || info.DB != "db1" || info.Command != ProcessCommandSleep || info.Query != "" {
+		t.Errorf("ProcessInfo() = %+v, want an idle connection of user1 on db1", info)
+	}
+	if info.ConnAttrs != nil {
+		t.Errorf("ProcessInfo().ConnAttrs = %v, want nil", info.ConnAttrs)
+	}
+
+	// The connection attributes sent by the client are reported.
+	attrsParams := *params
+	attrsParams.ConnAttrs = map[string]string{
+		ConnAttrProgramName: "myprogram",
+		ConnAttrPid:         "1234",
+	}
+	c3, err := Connect(ctx, &attrsParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info = l.Conn(c3.ConnectionID).ProcessInfo()
+	if !reflect.DeepEqual(info.ConnAttrs, attrsParams.ConnAttrs) {
+		t.Errorf("ProcessInfo().ConnAttrs = %v, want %v", info.ConnAttrs, attrsParams.ConnAttrs)
+	}
+	if got, want := ConnAttrsProgram(info.ConnAttrs), "myprogram[1234]"; got != want {
+		t.Errorf("ConnAttrsProgram(%v) = %v, want %v", info.ConnAttrs, got, want)
+	}
+	c3.Close()
+
+	// KillQuery interrupts the query, the connection stays usable.
+	done := make(chan error)
+	go func() {
+		_, err := c1.ExecuteFetch("wait for kill", 10, false)
+		done <- err
+	}()
+	waitForQuery(t, l, c1.ConnectionID, "wait for kill")
+	if info := l.Conn(c1.ConnectionID).ProcessInfo(); info.Command != ProcessCommandQuery {
+		t.Errorf("ProcessInfo().Command = %v, want %v", info.Command, ProcessCommandQuery)
+	}
+	l.Conn(c1.ConnectionID).KillQuery()
+	err = <-done
+	if sqlErr, ok := err.(*SQLError); !ok || sqlErr.Number() != ERQueryInterrupted {
+		t.Fatalf("killed query returned %v, want ERQueryInterrupted", err)
+	}
+	if _, err := c1.ExecuteFetch("select rows", 10, false); err != nil {
+		t.Fatalf("ExecuteFetch after KillQuery failed: %v", err)
+	}
+
+	// ComProcessKill for an unknown connection fails.
+	sendComProcessKillFrom := func(c *Conn, connectionID uint32) []byte {
+		t.Helper()
+		c.resetSequence()
+		data := make([]byte, 5)
+		pos := writeByte(data, 0, ComProcessKill)
+		writeUint32(data, pos, connectionID)
+		if err := c.writePacket(data); err != nil {
+			t.Fatalf("writePacket(ComProcessKill) failed: %v", err)
+		}
+		data, err := c.ReadPacket()
+		if err != nil {
+			t.Fatalf("ReadPacket failed: %v", err)
+		}
+		return data
+	}
+	sendComProcessKill := func(connectionID uint32) []byte {
+		t.Helper()
+		return sendComProcessKillFrom(c2, connectionID)
+	}
+	if data := sendComProcessKill(0); data[0] != ErrPacket {
+		t.Fatalf("ComProcessKill(0) returned %v, want an error", data)
+	} else if err := ParseErrorPacket(data).(*SQLError); err.Number() != ERNoSuchThread {
+		t.Errorf("ComProcessKill(0) returned %v, want ERNoSuchThread", err)
+	}
+
+	// ComProcessKill interrupts the query and closes the connection.
+	go func() {
+		_, err := c1.ExecuteFetch("wait for kill", 10, false)
+		done <- err
+	}()
+	waitForQuery(t, l, c1.ConnectionID, "wait for kill")
+	if data := sendComProcessKill(c1.ConnectionID); data[0] != OKPacket {
+		t.Fatalf("ComProcessKill(%v) returned %v", c1.ConnectionID, data)
+	}
+	if err := <-done; err == nil {
+		t.Errorf("killed query didn't fail")
+	}
+	for i := 0; i < 100 && l.Conn(c1.ConnectionID) != nil; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if conns := l.Conns(); len(conns) != 1 || conns[0].ConnectionID != c2.ConnectionID {
+		t.Errorf("Conns() after kill returned %v, want only %v", conns, c2.ConnectionID)
+	}
+
+	// Only the admins can kill the connections of the other users.
+	adminParams := *params
+	adminParams.Uname = "user2"
+	adminParams.Pass = "password2"
+	c4, err := Connect(ctx, &adminParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c4.Close()
+	if data := sendComProcessKillFrom(c4, c2.ConnectionID); data[0] != ErrPacket {
+		t.Fatalf("ComProcessKill(%v) of another user returned %v, want an error", c2.ConnectionID, data)
+	} else if err := ParseErrorPacket(data).(*SQLError); err.Number() != ERKillDenied {
+		t.Errorf("ComProcessKill(%v) of another user returned %v, want ERKillDenied", c2.ConnectionID, err)
+	}
+	if l.Conn(c2.ConnectionID) == nil {
+		t.Errorf("ComProcessKill(%v) of another user closed the connection", c2.ConnectionID)
+	}
+	l.ProcessAdminGroups = []string{"admins"}
+	if data := sendComProcessKillFrom(c4, c2.ConnectionID); data[0] != OKPacket {
+		t.Fatalf("ComProcessKill(%v) from an admin returned %v", c2.ConnectionID, data)
+	}
+	for i := 0; i < 100 && l.Conn(c2.ConnectionID) != nil; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if conns := l.Conns(); len(conns) != 1 || conns[0].ConnectionID != c4.ConnectionID {
+		t.Errorf("Conns() after kill returned %v, want only %v", conns, c4.ConnectionID)
+	}
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/src-d/go-vitess.v1/vt/proto/vtrpc"
+	"gopkg.in/src-d/go-vitess.v1/vt/vterrors"
+)
+
+// This file implements the PROXY protocol, versions 1 and 2, as
+// described in https://www.haproxy.org/download/1.8/doc/proxy-protocol.txt
+// A load balancer in front of the server sends a header at the start
+// of each connection, with the address of the real client. As the
+// MySQL protocol starts with the server talking, the header is read
+// before anything else is done with the connection.
+
+const (
+	// proxyProtocolV1MaxLength is the maximum length of a v1
+	// header, including the final CRLF.
+	proxyProtocolV1MaxLength = 107
+
+	// proxyProtocolV2HeaderLength is the length of the fixed part
+	// of a v2 header.
+	proxyProtocolV2HeaderLength = 16
+
+	// proxyProtocolHeaderTimeout is how long we wait for the header.
+	proxyProtocolHeaderTimeout = 10 * time.Second
+)
+
+var (
+	proxyProtocolV1Prefix    = []byte("PROXY ")
+	proxyProtocolV2Signature = []byte("\r\n\r\n\x00\r\nQUIT\n")
+)
+
+// proxyProtocolConn is a net.Conn that reports the addresses read in
+// a PROXY protocol header.
+type proxyProtocolConn struct {
+	net.Conn
+	remoteAddr net.Addr
+	localAddr  net.Addr
+}
+
+// RemoteAddr is part of the net.Conn interface. It returns the
+// address of the client, as sent by the proxy.
+func (pc *proxyProtocolConn) RemoteAddr() net.Addr {
+	return pc.remoteAddr
+}
+
+// LocalAddr is part of the net.Conn interface. It returns the
+// address the client connected to, as sent by the proxy.
+func (pc *proxyProtocolConn) LocalAddr() net.Addr {
+	return pc.localAddr
+}
+
+// isProxyProtocolTrusted returns true if the connection comes from
+// one of the ProxyProtocolTrustedNetworks, and should start with a
+// PROXY protocol header.
+func (l *Listener) isProxyProtocolTrusted(addr net.Addr) bool {
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	if !ok {
+		return false
+	}
+	for _, network := range l.ProxyProtocolTrustedNetworks {
+		if network.Contains(tcpAddr.IP) {
+			return true
+		}
+	}
+	return false
+}
+
+// readProxyProtocolHeader reads the PROXY protocol header at the start
+// of the connection, and returns a connection reporting the addresses
+// it contains. If the proxy didn't send the addresses (for instance
+// for its own health checks), the connection is returned as is.
+func readProxyProtocolHeader(conn net.Conn) (net.Conn, error) {
+	if err := conn.SetReadDeadline(time.Now().Add(proxyProtocolHeaderTimeout)); err != nil {
+		return nil, err
+	}
+	defer conn.SetReadDeadline(time.Time{})
+
+	// The shortest header is 'PROXY UNKNOWN\r\n', so it is safe
+	// to read the length of the v2 signature, without reading
+	// anything past the header.
+	signature := make([]byte, len(proxyProtocolV2Signature))
+	if _, err := io.ReadFull(conn, signature); err != nil {
+		return nil, vterrors.Wrapf(err, "cannot read PROXY protocol header")
+	}
+
+	var remoteAddr, localAddr net.Addr
+	var err error
+	switch {
+	case bytes.Equal(signature, proxyProtocolV2Signature):
+		remoteAddr, localAddr, err = readProxyProtocolV2(conn)
+	case bytes.HasPrefix(signature, proxyProtocolV1Prefix):
+		remoteAddr, localAddr, err = readProxyProtocolV1(conn, signature)
+	default:
+		err = vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid PROXY protocol header: %q", signature)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if remoteAddr == nil {
+		return conn, nil
+	}
+	return &proxyProtocolConn{
+		Conn:       conn,
+		remoteAddr: remoteAddr,
+		localAddr:  localAddr,
+	}, nil
+}
+
+// readProxyProtocolV1 reads the rest of a v1 header, which is a line
+// like 'PROXY TCP4 <src ip> <dst ip> <src port> <dst port>\r\n'.
+// start is what was already read. It returns nil addresses for the
+// UNKNOWN protocol.
+func readProxyProtocolV1(conn net.Conn, start []byte) (net.Addr, net.Addr, error) {
+	// Read one byte at a time, so we don't read past the header.
+	line := start
+	b := make([]byte, 1)
+	for !bytes.HasSuffix(line, []byte("\r\n")) {
+		if len(line) >= proxyProtocolV1MaxLength {
+			return nil, nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "PROXY protocol v1 header is too long")
+		}
+		if _, err := io.ReadFull(conn, b); err != nil {
+			return nil, nil, vterrors.Wrapf(err, "cannot read PROXY protocol v1 header")
+		}
+		line = append(line, b[0])
+	}
+
+	fields := strings.Split(string(line[:len(line)-2]), " ")
+	if len(fields) < 2 {
+		return nil, nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid PROXY protocol v1 header: %q", line)
+	}
+	switch fields[1] {
+	case "UNKNOWN":
+		return nil, nil, nil
+	case "TCP4", "TCP6":
+	default:
+		return nil, nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid PROXY protocol v1 header protocol: %q", line)
+	}
+	if len(fields) != 6 {
+		return nil, nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid PROXY protocol v1 header: %q", line)
+	}
+	remoteAddr, err := parseProxyProtocolV1Addr(fields[2], fields[4])
+	if err != nil {
+		return nil, nil, err
+	}
+	localAddr, err := parseProxyProtocolV1Addr(fields[3], fields[5])
+	if err != nil {
+		return nil, nil, err
+	}
+	return remoteAddr, localAddr, nil
+}
+
+func parseProxyProtocolV1Addr(ip, port string) (*net.TCPAddr, error) {
+	addr := &net.TCPAddr{
+		IP: net.ParseIP(ip),
+	}
+	if addr.IP == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid PROXY protocol v1 address: %q", ip)
+	}
+	p, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid PROXY protocol v1 port: %q", port)
+	}
+	addr.Port = int(p)
+	return addr, nil
+}
+
+// readProxyProtocolV2 reads the rest of a v2 header, after the
+// signature. It returns nil addresses for the LOCAL command, and for
+// the address families we don't support.
+func readProxyProtocolV2(conn net.Conn) (net.Addr, net.Addr, error) {
+	header := make([]byte, proxyProtocolV2HeaderLength-len(proxyProtocolV2Signature))
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return nil, nil, vterrors.Wrapf(err, "cannot read PROXY protocol v2 header")
+	}
+	versionCommand := header[0]
+	family := header[1]
+	length := int(binary.BigEndian.Uint16(header[2:4]))
+
+	if versionCommand>>4 != 2 {
+		return nil, nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid PROXY protocol v2 version: %v", versionCommand>>4)
+	}
+
+	// Read the addresses and the TLVs, the latter are ignored.
+	data := make([]byte, length)
+	if _, err := io.ReadFull(conn, data); err != nil {
+		return nil, nil, vterrors.Wrapf(err, "cannot read PROXY protocol v2 addresses")
+	}
+
+	switch versionCommand & 0x0f {
+	case 0x00:
+		// LOCAL: the connection was made by the proxy itself.
+		return nil, nil, nil
+	case 0x01:
+		// PROXY.
+	default:
+		return nil, nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid PROXY protocol v2 command: %v", versionCommand&0x0f)
+	}
+
+	var ipLength int
+	switch family >> 4 {
+	case 0x1:
+		ipLength = net.IPv4len
+	case 0x2:
+		ipLength = net.IPv6len
+	default:
+		// AF_UNSPEC or AF_UNIX: keep the connection addresses.
+		return nil, nil, nil
+	}
+	if len(data) < 2*ipLength+4 {
+		return nil, nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "PROXY protocol v2 addresses are too short: %v", len(data))
+	}
+	remoteAddr := &net.TCPAddr{
+		IP:   net.IP(data[:ipLength]),
+		Port: int(binary.BigEndian.Uint16(data[2*ipLength:])),
+	}
+	localAddr := &net.TCPAddr{
+		IP:   net.IP(data[ipLength : 2*ipLength]),
+		Port: int(binary.BigEndian.Uint16(data[2*ipLength+2:])),
+	}
+	return remoteAddr, localAddr, nil
+}
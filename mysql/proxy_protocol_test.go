@@ -0,0 +1,146 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"io"
+	"net"
+	"strings"
+	"testing"
+)
+
+func TestReadProxyProtocolHeader(t *testing.T) {
+	v2Header := func(command, family byte, addresses []byte) string {
+		header := string(proxyProtocolV2Signature) + string([]byte{0x20 | command, family, 0, byte(len(addresses))})
+		return header + string(addresses)
+	}
+
+	tests := []struct {
+		name       string
+		header     string
+		remoteAddr string
+		localAddr  string
+		err        string
+	}{{
+		name:       "v1 TCP4",
+		header:     "PROXY TCP4 192.168.0.1 192.168.0.11 56324 3306\r\n",
+		remoteAddr: "192.168.0.1:56324",
+		localAddr:  "192.168.0.11:3306",
+	}, {
+		name:       "v1 TCP6",
+		header:     "PROXY TCP6 2001:db8::1 2001:db8::2 56324 3306\r\n",
+		remoteAddr: "[2001:db8::1]:56324",
+		localAddr:  "[2001:db8::2]:3306",
+	}, {
+		name:   "v1 UNKNOWN",
+		header: "PROXY UNKNOWN\r\n",
+	}, {
+		name:   "v1 bad address",
+		header: "PROXY TCP4 192.168.0 192.168.0.11 56324 3306\r\n",
+		err:    "invalid PROXY protocol v1 address",
+	}, {
+		name:   "v1 bad port",
+		header: "PROXY TCP4 192.168.0.1 192.168.0.11 563240 3306\r\n",
+		err:    "invalid PROXY protocol v1 port",
+	}, {
+		name:   "v1 too long",
+		header: "PROXY TCP4 " + strings.Repeat("1", 100) + "\r\n",
+		err:    "too long",
+	}, {
+		name:       "v2 IPv4",
+		header:     v2Header(0x1, 0x11, []byte{10, 0, 0, 1, 10, 0, 0, 2, 0xdc, 0x04, 0x0c, 0xea}),
+		remoteAddr: "10.0.0.1:56324",
+		localAddr:  "10.0.0.2:3306",
+	}, {
+		name:   "v2 LOCAL",
+		header: v2Header(0x0, 0x00, nil),
+	}, {
+		name:   "v2 short addresses",
+		header: v2Header(0x1, 0x11, []byte{10, 0, 0, 1}),
+		err:    "too short",
+	}, {
+		name:   "not a header",
+		header: "GET / HTTP/1.1\r\n",
+		err:    "invalid PROXY protocol header",
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, server := net.Pipe()
+			defer client.Close()
+			defer server.Close()
+			go func() {
+				io.WriteString(client, test.header)
+			}()
+
+			conn, err := readProxyProtocolHeader(server)
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf("readProxyProtocolHeader returned %v, want error containing %q", err, test.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("readProxyProtocolHeader failed: %v", err)
+			}
+			if test.remoteAddr == "" {
+				if conn != server {
+					t.Errorf("readProxyProtocolHeader changed the connection for %q", test.header)
+				}
+				return
+			}
+			if got := conn.RemoteAddr().String(); got != test.remoteAddr {
+				t.Errorf("RemoteAddr() = %v, want %v", got, test.remoteAddr)
+			}
+			if got := conn.LocalAddr().String(); got != test.localAddr {
+				t.Errorf("LocalAddr() = %v, want %v", got, test.localAddr)
+			}
+		})
+	}
+}
+
+// TestProxyProtocolListener tests that the client address sent by a
+// trusted proxy is used by the connection.
+func TestProxyProtocolListener(t *testing.T) {
+	th := &testHandler{}
+
+	l, err := NewListener("tcp", "127.0.0.1:0", &AuthServerNone{}, th, 0, 0)
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	defer l.Close()
+	_, trusted, _ := net.ParseCIDR("127.0.0.0/8")
+	l.ProxyProtocolTrustedNetworks = []*net.IPNet{trusted}
+	go l.Accept()
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatalf("Dial failed: %v", err)
+	}
+	defer conn.Close()
+	if _, err := io.WriteString(conn, "PROXY TCP4 192.168.0.1 192.168.0.11 56324 3306\r\n"); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+
+	// Read the server handshake, the connection is then set up.
+	c := newConn(conn)
+	if _, err := c.readPacket(); err != nil {
+		t.Fatalf("readPacket failed: %v", err)
+	}
+	if got, want := th.lastConn.RemoteAddr().String(), "192.168.0.1:56324"; got != want {
+		t.Errorf("RemoteAddr() = %v, want %v", got, want)
+	}
+}
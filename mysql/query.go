@@ -17,6 +17,11 @@ limitations under the License.
 package mysql
 
 import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
 	"gopkg.in/src-d/go-vitess.v1/sqltypes"
 	"gopkg.in/src-d/go-vitess.v1/vt/proto/vtrpc"
 	"gopkg.in/src-d/go-vitess.v1/vt/vterrors"
@@ -35,7 +40,7 @@ import (
 // Returns SQLError(CRServerGone) if it can't.
 func (c *Conn) WriteComQuery(query string) error {
 	// This is a new command, need to reset the sequence.
-	c.sequence = 0
+	c.resetSequence()
 
 	data := c.startEphemeralPacket(len(query) + 1)
 	data[0] = ComQuery
@@ -514,6 +519,70 @@ func (c *Conn) parseComInitDB(data []byte) string {
 	return string(data[1:])
 }
 
+// parseComProcessKill parses a ComProcessKill packet, and returns the
+// ID of the connection to kill.
+func (c *Conn) parseComProcessKill(data []byte) (uint32, bool) {
+	val, _, ok := readUint32(data, 1)
+	return val, ok
+}
+
+// parseComChangeUser parses a ComChangeUser packet. It returns the
+// new user, the auth method and response the client used, and the
+// new default database. The auth response is a copy, so the packet
+// can be recycled. The character set, if present, is applied to the
+// connection. The connection attributes are ignored.
+func (c *Conn) parseComChangeUser(data []byte) (user, authMethod string, authResponse []byte, dbName string, ok bool) {
+	pos := 1
+	user, pos, ok = readNullString(data, pos)
+	if !ok {
+		return "", "", nil, "", false
+	}
+
+	// The auth response is length-encoded with
+	// CapabilityClientSecureConnection, null terminated otherwise.
+	if c.Capabilities&CapabilityClientSecureConnection != 0 {
+		var l byte
+		l, pos, ok = readByte(data, pos)
+		if !ok {
+			return "", "", nil, "", false
+		}
+		authResponse, pos, ok = readBytesCopy(data, pos, int(l))
+	} else {
+		a := ""
+		a, pos, ok = readNullString(data, pos)
+		authResponse = []byte(a)
+	}
+	if !ok {
+		return "", "", nil, "", false
+	}
+
+	dbName, pos, ok = readNullString(data, pos)
+	if !ok {
+		return "", "", nil, "", false
+	}
+
+	// The rest of the packet is optional.
+	authMethod = MysqlNativePassword
+	if pos < len(data) {
+		var characterSet uint16
+		characterSet, pos, ok = readUint16(data, pos)
+		if !ok {
+			return "", "", nil, "", false
+		}
+		c.CharacterSet = uint8(characterSet)
+	}
+	if pos < len(data) && c.Capabilities&CapabilityClientPluginAuth != 0 {
+		authMethod, _, ok = readNullString(data, pos)
+		if !ok {
+			return "", "", nil, "", false
+		}
+	}
+	if authMethod == "" {
+		authMethod = MysqlNativePassword
+	}
+	return user, authMethod, authResponse, dbName, true
+}
+
 func (c *Conn) sendColumnCount(count uint64) error {
 	length := lenEncIntSize(count)
 	data := c.startEphemeralPacket(length)
@@ -655,3 +724,587 @@ func (c *Conn) writeEndResult(more bool, affectedRows, lastInsertID uint64, warn
 
 	return nil
 }
+
+//
+// Prepared statements, server side methods.
+//
+
+func (c *Conn) parseComPrepare(data []byte) string {
+	return string(data[1:])
+}
+
+// parseComStmtExecute parses a ComStmtExecute packet, and fills in
+// the BindVars of the corresponding PrepareData.
+// It returns the statement ID and the cursor type. The returned error
+// is a SQLError.
+func (c *Conn) parseComStmtExecute(data []byte) (uint32, byte, error) {
+	// We already read the type.
+	pos := 1
+
+	// Statement ID.
+	stmtID, pos, ok := readUint32(data, pos)
+	if !ok {
+		return 0, 0, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "reading statement ID failed")
+	}
+	prepare, ok := c.PrepareData[stmtID]
+	if !ok {
+		return stmtID, 0, NewSQLError(ERUnknownStmtHandler, SSUnknownSQLState, "unknown prepared statement handler (%v) given to mysqld_stmt_execute", stmtID)
+	}
+
+	// Cursor type flags.
+	cursorType, pos, ok := readByte(data, pos)
+	if !ok {
+		return stmtID, 0, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "reading cursor type flags failed")
+	}
+
+	// Iteration count, always 1.
+	iterCount, pos, ok := readUint32(data, pos)
+	if !ok {
+		return stmtID, 0, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "reading iteration count failed")
+	}
+	if iterCount != 1 {
+		return stmtID, 0, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "iteration count is not equal to 1: %v", iterCount)
+	}
+
+	if prepare.ParamsCount == 0 {
+		return stmtID, cursorType, nil
+	}
+
+	// NULL-bitmap, one bit per parameter.
+	nullBitmap, pos, ok := readBytes(data, pos, int(prepare.ParamsCount+7)/8)
+	if !ok {
+		return stmtID, 0, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "reading NULL-bitmap failed")
+	}
+
+	// The parameter types are only sent with the first execution,
+	// or if they changed since the last one.
+	newParamsBoundFlag, pos, ok := readByte(data, pos)
+	if !ok {
+		return stmtID, 0, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "reading new-params-bound flag failed")
+	}
+	if newParamsBoundFlag == 0x01 {
+		for i := uint16(0); i < prepare.ParamsCount; i++ {
+			var mysqlType, flags byte
+			mysqlType, pos, ok = readByte(data, pos)
+			if !ok {
+				return stmtID, 0, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "reading parameter type failed")
+			}
+			flags, pos, ok = readByte(data, pos)
+			if !ok {
+				return stmtID, 0, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "reading parameter flags failed")
+			}
+			typ, err := stmtParamType(mysqlType, flags)
+			if err != nil {
+				return stmtID, 0, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "%v", err)
+			}
+			prepare.ParamsType[i] = int32(typ)
+		}
+	}
+
+	for i := 0; i < int(prepare.ParamsCount); i++ {
+		name := fmt.Sprintf("v%d", i+1)
+		if _, ok := prepare.BindVars[name]; ok {
+			// This parameter was sent with ComStmtSendLongData.
+			continue
+		}
+		if nullBitmap[i/8]&(1<<uint(i%8)) != 0 {
+			prepare.BindVars[name] = sqltypes.NullBindVariable
+			continue
+		}
+
+		var val sqltypes.Value
+		val, pos, ok = parseStmtArg(data, querypb.Type(prepare.ParamsType[i]), pos)
+		if !ok {
+			return stmtID, 0, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "decoding parameter %v of type %v failed", i+1, querypb.Type(prepare.ParamsType[i]))
+		}
+		prepare.BindVars[name] = sqltypes.ValueBindVariable(val)
+	}
+
+	return stmtID, cursorType, nil
+}
+
+// stmtParamType returns the vitess type for a parameter type sent in
+// a ComStmtExecute packet. flags only has the unsigned bit (0x80).
+func stmtParamType(mysqlType, flags byte) (querypb.Type, error) {
+	switch mysqlType {
+	case TypeVarchar, TypeEnum, TypeSet, TypeTinyBlob, TypeMediumBlob, TypeLongBlob, TypeBlob, TypeVarString, TypeString, TypeGeometry:
+		// All these are sent as length-encoded strings.
+		return sqltypes.VarBinary, nil
+	}
+	var mysqlFlags int64
+	if flags&0x80 != 0 {
+		mysqlFlags = int64(querypb.MySqlFlag_UNSIGNED_FLAG)
+	}
+	return sqltypes.MySQLToType(int64(mysqlType), mysqlFlags)
+}
+
+// parseStmtArg decodes the binary representation of a parameter of
+// type typ, and returns it as a Value.
+func parseStmtArg(data []byte, typ querypb.Type, pos int) (sqltypes.Value, int, bool) {
+	switch typ {
+	case sqltypes.Null:
+		return sqltypes.NULL, pos, true
+	case sqltypes.Int8:
+		val, pos, ok := readByte(data, pos)
+		return sqltypes.NewInt64(int64(int8(val))), pos, ok
+	case sqltypes.Uint8:
+		val, pos, ok := readByte(data, pos)
+		return sqltypes.NewUint64(uint64(val)), pos, ok
+	case sqltypes.Int16:
+		val, pos, ok := readUint16(data, pos)
+		return sqltypes.NewInt64(int64(int16(val))), pos, ok
+	case sqltypes.Uint16, sqltypes.Year:
+		val, pos, ok := readUint16(data, pos)
+		return sqltypes.NewUint64(uint64(val)), pos, ok
+	case sqltypes.Int24, sqltypes.Int32:
+		val, pos, ok := readUint32(data, pos)
+		return sqltypes.NewInt64(int64(int32(val))), pos, ok
+	case sqltypes.Uint24, sqltypes.Uint32:
+		val, pos, ok := readUint32(data, pos)
+		return sqltypes.NewUint64(uint64(val)), pos, ok
+	case sqltypes.Int64:
+		val, pos, ok := readUint64(data, pos)
+		return sqltypes.NewInt64(int64(val)), pos, ok
+	case sqltypes.Uint64:
+		val, pos, ok := readUint64(data, pos)
+		return sqltypes.NewUint64(val), pos, ok
+	case sqltypes.Float32:
+		val, pos, ok := readUint32(data, pos)
+		return sqltypes.NewFloat64(float64(math.Float32frombits(val))), pos, ok
+	case sqltypes.Float64:
+		val, pos, ok := readUint64(data, pos)
+		return sqltypes.NewFloat64(math.Float64frombits(val)), pos, ok
+	case sqltypes.Date, sqltypes.Datetime, sqltypes.Timestamp:
+		s, pos, ok := readBinaryDatetime(data, pos, typ == sqltypes.Date)
+		return sqltypes.MakeTrusted(typ, []byte(s)), pos, ok
+	case sqltypes.Time:
+		s, pos, ok := readBinaryTime(data, pos)
+		return sqltypes.MakeTrusted(typ, []byte(s)), pos, ok
+	default:
+		// Decimal, JSON, Bit and all the string types.
+		val, pos, ok := readLenEncStringAsBytes(data, pos)
+		if !ok {
+			return sqltypes.NULL, 0, false
+		}
+		// The buffer will be recycled, we need a copy.
+		return sqltypes.MakeTrusted(typ, append([]byte(nil), val...)), pos, ok
+	}
+}
+
+// readBinaryDatetime decodes the binary protocol representation of a
+// DATE, DATETIME or TIMESTAMP.
+func readBinaryDatetime(data []byte, pos int, dateOnly bool) (string, int, bool) {
+	length, pos, ok := readByte(data, pos)
+	if !ok {
+		return "", 0, false
+	}
+	var year uint16
+	var month, day, hour, minute, second byte
+	var micro uint32
+	switch length {
+	case 0:
+	case 4, 7, 11:
+		year, pos, ok = readUint16(data, pos)
+		if !ok || len(data) < pos+int(length)-2 {
+			return "", 0, false
+		}
+		month, day = data[pos], data[pos+1]
+		pos += 2
+		if length >= 7 {
+			hour, minute, second = data[pos], data[pos+1], data[pos+2]
+			pos += 3
+		}
+		if length == 11 {
+			micro, pos, _ = readUint32(data, pos)
+		}
+	default:
+		return "", 0, false
+	}
+
+	s := fmt.Sprintf("%04d-%02d-%02d", year, month, day)
+	if dateOnly {
+		return s, pos, true
+	}
+	s += fmt.Sprintf(" %02d:%02d:%02d", hour, minute, second)
+	if micro != 0 {
+		s += fmt.Sprintf(".%06d", micro)
+	}
+	return s, pos, true
+}
+
+// readBinaryTime decodes the binary protocol representation of a TIME.
+func readBinaryTime(data []byte, pos int) (string, int, bool) {
+	length, pos, ok := readByte(data, pos)
+	if !ok {
+		return "", 0, false
+	}
+	var negative, hour, minute, second byte
+	var days, micro uint32
+	switch length {
+	case 0:
+	case 8, 12:
+		negative, pos, ok = readByte(data, pos)
+		if !ok {
+			return "", 0, false
+		}
+		days, pos, ok = readUint32(data, pos)
+		if !ok || len(data) < pos+3 {
+			return "", 0, false
+		}
+		hour, minute, second = data[pos], data[pos+1], data[pos+2]
+		pos += 3
+		if length == 12 {
+			micro, pos, ok = readUint32(data, pos)
+			if !ok {
+				return "", 0, false
+			}
+		}
+	default:
+		return "", 0, false
+	}
+
+	s := ""
+	if negative == 1 {
+		s = "-"
+	}
+	s += fmt.Sprintf("%02d:%02d:%02d", days*24+uint32(hour), minute, second)
+	if micro != 0 {
+		s += fmt.Sprintf(".%06d", micro)
+	}
+	return s, pos, true
+}
+
+// parseComStmtSendLongData parses a ComStmtSendLongData packet.
+// It returns the statement ID, the parameter ID and the data chunk.
+// The chunk points into data, so it needs to be copied before the
+// packet is recycled.
+func (c *Conn) parseComStmtSendLongData(data []byte) (uint32, uint16, []byte, bool) {
+	pos := 1
+	stmtID, pos, ok := readUint32(data, pos)
+	if !ok {
+		return 0, 0, nil, false
+	}
+	paramID, pos, ok := readUint16(data, pos)
+	if !ok {
+		return 0, 0, nil, false
+	}
+	return stmtID, paramID, data[pos:], true
+}
+
+func (c *Conn) parseComStmtClose(data []byte) (uint32, bool) {
+	val, _, ok := readUint32(data, 1)
+	return val, ok
+}
+
+func (c *Conn) parseComStmtReset(data []byte) (uint32, bool) {
+	val, _, ok := readUint32(data, 1)
+	return val, ok
+}
+
+// parseComStmtFetch returns the statement ID and the number of rows
+// to fetch from its cursor.
+func (c *Conn) parseComStmtFetch(data []byte) (uint32, uint32, bool) {
+	stmtID, pos, ok := readUint32(data, 1)
+	if !ok {
+		return 0, 0, false
+	}
+	numRows, _, ok := readUint32(data, pos)
+	return stmtID, numRows, ok
+}
+
+// writePrepare writes the response to a ComPrepare: the statement
+// ID, followed by the definitions of the parameters and of the columns.
+func (c *Conn) writePrepare(fields []*querypb.Field, prepare *PrepareData) error {
+	paramsCount := prepare.ParamsCount
+	columnCount := len(fields)
+
+	data := c.startEphemeralPacket(12)
+	pos := 0
+	pos = writeByte(data, pos, OKPacket)
+	pos = writeUint32(data, pos, prepare.StatementID)
+	pos = writeUint16(data, pos, uint16(columnCount))
+	pos = writeUint16(data, pos, paramsCount)
+	pos = writeByte(data, pos, 0x00) // reserved
+	_ = writeUint16(data, pos, 0)    // warning count
+	if err := c.writeEphemeralPacket(); err != nil {
+		return err
+	}
+
+	if paramsCount > 0 {
+		for i := uint16(0); i < paramsCount; i++ {
+			if err := c.writeColumnDefinition(&querypb.Field{
+				Name:    "?",
+				Type:    sqltypes.VarBinary,
+				Charset: CharacterSetBinary,
+			}); err != nil {
+				return err
+			}
+		}
+		if c.Capabilities&CapabilityClientDeprecateEOF == 0 {
+			if err := c.writeEOFPacket(c.StatusFlags, 0); err != nil {
+				return err
+			}
+		}
+	}
+
+	if columnCount > 0 {
+		prepare.ColumnNames = make([]string, columnCount)
+		for i, field := range fields {
+			prepare.ColumnNames[i] = field.Name
+			if err := c.writeColumnDefinition(field); err != nil {
+				return err
+			}
+		}
+		if c.Capabilities&CapabilityClientDeprecateEOF == 0 {
+			if err := c.writeEOFPacket(c.StatusFlags, 0); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// writeBinaryRow writes a row using the binary protocol, used for
+// the results of ComStmtExecute.
+func (c *Conn) writeBinaryRow(fields []*querypb.Field, row []sqltypes.Value) error {
+	// The NULL-bitmap has an offset of 2 bits for result sets.
+	nullBitmapLen := (len(fields) + 7 + 2) / 8
+	length := 1 + nullBitmapLen
+	values := make([][]byte, len(row))
+	for i, val := range row {
+		if val.IsNull() {
+			continue
+		}
+		v, err := val2MySQL(val)
+		if err != nil {
+			return vterrors.Wrapf(err, "cannot convert value %v of column %v to the binary protocol", val, fields[i].Name)
+		}
+		values[i] = v
+		length += len(v)
+	}
+
+	data := c.startEphemeralPacket(length)
+	pos := writeByte(data, 0, OKPacket)
+	pos = writeZeroes(data, pos, nullBitmapLen)
+	for i, val := range row {
+		if val.IsNull() {
+			bytePos := (i+2)/8 + 1
+			data[bytePos] |= 1 << uint((i+2)%8)
+			continue
+		}
+		pos += copy(data[pos:], values[i])
+	}
+
+	if pos != length {
+		return vterrors.Errorf(vtrpc.Code_INTERNAL, "packet binary row: got %v bytes but expected %v", pos, length)
+	}
+
+	return c.writeEphemeralPacket()
+}
+
+// writeBinaryRows sends the rows of a Result using the binary protocol.
+func (c *Conn) writeBinaryRows(result *sqltypes.Result) error {
+	for _, row := range result.Rows {
+		if err := c.writeBinaryRow(result.Fields, row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// val2MySQL returns the binary protocol representation of a non-NULL
+// value, as used in binary result set rows.
+func val2MySQL(v sqltypes.Value) ([]byte, error) {
+	switch v.Type() {
+	case sqltypes.Int8:
+		val, err := strconv.ParseInt(v.ToString(), 10, 8)
+		if err != nil {
+			return nil, err
+		}
+		return []byte{byte(val)}, nil
+	case sqltypes.Uint8:
+		val, err := strconv.ParseUint(v.ToString(), 10, 8)
+		if err != nil {
+			return nil, err
+		}
+		return []byte{byte(val)}, nil
+	case sqltypes.Int16:
+		val, err := strconv.ParseInt(v.ToString(), 10, 16)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]byte, 2)
+		writeUint16(data, 0, uint16(val))
+		return data, nil
+	case sqltypes.Uint16, sqltypes.Year:
+		val, err := strconv.ParseUint(v.ToString(), 10, 16)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]byte, 2)
+		writeUint16(data, 0, uint16(val))
+		return data, nil
+	case sqltypes.Int24, sqltypes.Int32:
+		val, err := strconv.ParseInt(v.ToString(), 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]byte, 4)
+		writeUint32(data, 0, uint32(val))
+		return data, nil
+	case sqltypes.Uint24, sqltypes.Uint32:
+		val, err := strconv.ParseUint(v.ToString(), 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]byte, 4)
+		writeUint32(data, 0, uint32(val))
+		return data, nil
+	case sqltypes.Int64:
+		val, err := strconv.ParseInt(v.ToString(), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]byte, 8)
+		writeUint64(data, 0, uint64(val))
+		return data, nil
+	case sqltypes.Uint64:
+		val, err := strconv.ParseUint(v.ToString(), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]byte, 8)
+		writeUint64(data, 0, val)
+		return data, nil
+	case sqltypes.Float32:
+		val, err := strconv.ParseFloat(v.ToString(), 32)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]byte, 4)
+		writeUint32(data, 0, math.Float32bits(float32(val)))
+		return data, nil
+	case sqltypes.Float64:
+		val, err := strconv.ParseFloat(v.ToString(), 64)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]byte, 8)
+		writeUint64(data, 0, math.Float64bits(val))
+		return data, nil
+	case sqltypes.Date, sqltypes.Datetime, sqltypes.Timestamp:
+		return datetime2MySQL(v.ToString())
+	case sqltypes.Time:
+		return time2MySQL(v.ToString())
+	default:
+		raw := v.Raw()
+		data := make([]byte, lenEncIntSize(uint64(len(raw)))+len(raw))
+		pos := writeLenEncInt(data, 0, uint64(len(raw)))
+		copy(data[pos:], raw)
+		return data, nil
+	}
+}
+
+// datetime2MySQL converts a 'YYYY-MM-DD[ hh:mm:ss[.ffffff]]' string
+// into its binary protocol representation.
+func datetime2MySQL(s string) ([]byte, error) {
+	var year, month, day, hour, minute, second, micro int
+	date, clock := s, ""
+	if i := strings.IndexByte(s, ' '); i >= 0 {
+		date, clock = s[:i], s[i+1:]
+	}
+	if _, err := fmt.Sscanf(date, "%d-%d-%d", &year, &month, &day); err != nil {
+		return nil, fmt.Errorf("invalid date %q: %v", s, err)
+	}
+	if clock != "" {
+		var err error
+		if hour, minute, second, micro, err = parseClock(clock); err != nil {
+			return nil, fmt.Errorf("invalid datetime %q: %v", s, err)
+		}
+	}
+
+	var length byte
+	switch {
+	case micro != 0:
+		length = 11
+	case hour != 0 || minute != 0 || second != 0:
+		length = 7
+	case year != 0 || month != 0 || day != 0:
+		length = 4
+	}
+
+	data := make([]byte, 1+length)
+	pos := writeByte(data, 0, length)
+	if length >= 4 {
+		pos = writeUint16(data, pos, uint16(year))
+		pos = writeByte(data, pos, byte(month))
+		pos = writeByte(data, pos, byte(day))
+	}
+	if length >= 7 {
+		pos = writeByte(data, pos, byte(hour))
+		pos = writeByte(data, pos, byte(minute))
+		pos = writeByte(data, pos, byte(second))
+	}
+	if length == 11 {
+		writeUint32(data, pos, uint32(micro))
+	}
+	return data, nil
+}
+
+// time2MySQL converts a '[-]hhh:mm:ss[.ffffff]' string into its binary
+// protocol representation.
+func time2MySQL(s string) ([]byte, error) {
+	negative := byte(0)
+	clock := s
+	if strings.HasPrefix(clock, "-") {
+		negative = 1
+		clock = clock[1:]
+	}
+	hours, minute, second, micro, err := parseClock(clock)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time %q: %v", s, err)
+	}
+
+	var length byte
+	switch {
+	case micro != 0:
+		length = 12
+	case hours != 0 || minute != 0 || second != 0:
+		length = 8
+	}
+
+	data := make([]byte, 1+length)
+	pos := writeByte(data, 0, length)
+	if length >= 8 {
+		pos = writeByte(data, pos, negative)
+		pos = writeUint32(data, pos, uint32(hours/24))
+		pos = writeByte(data, pos, byte(hours%24))
+		pos = writeByte(data, pos, byte(minute))
+		pos = writeByte(data, pos, byte(second))
+	}
+	if length == 12 {
+		writeUint32(data, pos, uint32(micro))
+	}
+	return data, nil
+}
+
+// parseClock parses a 'h:mm:ss[.ffffff]' string. The fractional part
+// can have up to 6 digits.
+func parseClock(s string) (hour, minute, second, micro int, err error) {
+	frac := ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		s, frac = s[:i], s[i+1:]
+	}
+	if _, err = fmt.Sscanf(s, "%d:%d:%d", &hour, &minute, &second); err != nil {
+		return 0, 0, 0, 0, err
+	}
+	if frac != "" {
+		if len(frac) > 6 {
+			return 0, 0, 0, 0, fmt.Errorf("fractional part %q is too long", frac)
+		}
+		if micro, err = strconv.Atoi(frac + strings.Repeat("0", 6-len(frac))); err != nil {
+			return 0, 0, 0, 0, err
+		}
+	}
+	return hour, minute, second, micro, nil
+}
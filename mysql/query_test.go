@@ -488,3 +488,287 @@ func RowString(row []sqltypes.Value) string {
 	}
 	return result
 }
+
+func TestComStmt(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+	sConn.PrepareData = make(map[uint32]*PrepareData)
+	th := &testHandler{
+		result: &sqltypes.Result{
+			Fields: []*querypb.Field{
+				{Name: "id", Type: querypb.Type_INT32},
+				{Name: "name", Type: querypb.Type_VARCHAR},
+			},
+			Rows: [][]sqltypes.Value{
+				{
+					sqltypes.MakeTrusted(querypb.Type_INT32, []byte("10")),
+					sqltypes.MakeTrusted(querypb.Type_VARCHAR, []byte("nice")),
+				},
+				{
+					sqltypes.MakeTrusted(querypb.Type_INT32, []byte("20")),
+					sqltypes.NULL,
+				},
+			},
+		},
+	}
+
+	// sendCommand writes a packet from the client, and has the
+	// server handle it.
+	sendCommand := func(data []byte) {
+		t.Helper()
+		cConn.sequence = 0
+		if err := cConn.writePacket(data); err != nil {
+			t.Fatalf("writePacket failed: %v", err)
+		}
+		if err := sConn.handleNextCommand(th); err != nil {
+			t.Fatalf("handleNextCommand failed: %v", err)
+		}
+	}
+	readPacket := func() []byte {
+		t.Helper()
+		data, err := cConn.ReadPacket()
+		if err != nil {
+			t.Fatalf("ReadPacket failed: %v", err)
+		}
+		return data
+	}
+
+	// readBinaryResult reads a result set, and returns its raw rows.
+	readBinaryResult := func() [][]byte {
+		t.Helper()
+		if got := readPacket(); !reflect.DeepEqual(got, []byte{2}) {
+			t.Fatalf("column count: got %v", got)
+		}
+		for i := 0; i < 2; i++ {
+			field := &querypb.Field{}
+			if err := cConn.readColumnDefinition(field, i); err != nil {
+				t.Fatalf("readColumnDefinition failed: %v", err)
+			}
+			if !proto.Equal(field, th.result.Fields[i]) {
+				t.Errorf("field %v: got %v, want %v", i, field, th.result.Fields[i])
+			}
+		}
+		if got := readPacket(); !isEOFPacket(got) {
+			t.Fatalf("expected EOF packet, got %v", got)
+		}
+		var rows [][]byte
+		for {
+			row := readPacket()
+			if isEOFPacket(row) {
+				return rows
+			}
+			rows = append(rows, row)
+		}
+	}
+
+	// ComPrepare: the response has the statement ID and the
+	// number of parameters, followed by their definitions.
+	sendCommand(append([]byte{ComPrepare}, "select id, name from t where id = ? and name = ?"...))
+	want := []byte{OKPacket, 1, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0}
+	if got := readPacket(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("ComPrepare response: got %v, want %v", got, want)
+	}
+	for i := 0; i < 2; i++ {
+		field := &querypb.Field{}
+		if err := cConn.readColumnDefinition(field, i); err != nil {
+			t.Fatalf("readColumnDefinition failed: %v", err)
+		}
+		if field.Name != "?" {
+			t.Errorf("parameter %v has name %v", i, field.Name)
+		}
+	}
+	if got := readPacket(); !isEOFPacket(got) {
+		t.Fatalf("expected EOF packet, got %v", got)
+	}
+	wantBindVars := map[string]*querypb.BindVariable{
+		"v1": {},
+		"v2": {},
+	}
+	if !reflect.DeepEqual(th.lastBindVars, wantBindVars) {
+		t.Errorf("ComPrepare bind variables: got %v, want %v", th.lastBindVars, wantBindVars)
+	}
+
+	// ComStmtSendLongData for the second parameter, in two chunks.
+	sendCommand([]byte{ComStmtSendLongData, 1, 0, 0, 0, 1, 0, 'n', 'i'})
+	sendCommand([]byte{ComStmtSendLongData, 1, 0, 0, 0, 1, 0, 'c', 'e'})
+
+	// ComStmtExecute with a LONGLONG first parameter.
+	sendCommand([]byte{
+		ComStmtExecute,
+		1, 0, 0, 0, // statement ID
+		0,          // flags
+		1, 0, 0, 0, // iteration count
+		0,    // NULL-bitmap
+		1,    // new-params-bound flag
+		8, 0, // LONGLONG
+		253, 0, // VAR_STRING
+		10, 0, 0, 0, 0, 0, 0, 0, // first parameter
+	})
+	wantBindVars = map[string]*querypb.BindVariable{
+		"v1": sqltypes.Int64BindVariable(10),
+		"v2": sqltypes.BytesBindVariable([]byte("nice")),
+	}
+	if !reflect.DeepEqual(th.lastBindVars, wantBindVars) {
+		t.Errorf("ComStmtExecute bind variables: got %v, want %v", th.lastBindVars, wantBindVars)
+	}
+
+	// The result set uses the binary protocol for rows.
+	wantRows := [][]byte{
+		{0, 0, 10, 0, 0, 0, 4, 'n', 'i', 'c', 'e'},
+		{0, 0x08, 20, 0, 0, 0},
+	}
+	if got := readBinaryResult(); !reflect.DeepEqual(got, wantRows) {
+		t.Errorf("binary rows: got %v, want %v", got, wantRows)
+	}
+
+	// The second execution re-uses the parameter types, and has
+	// a NULL second parameter.
+	sendCommand([]byte{
+		ComStmtExecute,
+		1, 0, 0, 0, // statement ID
+		0,          // flags
+		1, 0, 0, 0, // iteration count
+		2,                       // NULL-bitmap
+		0,                       // new-params-bound flag
+		20, 0, 0, 0, 0, 0, 0, 0, // first parameter
+	})
+	wantBindVars = map[string]*querypb.BindVariable{
+		"v1": sqltypes.Int64BindVariable(20),
+		"v2": sqltypes.NullBindVariable,
+	}
+	if !reflect.DeepEqual(th.lastBindVars, wantBindVars) {
+		t.Errorf("ComStmtExecute bind variables: got %v, want %v", th.lastBindVars, wantBindVars)
+	}
+	if got := readBinaryResult(); !reflect.DeepEqual(got, wantRows) {
+		t.Errorf("binary rows: got %v, want %v", got, wantRows)
+	}
+
+	// With a read-only cursor, only the columns are sent, and the
+	// rows are read with ComStmtFetch.
+	sendCommand([]byte{
+		ComStmtExecute,
+		1, 0, 0, 0, // statement ID
+		CursorTypeReadOnly, // flags
+		1, 0, 0, 0,         // iteration count
+		2,                       // NULL-bitmap
+		0,                       // new-params-bound flag
+		20, 0, 0, 0, 0, 0, 0, 0, // first parameter
+	})
+	if got := readPacket(); !reflect.DeepEqual(got, []byte{2}) {
+		t.Fatalf("column count: got %v", got)
+	}
+	for i := 0; i < 2; i++ {
+		field := &querypb.Field{}
+		if err := cConn.readColumnDefinition(field, i); err != nil {
+			t.Fatalf("readColumnDefinition failed: %v", err)
+		}
+	}
+	readEOFFlags := func() uint16 {
+		t.Helper()
+		data := readPacket()
+		if !isEOFPacket(data) {
+			t.Fatalf("expected EOF packet, got %v", data)
+		}
+		flags, _, _ := readUint16(data, 3)
+		return flags
+	}
+	if flags := readEOFFlags(); flags&ServerStatusCursorExists == 0 {
+		t.Errorf("cursor not opened, got status flags %x", flags)
+	}
+	sendCommand([]byte{ComStmtFetch, 1, 0, 0, 0, 1, 0, 0, 0})
+	if got := readPacket(); !reflect.DeepEqual(got, wantRows[0]) {
+		t.Errorf("first fetched row: got %v, want %v", got, wantRows[0])
+	}
+	if flags := readEOFFlags(); flags&ServerStatusCursorExists == 0 || flags&ServerStatusLastRowSent != 0 {
+		t.Errorf("first fetch: got status flags %x", flags)
+	}
+	sendCommand([]byte{ComStmtFetch, 1, 0, 0, 0, 10, 0, 0, 0})
+	if got := readPacket(); !reflect.DeepEqual(got, wantRows[1]) {
+		t.Errorf("second fetched row: got %v, want %v", got, wantRows[1])
+	}
+	if flags := readEOFFlags(); flags&ServerStatusLastRowSent == 0 {
+		t.Errorf("second fetch: got status flags %x", flags)
+	}
+	// The cursor is closed after its last row.
+	sendCommand([]byte{ComStmtFetch, 1, 0, 0, 0, 10, 0, 0, 0})
+	if err := ParseErrorPacket(readPacket()); err.(*SQLError).Num != ERStmtHasNoOpenCursor {
+		t.Errorf("ComStmtFetch after the last row: unexpected error %v", err)
+	}
+
+	// ComStmtReset answers with an OK packet.
+	sendCommand([]byte{ComStmtReset, 1, 0, 0, 0})
+	if got := readPacket(); got[0] != OKPacket {
+		t.Errorf("ComStmtReset: expected OK packet, got %v", got)
+	}
+
+	// After ComStmtClose, the statement is unknown.
+	sendCommand([]byte{ComStmtClose, 1, 0, 0, 0})
+	sendCommand([]byte{ComStmtExecute, 1, 0, 0, 0, 0, 1, 0, 0, 0})
+	got := readPacket()
+	if !isErrorPacket(got) {
+		t.Fatalf("ComStmtExecute after ComStmtClose: expected error packet, got %v", got)
+	}
+	if err := ParseErrorPacket(got); err.(*SQLError).Num != ERUnknownStmtHandler {
+		t.Errorf("ComStmtExecute after ComStmtClose: unexpected error %v", err)
+	}
+}
+
+func TestBinaryDatetime(t *testing.T) {
+	testcases := []struct {
+		typ  querypb.Type
+		in   string
+		want []byte
+	}{{
+		typ:  querypb.Type_DATE,
+		in:   "2019-01-02",
+		want: []byte{4, 0xe3, 0x07, 1, 2},
+	}, {
+		typ:  querypb.Type_DATETIME,
+		in:   "0000-00-00 00:00:00",
+		want: []byte{0},
+	}, {
+		typ:  querypb.Type_DATETIME,
+		in:   "2019-01-02 03:04:05",
+		want: []byte{7, 0xe3, 0x07, 1, 2, 3, 4, 5},
+	}, {
+		typ:  querypb.Type_TIMESTAMP,
+		in:   "2019-01-02 03:04:05.000006",
+		want: []byte{11, 0xe3, 0x07, 1, 2, 3, 4, 5, 6, 0, 0, 0},
+	}, {
+		typ:  querypb.Type_TIME,
+		in:   "00:00:00",
+		want: []byte{0},
+	}, {
+		typ:  querypb.Type_TIME,
+		in:   "-27:04:05",
+		want: []byte{8, 1, 1, 0, 0, 0, 3, 4, 5},
+	}, {
+		typ:  querypb.Type_TIME,
+		in:   "01:02:03.100000",
+		want: []byte{12, 0, 0, 0, 0, 0, 1, 2, 3, 0xa0, 0x86, 0x01, 0},
+	}}
+	for _, tcase := range testcases {
+		got, err := val2MySQL(sqltypes.MakeTrusted(tcase.typ, []byte(tcase.in)))
+		if err != nil {
+			t.Errorf("val2MySQL(%v) failed: %v", tcase.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tcase.want) {
+			t.Errorf("val2MySQL(%v): got %v, want %v", tcase.in, got, tcase.want)
+		}
+
+		// And decode it back.
+		val, pos, ok := parseStmtArg(got, tcase.typ, 0)
+		if !ok || pos != len(got) {
+			t.Errorf("parseStmtArg(%v) failed: %v %v", got, pos, ok)
+			continue
+		}
+		if val.ToString() != tcase.in {
+			t.Errorf("parseStmtArg(%v): got %v, want %v", got, val.ToString(), tcase.in)
+		}
+	}
+}
@@ -16,13 +16,22 @@ limitations under the License.
 
 package mysql
 
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"gopkg.in/src-d/go-vitess.v1/vt/proto/vtrpc"
+	"gopkg.in/src-d/go-vitess.v1/vt/vterrors"
+)
+
 // This file contains the methods related to replication.
 
 // WriteComBinlogDump writes a ComBinlogDump command.
 // See http://dev.mysql.com/doc/internals/en/com-binlog-dump.html for syntax.
 // Returns a SQLError.
 func (c *Conn) WriteComBinlogDump(serverID uint32, binlogFilename string, binlogPos uint32, flags uint16) error {
-	c.sequence = 0
+	c.resetSequence()
 	length := 1 + // ComBinlogDump
 		4 + // binlog-pos
 		2 + // flags
@@ -44,7 +53,7 @@ func (c *Conn) WriteComBinlogDump(serverID uint32, binlogFilename string, binlog
 // Only works with MySQL 5.6+ (and not MariaDB).
 // See http://dev.mysql.com/doc/internals/en/com-binlog-dump-gtid.html for syntax.
 func (c *Conn) WriteComBinlogDumpGTID(serverID uint32, binlogFilename string, binlogPos uint64, flags uint16, gtidSet []byte) error {
-	c.sequence = 0
+	c.resetSequence()
 	length := 1 + // ComBinlogDumpGTID
 		2 + // flags
 		4 + // server-id
@@ -67,3 +76,186 @@ func (c *Conn) WriteComBinlogDumpGTID(serverID uint32, binlogFilename string, bi
 	}
 	return nil
 }
+
+// semiSyncSlave is the state of a replication connection that
+// acknowledges events to a semi-sync master.
+type semiSyncSlave struct {
+	// format and file follow the binlog stream, to know the
+	// position of its events. rotate is the first ROTATE_EVENT,
+	// which comes before the format needed to parse it.
+	format BinlogFormat
+	file   string
+	rotate binlogEvent
+
+	// ack is the ACK the last event read asked for.
+	ack semiSyncAck
+
+	// mu serializes the ACKs, which may be written while events
+	// are read.
+	mu sync.Mutex
+}
+
+// semiSyncAck is the binlog file and position a semi-sync master asked
+// to acknowledge, if requested is set.
+type semiSyncAck struct {
+	requested bool
+	file      string
+	position  uint64
+}
+
+// EnableSemiSyncSlave asks the master to wait for the ACKs of this
+// replication connection, as it does for semi-sync slaves, if semi-sync
+// is enabled on the master. It returns false if it isn't, and the binlog
+// stream is then a regular one. It must be called before the binlog
+// dump command.
+func (c *Conn) EnableSemiSyncSlave() (bool, error) {
+	qr, err := c.ExecuteFetch("SHOW GLOBAL VARIABLES LIKE 'rpl_semi_sync_%_enabled'", 10, false)
+	if err != nil {
+		return false, err
+	}
+	for _, row := range qr.Rows {
+		if row[1].ToString() != "ON" {
+			continue
+		}
+		// MySQL 8.0.26 renamed master to source, and the user
+		// variable the master checks at the same time.
+		var userVariable string
+		switch row[0].ToString() {
+		case "rpl_semi_sync_master_enabled":
+			userVariable = "@rpl_semi_sync_slave"
+		case "rpl_semi_sync_source_enabled":
+			userVariable = "@rpl_semi_sync_replica"
+		default:
+			continue
+		}
+		if _, err := c.ExecuteFetch(fmt.Sprintf("SET %s = 1", userVariable), 0, false); err != nil {
+			return false, err
+		}
+		c.semiSync = &semiSyncSlave{}
+		return true, nil
+	}
+	return false, nil
+}
+
+// readBinlogEventPacket reads the packet of the next binlog event, and
+// returns the event data. With semi-sync, it also removes the semi-sync
+// header, and remembers if the event asked for an ACK.
+// Returns a SQLError.
+func (c *Conn) readBinlogEventPacket() ([]byte, error) {
+	if c.semiSync != nil {
+		c.semiSync.ack = semiSyncAck{}
+	}
+	result, err := c.ReadPacket()
+	if err != nil {
+		return nil, err
+	}
+	switch result[0] {
+	case EOFPacket:
+		return nil, NewSQLError(CRServerLost, SSUnknownSQLState, "%v", io.EOF)
+	case ErrPacket:
+		return nil, ParseErrorPacket(result)
+	}
+	data := result[1:]
+	if c.semiSync == nil {
+		return data, nil
+	}
+
+	if len(data) < 2 || data[0] != semiSyncIndicator {
+		return nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "binlog event has no semi-sync header: %v", data)
+	}
+	ackRequested := data[1]&semiSyncAckRequested != 0
+	data = data[2:]
+	if err := c.semiSync.track(binlogEvent(data), ackRequested); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// track follows the binlog file name in the stream, and remembers the
+// position after ev if it asked for an ACK.
+func (s *semiSyncSlave) track(ev binlogEvent, ackRequested bool) error {
+	if !ev.IsValid() {
+		// The caller will deal with it.
+		return nil
+	}
+
+	switch {
+	case ev.IsFormatDescription():
+		format, err := ev.Format()
+		if err != nil {
+			return err
+		}
+		s.format = format
+		if s.rotate != nil {
+			s.file = s.rotateFile(s.rotate)
+			s.rotate = nil
+		}
+	case ev.IsRotate():
+		if s.format.IsZero() {
+			s.rotate = ev
+		} else {
+			s.file = s.rotateFile(ev)
+		}
+	}
+
+	if ackRequested {
+		s.ack = semiSyncAck{
+			requested: true,
+			file:      s.file,
+			position:  uint64(ev.NextPosition()),
+		}
+	}
+	return nil
+}
+
+// rotateFile returns the file name of a ROTATE_EVENT.
+func (s *semiSyncSlave) rotateFile(ev binlogEvent) string {
+	if s.format.ChecksumAlgorithm == BinlogChecksumAlgCRC32 {
+		ev = ev[:len(ev)-4]
+	}
+	_, file := ev.Rotate(s.format)
+	return file
+}
+
+// SemiSyncAckRequested returns the binlog file and position to
+// acknowledge, if the last event returned by ReadBinlogEvent asked
+// for an ACK. It must be called by the go routine reading the events.
+func (c *Conn) SemiSyncAckRequested() (string, uint64, bool) {
+	if c.semiSync == nil || !c.semiSync.ack.requested {
+		return "", 0, false
+	}
+	return c.semiSync.ack.file, c.semiSync.ack.position, true
+}
+
+// WriteSemiSyncAck writes a semi-sync ACK packet, to tell the master
+// the events up to the given binlog file and position were received.
+// The master reads it separately from the binlog stream, so unlike
+// other commands, it doesn't change the packet sequence, and can be
+// written while events are read.
+// Returns a SQLError.
+func (c *Conn) WriteSemiSyncAck(binlogFilename string, binlogPos uint64) error {
+	if c.semiSync == nil {
+		return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "semi-sync is not enabled on this connection")
+	}
+	if c.compressedWriter != nil {
+		return vterrors.Errorf(vtrpc.Code_UNIMPLEMENTED, "semi-sync ACKs are not supported with the compressed protocol")
+	}
+	c.semiSync.mu.Lock()
+	defer c.semiSync.mu.Unlock()
+
+	length := 1 + // semiSyncIndicator
+		8 + // binlog-pos
+		len(binlogFilename) // binlog-filename
+	data := make([]byte, 4+length)
+	data[0] = byte(length)
+	data[1] = byte(length >> 8)
+	data[2] = byte(length >> 16)
+	data[3] = 0 // It is a new command.
+	pos := writeByte(data, 4, semiSyncIndicator)
+	pos = writeUint64(data, pos, binlogPos)
+	_ = writeEOFString(data, pos, binlogFilename)
+	if _, err := c.conn.Write(data); err != nil {
+		return NewSQLError(CRServerGone, SSUnknownSQLState, "%v", err)
+	}
+	return nil
+}
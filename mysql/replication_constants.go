@@ -149,6 +149,17 @@ const (
 	BinlogChecksumAlgUndef = 255
 )
 
+// These constants are used by semi-sync replication.
+const (
+	// semiSyncIndicator is the magic number that starts the semi-sync
+	// header of binlog events, and the semi-sync ACK packet.
+	semiSyncIndicator = 0xef
+
+	// semiSyncAckRequested is set in the semi-sync header of the
+	// binlog events the master waits an ACK for.
+	semiSyncAckRequested = 0x01
+)
+
 // These constants describe the event types.
 // See: http://dev.mysql.com/doc/internals/en/binlog-event-type.html
 const (
@@ -202,6 +213,23 @@ const (
 	eMariaStartEncryptionEvent  = 164
 )
 
+// These constants describe the types of the optional metadata fields
+// MySQL 8.0 appends to a TABLE_MAP_EVENT, see binlog_row_metadata.
+const (
+	tmSignedness               = 1
+	tmDefaultCharset           = 2
+	tmColumnCharset            = 3
+	tmColumnName               = 4
+	tmSetStrValue              = 5
+	tmEnumStrValue             = 6
+	tmGeometryType             = 7
+	tmSimplePrimaryKey         = 8
+	tmPrimaryKeyWithPrefix     = 9
+	tmEnumAndSetDefaultCharset = 10
+	tmEnumAndSetColumnCharset  = 11
+	tmColumnVisibility         = 12
+)
+
 // These constants describe the type of status variables in q Query packet.
 const (
 	// QFlags2Code is Q_FLAGS2_CODE
@@ -128,3 +128,141 @@ func TestComBinlogDumpGTID(t *testing.T) {
 		t.Errorf("ComBinlogDumpGTID returned unexpected data:\n%v\nwas expecting:\n%v", data, expectedData)
 	}
 }
+
+func TestSemiSyncSlave(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+	cConn.flavor = mysqlFlavor{}
+	cConn.semiSync = &semiSyncSlave{}
+
+	f := NewMySQL56BinlogFormat()
+	s := NewFakeBinlogStream()
+	write := func(ev BinlogEvent, ackRequested bool) {
+		header := []byte{0, semiSyncIndicator, 0}
+		if ackRequested {
+			header[2] = semiSyncAckRequested
+		}
+		if err := sConn.writePacket(append(header, ev.(mysql56BinlogEvent).Bytes()...)); err != nil {
+			t.Fatalf("writePacket failed: %v", err)
+		}
+	}
+	read := func(wantFile string, wantPos uint64, wantAck bool) {
+		if _, err := cConn.ReadBinlogEvent(); err != nil {
+			t.Fatalf("ReadBinlogEvent failed: %v", err)
+		}
+		file, pos, ack := cConn.SemiSyncAckRequested()
+		if file != wantFile || pos != wantPos || ack != wantAck {
+			t.Errorf("SemiSyncAckRequested() = %v, %v, %v, want %v, %v, %v", file, pos, ack, wantFile, wantPos, wantAck)
+		}
+	}
+
+	// The file name comes from the first ROTATE_EVENT, parsed once
+	// the format is known, then from the next ones.
+	write(NewRotateEvent(f, s, 4, "vt-bin.000001"), false)
+	write(NewFormatDescriptionEvent(f, s), false)
+	s.LogPosition = 200
+	write(NewQueryEvent(f, s, Query{Database: "vt_test", SQL: "BEGIN"}), false)
+	s.LogPosition = 300
+	write(NewXIDEvent(f, s), true)
+	s.LogPosition = 350
+	write(NewRotateEvent(f, s, 4, "vt-bin.000002"), false)
+	s.LogPosition = 400
+	write(NewXIDEvent(f, s), true)
+
+	read("", 0, false)
+	read("", 0, false)
+	read("", 0, false)
+	read("vt-bin.000001", 300, true)
+	read("", 0, false)
+	read("vt-bin.000002", 400, true)
+
+	// The ACK packet is a new command, sent in the middle of the stream.
+	if err := cConn.WriteSemiSyncAck("vt-bin.000002", 400); err != nil {
+		t.Fatalf("WriteSemiSyncAck failed: %v", err)
+	}
+	sConn.sequence = 0
+	data, err := sConn.ReadPacket()
+	if err != nil {
+		t.Fatalf("sConn.ReadPacket - semi-sync ACK failed: %v", err)
+	}
+	expectedData := []byte{
+		semiSyncIndicator,
+		0x90, 0x01, 0, 0, 0, 0, 0, 0, // binlog-pos
+		'v', 't', '-', 'b', 'i', 'n', '.', '0', '0', '0', '0', '0', '2', // binlog-filename
+	}
+	if !reflect.DeepEqual(data, expectedData) {
+		t.Errorf("semi-sync ACK returned unexpected data:\n%v\nwas expecting:\n%v", data, expectedData)
+	}
+
+	// Events without the semi-sync header are rejected.
+	if err := sConn.writePacket(append([]byte{0}, NewXIDEvent(f, s).(mysql56BinlogEvent).Bytes()...)); err != nil {
+		t.Fatalf("writePacket failed: %v", err)
+	}
+	cConn.sequence = sConn.sequence - 1
+	if _, err := cConn.ReadBinlogEvent(); err == nil {
+		t.Errorf("ReadBinlogEvent of an event without semi-sync header returned no error")
+	}
+}
+
+func TestSemiSyncSlaveFilePos(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+	cConn.flavor = newFilePosFlavor()
+	cConn.semiSync = &semiSyncSlave{}
+
+	f := NewMySQL56BinlogFormat()
+	s := NewFakeBinlogStream()
+	write := func(ev BinlogEvent, ackRequested bool) {
+		header := []byte{0, semiSyncIndicator, 0}
+		if ackRequested {
+			header[2] = semiSyncAckRequested
+		}
+		if err := sConn.writePacket(append(header, ev.(mysql56BinlogEvent).Bytes()...)); err != nil {
+			t.Fatalf("writePacket failed: %v", err)
+		}
+	}
+	read := func(wantGTID bool, wantPos uint64, wantAck bool) {
+		ev, err := cConn.ReadBinlogEvent()
+		if err != nil {
+			t.Fatalf("ReadBinlogEvent failed: %v", err)
+		}
+		if got := ev.IsGTID(); got != wantGTID {
+			t.Errorf("IsGTID() = %v, want %v", got, wantGTID)
+		}
+		wantFile := ""
+		if wantAck {
+			wantFile = "vt-bin.000001"
+		}
+		file, pos, ack := cConn.SemiSyncAckRequested()
+		if file != wantFile || pos != wantPos || ack != wantAck {
+			t.Errorf("SemiSyncAckRequested() = %v, %v, %v, want %v, %v, %v", file, pos, ack, wantFile, wantPos, wantAck)
+		}
+	}
+
+	write(NewRotateEvent(f, s, 4, "vt-bin.000001"), false)
+	write(NewFormatDescriptionEvent(f, s), false)
+	s.LogPosition = 200
+	write(NewQueryEvent(f, s, Query{Database: "vt_test", SQL: "BEGIN"}), false)
+	s.LogPosition = 300
+	write(NewXIDEvent(f, s), true)
+	s.LogPosition = 400
+	write(NewQueryEvent(f, s, Query{Database: "vt_test", SQL: "create table t (id int)"}), false)
+
+	read(false, 0, false)
+	read(false, 0, false)
+	read(false, 0, false)
+	// The made up GTID event comes before the event that asked for
+	// the ACK, which is only reported with that event.
+	read(true, 0, false)
+	read(false, 300, true)
+	read(true, 0, false)
+	read(false, 0, false)
+}
@@ -17,10 +17,12 @@ limitations under the License.
 package mysql
 
 import (
+	"crypto/rsa"
 	"crypto/tls"
 	"io"
 	"net"
 	"strings"
+	"sync"
 	"time"
 
 	"gopkg.in/src-d/go-vitess.v1/netutil"
@@ -31,6 +33,8 @@ import (
 	"gopkg.in/src-d/go-vitess.v1/vt/log"
 	"gopkg.in/src-d/go-vitess.v1/vt/proto/vtrpc"
 	"gopkg.in/src-d/go-vitess.v1/vt/vterrors"
+
+	querypb "gopkg.in/src-d/go-vitess.v1/vt/proto/query"
 )
 
 const (
@@ -56,6 +60,8 @@ var (
 	connAccept = stats.NewCounter("MysqlServerConnAccepted", "Connections accepted by MySQL server")
 	connSlow   = stats.NewCounter("MysqlServerConnSlow", "Connections that took more than the configured mysql_slow_connect_warn_threshold to establish")
 
+	connProxyProtocolErrors = stats.NewCounter("MysqlServerProxyProtocolErrors", "Connections from a trusted proxy that didn't send a valid PROXY protocol header")
+
 	connCountByTLSVer = stats.NewGaugesWithSingleLabel("MysqlServerConnCountByTLSVer", "Active MySQL server connections by TLS version", "tls")
 	connCountPerUser  = stats.NewGaugesWithSingleLabel("MysqlServerConnCountPerUser", "Active MySQL server connections per user", "count")
 	_                 = stats.NewGaugeFunc("MysqlServerConnCountUnauthenticated", "Active MySQL server connections that haven't authenticated yet", func() int64 {
@@ -88,12 +94,32 @@ type Handler interface {
 	// ConnectionClosed is called when a connection is closed.
 	ConnectionClosed(c *Conn)
 
+	// ResetConnection is called when a connection receives a
+	// ComResetConnection or a successful ComChangeUser. The handler
+	// should roll back any transaction in progress, and reset the
+	// session state it keeps in ClientData.
+	ResetConnection(c *Conn)
+
 	// ComQuery is called when a connection receives a query.
 	// Note the contents of the query slice may change after
 	// the first call to callback. So the Handler should not
 	// hang on to the byte slice.
 	ComQuery(c *Conn, query string, callback func(*sqltypes.Result) error) error
 
+	// ComPrepare is called when a connection receives a prepared
+	// statement query. The bindVars map has one entry per '?'
+	// placeholder (named v1, v2, ...), without a value. It returns
+	// the fields of the result set the statement will produce, or
+	// nil if they are only known once it is executed, in which case
+	// the client gets them with the result of each execution. The
+	// handler is in charge of rejecting invalid queries.
+	ComPrepare(c *Conn, query string, bindVars map[string]*querypb.BindVariable) ([]*querypb.Field, error)
+
+	// ComStmtExecute is called when a connection receives a statement
+	// execute query. The bind variables of the statement have been
+	// filled in prepare.BindVars.
+	ComStmtExecute(c *Conn, prepare *PrepareData, callback func(*sqltypes.Result) error) error
+
 	// WarningCount is called at the end of each query to obtain
 	// the value to be returned to the client in the EOF packet.
 	// Note that this will be called either in the context of the
@@ -132,14 +158,44 @@ type Listener struct {
 	// by the server when TLS is not in use.
 	AllowClearTextWithoutTLS bool
 
+	// AllowCompression makes the server advertise
+	// CapabilityClientCompress, so clients can use the compressed
+	// protocol. It trades CPU for bandwidth.
+	AllowCompression bool
+
+	// AllowLocalInfile makes the server advertise
+	// CapabilityClientLocalFiles, so the Handler can ask clients
+	// for files with Conn.RequestLocalInfile, to run LOAD DATA
+	// LOCAL INFILE statements.
+	AllowLocalInfile bool
+
+	// CachingSha2PrivateKey is the RSA key used by the
+	// caching_sha2_password full authentication when TLS is not in
+	// use: the client asks for the public key, and sends back its
+	// password encrypted with it. If not set, the full
+	// authentication is only possible over TLS.
+	CachingSha2PrivateKey *rsa.PrivateKey
+
 	// SlowConnectWarnThreshold if non-zero specifies an amount of time
 	// beyond which a warning is logged to identify the slow connection
 	SlowConnectWarnThreshold time.Duration
 
-	// The following parameters are changed by the Accept routine.
+	// ProxyProtocolTrustedNetworks enables the PROXY protocol (v1
+	// and v2) for the connections coming from these networks, that
+	// is from the load balancers in front of the server. These
+	// connections must start with a PROXY protocol header, and the
+	// client address it contains is used as the remote address of
+	// the connection, for logging and authentication. The other
+	// connections are handled as usual.
+	ProxyProtocolTrustedNetworks []*net.IPNet
+
+	// ProcessAdminGroups are the groups, as returned by the Getter
+	// of the AuthServer, whose users can kill the connections of
+	// the other users with COM_PROCESS_KILL. The other users can
+	// only kill their own connections.
+	ProcessAdminGroups []string
 
-	// Incrementing ID for connection id.
-	connectionID uint32
+	// The following parameters are changed by the Accept routine.
 
 	// Read timeout on a given connection
 	connReadTimeout time.Duration
@@ -152,6 +208,13 @@ type Listener struct {
 	// shutdown indicates that Shutdown method was called.
 	shutdown sync2.AtomicBool
 
+	// connsMu protects conns.
+	connsMu sync.Mutex
+
+	// conns contains the open connections, indexed by connection
+	// ID. See processlist.go.
+	conns map[uint32]*Conn
+
 	// RequireSecureTransport configures the server to reject connections from insecure clients
 	RequireSecureTransport bool
 }
@@ -211,10 +274,10 @@ func NewListenerWithConfig(cfg ListenerConfig) (*Listener, error) {
 		handler:            cfg.Handler,
 		listener:           l,
 		ServerVersion:      DefaultServerVersion,
-		connectionID:       1,
 		connReadTimeout:    cfg.ConnReadTimeout,
 		connWriteTimeout:   cfg.ConnWriteTimeout,
 		connReadBufferSize: cfg.ConnReadBufferSize,
+		conns:              make(map[uint32]*Conn),
 	}, nil
 }
 
@@ -234,8 +297,7 @@ func (l *Listener) Accept() {
 
 		acceptTime := time.Now()
 
-		connectionID := l.connectionID
-		l.connectionID++
+		connectionID := nextConnectionID()
 
 		connCount.Add(1)
 		connAccept.Add(1)
@@ -247,12 +309,30 @@ func (l *Listener) Accept() {
 // handle is called in a go routine for each client connection.
 // FIXME(alainjobart) handle per-connection logs in a way that makes sense.
 func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Time) {
+	// Connections from a trusted proxy start with a PROXY protocol
+	// header, that tells us the real address of the client.
+	if l.isProxyProtocolTrusted(conn.RemoteAddr()) {
+		proxyConn, err := readProxyProtocolHeader(conn)
+		if err != nil {
+			log.Errorf("Cannot read PROXY protocol header from %v: %v", conn.RemoteAddr(), err)
+			connProxyProtocolErrors.Add(1)
+			connCount.Add(-1)
+			conn.Close()
+			return
+		}
+		conn = proxyConn
+	}
+
 	if l.connReadTimeout != 0 || l.connWriteTimeout != 0 {
 		conn = netutil.NewConnWithTimeouts(conn, l.connReadTimeout, l.connWriteTimeout)
 	}
 	c := newServerConn(conn, l)
 	c.ConnectionID = connectionID
 
+	// Register the connection, so it shows up in the process list.
+	l.addConn(c)
+	defer l.removeConn(c)
+
 	// Catch panics, and close the connection in any case.
 	defer func() {
 		if x := recover(); x != nil {
@@ -273,7 +353,7 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 	defer connCount.Add(-1)
 
 	// First build and send the server handshake packet.
-	salt, err := c.writeHandshakeV10(l.ServerVersion, l.authServer, l.TLSConfig != nil)
+	salt, err := c.writeHandshakeV10(l.ServerVersion, l.authServer, l.TLSConfig != nil, l.AllowCompression, l.AllowLocalInfile)
 	if err != nil {
 		if err != io.EOF {
 			log.Errorf("Cannot send HandshakeV10 packet to %s: %v", c, err)
@@ -315,8 +395,7 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 		}
 		c.recycleReadPacket()
 
-		if con, ok := c.conn.(*tls.Conn); ok {
-			connState := con.ConnectionState()
+		if connState, ok := c.TLSConnectionState(); ok {
 			tlsVerStr := tlsVersionToString(connState.Version)
 			if tlsVerStr != "" {
 				connCountByTLSVer.Add(tlsVerStr, 1)
@@ -331,27 +410,82 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 		defer connCountByTLSVer.Add(versionNoTLS, -1)
 	}
 
+	// Remember the salt, ComChangeUser uses it too.
+	c.salt = salt
+
+	if !l.authenticate(c, salt, user, authMethod, authResponse) {
+		return
+	}
+
+	if c.User != "" {
+		connCountPerUser.Add(c.User, 1)
+	}
+	defer func() {
+		// ComChangeUser may have changed the user.
+		if c.User != "" {
+			connCountPerUser.Add(c.User, -1)
+		}
+	}()
+
+	// The connection shows up as idle in the process list.
+	c.setProcessState("")
+
+	// Negotiation worked, send OK packet.
+	if err := c.writeOKPacket(0, 0, c.StatusFlags, 0); err != nil {
+		log.Errorf("Cannot write OK packet to %s: %v", c, err)
+		return
+	}
+
+	// Switch to the compressed protocol if it was negotiated.
+	if c.Capabilities&CapabilityClientCompress != 0 {
+		c.enableCompression()
+	}
+
+	// Record how long we took to establish the connection
+	timings.Record(connectTimingKey, acceptTime)
+
+	// Log a warning if it took too long to connect
+	connectTime := time.Since(acceptTime)
+	if l.SlowConnectWarnThreshold != 0 && connectTime > l.SlowConnectWarnThreshold {
+		connSlow.Add(1)
+		log.Warningf("Slow connection from %s: %v", c, connectTime)
+	}
+
+	for {
+		err := c.handleNextCommand(l.handler)
+		if err != nil {
+			return
+		}
+	}
+}
+
+// authenticate validates the user with the AuthServer, going through
+// the auth method negotiation if needed. authMethod and authResponse
+// are what the client sent, computed with salt. It sets c.User and
+// c.UserData, and returns true if it worked. Otherwise the error has
+// been sent to the client, and the connection should be closed.
+// It is used by the handshake and by ComChangeUser.
+func (l *Listener) authenticate(c *Conn, salt []byte, user, authMethod string, authResponse []byte) bool {
 	// See what auth method the AuthServer wants to use for that user.
 	authServerMethod, err := l.authServer.AuthMethod(user)
 	if err != nil {
 		c.writeErrorPacketFromError(err)
-		return
+		return false
 	}
 
 	// Compare with what the client sent back.
+	var userData Getter
 	switch {
 	case authServerMethod == MysqlNativePassword && authMethod == MysqlNativePassword:
 		// Both server and client want to use MysqlNativePassword:
 		// the negotiation can be completed right away, using the
 		// ValidateHash() method.
-		userData, err := l.authServer.ValidateHash(salt, user, authResponse, conn.RemoteAddr())
+		userData, err = l.authServer.ValidateHash(salt, user, authResponse, c.RemoteAddr())
 		if err != nil {
 			log.Warningf("Error authenticating user using MySQL native password: %v", err)
 			c.writeErrorPacketFromError(err)
-			return
+			return false
 		}
-		c.User = user
-		c.UserData = userData
 
 	case authServerMethod == MysqlNativePassword:
 		// The server really wants to use MysqlNativePassword,
@@ -359,31 +493,58 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 
 		salt, err := l.authServer.Salt()
 		if err != nil {
-			return
+			return false
 		}
 		//lint:ignore SA4006 This line is required because the binary protocol requires padding with 0
 		data := make([]byte, 21)
 		data = append(salt, byte(0x00))
 		if err := c.writeAuthSwitchRequest(MysqlNativePassword, data); err != nil {
 			log.Errorf("Error writing auth switch packet for %s: %v", c, err)
-			return
+			return false
 		}
 
 		response, err := c.readEphemeralPacket()
 		if err != nil {
 			log.Errorf("Error reading auth switch response for %s: %v", c, err)
-			return
+			return false
 		}
 		c.recycleReadPacket()
 
-		userData, err := l.authServer.ValidateHash(salt, user, response, conn.RemoteAddr())
+		userData, err = l.authServer.ValidateHash(salt, user, response, c.RemoteAddr())
 		if err != nil {
 			log.Warningf("Error authenticating user using MySQL native password: %v", err)
 			c.writeErrorPacketFromError(err)
-			return
+			return false
+		}
+
+	case authServerMethod == MysqlCachingSha2Password:
+		// The server wants to use MysqlCachingSha2Password. If the
+		// client returned a result for something else, ask it to
+		// switch, with a new salt.
+		if authMethod != MysqlCachingSha2Password {
+			salt, err = l.authServer.Salt()
+			if err != nil {
+				return false
+			}
+			data := append(salt, byte(0x00))
+			if err := c.writeAuthSwitchRequest(MysqlCachingSha2Password, data); err != nil {
+				log.Errorf("Error writing auth switch packet for %s: %v", c, err)
+				return false
+			}
+
+			authResponse, err = c.ReadPacket()
+			if err != nil {
+				log.Errorf("Error reading auth switch response for %s: %v", c, err)
+				return false
+			}
+		}
+
+		userData, err = l.negotiateCachingSha2Password(c, salt, user, authResponse)
+		if err != nil {
+			log.Warningf("Error authenticating user using caching_sha2_password: %v", err)
+			c.writeErrorPacketFromError(err)
+			return false
 		}
-		c.User = user
-		c.UserData = userData
 
 	default:
 		// The server wants to use something else, re-negotiate.
@@ -391,7 +552,7 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 		// The negotiation happens in clear text. Let's check we can.
 		if !l.AllowClearTextWithoutTLS && c.Capabilities&CapabilityClientSSL == 0 {
 			c.writeErrorPacket(CRServerHandshakeErr, SSUnknownSQLState, "Cannot use clear text authentication over non-SSL connections.")
-			return
+			return false
 		}
 
 		// Switch our auth method to what the server wants.
@@ -402,47 +563,30 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 		}
 		if err := c.writeAuthSwitchRequest(authServerMethod, data); err != nil {
 			log.Errorf("Error writing auth switch packet for %s: %v", c, err)
-			return
+			return false
 		}
 
 		// Then hand over the rest of the negotiation to the
 		// auth server.
-		userData, err := l.authServer.Negotiate(c, user, conn.RemoteAddr())
+		userData, err = l.authServer.Negotiate(c, user, c.RemoteAddr())
 		if err != nil {
 			c.writeErrorPacketFromError(err)
-			return
+			return false
 		}
-		c.User = user
-		c.UserData = userData
 	}
 
-	if c.User != "" {
-		connCountPerUser.Add(c.User, 1)
-		defer connCountPerUser.Add(c.User, -1)
-	}
-
-	// Negotiation worked, send OK packet.
-	if err := c.writeOKPacket(0, 0, c.StatusFlags, 0); err != nil {
-		log.Errorf("Cannot write OK packet to %s: %v", c, err)
-		return
-	}
-
-	// Record how long we took to establish the connection
-	timings.Record(connectTimingKey, acceptTime)
-
-	// Log a warning if it took too long to connect
-	connectTime := time.Since(acceptTime)
-	if l.SlowConnectWarnThreshold != 0 && connectTime > l.SlowConnectWarnThreshold {
-		connSlow.Add(1)
-		log.Warningf("Slow connection from %s: %v", c, connectTime)
-	}
-
-	for {
-		err := c.handleNextCommand(l.handler)
-		if err != nil {
-			return
+	// The user may only be allowed on some connections.
+	if requirements, ok := userData.(ConnRequirements); ok {
+		if err := requirements.CheckConn(c, user); err != nil {
+			log.Warningf("Error authenticating user %v from %s: %v", user, c, err)
+			c.writeErrorPacketFromError(err)
+			return false
 		}
 	}
+
+	c.User = user
+	c.UserData = userData
+	return true
 }
 
 // Close stops the listener, which prevents accept of any new connections. Existing connections won't be closed.
@@ -464,7 +608,7 @@ func (l *Listener) isShutdown() bool {
 
 // writeHandshakeV10 writes the Initial Handshake Packet, server side.
 // It returns the salt data.
-func (c *Conn) writeHandshakeV10(serverVersion string, authServer AuthServer, enableTLS bool) ([]byte, error) {
+func (c *Conn) writeHandshakeV10(serverVersion string, authServer AuthServer, enableTLS, enableCompression, enableLocalInfile bool) ([]byte, error) {
 	capabilities := CapabilityClientLongPassword |
 		CapabilityClientLongFlag |
 		CapabilityClientConnectWithDB |
@@ -476,10 +620,17 @@ func (c *Conn) writeHandshakeV10(serverVersion string, authServer AuthServer, en
 		CapabilityClientPluginAuth |
 		CapabilityClientPluginAuthLenencClientData |
 		CapabilityClientDeprecateEOF |
-		CapabilityClientConnAttr
+		CapabilityClientConnAttr |
+		CapabilityClientSessionTrack
 	if enableTLS {
 		capabilities |= CapabilityClientSSL
 	}
+	if enableCompression {
+		capabilities |= CapabilityClientCompress
+	}
+	if enableLocalInfile {
+		capabilities |= CapabilityClientLocalFiles
+	}
 
 	length :=
 		1 + // protocol version
@@ -582,8 +733,10 @@ func (l *Listener) parseClientHandshakePacket(c *Conn, firstTime bool, data []by
 	// Remember a subset of the capabilities, so we can use them
 	// later in the protocol. If we re-received the handshake packet
 	// after SSL negotiation, do not overwrite capabilities.
+	// CapabilityClientSecureConnection and CapabilityClientPluginAuth
+	// are needed to parse ComChangeUser packets.
 	if firstTime {
-		c.Capabilities = clientFlags & (CapabilityClientDeprecateEOF | CapabilityClientFoundRows)
+		c.Capabilities = clientFlags & (CapabilityClientDeprecateEOF | CapabilityClientFoundRows | CapabilityClientSecureConnection | CapabilityClientPluginAuth | CapabilityClientSessionTrack)
 	}
 
 	// set connection capability for executing multi statements
@@ -591,6 +744,18 @@ func (l *Listener) parseClientHandshakePacket(c *Conn, firstTime bool, data []by
 		c.Capabilities |= CapabilityClientMultiStatements
 	}
 
+	// set connection capability for the compressed protocol, if we
+	// advertised it. It is only used after the handshake.
+	if l.AllowCompression && clientFlags&CapabilityClientCompress > 0 {
+		c.Capabilities |= CapabilityClientCompress
+	}
+
+	// set connection capability for LOAD DATA LOCAL INFILE, if we
+	// advertised it.
+	if l.AllowLocalInfile && clientFlags&CapabilityClientLocalFiles > 0 {
+		c.Capabilities |= CapabilityClientLocalFiles
+	}
+
 	// Max packet size. Don't do anything with this now.
 	// See doc.go for more information.
 	_, pos, ok = readUint32(data, pos)
@@ -683,8 +848,11 @@ func (l *Listener) parseClientHandshakePacket(c *Conn, firstTime bool, data []by
 
 	// Decode connection attributes send by the client
 	if clientFlags&CapabilityClientConnAttr != 0 {
-		if _, _, err := parseConnAttrs(data, pos); err != nil {
+		attrs, _, err := parseConnAttrs(data, pos)
+		if err != nil {
 			log.Warningf("Decode connection attributes send by the client: %v", err)
+		} else {
+			c.ConnAttrs = attrs
 		}
 	}
 
@@ -704,12 +872,13 @@ func parseConnAttrs(data []byte, pos int) (map[string]string, int, error) {
 	attrs := make(map[string]string)
 
 	for attrLenRead < attrLen {
-		var keyLen byte
-		keyLen, pos, ok = readByte(data, pos)
+		var keyLen uint64
+		start := pos
+		keyLen, pos, ok = readLenEncInt(data, pos)
 		if !ok {
 			return nil, 0, vterrors.Errorf(vtrpc.Code_INTERNAL, "parseClientHandshakePacket: can't read connection attribute key length")
 		}
-		attrLenRead += uint64(keyLen) + 1
+		attrLenRead += uint64(pos-start) + keyLen
 
 		var connAttrKey []byte
 		connAttrKey, pos, ok = readBytesCopy(data, pos, int(keyLen))
@@ -717,12 +886,13 @@ func parseConnAttrs(data []byte, pos int) (map[string]string, int, error) {
 			return nil, 0, vterrors.Errorf(vtrpc.Code_INTERNAL, "parseClientHandshakePacket: can't read connection attribute key")
 		}
 
-		var valLen byte
-		valLen, pos, ok = readByte(data, pos)
+		var valLen uint64
+		start = pos
+		valLen, pos, ok = readLenEncInt(data, pos)
 		if !ok {
 			return nil, 0, vterrors.Errorf(vtrpc.Code_INTERNAL, "parseClientHandshakePacket: can't read connection attribute value length")
 		}
-		attrLenRead += uint64(valLen) + 1
+		attrLenRead += uint64(pos-start) + valLen
 
 		var connAttrVal []byte
 		connAttrVal, pos, ok = readBytesCopy(data, pos, int(valLen))
@@ -737,6 +907,20 @@ func parseConnAttrs(data []byte, pos int) (map[string]string, int, error) {
 
 }
 
+// ConnAttrsProgram returns the client program from the connection
+// attributes, as "program_name[pid]". It returns "" if the client
+// didn't send its program name.
+func ConnAttrsProgram(attrs map[string]string) string {
+	program := attrs[ConnAttrProgramName]
+	if program == "" {
+		return ""
+	}
+	if pid := attrs[ConnAttrPid]; pid != "" {
+		program += "[" + pid + "]"
+	}
+	return program
+}
+
 // writeAuthSwitchRequest writes an auth switch request packet.
 func (c *Conn) writeAuthSwitchRequest(pluginName string, pluginData []byte) error {
 	length := 1 + // AuthSwitchRequestPacket
@@ -762,6 +946,85 @@ func (c *Conn) writeAuthSwitchRequest(pluginName string, pluginData []byte) erro
 	return c.writeEphemeralPacket()
 }
 
+// writeAuthMoreData writes an auth more data packet.
+func (c *Conn) writeAuthMoreData(pluginData []byte) error {
+	data := c.startEphemeralPacket(1 + len(pluginData))
+	pos := writeByte(data, 0, AuthMoreDataPacket)
+	copy(data[pos:], pluginData)
+	return c.writeEphemeralPacket()
+}
+
+// negotiateCachingSha2Password finishes the caching_sha2_password
+// negotiation, after the client sent authResponse, its scramble of
+// the password with salt. It returns the user data, and the framework
+// is responsible for writing the OK or Error packet.
+func (l *Listener) negotiateCachingSha2Password(c *Conn, salt []byte, user string, authResponse []byte) (Getter, error) {
+	authServer, ok := l.authServer.(CachingSha2AuthServer)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "AuthServer does not support %v", MysqlCachingSha2Password)
+	}
+	remoteAddr := c.RemoteAddr()
+
+	// An empty password is sent as an empty scramble, and the
+	// client doesn't expect any more data.
+	if len(authResponse) == 0 || (len(authResponse) == 1 && authResponse[0] == 0) {
+		return authServer.ValidateCachingSha2Password(user, "", remoteAddr)
+	}
+
+	// Fast authentication, using the scramble.
+	userData, err := authServer.ValidateCachingSha2Hash(salt, user, authResponse, remoteAddr)
+	if err != nil {
+		return nil, err
+	}
+	if userData != nil {
+		if err := c.writeAuthMoreData([]byte{cachingSha2FastAuth}); err != nil {
+			return nil, err
+		}
+		return userData, nil
+	}
+
+	// Full authentication, we need the password.
+	if err := c.writeAuthMoreData([]byte{cachingSha2FullAuth}); err != nil {
+		return nil, err
+	}
+	data, err := c.ReadPacket()
+	if err != nil {
+		return nil, err
+	}
+
+	// Over TLS or a unix socket, the password is sent in the clear.
+	if _, isUnix := remoteAddr.(*net.UnixAddr); isUnix || c.Capabilities&CapabilityClientSSL != 0 {
+		if len(data) == 0 || data[len(data)-1] != 0 {
+			return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "received invalid response packet, datalen=%v", len(data))
+		}
+		return authServer.ValidateCachingSha2Password(user, string(data[:len(data)-1]), remoteAddr)
+	}
+
+	// Otherwise it is encrypted with our public key, that the
+	// client may ask for first.
+	if l.CachingSha2PrivateKey == nil {
+		return nil, NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "Cannot use caching_sha2_password full authentication over non-SSL connections without a RSA key.")
+	}
+	if len(data) == 1 && data[0] == cachingSha2RequestPublicKey {
+		pub, err := marshalPublicKey(&l.CachingSha2PrivateKey.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.writeAuthMoreData(pub); err != nil {
+			return nil, err
+		}
+		data, err = c.ReadPacket()
+		if err != nil {
+			return nil, err
+		}
+	}
+	password, err := decryptPasswordWithPrivateKey(salt, data, l.CachingSha2PrivateKey)
+	if err != nil {
+		return nil, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
+	}
+	return authServer.ValidateCachingSha2Password(user, password, remoteAddr)
+}
+
 // Whenever we move to a new version of go, we will need add any new supported TLS versions here
 func tlsVersionToString(version uint16) string {
 	switch version {
@@ -18,6 +18,7 @@ package mysql
 
 import (
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"os"
@@ -64,10 +65,15 @@ var selectRowsResult = &sqltypes.Result{
 }
 
 type testHandler struct {
-	lastConn *Conn
-	result   *sqltypes.Result
-	err      error
-	warnings uint16
+	lastConn     *Conn
+	lastBindVars map[string]*querypb.BindVariable
+	result       *sqltypes.Result
+	err          error
+	warnings     uint16
+	resets       int
+
+	// localInfileRead is how much the "load local" query reads.
+	localInfileRead int
 }
 
 func (th *testHandler) NewConnection(c *Conn) {
@@ -77,6 +83,10 @@ func (th *testHandler) NewConnection(c *Conn) {
 func (th *testHandler) ConnectionClosed(c *Conn) {
 }
 
+func (th *testHandler) ResetConnection(c *Conn) {
+	th.resets++
+}
+
 func (th *testHandler) ComQuery(c *Conn, query string, callback func(*sqltypes.Result) error) error {
 	if th.result != nil {
 		callback(th.result)
@@ -93,6 +103,33 @@ func (th *testHandler) ComQuery(c *Conn, query string, callback func(*sqltypes.R
 	case "error after send":
 		callback(selectRowsResult)
 		return th.err
+	case "load local":
+		// Reads a few bytes only, Close drops the rest.
+		r, err := c.RequestLocalInfile("data.csv")
+		if err != nil {
+			return err
+		}
+		data := make([]byte, th.localInfileRead)
+		n, err := io.ReadFull(r, data)
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return err
+		}
+		if err := r.Close(); err != nil {
+			return err
+		}
+		callback(&sqltypes.Result{
+			Fields: []*querypb.Field{{Name: "data", Type: querypb.Type_VARCHAR}},
+			Rows:   [][]sqltypes.Value{{sqltypes.NewVarChar(string(data[:n]))}},
+		})
+	case "wait for kill":
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		c.SetCancelFunc(cancel)
+		<-ctx.Done()
+		if ctx.Err() == context.Canceled {
+			return NewSQLError(ERQueryInterrupted, SSQueryInterrupted, "Query execution was interrupted")
+		}
+		return ctx.Err()
 	case "insert":
 		callback(&sqltypes.Result{
 			RowsAffected: 123,
@@ -171,6 +208,19 @@ func (th *testHandler) ComQuery(c *Conn, query string, callback func(*sqltypes.R
 	return nil
 }
 
+func (th *testHandler) ComPrepare(c *Conn, query string, bindVars map[string]*querypb.BindVariable) ([]*querypb.Field, error) {
+	if query == "error" {
+		return nil, th.err
+	}
+	th.lastBindVars = bindVars
+	return nil, nil
+}
+
+func (th *testHandler) ComStmtExecute(c *Conn, prepare *PrepareData, callback func(*sqltypes.Result) error) error {
+	th.lastBindVars = prepare.BindVars
+	return th.ComQuery(c, prepare.PrepareStmt, callback)
+}
+
 func (th *testHandler) WarningCount(c *Conn) uint16 {
 	return th.warnings
 }
@@ -489,6 +539,174 @@ func TestConnCounts(t *testing.T) {
 	//checkCountsForUser(t, user, 0)
 }
 
+// TestChangeUserAndResetConnection tests the server side of
+// ComChangeUser and ComResetConnection.
+func TestChangeUserAndResetConnection(t *testing.T) {
+	th := &testHandler{}
+
+	authServer := NewAuthServerStatic()
+	authServer.Entries["changeUser1"] = []*AuthServerStaticEntry{{
+		Password: "password1",
+		UserData: "userData1",
+	}}
+	authServer.Entries["changeUser2"] = []*AuthServerStaticEntry{{
+		Password: "password2",
+		UserData: "userData2",
+	}}
+	l, err := NewListener("tcp", ":0", authServer, th, 0, 0)
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	defer l.Close()
+	go l.Accept()
+
+	host, port := getHostPort(t, l.Addr())
+
+	params := &ConnParams{
+		Host:   host,
+		Port:   port,
+		Uname:  "changeUser1",
+		Pass:   "password1",
+		DbName: "db1",
+	}
+	c, err := Connect(context.Background(), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	sConn := th.lastConn
+	checkCountsForUser(t, "changeUser1", 1)
+
+	// Start a prepared statement, it should be dropped by the reset.
+	sConn.PrepareData[1] = &PrepareData{StatementID: 1}
+
+	// ComResetConnection returns OK and calls the handler.
+	c.resetSequence()
+	if err := c.writePacket([]byte{ComResetConnection}); err != nil {
+		t.Fatalf("writePacket(ComResetConnection) failed: %v", err)
+	}
+	data, err := c.ReadPacket()
+	if err != nil || data[0] != OKPacket {
+		t.Fatalf("ComResetConnection returned %v %v", data, err)
+	}
+	if th.resets != 1 || len(sConn.PrepareData) != 0 {
+		t.Errorf("ComResetConnection didn't reset the connection: resets=%v PrepareData=%v", th.resets, sConn.PrepareData)
+	}
+
+	// ComChangeUser with the right password switches user.
+	if err := writeTestComChangeUser(c, "changeUser2", ScramblePassword(sConn.salt, []byte("password2")), "db2"); err != nil {
+		t.Fatalf("writeTestComChangeUser failed: %v", err)
+	}
+	data, err = c.ReadPacket()
+	if err != nil || data[0] != OKPacket {
+		t.Fatalf("ComChangeUser returned %v %v", data, err)
+	}
+	if sConn.User != "changeUser2" || sConn.UserData.Get().Username != "userData2" || sConn.SchemaName != "db2" {
+		t.Errorf("ComChangeUser didn't switch user: %v %v %v", sConn.User, sConn.UserData.Get().Username, sConn.SchemaName)
+	}
+	if th.resets != 2 {
+		t.Errorf("ComChangeUser didn't reset the connection: resets=%v", th.resets)
+	}
+	checkCountsForUser(t, "changeUser1", 0)
+	checkCountsForUser(t, "changeUser2", 1)
+
+	// The connection is still usable.
+	result, err := c.ExecuteFetch("select rows", 10000, true)
+	if err != nil {
+		t.Fatalf("ExecuteFetch failed: %v", err)
+	}
+	if len(result.Rows) != 2 {
+		t.Errorf("Got wrong result from ExecuteFetch(select rows): %v", result)
+	}
+
+	// ComChangeUser with a bad password fails.
+	if err := writeTestComChangeUser(c, "changeUser1", ScramblePassword(sConn.salt, []byte("bad")), ""); err != nil {
+		t.Fatalf("writeTestComChangeUser failed: %v", err)
+	}
+	data, err = c.ReadPacket()
+	if err != nil || data[0] != ErrPacket {
+		t.Fatalf("ComChangeUser returned %v %v", data, err)
+	}
+	err = ParseErrorPacket(data)
+	if sqlErr, ok := err.(*SQLError); !ok || sqlErr.Number() != ERAccessDeniedError {
+		t.Errorf("ComChangeUser returned unexpected error: %v", err)
+	}
+}
+
+// writeTestComChangeUser writes a ComChangeUser packet, using
+// mysql_native_password.
+func writeTestComChangeUser(c *Conn, user string, authResponse []byte, dbName string) error {
+	data := []byte{ComChangeUser}
+	data = append(data, user...)
+	data = append(data, 0)
+	data = append(data, byte(len(authResponse)))
+	data = append(data, authResponse...)
+	data = append(data, dbName...)
+	data = append(data, 0)
+	data = append(data, CharacterSetUtf8, 0)
+	data = append(data, MysqlNativePassword...)
+	data = append(data, 0)
+	c.resetSequence()
+	return c.writePacket(data)
+}
+
+// TestMultiStatements tests that the statements of a query are run in
+// turn, and that the first error stops the execution.
+func TestMultiStatements(t *testing.T) {
+	th := &testHandler{}
+
+	authServer := NewAuthServerStatic()
+	authServer.Entries["user1"] = []*AuthServerStaticEntry{{
+		Password: "password1",
+	}}
+	l, err := NewListener("tcp", ":0", authServer, th, 0, 0)
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	defer l.Close()
+	go l.Accept()
+
+	host, port := getHostPort(t, l.Addr())
+	params := &ConnParams{
+		Host:  host,
+		Port:  port,
+		Uname: "user1",
+		Pass:  "password1",
+	}
+	c, err := Connect(context.Background(), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	// Two statements, each with a result.
+	result, more, err := c.ExecuteFetchMulti("insert; select rows", 10000, true)
+	if err != nil || !more || result.RowsAffected != 123 {
+		t.Fatalf("ExecuteFetchMulti(insert) returned %v %v %v", result, more, err)
+	}
+	result, more, _, err = c.ReadQueryResult(10000, true)
+	if err != nil || more || len(result.Rows) != 2 {
+		t.Fatalf("ReadQueryResult(select rows) returned %v %v %v", result, more, err)
+	}
+
+	// The error stops the execution, the last statement is not run.
+	th.err = NewSQLError(ERUnknownComError, SSUnknownComError, "forced query error")
+	result, more, err = c.ExecuteFetchMulti("select rows; error; insert", 10000, true)
+	if err != nil || !more || len(result.Rows) != 2 {
+		t.Fatalf("ExecuteFetchMulti(select rows) returned %v %v %v", result, more, err)
+	}
+	_, more, _, err = c.ReadQueryResult(10000, true)
+	if err == nil || !strings.Contains(err.Error(), "forced query error") || more {
+		t.Fatalf("ReadQueryResult(error) returned %v %v", more, err)
+	}
+
+	// The connection is still in sync.
+	result, err = c.ExecuteFetch("select rows", 10000, true)
+	if err != nil || len(result.Rows) != 2 {
+		t.Fatalf("ExecuteFetch(select rows) returned %v %v", result, err)
+	}
+}
+
 func checkCountsForUser(t *testing.T, user string, expected int64) {
 	connCounts := connCountPerUser.Counts()
 
@@ -0,0 +1,93 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+// This file implements the server side of session state tracking,
+// used when the client asked for CapabilityClientSessionTrack.
+// The Handler records the changes to the session while it runs a
+// query, and they are sent to the client at the end of the next OK
+// packet, after the info string:
+// - lenenc string: all the changes, each one being:
+//   - 1 byte: the type of change, one of the SessionTrack* constants.
+//   - lenenc string: the data, which depends on the type of change.
+// The ServerSessionStateChanged status flag is set in that case.
+
+// TrackSchema records a change of the default database, to be sent to
+// the client with the next OK packet. It does nothing if the client
+// doesn't support session state tracking.
+func (c *Conn) TrackSchema(schema string) {
+	data := make([]byte, lenEncStringSize(schema))
+	writeLenEncString(data, 0, schema)
+	c.trackSessionState(SessionTrackSchema, data)
+}
+
+// TrackSystemVariable records a change of a system variable, to be
+// sent to the client with the next OK packet. It does nothing if the
+// client doesn't support session state tracking.
+func (c *Conn) TrackSystemVariable(name, value string) {
+	data := make([]byte, lenEncStringSize(name)+lenEncStringSize(value))
+	pos := writeLenEncString(data, 0, name)
+	writeLenEncString(data, pos, value)
+	c.trackSessionState(SessionTrackSystemVariables, data)
+}
+
+// TrackTransactionState records a change of the transaction state,
+// to be sent to the client with the next OK packet. The state uses
+// the 8 characters format of MySQL's session_track_transaction_info,
+// for instance "T_______" for an explicit transaction, and "________"
+// when no transaction is in progress. It does nothing if the client
+// doesn't support session state tracking.
+func (c *Conn) TrackTransactionState(state string) {
+	data := make([]byte, lenEncStringSize(state))
+	writeLenEncString(data, 0, state)
+	c.trackSessionState(SessionTrackTransactionState, data)
+}
+
+// trackSessionState adds a change of the given type to the pending
+// session state changes.
+func (c *Conn) trackSessionState(changeType byte, data []byte) {
+	if c.Capabilities&CapabilityClientSessionTrack == 0 {
+		return
+	}
+	entry := make([]byte, 1+lenEncIntSize(uint64(len(data)))+len(data))
+	pos := writeByte(entry, 0, changeType)
+	pos = writeLenEncInt(entry, pos, uint64(len(data)))
+	copy(entry[pos:], data)
+	c.sessionState = append(c.sessionState, entry...)
+}
+
+// okPacketSessionState returns the status flags and the end of an OK
+// packet. If the client supports session state tracking, it is the
+// (empty) info string, followed by the pending session state changes
+// if any. The pending changes are then cleared.
+func (c *Conn) okPacketSessionState(flags uint16) (uint16, []byte) {
+	if c.Capabilities&CapabilityClientSessionTrack == 0 {
+		return flags, nil
+	}
+
+	if len(c.sessionState) == 0 {
+		// Just the empty info string.
+		return flags, []byte{0}
+	}
+
+	length := uint64(len(c.sessionState))
+	data := make([]byte, 1+lenEncIntSize(length)+len(c.sessionState))
+	pos := writeLenEncInt(data, 1, length)
+	copy(data[pos:], c.sessionState)
+	c.sessionState = nil
+	return flags | ServerSessionStateChanged, data
+}
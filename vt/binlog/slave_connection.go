@@ -44,6 +44,25 @@ type SlaveConnection struct {
 	slaveID uint32
 	cancel  context.CancelFunc
 	wg      sync.WaitGroup
+
+	// stream is the connection the events are read from and
+	// acknowledged to. It is Conn, except in tests.
+	stream binlogStream
+}
+
+// binlogStream is the part of a mysql.Conn used to stream binlog events.
+type binlogStream interface {
+	ReadBinlogEvent() (mysql.BinlogEvent, error)
+	SemiSyncAckRequested() (string, uint64, bool)
+	WriteSemiSyncAck(binlogFilename string, binlogPos uint64) error
+}
+
+// semiSyncEvent is a binlog event sent to the consumer that asked for a
+// semi-sync ACK of the given binlog file and position.
+type semiSyncEvent struct {
+	mysql.BinlogEvent
+	file string
+	pos  uint64
 }
 
 // NewSlaveConnection creates a new slave connection to the mysqld instance.
@@ -63,6 +82,7 @@ func NewSlaveConnection(cp *mysql.ConnParams) (*SlaveConnection, error) {
 		Conn:    conn,
 		cp:      cp,
 		slaveID: slaveIDPool.Get(),
+		stream:  conn,
 	}
 	log.Infof("new slave connection: slaveID=%d", sc.slaveID)
 	return sc, nil
@@ -90,6 +110,27 @@ func connectForReplication(cp *mysql.ConnParams) (*mysql.Conn, error) {
 	return conn, nil
 }
 
+// EnableSemiSync makes the master wait for the ACKs of this connection,
+// as it does for semi-sync slaves. It returns false if semi-sync is not
+// enabled on the master. It must be called before the StartBinlogDump*
+// methods, and the consumer of the events then calls SemiSyncAck.
+func (sc *SlaveConnection) EnableSemiSync() (bool, error) {
+	return sc.Conn.EnableSemiSyncSlave()
+}
+
+// SemiSyncAck acknowledges ev, an event received from the channel, if
+// it asked for it. The consumer calls it once it has durably processed
+// the event, to be a semi-sync ACK target.
+// It does nothing for the other events, which are all of them if
+// semi-sync was not enabled with EnableSemiSync.
+func (sc *SlaveConnection) SemiSyncAck(ev mysql.BinlogEvent) error {
+	sev, ok := ev.(semiSyncEvent)
+	if !ok {
+		return nil
+	}
+	return sc.stream.WriteSemiSyncAck(sev.file, sev.pos)
+}
+
 // slaveIDPool is the IDPool for server IDs used to connect as a slave.
 var slaveIDPool = pools.NewIDPool()
 
@@ -140,7 +181,7 @@ func (sc *SlaveConnection) streamEvents(ctx context.Context) chan mysql.BinlogEv
 			sc.wg.Done()
 		}()
 		for {
-			event, err := sc.Conn.ReadBinlogEvent()
+			event, err := sc.stream.ReadBinlogEvent()
 			if err != nil {
 				if sqlErr, ok := err.(*mysql.SQLError); ok && sqlErr.Number() == mysql.CRServerLost {
 					// CRServerLost = Lost connection to MySQL server during query
@@ -152,6 +193,11 @@ func (sc *SlaveConnection) streamEvents(ctx context.Context) chan mysql.BinlogEv
 				log.Errorf("read error while streaming binlog events: %v", err)
 				return
 			}
+			// The ACK goes with the event, for the consumer
+			// to send it once the event is processed.
+			if file, pos, ok := sc.stream.SemiSyncAckRequested(); ok {
+				event = semiSyncEvent{BinlogEvent: event, file: file, pos: pos}
+			}
 
 			select {
 			case eventChan <- event:
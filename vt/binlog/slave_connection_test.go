@@ -0,0 +1,92 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binlog
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/src-d/go-vitess.v1/mysql"
+)
+
+// fakeSemiSyncEvent is an event of a fakeBinlogStream, which may ask
+// for a semi-sync ACK.
+type fakeSemiSyncEvent struct {
+	event mysql.BinlogEvent
+	file  string
+	pos   uint64
+}
+
+// fakeBinlogStream streams the events it is given, and records the
+// semi-sync ACKs it receives.
+type fakeBinlogStream struct {
+	events []fakeSemiSyncEvent
+	last   fakeSemiSyncEvent
+	acks   []string
+}
+
+func (s *fakeBinlogStream) ReadBinlogEvent() (mysql.BinlogEvent, error) {
+	if len(s.events) == 0 {
+		return nil, mysql.NewSQLError(mysql.CRServerLost, mysql.SSUnknownSQLState, "EOF")
+	}
+	s.last, s.events = s.events[0], s.events[1:]
+	return s.last.event, nil
+}
+
+func (s *fakeBinlogStream) SemiSyncAckRequested() (string, uint64, bool) {
+	return s.last.file, s.last.pos, s.last.file != ""
+}
+
+func (s *fakeBinlogStream) WriteSemiSyncAck(binlogFilename string, binlogPos uint64) error {
+	s.acks = append(s.acks, fmt.Sprintf("%v:%v", binlogFilename, binlogPos))
+	return nil
+}
+
+func TestSlaveConnectionSemiSyncAck(t *testing.T) {
+	f := mysql.NewMySQL56BinlogFormat()
+	s := mysql.NewFakeBinlogStream()
+	stream := &fakeBinlogStream{
+		events: []fakeSemiSyncEvent{
+			{event: mysql.NewQueryEvent(f, s, mysql.Query{Database: "vt_test", SQL: "BEGIN"})},
+			{event: mysql.NewXIDEvent(f, s), file: "vt-bin.000001", pos: 300},
+			{event: mysql.NewQueryEvent(f, s, mysql.Query{Database: "vt_test", SQL: "BEGIN"})},
+			{event: mysql.NewXIDEvent(f, s), file: "vt-bin.000001", pos: 500},
+		},
+	}
+	sc := &SlaveConnection{stream: stream}
+
+	// The consumer acknowledges each event as soon as it gets it.
+	var xids int
+	for ev := range sc.streamEvents(context.Background()) {
+		if ev.IsXID() {
+			xids++
+		}
+		if err := sc.SemiSyncAck(ev); err != nil {
+			t.Fatalf("SemiSyncAck failed: %v", err)
+		}
+	}
+	if xids != 2 {
+		t.Errorf("got %v XID events, want 2", xids)
+	}
+	want := []string{"vt-bin.000001:300", "vt-bin.000001:500"}
+	if !reflect.DeepEqual(stream.acks, want) {
+		t.Errorf("got ACKs %v, want %v", stream.acks, want)
+	}
+}
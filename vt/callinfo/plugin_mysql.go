@@ -32,12 +32,14 @@ func MysqlCallInfo(ctx context.Context, c *mysql.Conn) context.Context {
 	return NewContext(ctx, &mysqlCallInfoImpl{
 		remoteAddr: c.RemoteAddr().String(),
 		user:       c.User,
+		program:    mysql.ConnAttrsProgram(c.ConnAttrs),
 	})
 }
 
 type mysqlCallInfoImpl struct {
 	remoteAddr string
 	user       string
+	program    string
 }
 
 func (mci *mysqlCallInfoImpl) RemoteAddr() string {
@@ -49,9 +51,16 @@ func (mci *mysqlCallInfoImpl) Username() string {
 }
 
 func (mci *mysqlCallInfoImpl) Text() string {
+	if mci.program != "" {
+		return fmt.Sprintf("%s@%s(Mysql %s)", mci.user, mci.remoteAddr, mci.program)
+	}
 	return fmt.Sprintf("%s@%s(Mysql)", mci.user, mci.remoteAddr)
 }
 
 func (mci *mysqlCallInfoImpl) HTML() template.HTML {
-	return template.HTML("<b>MySQL User:</b> " + mci.user + " <b>Remote Addr:<b> " + mci.remoteAddr)
+	html := "<b>MySQL User:</b> " + mci.user + " <b>Remote Addr:<b> " + mci.remoteAddr
+	if mci.program != "" {
+		html += " <b>Program:</b> " + template.HTMLEscapeString(mci.program)
+	}
+	return template.HTML(html)
 }
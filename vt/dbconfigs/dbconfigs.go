@@ -96,6 +96,7 @@ func registerBaseFlags() {
 	flag.IntVar(&baseConfig.Port, "db_port", 0, "tcp port")
 	flag.StringVar(&baseConfig.Charset, "db_charset", "", "Character set. Only utf8 or latin1 based character sets are supported.")
 	flag.Uint64Var(&baseConfig.Flags, "db_flags", 0, "Flag values as defined by MySQL.")
+	flag.StringVar(&baseConfig.Flavor, "db_flavor", "", "Replication flavor to use instead of auto-detecting it. Set to FilePos for servers that don't use GTIDs.")
 	flag.StringVar(&baseConfig.SslCa, "db_ssl_ca", "", "connection ssl ca")
 	flag.StringVar(&baseConfig.SslCaPath, "db_ssl_ca_path", "", "connection ssl ca path")
 	flag.StringVar(&baseConfig.SslCert, "db_ssl_cert", "", "connection ssl certificate")
@@ -252,6 +253,9 @@ func Init(defaultSocketFile string) (*DBConfigs, error) {
 		if baseConfig.Flags != 0 {
 			uc.param.Flags = baseConfig.Flags
 		}
+		if baseConfig.Flavor != "" {
+			uc.param.Flavor = baseConfig.Flavor
+		}
 		if uc.useSSL {
 			uc.param.SslCa = baseConfig.SslCa
 			uc.param.SslCaPath = baseConfig.SslCaPath
@@ -157,3 +157,25 @@ func NoTestMycnfHook(t *testing.T) {
 		t.Errorf("Unexpected env %v set to %v", "MY_VAR", got)
 	}
 }
+
+func TestMycnfTemplatesFlavor(t *testing.T) {
+	defer os.Setenv("MYSQL_FLAVOR", os.Getenv("MYSQL_FLAVOR"))
+	os.Setenv("MYSQL_FLAVOR", "")
+
+	// The flavor comes from the detected mysqld version.
+	mysqld := &Mysqld{version: "mysqld  Ver 8.0.16 for Linux on x86_64 (MySQL Community Server - GPL)"}
+	flavor := mysqld.mycnfFlavor()
+	if flavor != "MySQL80" {
+		t.Errorf("mycnfFlavor() = %v, want MySQL80", flavor)
+	}
+	templates := getMycnfTemplates("/vt", flavor)
+	if got, want := templates[len(templates)-1], "/vt/config/mycnf/master_mysql80.cnf"; got != want {
+		t.Errorf("getMycnfTemplates() ends with %v, want %v", got, want)
+	}
+
+	// MYSQL_FLAVOR takes precedence.
+	os.Setenv("MYSQL_FLAVOR", "MariaDB")
+	if got := mysqld.mycnfFlavor(); got != "MariaDB" {
+		t.Errorf("mycnfFlavor() = %v, want MariaDB", got)
+	}
+}
@@ -90,6 +90,8 @@ type Mysqld struct {
 	mutex         sync.Mutex
 	onTermFuncs   []func()
 	cancelWaitCmd chan struct{}
+	// version is the output of 'mysqld --version', once detected.
+	version string
 }
 
 // NewMysqld creates a Mysqld object based on the provided configuration
@@ -538,7 +540,50 @@ func (mysqld *Mysqld) Init(ctx context.Context, cnf *Mycnf, initDBSQLFile string
 // Instead, initialization is built into mysqld.
 func useMysqldInitialize(version string) bool {
 	return strings.Contains(version, "Ver 5.7.") ||
-		strings.Contains(version, "Ver 8.0.")
+		strings.Contains(version, "Ver 8.")
+}
+
+// mysqldVersion returns the output of 'mysqld --version'.
+func mysqldVersion() (string, error) {
+	mysqlRoot, err := vtenv.VtMysqlRoot()
+	if err != nil {
+		return "", err
+	}
+	mysqldPath, err := binaryPath(mysqlRoot, "mysqld")
+	if err != nil {
+		return "", err
+	}
+	_, version, err := execCmd(mysqldPath, []string{"--version"}, nil, mysqlRoot, nil)
+	return version, err
+}
+
+// mysqldVersion returns the output of 'mysqld --version'. It is only
+// run once, the next calls return the same version.
+func (mysqld *Mysqld) mysqldVersion() (string, error) {
+	mysqld.mutex.Lock()
+	defer mysqld.mutex.Unlock()
+	if mysqld.version == "" {
+		version, err := mysqldVersion()
+		if err != nil {
+			return "", err
+		}
+		mysqld.version = version
+	}
+	return mysqld.version, nil
+}
+
+// mycnfFlavor returns the flavor to generate my.cnf for. It is the
+// MYSQL_FLAVOR environment variable if set. Otherwise MySQL 8.0 is
+// detected from the mysqld version, as it doesn't accept some of the
+// MySQL 5.6 settings.
+func (mysqld *Mysqld) mycnfFlavor() string {
+	if flavor := os.Getenv("MYSQL_FLAVOR"); flavor != "" {
+		return flavor
+	}
+	if version, err := mysqld.mysqldVersion(); err == nil && strings.Contains(version, "Ver 8.") {
+		return "MySQL80"
+	}
+	return ""
 }
 
 func (mysqld *Mysqld) installDataDir(cnf *Mycnf) error {
@@ -557,7 +602,7 @@ func (mysqld *Mysqld) installDataDir(cnf *Mycnf) error {
 	}
 
 	// Check mysqld version.
-	_, version, err := execCmd(mysqldPath, []string{"--version"}, nil, mysqlRoot, nil)
+	version, err := mysqld.mysqldVersion()
 	if err != nil {
 		return err
 	}
@@ -606,7 +651,7 @@ func (mysqld *Mysqld) initConfig(root string, cnf *Mycnf, outFile string) error
 	switch hr := hook.NewHookWithEnv("make_mycnf", nil, env).Execute(); hr.ExitStatus {
 	case hook.HOOK_DOES_NOT_EXIST:
 		log.Infof("make_mycnf hook doesn't exist, reading template files")
-		configData, err = cnf.makeMycnf(getMycnfTemplates(root))
+		configData, err = cnf.makeMycnf(getMycnfTemplates(root, mysqld.mycnfFlavor()))
 	case hook.HOOK_SUCCESS:
 		configData, err = cnf.fillMycnfTemplate(hr.Stdout)
 	default:
@@ -628,7 +673,7 @@ func contains(haystack []string, needle string) bool {
 	return false
 }
 
-func getMycnfTemplates(root string) []string {
+func getMycnfTemplates(root, flavor string) []string {
 	if *mycnfTemplateFile != "" {
 		return []string{*mycnfTemplateFile}
 	}
@@ -644,7 +689,7 @@ func getMycnfTemplates(root string) []string {
 		cnfTemplatePaths = append(cnfTemplatePaths, parts...)
 	}
 
-	switch mysqlFlavor := os.Getenv("MYSQL_FLAVOR"); mysqlFlavor {
+	switch flavor {
 	case "MariaDB":
 		path := path.Join(root, "config/mycnf/master_mariadb.cnf")
 		if !contains(cnfTemplatePaths, path) {
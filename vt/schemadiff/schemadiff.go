@@ -0,0 +1,651 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schemadiff computes the statements that change a schema,
+// described by CREATE TABLE statements, into another one.
+//
+// The diff is declarative: it only depends on the two schemas, and
+// applying it to the first one results in the second one, with a
+// few exceptions. Tables and columns are matched by name, ignoring
+// case, so they are dropped and added again instead of being
+// renamed. The order of the existing columns, the partitions, and
+// the table options that are removed are not changed.
+//
+// The definitions are compared the way MySQL stores them, so that
+// the output of SHOW CREATE TABLE has no diff with the statement
+// that created the table: the synonyms of types and the display
+// width of integers are ignored, and the keys of columns, like
+// PRIMARY KEY, are indexes of the table.
+package schemadiff
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"gopkg.in/src-d/go-vitess.v1/vt/sqlparser"
+)
+
+// ParseSchema parses a schema, which is a list of CREATE TABLE
+// statements separated by semicolons. Every table must be created
+// only once.
+func ParseSchema(sql string) ([]*sqlparser.DDL, error) {
+	pieces, err := sqlparser.SplitStatementToPieces(sql)
+	if err != nil {
+		return nil, err
+	}
+	var tables []*sqlparser.DDL
+	for _, piece := range pieces {
+		stmt, err := sqlparser.ParseStrictDDL(piece)
+		if err == sqlparser.ErrEmpty {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse %s: %v", strings.TrimSpace(piece), err)
+		}
+		ddl, ok := stmt.(*sqlparser.DDL)
+		if !ok || checkCreateTable(ddl) != nil {
+			return nil, fmt.Errorf("not a create table statement: %s", sqlparser.String(stmt))
+		}
+		tables = append(tables, ddl)
+	}
+	if _, err := tablesByName(tables); err != nil {
+		return nil, err
+	}
+	return tables, nil
+}
+
+// DiffSchemas returns the statements that change the tables of
+// from into the ones of to: the CREATE TABLE of the new tables,
+// then the ALTER TABLE of the changed ones, and the DROP TABLE of
+// the ones that are not part of to.
+func DiffSchemas(from, to []*sqlparser.DDL) ([]*sqlparser.DDL, error) {
+	fromTables, err := tablesByName(from)
+	if err != nil {
+		return nil, err
+	}
+	toTables, err := tablesByName(to)
+	if err != nil {
+		return nil, err
+	}
+
+	var creates, alters, drops []*sqlparser.DDL
+	for _, table := range to {
+		old, ok := fromTables[tableName(table)]
+		if !ok {
+			creates = append(creates, table)
+			continue
+		}
+		alter, err := DiffTables(old, table)
+		if err != nil {
+			return nil, err
+		}
+		if alter != nil {
+			alters = append(alters, alter)
+		}
+	}
+	for _, table := range from {
+		if _, ok := toTables[tableName(table)]; !ok {
+			drops = append(drops, &sqlparser.DDL{
+				Action:     sqlparser.DropStr,
+				FromTables: sqlparser.TableNames{table.Table},
+			})
+		}
+	}
+
+	diff := make([]*sqlparser.DDL, 0, len(creates)+len(alters)+len(drops))
+	diff = append(diff, creates...)
+	diff = append(diff, alters...)
+	return append(diff, drops...), nil
+}
+
+// DiffTables returns the ALTER TABLE statement that changes the
+// table of the CREATE TABLE statement from into the one of to,
+// or nil if they are the same.
+func DiffTables(from, to *sqlparser.DDL) (*sqlparser.DDL, error) {
+	fromTable, err := newTable(from)
+	if err != nil {
+		return nil, err
+	}
+	toTable, err := newTable(to)
+	if err != nil {
+		return nil, err
+	}
+	// A column has the charset and collation of its table if it
+	// doesn't have its own, and the table has the ones of the
+	// other table if it doesn't have its own either.
+	if fromTable.charset == "" {
+		fromTable.charset = toTable.charset
+	}
+	if toTable.charset == "" {
+		toTable.charset = fromTable.charset
+	}
+	if fromTable.collate == "" {
+		fromTable.collate = toTable.collate
+	}
+	if toTable.collate == "" {
+		toTable.collate = fromTable.collate
+	}
+	// Adding a primary key makes its columns NOT NULL.
+	for col := range toTable.primary {
+		fromTable.primary[col] = true
+	}
+
+	// The foreign keys and the indexes are dropped before the
+	// columns they refer to, and added after them.
+	dropConstraints, addConstraints, err := diffConstraints(from, to)
+	if err != nil {
+		return nil, err
+	}
+	dropIndexes, addIndexes := diffIndexes(fromTable, toTable)
+	dropColumns, modifyColumns, addColumns := diffColumns(fromTable, toTable)
+
+	var specs sqlparser.AlterSpecs
+	specs = append(specs, dropConstraints...)
+	specs = append(specs, dropIndexes...)
+	specs = append(specs, dropColumns...)
+	specs = append(specs, modifyColumns...)
+	specs = append(specs, addColumns...)
+	specs = append(specs, addIndexes...)
+	specs = append(specs, addConstraints...)
+	specs = append(specs, diffTableOptions(fromTable.options, toTable.options)...)
+	if len(specs) == 0 {
+		return nil, nil
+	}
+	return &sqlparser.DDL{
+		Action:     sqlparser.AlterStr,
+		Table:      from.Table,
+		AlterSpecs: specs,
+	}, nil
+}
+
+// table is a CREATE TABLE statement, with the keys of its columns
+// moved to its indexes.
+type table struct {
+	ddl     *sqlparser.DDL
+	columns []*sqlparser.ColumnDefinition
+	indexes []*sqlparser.IndexDefinition
+	options []tableOption
+
+	// primary has the lowered names of the columns of the primary
+	// key, which are NOT NULL even if they are not declared so.
+	primary map[string]bool
+
+	// charset and collate are the lowered default charset and
+	// collation of the table, if it has them.
+	charset, collate string
+}
+
+func newTable(ddl *sqlparser.DDL) (*table, error) {
+	if err := checkCreateTable(ddl); err != nil {
+		return nil, err
+	}
+	options, err := parseTableOptions(ddl.TableSpec.Options)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse the table options of %s: %v", sqlparser.String(ddl.Table), err)
+	}
+	t := &table{
+		ddl:     ddl,
+		indexes: append([]*sqlparser.IndexDefinition(nil), ddl.TableSpec.Indexes...),
+		options: options,
+		primary: make(map[string]bool),
+	}
+	for _, col := range ddl.TableSpec.Columns {
+		if idx := columnIndex(col); idx != nil {
+			t.indexes = append(t.indexes, idx)
+		}
+		// The columns are copied, so that their keys are not part
+		// of the specs that add or modify them.
+		newCol := *col
+		newCol.Type.KeyOpt = sqlparser.ColKeyNone
+		t.columns = append(t.columns, &newCol)
+	}
+	for _, idx := range t.indexes {
+		if !idx.Info.Primary {
+			continue
+		}
+		for _, col := range idx.Columns {
+			t.primary[col.Column.Lowered()] = true
+		}
+	}
+	for _, opt := range options {
+		switch opt.key {
+		case "charset":
+			t.charset = strings.ToLower(strings.Trim(opt.value, "'"))
+		case "collate":
+			t.collate = strings.ToLower(strings.Trim(opt.value, "'"))
+		}
+	}
+	return t, nil
+}
+
+// columnIndex returns the index of the key of a column, like MySQL
+// creates it, or nil if the column has no key.
+func columnIndex(col *sqlparser.ColumnDefinition) *sqlparser.IndexDefinition {
+	var info *sqlparser.IndexInfo
+	switch col.Type.KeyOpt {
+	case sqlparser.ColKeyPrimary, sqlparser.ColKey:
+		info = &sqlparser.IndexInfo{Type: "primary key", Name: sqlparser.NewColIdent("PRIMARY"), Primary: true, Unique: true}
+	case sqlparser.ColKeyUnique, sqlparser.ColKeyUniqueKey:
+		info = &sqlparser.IndexInfo{Type: "unique key", Name: col.Name, Unique: true}
+	case sqlparser.ColKeySpatialKey:
+		info = &sqlparser.IndexInfo{Type: "spatial key", Name: col.Name, Spatial: true}
+	default:
+		return nil
+	}
+	return &sqlparser.IndexDefinition{
+		Info:    info,
+		Columns: []*sqlparser.IndexColumn{{Column: col.Name}},
+	}
+}
+
+// checkCreateTable returns an error if ddl is not a fully parsed
+// CREATE TABLE statement.
+func checkCreateTable(ddl *sqlparser.DDL) error {
+	if ddl.Action != sqlparser.CreateStr || ddl.TableSpec == nil {
+		return fmt.Errorf("not a create table statement: %s", sqlparser.String(ddl))
+	}
+	return nil
+}
+
+// tablesByName maps the lowered names of tables to their CREATE
+// TABLE statement.
+func tablesByName(tables []*sqlparser.DDL) (map[string]*sqlparser.DDL, error) {
+	byName := make(map[string]*sqlparser.DDL, len(tables))
+	for _, table := range tables {
+		if err := checkCreateTable(table); err != nil {
+			return nil, err
+		}
+		name := tableName(table)
+		if _, ok := byName[name]; ok {
+			return nil, fmt.Errorf("table %s is defined more than once", table.Table.Name.String())
+		}
+		byName[name] = table
+	}
+	return byName, nil
+}
+
+func tableName(table *sqlparser.DDL) string {
+	return strings.ToLower(table.Table.Name.String())
+}
+
+// diffColumns returns the specs that drop, modify and add the
+// columns of from and to.
+func diffColumns(fromTable, toTable *table) (drops, modifies, adds sqlparser.AlterSpecs) {
+	from, to := fromTable.columns, toTable.columns
+	for _, col := range from {
+		if findColumn(to, col.Name) == nil {
+			drops = append(drops, &sqlparser.AlterSpec{Action: sqlparser.DropColumnStr, Name: col.Name})
+		}
+	}
+	for i, col := range to {
+		old := findColumn(from, col.Name)
+		if old == nil {
+			adds = append(adds, &sqlparser.AlterSpec{
+				Action:   sqlparser.AddColumnStr,
+				Column:   col,
+				Position: columnPosition(from, to, i),
+			})
+			continue
+		}
+		if fromTable.columnSignature(old) != toTable.columnSignature(col) {
+			modifies = append(modifies, &sqlparser.AlterSpec{Action: sqlparser.ModifyColumnStr, Column: col})
+		}
+	}
+	return drops, modifies, adds
+}
+
+// columnPosition returns the position of the new column i of to.
+// The position is not needed if the column is added after all the
+// columns of from.
+func columnPosition(from, to []*sqlparser.ColumnDefinition, i int) *sqlparser.ColumnPosition {
+	appended := true
+	for _, col := range to[i+1:] {
+		if findColumn(from, col.Name) != nil {
+			appended = false
+			break
+		}
+	}
+	switch {
+	case appended:
+		return nil
+	case i == 0:
+		return &sqlparser.ColumnPosition{First: true}
+	}
+	return &sqlparser.ColumnPosition{After: to[i-1].Name}
+}
+
+func findColumn(cols []*sqlparser.ColumnDefinition, name sqlparser.ColIdent) *sqlparser.ColumnDefinition {
+	for _, col := range cols {
+		if col.Name.Equal(name) {
+			return col
+		}
+	}
+	return nil
+}
+
+// typeSynonyms maps the types that MySQL stores as another type
+// to that type.
+var typeSynonyms = map[string]string{
+	"integer": "int",
+	"bool":    "tinyint",
+	"boolean": "tinyint",
+	"numeric": "decimal",
+	"real":    "double",
+}
+
+// columnSignature returns the definition of a column of t, without
+// the differences that don't change it: the case and the synonyms
+// of the type, the default lengths, the display width of integers,
+// the charset and collation of the table, the NOT NULL of the
+// columns of the primary key, the NULL default of the columns that
+// can be NULL, and the quotes of the default values.
+func (t *table) columnSignature(col *sqlparser.ColumnDefinition) string {
+	typ := col.Type
+	typ.Type = strings.ToLower(typ.Type)
+	if synonym, ok := typeSynonyms[typ.Type]; ok {
+		typ.Type = synonym
+	}
+	switch typ.Type {
+	case "tinyint", "smallint", "mediumint", "int", "bigint":
+		// The display width only matters with ZEROFILL, which
+		// also makes the column unsigned.
+		if typ.Zerofill {
+			typ.Unsigned = true
+		} else {
+			typ.Length = nil
+		}
+	case "decimal":
+		if typ.Length == nil {
+			typ.Length = sqlparser.NewIntVal([]byte("10"))
+		}
+		if typ.Scale == nil {
+			typ.Scale = sqlparser.NewIntVal([]byte("0"))
+		}
+	case "bit", "char", "binary":
+		if typ.Length == nil {
+			typ.Length = sqlparser.NewIntVal([]byte("1"))
+		}
+	case "year":
+		typ.Length = nil
+	case "time", "datetime", "timestamp":
+		if typ.Length != nil && string(typ.Length.Val) == "0" {
+			typ.Length = nil
+		}
+	}
+
+	typ.Charset = strings.ToLower(typ.Charset)
+	if typ.Charset == t.charset {
+		typ.Charset = ""
+	}
+	typ.Collate = strings.ToLower(typ.Collate)
+	if typ.Collate == t.collate {
+		typ.Collate = ""
+	}
+
+	if t.primary[col.Name.Lowered()] {
+		typ.NotNull = true
+	}
+	switch def := typ.Default.(type) {
+	case *sqlparser.NullVal:
+		if !typ.NotNull {
+			typ.Default = nil
+		}
+	case *sqlparser.SQLVal:
+		// MySQL quotes the default values of numbers.
+		if def.Type == sqlparser.IntVal || def.Type == sqlparser.FloatVal {
+			typ.Default = sqlparser.NewStrVal(def.Val)
+		}
+	}
+	return sqlparser.String(&typ)
+}
+
+// diffIndexes returns the specs that drop and add the indexes of
+// from and to. The indexes that are changed are dropped and added
+// again. The indexes that MySQL created for the foreign keys of to
+// are not dropped.
+func diffIndexes(fromTable, toTable *table) (drops, adds sqlparser.AlterSpecs) {
+	from, to := fromTable.indexes, toTable.indexes
+	for _, idx := range from {
+		newIdx := findIndex(to, indexName(idx))
+		if newIdx == nil && foreignKeyIndex(idx, toTable) {
+			continue
+		}
+		if newIdx == nil || indexSignature(newIdx) != indexSignature(idx) {
+			if idx.Info.Primary {
+				drops = append(drops, &sqlparser.AlterSpec{Action: sqlparser.DropPrimaryKeyStr})
+			} else {
+				drops = append(drops, &sqlparser.AlterSpec{Action: sqlparser.DropIndexStr, Name: sqlparser.NewColIdent(indexName(idx))})
+			}
+		}
+	}
+	for _, idx := range to {
+		if old := findIndex(from, indexName(idx)); old == nil || indexSignature(old) != indexSignature(idx) {
+			adds = append(adds, &sqlparser.AlterSpec{Action: sqlparser.AddIndexStr, Index: idx})
+		}
+	}
+	return drops, adds
+}
+
+// foreignKeyIndex returns true if idx can be used by a foreign key
+// of t that none of the indexes of t can be used for. MySQL creates
+// such an index when it creates the foreign key.
+func foreignKeyIndex(idx *sqlparser.IndexDefinition, t *table) bool {
+	for _, c := range t.ddl.TableSpec.Constraints {
+		fk, ok := c.Details.(*sqlparser.ForeignKeyDefinition)
+		if !ok || !indexCovers(idx, fk.Source) {
+			continue
+		}
+		covered := false
+		for _, other := range t.indexes {
+			if indexCovers(other, fk.Source) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return true
+		}
+	}
+	return false
+}
+
+// indexCovers returns true if cols are the first columns of idx.
+func indexCovers(idx *sqlparser.IndexDefinition, cols sqlparser.Columns) bool {
+	if len(idx.Columns) < len(cols) {
+		return false
+	}
+	for i, col := range cols {
+		if !idx.Columns[i].Column.Equal(col) {
+			return false
+		}
+	}
+	return true
+}
+
+func findIndex(indexes []*sqlparser.IndexDefinition, name string) *sqlparser.IndexDefinition {
+	for _, idx := range indexes {
+		if indexName(idx) == name {
+			return idx
+		}
+	}
+	return nil
+}
+
+// indexName returns the lowered name of an index. Like MySQL, the
+// indexes without a name are named after their first column.
+func indexName(idx *sqlparser.IndexDefinition) string {
+	if idx.Info.Primary {
+		return "primary"
+	}
+	if idx.Info.Name.IsEmpty() && len(idx.Columns) != 0 {
+		return idx.Columns[0].Column.Lowered()
+	}
+	return idx.Info.Name.Lowered()
+}
+
+// indexSignature returns the definition of an index, without its
+// name and the keywords that are synonyms, like INDEX and KEY.
+func indexSignature(idx *sqlparser.IndexDefinition) string {
+	buf := &bytes.Buffer{}
+	switch {
+	case idx.Info.Primary:
+		buf.WriteString("primary key")
+	case idx.Info.Unique:
+		buf.WriteString("unique key")
+	case idx.Info.Spatial:
+		buf.WriteString("spatial key")
+	case idx.Info.Fulltext:
+		buf.WriteString("fulltext key")
+	default:
+		buf.WriteString("key")
+	}
+	for _, col := range idx.Columns {
+		fmt.Fprintf(buf, " %s", col.Column.Lowered())
+		if col.Length != nil {
+			fmt.Fprintf(buf, "(%s)", col.Length.Val)
+		}
+	}
+	for _, opt := range idx.Options {
+		fmt.Fprintf(buf, " %s", strings.ToLower(opt.Name))
+		if opt.Using != "" {
+			fmt.Fprintf(buf, " %s", strings.ToLower(opt.Using))
+		} else {
+			fmt.Fprintf(buf, " %s", sqlparser.String(opt.Value))
+		}
+	}
+	return buf.String()
+}
+
+// diffConstraints returns the specs that drop and add the foreign
+// keys of from and to. The foreign keys of to without a name are
+// matched by definition, since MySQL names them when it creates
+// them, and the ones that are changed are dropped and added again.
+func diffConstraints(from, to *sqlparser.DDL) (drops, adds sqlparser.AlterSpecs, err error) {
+	kept := make(map[*sqlparser.ConstraintDefinition]bool)
+	for _, c := range to.TableSpec.Constraints {
+		if old := findConstraint(from.TableSpec.Constraints, c); old != nil && sameConstraint(old, c) {
+			kept[old] = true
+			continue
+		}
+		adds = append(adds, &sqlparser.AlterSpec{Action: sqlparser.AddConstraintStr, Constraint: c})
+	}
+	for _, c := range from.TableSpec.Constraints {
+		if kept[c] {
+			continue
+		}
+		if c.Name == "" {
+			return nil, nil, fmt.Errorf("cannot drop the foreign key of %s without a name: %s", sqlparser.String(from.Table), sqlparser.String(c))
+		}
+		drops = append(drops, &sqlparser.AlterSpec{Action: sqlparser.DropForeignKeyStr, Name: sqlparser.NewColIdent(c.Name)})
+	}
+	return drops, adds, nil
+}
+
+// findConstraint returns the constraint of constraints that has the
+// name of c, or its definition if c has no name.
+func findConstraint(constraints []*sqlparser.ConstraintDefinition, c *sqlparser.ConstraintDefinition) *sqlparser.ConstraintDefinition {
+	for _, other := range constraints {
+		if c.Name != "" && strings.EqualFold(other.Name, c.Name) {
+			return other
+		}
+		if c.Name == "" && sameConstraint(other, c) {
+			return other
+		}
+	}
+	return nil
+}
+
+func sameConstraint(a, b *sqlparser.ConstraintDefinition) bool {
+	return sqlparser.String(a.Details) == sqlparser.String(b.Details)
+}
+
+// tableOption is a table option of a CREATE TABLE statement.
+type tableOption struct {
+	// name is the name of the option as it was written, and key
+	// identifies the options that are the same, like CHARSET and
+	// DEFAULT CHARACTER SET.
+	name, key, value string
+}
+
+// diffTableOptions returns the specs that set the table options of
+// to that have a different value in from. The AUTO_INCREMENT option
+// is ignored, because its value depends on the rows of the table.
+func diffTableOptions(from, to []tableOption) sqlparser.AlterSpecs {
+	fromOptions := make(map[string]string)
+	for _, opt := range from {
+		fromOptions[opt.key] = opt.value
+	}
+	var specs sqlparser.AlterSpecs
+	for _, opt := range to {
+		if opt.key == "auto_increment" {
+			continue
+		}
+		if value, ok := fromOptions[opt.key]; ok && sameOptionValue(value, opt.value) {
+			continue
+		}
+		specs = append(specs, &sqlparser.AlterSpec{
+			Action: sqlparser.TableOptionStr,
+			Option: opt.name,
+			Value:  opt.value,
+		})
+	}
+	return specs
+}
+
+// sameOptionValue returns true if the table option values a and b
+// are the same. Only the strings are case sensitive.
+func sameOptionValue(a, b string) bool {
+	if strings.HasPrefix(a, "'") {
+		return a == b
+	}
+	return strings.EqualFold(a, b)
+}
+
+// parseTableOptions parses the table options of a TableSpec, like
+// " ENGINE=InnoDB DEFAULT CHARSET=utf8", which are the same as the
+// ones of an ALTER TABLE statement.
+func parseTableOptions(options string) ([]tableOption, error) {
+	if strings.TrimSpace(options) == "" {
+		return nil, nil
+	}
+	stmt, err := sqlparser.ParseStrictDDL("alter table t " + options)
+	if err != nil {
+		return nil, err
+	}
+	var parsed []tableOption
+	for _, spec := range stmt.(*sqlparser.DDL).AlterSpecs {
+		if spec.Action != sqlparser.TableOptionStr {
+			return nil, fmt.Errorf("not a table option: %s", sqlparser.String(spec))
+		}
+		parsed = append(parsed, tableOption{
+			name:  spec.Option,
+			key:   tableOptionKey(spec.Option),
+			value: spec.Value,
+		})
+	}
+	return parsed, nil
+}
+
+// tableOptionKey returns the key of the table option name.
+func tableOptionKey(name string) string {
+	key := strings.ToLower(name)
+	key = strings.TrimPrefix(key, "default ")
+	if key == "character set" {
+		return "charset"
+	}
+	return key
+}
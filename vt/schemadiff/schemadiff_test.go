@@ -0,0 +1,329 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/src-d/go-vitess.v1/vt/sqlparser"
+)
+
+func TestDiffTables(t *testing.T) {
+	testcases := []struct {
+		from, to, out string
+	}{{
+		from: "create table t (id int, name varchar(10), primary key (id)) engine=InnoDB",
+		to:   "CREATE TABLE `t` (`id` INT, `name` VARCHAR(10), PRIMARY KEY (`id`)) ENGINE=innodb",
+		out:  "",
+	}, {
+		from: "create table t (id int, a int default null)",
+		to:   "create table t (id int, a int)",
+		out:  "",
+	}, {
+		from: "create table t (id int)",
+		to:   "create table t (id int, a int, b varchar(10) not null)",
+		out:  "alter table t add column a int, add column b varchar(10) not null",
+	}, {
+		from: "create table t (id int, c int)",
+		to:   "create table t (a int, id int, b int, c int)",
+		out:  "alter table t add column a int first, add column b int after id",
+	}, {
+		from: "create table t (id int, a int, b int)",
+		to:   "create table t (id int, b bigint)",
+		out:  "alter table t drop column a, modify column b bigint",
+	}, {
+		from: "create table t (id int, a int, key a (a), key (id), unique key b (id, a))",
+		to:   "create table t (id int, a int, index a (a), unique key b (a, id), key c (a))",
+		out: "alter table t drop index id, drop index b, " +
+			"add unique key b (a, id), add key c (a)",
+	}, {
+		from: "create table t (id int, a int, primary key (id))",
+		to:   "create table t (id int, a int, primary key (id, a))",
+		out:  "alter table t drop primary key, add primary key (id, a)",
+	}, {
+		from: "create table t (id int, a int, constraint fk foreign key (a) references u (id), key a (a))",
+		to:   "create table t (id int, constraint fk foreign key (id) references u (id))",
+		out: "alter table t drop foreign key fk, drop index a, drop column a, " +
+			"add constraint fk foreign key (id) references u (id)",
+	}, {
+		from: "create table t (id int, foreign key (id) references u (id))",
+		to:   "create table t (id int, foreign key (id) references u (id), foreign key (id) references v (id))",
+		out:  "alter table t add foreign key (id) references v (id)",
+	}, {
+		from: "create table t (id int) engine=InnoDB auto_increment=10 default charset=latin1",
+		to:   "create table t (id int) ENGINE=InnoDB AUTO_INCREMENT=20 CHARACTER SET=utf8, comment='a, b'",
+		out:  "alter table t character set=utf8, comment='a, b'",
+	}, {
+		from: "create table t (id int) engine=InnoDB comment='x'",
+		to:   "create table t (id int) engine MyISAM comment 'y'",
+		out:  "alter table t engine=MyISAM, comment='y'",
+	}, {
+		from: "create table t (id int(11) not null, name varchar(10) default null)",
+		to:   "create table t (id int not null, name varchar(10))",
+		out:  "",
+	}, {
+		from: "create table t (a tinyint(1), b int(11), c decimal(10,0), d double, e char(1), f year(4))",
+		to:   "create table t (a bool, b integer, c numeric, d real, e char, f year)",
+		out:  "",
+	}, {
+		from: "create table t (a int(5) unsigned zerofill, b decimal(5))",
+		to:   "create table t (a int zerofill, b decimal(5,2))",
+		out:  "alter table t modify column a int zerofill, modify column b decimal(5,2)",
+	}, {
+		from: "create table t (a int default '0', b varchar(10) default '0')",
+		to:   "create table t (a int default 0, b varchar(10) default 0)",
+		out:  "",
+	}, {
+		from: "create table t (a varchar(10), b varchar(10) character set latin1) default charset=utf8",
+		to:   "create table t (a varchar(10) character set utf8, b varchar(10) character set latin1) charset utf8",
+		out:  "",
+	}, {
+		from: "create table t (id int not null, email varchar(10), primary key (id), unique key email (email))",
+		to:   "create table t (id int primary key, email varchar(10) unique)",
+		out:  "",
+	}, {
+		from: "create table t (id int)",
+		to:   "create table t (id int primary key)",
+		out:  "alter table t add primary key (id)",
+	}, {
+		from: "create table t (id int, a int, primary key (id))",
+		to:   "create table t (id int, a int primary key)",
+		out:  "alter table t drop primary key, modify column id int, add primary key (a)",
+	}, {
+		from: "create table t (id int, a int, constraint t_ibfk_1 foreign key (a) references u (id), key a (a))",
+		to:   "create table t (id int, a int, foreign key (a) references u (id))",
+		out:  "",
+	}, {
+		from: "create table t (id int)",
+		to:   "create table T (id int, a int)",
+		out:  "alter table t add column a int",
+	}}
+	for _, tcase := range testcases {
+		alter, err := DiffTables(parseTable(t, tcase.from), parseTable(t, tcase.to))
+		if err != nil {
+			t.Errorf("DiffTables(%s, %s): %v", tcase.from, tcase.to, err)
+			continue
+		}
+		out := ""
+		if alter != nil {
+			out = sqlparser.String(alter)
+		}
+		if out != tcase.out {
+			t.Errorf("DiffTables(%s, %s): %q, want %q", tcase.from, tcase.to, out, tcase.out)
+			continue
+		}
+		if alter == nil {
+			continue
+		}
+		// The diff must parse back to the same statement.
+		stmt, err := sqlparser.ParseStrictDDL(out)
+		if err != nil {
+			t.Errorf("ParseStrictDDL(%s): %v", out, err)
+			continue
+		}
+		if got := sqlparser.String(stmt); got != out {
+			t.Errorf("ParseStrictDDL(%s): %s", out, got)
+		}
+	}
+}
+
+// TestDiffShowCreateTable checks that there is no diff between the
+// output of SHOW CREATE TABLE and the statement that created the
+// table.
+func TestDiffShowCreateTable(t *testing.T) {
+	testcases := []struct {
+		showCreate, desired string
+	}{{
+		showCreate: "CREATE TABLE `customer` (\n" +
+			"  `customer_id` bigint(20) NOT NULL AUTO_INCREMENT,\n" +
+			"  `email` varchar(128) CHARACTER SET latin1 DEFAULT NULL,\n" +
+			"  `name` varchar(64) COLLATE utf8_bin NOT NULL DEFAULT '',\n" +
+			"  `active` tinyint(1) NOT NULL DEFAULT '1',\n" +
+			"  `balance` decimal(10,0) DEFAULT NULL,\n" +
+			"  `region_id` int(11) DEFAULT NULL,\n" +
+			"  `created` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,\n" +
+			"  PRIMARY KEY (`customer_id`),\n" +
+			"  UNIQUE KEY `email` (`email`),\n" +
+			"  KEY `fk_region` (`region_id`),\n" +
+			"  CONSTRAINT `fk_region` FOREIGN KEY (`region_id`) REFERENCES `region` (`id`) ON DELETE CASCADE\n" +
+			") ENGINE=InnoDB AUTO_INCREMENT=1234 DEFAULT CHARSET=utf8",
+		desired: `create table customer (
+			customer_id bigint not null auto_increment primary key,
+			email varchar(128) character set latin1 unique,
+			name varchar(64) collate utf8_bin not null default '',
+			active bool not null default 1,
+			balance numeric,
+			region_id integer,
+			created timestamp not null default current_timestamp on update current_timestamp,
+			constraint fk_region foreign key (region_id) references region (id) on delete cascade
+		) engine InnoDB default charset utf8`,
+	}, {
+		showCreate: "CREATE TABLE `event` (\n" +
+			"  `id` int(10) unsigned NOT NULL,\n" +
+			"  `t` datetime NOT NULL,\n" +
+			"  `kind` enum('a','b') NOT NULL DEFAULT 'a',\n" +
+			"  PRIMARY KEY (`id`,`t`)\n" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin\n" +
+			"/*!50100 PARTITION BY RANGE (`id`)\n" +
+			"(PARTITION p0 VALUES LESS THAN (100) ENGINE = InnoDB,\n" +
+			" PARTITION p1 VALUES LESS THAN MAXVALUE ENGINE = InnoDB) */",
+		desired: `create table event (
+			id int unsigned,
+			t datetime not null,
+			kind enum('a', 'b') not null default 'a',
+			primary key (id, t)
+		) engine=InnoDB charset=utf8mb4 collate=utf8mb4_bin
+		partition by range (id) (partition p0 values less than (100), partition p1 values less than maxvalue)`,
+	}}
+	for _, tcase := range testcases {
+		desired, err := ParseSchema(tcase.desired)
+		if err != nil {
+			t.Errorf("ParseSchema(%s): %v", tcase.desired, err)
+			continue
+		}
+		alter, err := DiffTables(parseTable(t, tcase.showCreate), desired[0])
+		if err != nil || alter != nil {
+			t.Errorf("DiffTables(%s, %s): %v, %v, want no diff", tcase.showCreate, tcase.desired, sqlparser.String(alter), err)
+		}
+	}
+}
+
+func TestDiffTablesErrors(t *testing.T) {
+	testcases := []struct {
+		from, to, err string
+	}{{
+		from: "create table t (id int)",
+		to:   "drop table t",
+		err:  "not a create table statement: drop table t",
+	}, {
+		from: "create table t (id int, foreign key (id) references u (id))",
+		to:   "create table t (id int)",
+		err:  "cannot drop the foreign key of t without a name: foreign key (id) references u (id)",
+	}}
+	for _, tcase := range testcases {
+		from, err := sqlparser.ParseStrictDDL(tcase.from)
+		if err != nil {
+			t.Fatal(err)
+		}
+		to, err := sqlparser.ParseStrictDDL(tcase.to)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = DiffTables(from.(*sqlparser.DDL), to.(*sqlparser.DDL))
+		if err == nil || err.Error() != tcase.err {
+			t.Errorf("DiffTables(%s, %s): %v, want %s", tcase.from, tcase.to, err, tcase.err)
+		}
+	}
+}
+
+func TestDiffSchemas(t *testing.T) {
+	from, err := ParseSchema(`
+		create table a (id int);
+		create table b (id int);
+		create table c (id int);`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	to, err := ParseSchema(`
+		create table e (id int);
+		create table c (id int, name varchar(10));
+		create table d (id int);
+		create table a (id int);`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff, err := DiffSchemas(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, ddl := range diff {
+		got = append(got, sqlparser.String(ddl))
+	}
+	want := []string{
+		"create table e (\n\tid int\n)",
+		"create table d (\n\tid int\n)",
+		"alter table c add column name varchar(10)",
+		"drop table b",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffSchemas:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	if diff, err := DiffSchemas(to, to); err != nil || len(diff) != 0 {
+		t.Errorf("DiffSchemas of the same schema: %v, %v, want none", diff, err)
+	}
+}
+
+func TestParseSchema(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out int
+		err string
+	}{{
+		in:  "",
+		out: 0,
+	}, {
+		in:  "create table a (id int);\n\ncreate table b (id int);\n",
+		out: 2,
+	}, {
+		in:  "create table a (id int); create table a (name int)",
+		err: "table a is defined more than once",
+	}, {
+		in:  "create table a (id int) partition by hash (id) partitions 4",
+		out: 1,
+	}, {
+		in:  "create table a (id int); create table A (name int)",
+		err: "table A is defined more than once",
+	}, {
+		in:  "create table a (id int); drop table b",
+		err: "not a create table statement: drop table b",
+	}, {
+		in:  "create view v as select 1 from dual",
+		err: "not a create table statement: create table v",
+	}, {
+		in:  "create table a (id int",
+		err: "cannot parse create table a (id int: syntax error at position 23",
+	}}
+	for _, tcase := range testcases {
+		tables, err := ParseSchema(tcase.in)
+		if tcase.err != "" {
+			if err == nil || err.Error() != tcase.err {
+				t.Errorf("ParseSchema(%q): %v, want %s", tcase.in, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseSchema(%q): %v", tcase.in, err)
+			continue
+		}
+		if len(tables) != tcase.out {
+			t.Errorf("ParseSchema(%q): %d tables, want %d", tcase.in, len(tables), tcase.out)
+		}
+	}
+}
+
+func parseTable(t *testing.T, sql string) *sqlparser.DDL {
+	t.Helper()
+	stmt, err := sqlparser.ParseStrictDDL(sql)
+	if err != nil {
+		t.Fatalf("ParseStrictDDL(%s): %v", sql, err)
+	}
+	return stmt.(*sqlparser.DDL)
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemamanager
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/src-d/go-vitess.v1/vt/schemadiff"
+	"gopkg.in/src-d/go-vitess.v1/vt/sqlparser"
+	"gopkg.in/src-d/go-vitess.v1/vt/wrangler"
+)
+
+// DesiredSchemaController implements Controller interface. It reads
+// the schema changes that turn the tables of a keyspace into the
+// ones of a desired schema, which is a list of CREATE TABLE
+// statements. The tables of the keyspace that are not part of the
+// desired schema are dropped.
+type DesiredSchemaController struct {
+	*PlainController
+	wr     *wrangler.Wrangler
+	schema string
+	tables []*sqlparser.DDL
+}
+
+// NewDesiredSchemaController creates a new DesiredSchemaController instance.
+func NewDesiredSchemaController(wr *wrangler.Wrangler, schema string, keyspace string) *DesiredSchemaController {
+	return &DesiredSchemaController{
+		PlainController: NewPlainController("", keyspace),
+		wr:              wr,
+		schema:          schema,
+	}
+}
+
+// Open parses the desired schema.
+func (controller *DesiredSchemaController) Open(ctx context.Context) error {
+	tables, err := schemadiff.ParseSchema(controller.schema)
+	if err != nil {
+		return fmt.Errorf("cannot parse the desired schema: %v", err)
+	}
+	controller.tables = tables
+	return nil
+}
+
+// Read reads the schema of the keyspace from the master of each of
+// its shards, and returns the schema changes that turn it into the
+// desired schema. The changes must be the same for every shard.
+func (controller *DesiredSchemaController) Read(ctx context.Context) ([]string, error) {
+	keyspace := controller.Keyspace()
+	shards, err := controller.wr.TopoServer().GetShardNames(ctx, keyspace)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get shard names for keyspace: %s, error: %v", keyspace, err)
+	}
+	if len(shards) == 0 {
+		return nil, fmt.Errorf("keyspace: %s does not contain any shards", keyspace)
+	}
+	sort.Strings(shards)
+	var sqls []string
+	for i, shard := range shards {
+		shardSqls, err := controller.readShard(ctx, keyspace, shard)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			sqls = shardSqls
+			continue
+		}
+		if !reflect.DeepEqual(shardSqls, sqls) {
+			return nil, fmt.Errorf("the schema of shard: %s is not the same as the one of shard: %s, keyspace: %s, changes: %v, want: %v", shard, shards[0], keyspace, shardSqls, sqls)
+		}
+	}
+	return sqls, nil
+}
+
+// readShard returns the schema changes that turn the schema of the
+// master of a shard into the desired schema.
+func (controller *DesiredSchemaController) readShard(ctx context.Context, keyspace, shard string) ([]string, error) {
+	shardInfo, err := controller.wr.TopoServer().GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get shard info, keyspace: %s, shard: %s, error: %v", keyspace, shard, err)
+	}
+	if !shardInfo.HasMaster() {
+		return nil, fmt.Errorf("shard: %s does not have a master", shard)
+	}
+	schema, err := controller.wr.GetSchema(ctx, shardInfo.MasterAlias, nil, nil, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the schema of keyspace: %s, shard: %s, error: %v", keyspace, shard, err)
+	}
+
+	current := make([]*sqlparser.DDL, 0, len(schema.TableDefinitions))
+	for _, td := range schema.TableDefinitions {
+		stmt, err := sqlparser.ParseStrictDDL(td.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse the schema of table: %s, shard: %s, error: %v", td.Name, shard, err)
+		}
+		ddl, ok := stmt.(*sqlparser.DDL)
+		if !ok {
+			return nil, fmt.Errorf("the schema of table: %s is not a create table statement: %s", td.Name, td.Schema)
+		}
+		current = append(current, ddl)
+	}
+
+	diff, err := schemadiff.DiffSchemas(current, controller.tables)
+	if err != nil {
+		return nil, fmt.Errorf("cannot diff the schema of shard: %s, error: %v", shard, err)
+	}
+	sqls := make([]string, 0, len(diff))
+	for _, ddl := range diff {
+		sqls = append(sqls, sqlparser.String(ddl))
+	}
+	return sqls, nil
+}
+
+var _ Controller = (*DesiredSchemaController)(nil)
@@ -0,0 +1,127 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemamanager
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/src-d/go-vitess.v1/vt/logutil"
+	"gopkg.in/src-d/go-vitess.v1/vt/mysqlctl/tmutils"
+	"gopkg.in/src-d/go-vitess.v1/vt/wrangler"
+
+	tabletmanagerdatapb "gopkg.in/src-d/go-vitess.v1/vt/proto/tabletmanagerdata"
+	topodatapb "gopkg.in/src-d/go-vitess.v1/vt/proto/topodata"
+)
+
+func TestDesiredSchemaController(t *testing.T) {
+	fakeTmc := newFakeTabletManagerClient()
+	fakeTmc.AddSchemaDefinition("vt_test_keyspace", &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{
+				Name:   "kept_table",
+				Schema: "CREATE TABLE `kept_table` (\n  `pk` int(11) NOT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8",
+				Type:   tmutils.TableBaseTable,
+			},
+			{
+				Name:   "old_table",
+				Schema: "CREATE TABLE `old_table` (\n  `pk` int(11) NOT NULL\n) ENGINE=InnoDB DEFAULT CHARSET=utf8",
+				Type:   tmutils.TableBaseTable,
+			},
+		},
+	})
+	wr := wrangler.New(logutil.NewConsoleLogger(), newFakeTopo(t), fakeTmc)
+	schema := `
+		create table kept_table (pk int primary key, name varchar(10)) engine=InnoDB default charset=utf8;
+		create table new_table (pk int not null);`
+	controller := NewDesiredSchemaController(wr, schema, "test_keyspace")
+	ctx := context.Background()
+	if err := controller.Open(ctx); err != nil {
+		t.Fatalf("controller.Open should succeed, but got error: %v", err)
+	}
+	defer controller.Close()
+
+	sqls, err := controller.Read(ctx)
+	if err != nil {
+		t.Fatalf("controller.Read should succeed, but got error: %v", err)
+	}
+	want := []string{
+		"create table new_table (\n\tpk int not null\n)",
+		"alter table kept_table add column name varchar(10)",
+		"drop table old_table",
+	}
+	if !reflect.DeepEqual(sqls, want) {
+		t.Fatalf("expect to get sqls: %q, but got: %q", want, sqls)
+	}
+
+	controller = NewDesiredSchemaController(wr, "drop table kept_table", "test_keyspace")
+	if err := controller.Open(ctx); err == nil {
+		t.Fatalf("controller.Open should fail for a schema that is not a list of create table statements")
+	}
+}
+
+func TestDesiredSchemaControllerShardsDiffer(t *testing.T) {
+	fakeTmc := &shardSchemaTabletManagerClient{
+		fakeTabletManagerClient: newFakeTabletManagerClient(),
+		schemas:                 make(map[uint32]*tabletmanagerdatapb.SchemaDefinition),
+	}
+	fakeTmc.AddSchemaDefinition("vt_test_keyspace", &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:   "t",
+			Schema: "CREATE TABLE `t` (\n  `pk` int(11) NOT NULL\n) ENGINE=InnoDB DEFAULT CHARSET=utf8",
+			Type:   tmutils.TableBaseTable,
+		}},
+	})
+	// The master of the second shard has a column that the others
+	// don't have.
+	fakeTmc.schemas[2] = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:   "t",
+			Schema: "CREATE TABLE `t` (\n  `pk` int(11) NOT NULL,\n  `name` varchar(10) DEFAULT NULL\n) ENGINE=InnoDB DEFAULT CHARSET=utf8",
+			Type:   tmutils.TableBaseTable,
+		}},
+	}
+	wr := wrangler.New(logutil.NewConsoleLogger(), newFakeTopo(t), fakeTmc)
+	controller := NewDesiredSchemaController(wr, "create table t (pk int not null, name varchar(10))", "test_keyspace")
+	ctx := context.Background()
+	if err := controller.Open(ctx); err != nil {
+		t.Fatalf("controller.Open should succeed, but got error: %v", err)
+	}
+	defer controller.Close()
+
+	if _, err := controller.Read(ctx); err == nil || !strings.Contains(err.Error(), "the schema of shard: 1 is not the same as the one of shard: 0") {
+		t.Fatalf("controller.Read should fail because the shards have different schemas, but got error: %v", err)
+	}
+}
+
+// shardSchemaTabletManagerClient returns the schema of some tablets
+// instead of the one of their database.
+type shardSchemaTabletManagerClient struct {
+	*fakeTabletManagerClient
+	// schemas are the schemas of the tablets, by uid.
+	schemas map[uint32]*tabletmanagerdatapb.SchemaDefinition
+}
+
+func (client *shardSchemaTabletManagerClient) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	if schema, ok := client.schemas[tablet.Alias.Uid]; ok {
+		return schema, nil
+	}
+	return client.fakeTabletManagerClient.GetSchema(ctx, tablet, tables, excludeTables, includeViews)
+}
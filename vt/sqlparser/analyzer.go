@@ -46,6 +46,8 @@ const (
 	StmtSet
 	StmtShow
 	StmtUse
+	StmtKill
+	StmtLoad
 	StmtOther
 	StmtUnknown
 	StmtComment
@@ -100,6 +102,10 @@ func Preview(sql string) int {
 		return StmtShow
 	case "use":
 		return StmtUse
+	case "kill":
+		return StmtKill
+	case "load":
+		return StmtLoad
 	case "analyze", "describe", "desc", "explain", "repair", "optimize":
 		return StmtOther
 	}
@@ -138,6 +144,10 @@ func StmtType(stmtType int) string {
 		return "SHOW"
 	case StmtUse:
 		return "USE"
+	case StmtKill:
+		return "KILL"
+	case StmtLoad:
+		return "LOAD"
 	case StmtOther:
 		return "OTHER"
 	default:
@@ -60,6 +60,8 @@ func TestPreview(t *testing.T) {
 		{"set", StmtSet},
 		{"show", StmtShow},
 		{"use", StmtUse},
+		{"kill query 12", StmtKill},
+		{"load data local infile 't.csv' into table t", StmtLoad},
 		{"analyze", StmtOther},
 		{"describe", StmtOther},
 		{"desc", StmtOther},
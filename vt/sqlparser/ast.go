@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -61,7 +62,16 @@ func yyParsePooled(yylex yyLexer) int {
 		parser = yyNewParser().(*yyParserImpl)
 	}
 
+	// The tokenizer needs the parser to find the end of the nodes.
+	tkn, _ := yylex.(*Tokenizer)
+	if tkn != nil && tkn.positions != nil {
+		tkn.parser = parser
+	}
+
 	defer func() {
+		if tkn != nil {
+			tkn.parser = nil
+		}
 		*parser = zeroParser
 		parserPool.Put(parser)
 	}()
@@ -83,15 +93,20 @@ func yyParsePooled(yylex yyLexer) int {
 // Parse parses the SQL in full and returns a Statement, which
 // is the AST representation of the query. If a DDL statement
 // is partially parsed but still contains a syntax error, the
-// error is ignored and the DDL is returned anyway.
+// error is ignored and the DDL is returned anyway, with its
+// Partial field set.
 func Parse(sql string) (Statement, error) {
-	tokenizer := NewStringTokenizer(sql)
+	return parseTokenizer(sql, NewStringTokenizer(sql))
+}
+
+func parseTokenizer(sql string, tokenizer *Tokenizer) (Statement, error) {
 	if yyParsePooled(tokenizer) != 0 {
 		if tokenizer.partialDDL != nil {
 			if typ, val := tokenizer.Scan(); typ != 0 {
 				return nil, fmt.Errorf("extra characters encountered after end of DDL: '%s'", string(val))
 			}
 			log.Warningf("ignoring error parsing DDL '%s': %v", sql, tokenizer.LastError)
+			tokenizer.partialDDL.Partial = true
 			tokenizer.ParseTree = tokenizer.partialDDL
 			return tokenizer.ParseTree, nil
 		}
@@ -150,6 +165,7 @@ func parseNext(tokenizer *Tokenizer, strict bool) (Statement, error) {
 	tokenizer.multi = true
 	if yyParsePooled(tokenizer) != 0 {
 		if tokenizer.partialDDL != nil && !strict {
+			tokenizer.partialDDL.Partial = true
 			tokenizer.ParseTree = tokenizer.partialDDL
 			return tokenizer.ParseTree, nil
 		}
@@ -203,7 +219,8 @@ func SplitStatementToPieces(blob string) (pieces []string, err error) {
 		} else if tkn == 0 || tkn == eofChar {
 			blobTail := tokenizer.Position - 2
 
-			if stmtBegin < blobTail {
+			// Ignore the blanks after the last statement.
+			if stmtBegin < blobTail && strings.TrimSpace(blob[stmtBegin:blobTail+1]) != "" {
 				stmt = blob[stmtBegin : blobTail+1]
 				pieces = append(pieces, stmt)
 			}
@@ -215,6 +232,19 @@ func SplitStatementToPieces(blob string) (pieces []string, err error) {
 	return
 }
 
+// CountPositionalArgs returns the number of '?' placeholders in sql,
+// which the parser turns into the bind variables :v1, :v2, ...
+// The question marks in strings and comments are not counted.
+func CountPositionalArgs(sql string) int {
+	tokenizer := NewStringTokenizer(sql)
+	for {
+		if tkn, _ := tokenizer.Scan(); tkn == 0 || tkn == LEX_ERROR {
+			break
+		}
+	}
+	return tokenizer.posVarIndex
+}
+
 // SQLNode defines the interface for all nodes
 // generated by the parser.
 type SQLNode interface {
@@ -288,6 +318,8 @@ func (*DBDDL) iStatement()      {}
 func (*DDL) iStatement()        {}
 func (*Show) iStatement()       {}
 func (*Use) iStatement()        {}
+func (*Kill) iStatement()       {}
+func (*Load) iStatement()       {}
 func (*Begin) iStatement()      {}
 func (*Commit) iStatement()     {}
 func (*Rollback) iStatement()   {}
@@ -315,6 +347,7 @@ func (*ParenSelect) iSelectStatement() {}
 
 // Select represents a SELECT statement.
 type Select struct {
+	With        *With
 	Cache       string
 	Comments    Comments
 	Distinct    string
@@ -324,6 +357,7 @@ type Select struct {
 	Where       *Where
 	GroupBy     GroupBy
 	Having      *Where
+	Windows     WindowDefs
 	OrderBy     OrderBy
 	Limit       *Limit
 	Lock        string
@@ -359,10 +393,10 @@ func (node *Select) SetLimit(limit *Limit) {
 
 // Format formats the node.
 func (node *Select) Format(buf *TrackedBuffer) {
-	buf.Myprintf("select %v%s%s%s%v from %v%v%v%v%v%v%s",
-		node.Comments, node.Cache, node.Distinct, node.Hints, node.SelectExprs,
+	buf.Myprintf("%vselect %v%s%s%s%v from %v%v%v%v%v%v%v%s",
+		node.With, node.Comments, node.Cache, node.Distinct, node.Hints, node.SelectExprs,
 		node.From, node.Where,
-		node.GroupBy, node.Having, node.OrderBy,
+		node.GroupBy, node.Having, node.Windows, node.OrderBy,
 		node.Limit, node.Lock)
 }
 
@@ -372,12 +406,14 @@ func (node *Select) walkSubtree(visit Visit) error {
 	}
 	return Walk(
 		visit,
+		node.With,
 		node.Comments,
 		node.SelectExprs,
 		node.From,
 		node.Where,
 		node.GroupBy,
 		node.Having,
+		node.Windows,
 		node.OrderBy,
 		node.Limit,
 	)
@@ -459,6 +495,7 @@ func (node *ParenSelect) walkSubtree(visit Visit) error {
 
 // Union represents a UNION statement.
 type Union struct {
+	With        *With
 	Type        string
 	Left, Right SelectStatement
 	OrderBy     OrderBy
@@ -485,7 +522,7 @@ func (node *Union) SetLimit(limit *Limit) {
 
 // Format formats the node.
 func (node *Union) Format(buf *TrackedBuffer) {
-	buf.Myprintf("%v %s %v%v%v%s", node.Left, node.Type, node.Right,
+	buf.Myprintf("%v%v %s %v%v%v%s", node.With, node.Left, node.Type, node.Right,
 		node.OrderBy, node.Limit, node.Lock)
 }
 
@@ -495,11 +532,132 @@ func (node *Union) walkSubtree(visit Visit) error {
 	}
 	return Walk(
 		visit,
+		node.With,
 		node.Left,
 		node.Right,
 	)
 }
 
+// With represents the WITH clause of a SELECT statement.
+type With struct {
+	Recursive bool
+	CTEs      []*CommonTableExpr
+}
+
+// Format formats the node.
+func (node *With) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.Myprintf("with ")
+	if node.Recursive {
+		buf.Myprintf("recursive ")
+	}
+	prefix := ""
+	for _, cte := range node.CTEs {
+		buf.Myprintf("%s%v", prefix, cte)
+		prefix = ", "
+	}
+	buf.Myprintf(" ")
+}
+
+func (node *With) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	for _, cte := range node.CTEs {
+		if err := Walk(visit, cte); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CommonTableExpr represents a common table expression of a
+// WITH clause.
+type CommonTableExpr struct {
+	Name     TableIdent
+	Columns  Columns
+	Subquery *Subquery
+}
+
+// Format formats the node.
+func (node *CommonTableExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v%v as %v", node.Name, node.Columns, node.Subquery)
+}
+
+func (node *CommonTableExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Name,
+		node.Columns,
+		node.Subquery,
+	)
+}
+
+// VisitCTERefs calls visit on every table of node that references a
+// common table expression instead of a table, along with the
+// expression it references. It follows the SQL scoping rules: a
+// common table expression can reference the ones that precede it in
+// its WITH clause, or itself if it's recursive, and hides the tables
+// and outer common table expressions with the same name.
+func VisitCTERefs(node SQLNode, visit func(ref *AliasedTableExpr, cte *CommonTableExpr) error) error {
+	return Walk(cteRefsVisitor(nil, visit), node)
+}
+
+// cteRefsVisitor returns the Visit function of VisitCTERefs, for
+// the common table expressions that are in scope.
+func cteRefsVisitor(ctes map[string]*CommonTableExpr, visit func(*AliasedTableExpr, *CommonTableExpr) error) Visit {
+	return func(node SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *Select:
+			if node.With != nil {
+				return false, visitScopedCTERefs(node, node.With, ctes, visit)
+			}
+		case *Union:
+			if node.With != nil {
+				return false, visitScopedCTERefs(node, node.With, ctes, visit)
+			}
+		case *With:
+			// The WITH clause is visited by its statement.
+			return false, nil
+		case *AliasedTableExpr:
+			name, ok := node.Expr.(TableName)
+			if !ok || !name.Qualifier.IsEmpty() {
+				return true, nil
+			}
+			if cte, ok := ctes[name.Name.String()]; ok {
+				return false, visit(node, cte)
+			}
+		}
+		return true, nil
+	}
+}
+
+// visitScopedCTERefs visits the common table expressions of with,
+// and then the rest of stmt, with them in scope.
+func visitScopedCTERefs(stmt SelectStatement, with *With, ctes map[string]*CommonTableExpr, visit func(*AliasedTableExpr, *CommonTableExpr) error) error {
+	inner := make(map[string]*CommonTableExpr, len(ctes)+len(with.CTEs))
+	for name, cte := range ctes {
+		inner[name] = cte
+	}
+	if with.Recursive {
+		for _, cte := range with.CTEs {
+			inner[cte.Name.String()] = cte
+		}
+	}
+	for _, cte := range with.CTEs {
+		if err := Walk(cteRefsVisitor(inner, visit), cte.Subquery); err != nil {
+			return err
+		}
+		inner[cte.Name.String()] = cte
+	}
+	return stmt.walkSubtree(cteRefsVisitor(inner, visit))
+}
+
 // Stream represents a SELECT statement.
 type Stream struct {
 	Comments   Comments
@@ -734,6 +892,16 @@ type DDL struct {
 	OptLike       *OptLike
 	PartitionSpec *PartitionSpec
 
+	// AlterSpecs is set if Action is AlterStr and the alter
+	// operations were fully analyzed. The partition operations
+	// are in PartitionSpec.
+	AlterSpecs AlterSpecs
+
+	// Partial is set if the statement could only be parsed up to
+	// its table: the fields that describe the rest of it are not
+	// set, even if it's not empty. See Parse.
+	Partial bool
+
 	// VindexSpec is set for CreateVindexStr, DropVindexStr, AddColVindexStr, DropColVindexStr.
 	VindexSpec *VindexSpec
 
@@ -783,10 +951,12 @@ func (node *DDL) Format(buf *TrackedBuffer) {
 			buf.Myprintf(", %v to %v", node.FromTables[i], node.ToTables[i])
 		}
 	case AlterStr:
+		buf.Myprintf("%s table %v", node.Action, node.Table)
+		if len(node.AlterSpecs) != 0 {
+			buf.Myprintf(" %v", node.AlterSpecs)
+		}
 		if node.PartitionSpec != nil {
-			buf.Myprintf("%s table %v %v", node.Action, node.Table, node.PartitionSpec)
-		} else {
-			buf.Myprintf("%s table %v", node.Action, node.Table)
+			buf.Myprintf(" %v", node.PartitionSpec)
 		}
 	case FlushStr:
 		buf.Myprintf("%s", node.Action)
@@ -841,9 +1011,255 @@ func (node *DDL) AffectedTables() TableNames {
 	return TableNames{node.Table}
 }
 
+// AlterSpec strings.
+const (
+	AddColumnStr         = "add column"
+	DropColumnStr        = "drop column"
+	ModifyColumnStr      = "modify column"
+	ChangeColumnStr      = "change column"
+	AlterColumnStr       = "alter column"
+	AddIndexStr          = "add index"
+	DropIndexStr         = "drop index"
+	DropPrimaryKeyStr    = "drop primary key"
+	RenameIndexStr       = "rename index"
+	RenameColumnStr      = "rename column"
+	RenameTableStr       = "rename to"
+	AlterIndexStr        = "alter index"
+	AddConstraintStr     = "add constraint"
+	DropForeignKeyStr    = "drop foreign key"
+	DropCheckStr         = "drop check"
+	DropConstraintStr    = "drop constraint"
+	ConvertStr           = "convert to character set"
+	OrderByStr           = "order by"
+	ForceAlterStr        = "force"
+	EnableKeysStr        = "enable keys"
+	DisableKeysStr       = "disable keys"
+	DiscardTablespaceStr = "discard tablespace"
+	ImportTablespaceStr  = "import tablespace"
+	TableOptionStr       = "table option"
+)
+
+// tableOptions are the names of the table options, and of the
+// options of an ALTER TABLE statement.
+var tableOptions = map[string]bool{
+	"algorithm":             true,
+	"auto_increment":        true,
+	"autoextend_size":       true,
+	"avg_row_length":        true,
+	"character set":         true,
+	"charset":               true,
+	"checksum":              true,
+	"collate":               true,
+	"comment":               true,
+	"compression":           true,
+	"connection":            true,
+	"data directory":        true,
+	"default character set": true,
+	"default charset":       true,
+	"default collate":       true,
+	"delay_key_write":       true,
+	"encryption":            true,
+	"engine":                true,
+	"index directory":       true,
+	"insert_method":         true,
+	"key_block_size":        true,
+	"lock":                  true,
+	"max_rows":              true,
+	"min_rows":              true,
+	"pack_keys":             true,
+	"password":              true,
+	"row_format":            true,
+	"stats_auto_recalc":     true,
+	"stats_persistent":      true,
+	"stats_sample_pages":    true,
+	"tablespace":            true,
+}
+
+// isTableOption returns true if name is the name of a table option.
+func isTableOption(name string) bool {
+	return tableOptions[strings.ToLower(name)]
+}
+
+// AlterSpecs represents the operations of an ALTER TABLE statement.
+type AlterSpecs []*AlterSpec
+
+// Format formats the node.
+func (node AlterSpecs) Format(buf *TrackedBuffer) {
+	var prefix string
+	for _, n := range node {
+		buf.Myprintf("%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
+func (node AlterSpecs) walkSubtree(visit Visit) error {
+	for _, n := range node {
+		if err := Walk(visit, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AlterSpec describes an operation of an ALTER TABLE statement.
+type AlterSpec struct {
+	Action string
+
+	// Column is set for AddColumnStr, ModifyColumnStr and ChangeColumnStr.
+	Column *ColumnDefinition
+
+	// Position is set if the column of AddColumnStr, ModifyColumnStr
+	// or ChangeColumnStr is placed first or after another column.
+	Position *ColumnPosition
+
+	// Name is the column, index or constraint of ChangeColumnStr,
+	// DropColumnStr, AlterColumnStr, DropIndexStr, RenameIndexStr,
+	// RenameColumnStr, AlterIndexStr, DropForeignKeyStr, DropCheckStr
+	// and DropConstraintStr.
+	Name ColIdent
+
+	// NewName is set for RenameIndexStr and RenameColumnStr.
+	NewName ColIdent
+
+	// NewTable is set for RenameTableStr.
+	NewTable TableName
+
+	// Invisible is set if the index of AlterIndexStr is made
+	// invisible rather than visible.
+	Invisible bool
+
+	// Default is the new default of the column of AlterColumnStr.
+	// The default is dropped if it's nil.
+	Default Expr
+
+	// Index is set for AddIndexStr.
+	Index *IndexDefinition
+
+	// Constraint is set for AddConstraintStr.
+	Constraint *ConstraintDefinition
+
+	// Charset and Collate are set for ConvertStr. Collate is
+	// optional.
+	Charset string
+	Collate string
+
+	// OrderBy is set for OrderByStr.
+	OrderBy OrderBy
+
+	// Option is the name of the option of TableOptionStr, like
+	// engine or default charset, and Value is its value. The
+	// options of the statement, like algorithm and lock, are
+	// table options too.
+	Option string
+	Value  string
+}
+
+// Format formats the node.
+func (node *AlterSpec) Format(buf *TrackedBuffer) {
+	switch node.Action {
+	case AddColumnStr, ModifyColumnStr:
+		buf.Myprintf("%s %v", node.Action, node.Column)
+	case ChangeColumnStr:
+		buf.Myprintf("%s %v %v", node.Action, node.Name, node.Column)
+	case DropColumnStr, DropIndexStr, DropForeignKeyStr, DropCheckStr, DropConstraintStr:
+		buf.Myprintf("%s %v", node.Action, node.Name)
+	case AlterColumnStr:
+		if node.Default != nil {
+			buf.Myprintf("%s %v set default %v", node.Action, node.Name, node.Default)
+		} else {
+			buf.Myprintf("%s %v drop default", node.Action, node.Name)
+		}
+	case AddIndexStr:
+		buf.Myprintf("add %v", node.Index)
+	case AddConstraintStr:
+		buf.Myprintf("add %v", node.Constraint)
+	case DropPrimaryKeyStr:
+		buf.Myprintf("%s", node.Action)
+	case RenameIndexStr, RenameColumnStr:
+		buf.Myprintf("%s %v to %v", node.Action, node.Name, node.NewName)
+	case RenameTableStr:
+		buf.Myprintf("%s %v", node.Action, node.NewTable)
+	case AlterIndexStr:
+		if node.Invisible {
+			buf.Myprintf("%s %v invisible", node.Action, node.Name)
+		} else {
+			buf.Myprintf("%s %v visible", node.Action, node.Name)
+		}
+	case ConvertStr:
+		buf.Myprintf("%s %s", node.Action, node.Charset)
+		if node.Collate != "" {
+			buf.Myprintf(" collate %s", node.Collate)
+		}
+	case OrderByStr:
+		buf.Myprintf("%s ", node.Action)
+		var prefix string
+		for _, n := range node.OrderBy {
+			buf.Myprintf("%s%v", prefix, n)
+			prefix = ", "
+		}
+	case ForceAlterStr, EnableKeysStr, DisableKeysStr, DiscardTablespaceStr, ImportTablespaceStr:
+		buf.Myprintf("%s", node.Action)
+	case TableOptionStr:
+		buf.Myprintf("%s=%s", node.Option, node.Value)
+	default:
+		panic("unimplemented")
+	}
+	if node.Position != nil {
+		buf.Myprintf(" %v", node.Position)
+	}
+}
+
+func (node *AlterSpec) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Column,
+		node.Position,
+		node.Name,
+		node.NewName,
+		node.NewTable,
+		node.Default,
+		node.Index,
+		node.Constraint,
+		node.OrderBy,
+	)
+}
+
+// ColumnPosition is the position of a column that an ALTER TABLE
+// statement adds or changes.
+type ColumnPosition struct {
+	// First is set if the column is placed first.
+	First bool
+	// After is the column that the column is placed after.
+	After ColIdent
+}
+
+// Format formats the node.
+func (node *ColumnPosition) Format(buf *TrackedBuffer) {
+	if node.First {
+		buf.Myprintf("first")
+	} else {
+		buf.Myprintf("after %v", node.After)
+	}
+}
+
+func (node *ColumnPosition) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.After)
+}
+
 // Partition strings
 const (
-	ReorganizeStr = "reorganize partition"
+	ReorganizeStr        = "reorganize partition"
+	AddPartitionStr      = "add partition"
+	DropPartitionStr     = "drop partition"
+	TruncatePartitionStr = "truncate partition"
+	CoalescePartitionStr = "coalesce partition"
+	PartitionByStr       = "partition by"
 )
 
 // OptLike works for create table xxx like xxx
@@ -868,6 +1284,15 @@ type PartitionSpec struct {
 	Action      string
 	Name        ColIdent
 	Definitions []*PartitionDefinition
+
+	// Names is set for DropPartitionStr and TruncatePartitionStr.
+	Names Columns
+
+	// Number is the number of partitions of CoalescePartitionStr.
+	Number *SQLVal
+
+	// By is set for PartitionByStr.
+	By *PartitionBy
 }
 
 // Format formats the node.
@@ -881,6 +1306,25 @@ func (node *PartitionSpec) Format(buf *TrackedBuffer) {
 			prefix = ", "
 		}
 		buf.Myprintf(")")
+	case AddPartitionStr:
+		buf.Myprintf("add partition (")
+		var prefix string
+		for _, pd := range node.Definitions {
+			buf.Myprintf("%s%v", prefix, pd)
+			prefix = ", "
+		}
+		buf.Myprintf(")")
+	case DropPartitionStr, TruncatePartitionStr:
+		buf.Myprintf("%s ", node.Action)
+		var prefix string
+		for _, n := range node.Names {
+			buf.Myprintf("%s%v", prefix, n)
+			prefix = ", "
+		}
+	case CoalescePartitionStr:
+		buf.Myprintf("%s %v", node.Action, node.Number)
+	case PartitionByStr:
+		buf.Myprintf("%v", node.By)
 	default:
 		panic("unimplemented")
 	}
@@ -898,7 +1342,7 @@ func (node *PartitionSpec) walkSubtree(visit Visit) error {
 			return err
 		}
 	}
-	return nil
+	return Walk(visit, node.Names, node.Number, node.By)
 }
 
 // PartitionDefinition describes a very minimal partition definition
@@ -906,14 +1350,28 @@ type PartitionDefinition struct {
 	Name     ColIdent
 	Limit    Expr
 	Maxvalue bool
+
+	// In is set for the partitions of list partitioning.
+	In ValTuple
+
+	// Engine is the storage engine of the partition, if set.
+	Engine string
 }
 
 // Format formats the node
 func (node *PartitionDefinition) Format(buf *TrackedBuffer) {
-	if !node.Maxvalue {
-		buf.Myprintf("partition %v values less than (%v)", node.Name, node.Limit)
-	} else {
+	switch {
+	case node.Maxvalue:
 		buf.Myprintf("partition %v values less than (maxvalue)", node.Name)
+	case node.Limit != nil:
+		buf.Myprintf("partition %v values less than (%v)", node.Name, node.Limit)
+	case node.In != nil:
+		buf.Myprintf("partition %v values in %v", node.Name, node.In)
+	default:
+		buf.Myprintf("partition %v", node.Name)
+	}
+	if node.Engine != "" {
+		buf.Myprintf(" engine=%s", node.Engine)
 	}
 }
 
@@ -925,15 +1383,89 @@ func (node *PartitionDefinition) walkSubtree(visit Visit) error {
 		visit,
 		node.Name,
 		node.Limit,
+		node.In,
 	)
 }
 
+// PartitionBy types
+const (
+	HashPartitionStr  = "hash"
+	KeyPartitionStr   = "key"
+	RangePartitionStr = "range"
+	ListPartitionStr  = "list"
+)
+
+// PartitionBy is the PARTITION BY clause of a CREATE or ALTER TABLE
+// statement.
+type PartitionBy struct {
+	Type   string
+	Linear bool
+
+	// Expr is the expression of hash, range and list partitioning.
+	Expr Expr
+
+	// Columns are the columns of key partitioning, or of range and
+	// list partitioning if ColumnList is set.
+	Columns    Columns
+	ColumnList bool
+
+	// Partitions is the number of partitions, if set.
+	Partitions  *SQLVal
+	Definitions []*PartitionDefinition
+}
+
+// Format formats the node.
+func (node *PartitionBy) Format(buf *TrackedBuffer) {
+	buf.Myprintf("partition by ")
+	if node.Linear {
+		buf.Myprintf("linear ")
+	}
+	buf.Myprintf("%s", node.Type)
+	switch {
+	case node.Expr != nil:
+		buf.Myprintf(" (%v)", node.Expr)
+	case node.ColumnList:
+		buf.Myprintf(" columns %v", node.Columns)
+	case len(node.Columns) != 0:
+		buf.Myprintf(" %v", node.Columns)
+	default:
+		buf.Myprintf(" ()")
+	}
+	if node.Partitions != nil {
+		buf.Myprintf(" partitions %v", node.Partitions)
+	}
+	if len(node.Definitions) != 0 {
+		prefix := " ("
+		for _, pd := range node.Definitions {
+			buf.Myprintf("%s%v", prefix, pd)
+			prefix = ", "
+		}
+		buf.Myprintf(")")
+	}
+}
+
+func (node *PartitionBy) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	if err := Walk(visit, node.Expr, node.Columns, node.Partitions); err != nil {
+		return err
+	}
+	for _, def := range node.Definitions {
+		if err := Walk(visit, def); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // TableSpec describes the structure of a table from a CREATE TABLE statement
 type TableSpec struct {
 	Columns     []*ColumnDefinition
 	Indexes     []*IndexDefinition
 	Constraints []*ConstraintDefinition
 	Options     string
+	PartitionBy *PartitionBy
 }
 
 // Format formats the node.
@@ -954,6 +1486,9 @@ func (ts *TableSpec) Format(buf *TrackedBuffer) {
 	}
 
 	buf.Myprintf("\n)%s", strings.Replace(ts.Options, ", ", ",\n  ", -1))
+	if ts.PartitionBy != nil {
+		buf.Myprintf("\n%v", ts.PartitionBy)
+	}
 }
 
 // AddColumn appends the given column to the list in the spec
@@ -994,7 +1529,7 @@ func (ts *TableSpec) walkSubtree(visit Visit) error {
 		}
 	}
 
-	return nil
+	return Walk(visit, ts.PartitionBy)
 }
 
 // ColumnDefinition describes a column in a CREATE TABLE statement
@@ -1042,6 +1577,11 @@ type ColumnType struct {
 	Charset string
 	Collate string
 
+	// Generated is the expression of a generated column, which
+	// is stored if Stored is set, and virtual otherwise.
+	Generated Expr
+	Stored    BoolVal
+
 	// Enum values
 	EnumValues []string
 
@@ -1077,6 +1617,14 @@ func (ct *ColumnType) Format(buf *TrackedBuffer) {
 	if ct.Collate != "" {
 		opts = append(opts, keywordStrings[COLLATE], ct.Collate)
 	}
+	if ct.Generated != nil {
+		opts = append(opts, keywordStrings[GENERATED], "always", keywordStrings[AS], "("+String(ct.Generated)+")")
+		if ct.Stored {
+			opts = append(opts, keywordStrings[STORED])
+		} else {
+			opts = append(opts, keywordStrings[VIRTUAL])
+		}
+	}
 	if ct.NotNull {
 		opts = append(opts, keywordStrings[NOT], keywordStrings[NULL])
 	}
@@ -1092,19 +1640,19 @@ func (ct *ColumnType) Format(buf *TrackedBuffer) {
 	if ct.Comment != nil {
 		opts = append(opts, keywordStrings[COMMENT_KEYWORD], String(ct.Comment))
 	}
-	if ct.KeyOpt == colKeyPrimary {
+	if ct.KeyOpt == ColKeyPrimary {
 		opts = append(opts, keywordStrings[PRIMARY], keywordStrings[KEY])
 	}
-	if ct.KeyOpt == colKeyUnique {
+	if ct.KeyOpt == ColKeyUnique {
 		opts = append(opts, keywordStrings[UNIQUE])
 	}
-	if ct.KeyOpt == colKeyUniqueKey {
+	if ct.KeyOpt == ColKeyUniqueKey {
 		opts = append(opts, keywordStrings[UNIQUE], keywordStrings[KEY])
 	}
-	if ct.KeyOpt == colKeySpatialKey {
+	if ct.KeyOpt == ColKeySpatialKey {
 		opts = append(opts, keywordStrings[SPATIAL], keywordStrings[KEY])
 	}
-	if ct.KeyOpt == colKey {
+	if ct.KeyOpt == ColKey {
 		opts = append(opts, keywordStrings[KEY])
 	}
 
@@ -1289,11 +1837,12 @@ func (idx *IndexDefinition) walkSubtree(visit Visit) error {
 
 // IndexInfo describes the name and type of an index in a CREATE TABLE statement
 type IndexInfo struct {
-	Type    string
-	Name    ColIdent
-	Primary bool
-	Spatial bool
-	Unique  bool
+	Type     string
+	Name     ColIdent
+	Primary  bool
+	Spatial  bool
+	Fulltext bool
+	Unique   bool
 }
 
 // Format formats the node.
@@ -1336,13 +1885,15 @@ type IndexOption struct {
 // index element and contains the type of the option
 type ColumnKeyOption int
 
+// Column key options. ColKey is a KEY without PRIMARY, which is
+// also a primary key.
 const (
-	colKeyNone ColumnKeyOption = iota
-	colKeyPrimary
-	colKeySpatialKey
-	colKeyUnique
-	colKeyUniqueKey
-	colKey
+	ColKeyNone ColumnKeyOption = iota
+	ColKeyPrimary
+	ColKeySpatialKey
+	ColKeyUnique
+	ColKeyUniqueKey
+	ColKey
 )
 
 // VindexSpec defines a vindex for a CREATE VINDEX or DROP VINDEX statement
@@ -1586,33 +2137,164 @@ func (node *ShowFilter) Format(buf *TrackedBuffer) {
 	if node == nil {
 		return
 	}
-	if node.Like != "" {
-		buf.Myprintf(" like '%s'", node.Like)
-	} else {
-		buf.Myprintf(" where %v", node.Filter)
+	if node.Like != "" {
+		buf.Myprintf(" like '%s'", node.Like)
+	} else {
+		buf.Myprintf(" where %v", node.Filter)
+	}
+}
+
+func (node *ShowFilter) walkSubtree(visit Visit) error {
+	return nil
+}
+
+// Use represents a use statement.
+type Use struct {
+	DBName TableIdent
+}
+
+// Format formats the node.
+func (node *Use) Format(buf *TrackedBuffer) {
+	if node.DBName.v != "" {
+		buf.Myprintf("use %v", node.DBName)
+	} else {
+		buf.Myprintf("use")
+	}
+}
+
+func (node *Use) walkSubtree(visit Visit) error {
+	return Walk(visit, node.DBName)
+}
+
+// Kill represents a KILL statement.
+type Kill struct {
+	Type string
+	ID   uint64
+}
+
+// Kill.Type
+const (
+	KillConnectionStr = "connection"
+	KillQueryStr      = "query"
+)
+
+// Format formats the node.
+func (node *Kill) Format(buf *TrackedBuffer) {
+	buf.Myprintf("kill %s %s", node.Type, strconv.FormatUint(node.ID, 10))
+}
+
+func (node *Kill) walkSubtree(visit Visit) error {
+	return nil
+}
+
+// Load represents a LOAD DATA statement.
+type Load struct {
+	Comments    Comments
+	Priority    string
+	Local       string
+	File        *SQLVal
+	Duplicate   string
+	Table       TableName
+	Partitions  Partitions
+	Charset     string
+	Fields      *LoadFields
+	Lines       *LoadLines
+	IgnoreLines *SQLVal
+	Columns     Columns
+}
+
+// Load.Priority, Load.Local and Load.Duplicate
+const (
+	LowPriorityStr = "low_priority "
+	ConcurrentStr  = "concurrent "
+	LocalStr       = "local "
+	LoadReplaceStr = "replace "
+)
+
+// Format formats the node.
+func (node *Load) Format(buf *TrackedBuffer) {
+	buf.Myprintf("load %vdata %s%sinfile %v %sinto table %v%v",
+		node.Comments, node.Priority, node.Local, node.File,
+		node.Duplicate, node.Table, node.Partitions)
+	if node.Charset != "" {
+		buf.Myprintf(" character set %s", node.Charset)
+	}
+	if node.Fields != nil {
+		buf.Myprintf(" %v", node.Fields)
+	}
+	if node.Lines != nil {
+		buf.Myprintf(" %v", node.Lines)
+	}
+	if node.IgnoreLines != nil {
+		buf.Myprintf(" ignore %v lines", node.IgnoreLines)
+	}
+	if node.Columns != nil {
+		buf.Myprintf(" %v", node.Columns)
+	}
+}
+
+func (node *Load) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Comments,
+		node.Table,
+		node.Columns,
+	)
+}
+
+// LoadFields represents the FIELDS clause of a LOAD DATA statement.
+// The options that are not set are nil.
+type LoadFields struct {
+	TerminatedBy *SQLVal
+	Optionally   bool
+	EnclosedBy   *SQLVal
+	EscapedBy    *SQLVal
+}
+
+// Format formats the node.
+func (node *LoadFields) Format(buf *TrackedBuffer) {
+	buf.Myprintf("fields")
+	if node.TerminatedBy != nil {
+		buf.Myprintf(" terminated by %v", node.TerminatedBy)
+	}
+	if node.EnclosedBy != nil {
+		if node.Optionally {
+			buf.Myprintf(" optionally")
+		}
+		buf.Myprintf(" enclosed by %v", node.EnclosedBy)
+	}
+	if node.EscapedBy != nil {
+		buf.Myprintf(" escaped by %v", node.EscapedBy)
 	}
 }
 
-func (node *ShowFilter) walkSubtree(visit Visit) error {
+func (node *LoadFields) walkSubtree(visit Visit) error {
 	return nil
 }
 
-// Use represents a use statement.
-type Use struct {
-	DBName TableIdent
+// LoadLines represents the LINES clause of a LOAD DATA statement.
+// The options that are not set are nil.
+type LoadLines struct {
+	StartingBy   *SQLVal
+	TerminatedBy *SQLVal
 }
 
 // Format formats the node.
-func (node *Use) Format(buf *TrackedBuffer) {
-	if node.DBName.v != "" {
-		buf.Myprintf("use %v", node.DBName)
-	} else {
-		buf.Myprintf("use")
+func (node *LoadLines) Format(buf *TrackedBuffer) {
+	buf.Myprintf("lines")
+	if node.StartingBy != nil {
+		buf.Myprintf(" starting by %v", node.StartingBy)
+	}
+	if node.TerminatedBy != nil {
+		buf.Myprintf(" terminated by %v", node.TerminatedBy)
 	}
 }
 
-func (node *Use) walkSubtree(visit Visit) error {
-	return Walk(visit, node.DBName)
+func (node *LoadLines) walkSubtree(visit Visit) error {
+	return nil
 }
 
 // Begin represents a Begin statement.
@@ -1878,6 +2560,7 @@ type TableExpr interface {
 func (*AliasedTableExpr) iTableExpr() {}
 func (*ParenTableExpr) iTableExpr()   {}
 func (*JoinTableExpr) iTableExpr()    {}
+func (*JSONTableExpr) iTableExpr()    {}
 
 // AliasedTableExpr represents a table expression
 // coupled with an optional alias or index hint.
@@ -2075,6 +2758,154 @@ func (node *JoinTableExpr) walkSubtree(visit Visit) error {
 	)
 }
 
+// JSONTableExpr represents a JSON_TABLE table function, which
+// returns the rows found at Path in the JSON document Expr.
+type JSONTableExpr struct {
+	Expr    Expr
+	Path    Expr
+	Columns JSONTableColumns
+	As      TableIdent
+}
+
+// Format formats the node.
+func (node *JSONTableExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("json_table(%v, %v columns(%v)) as %v", node.Expr, node.Path, node.Columns, node.As)
+}
+
+func (node *JSONTableExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Expr,
+		node.Path,
+		node.Columns,
+		node.As,
+	)
+}
+
+// ColumnNames returns the names of the columns of the JSON table,
+// including the ones of nested paths, in order.
+func (node *JSONTableExpr) ColumnNames() []ColIdent {
+	return node.Columns.names(nil)
+}
+
+// JSONTableColumns represents the COLUMNS clause of JSON_TABLE.
+type JSONTableColumns []*JSONTableColumn
+
+// Format formats the node.
+func (node JSONTableColumns) Format(buf *TrackedBuffer) {
+	var prefix string
+	for _, n := range node {
+		buf.Myprintf("%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
+func (node JSONTableColumns) walkSubtree(visit Visit) error {
+	for _, n := range node {
+		if err := Walk(visit, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (node JSONTableColumns) names(names []ColIdent) []ColIdent {
+	for _, col := range node {
+		if col.Kind == JSONTableNestedStr {
+			names = col.Nested.names(names)
+			continue
+		}
+		names = append(names, col.Name)
+	}
+	return names
+}
+
+// JSONTableColumn represents a column of JSON_TABLE. A nested
+// path has no name, it defines the columns in Nested instead.
+type JSONTableColumn struct {
+	Kind    string
+	Name    ColIdent
+	Type    ColumnType
+	Path    Expr
+	OnEmpty *JSONTableOnResponse
+	OnError *JSONTableOnResponse
+	Nested  JSONTableColumns
+}
+
+// JSONTableColumn.Kind
+const (
+	JSONTableOrdinalityStr = "for ordinality"
+	JSONTablePathStr       = "path"
+	JSONTableExistsStr     = "exists path"
+	JSONTableNestedStr     = "nested path"
+)
+
+// Format formats the node.
+func (node *JSONTableColumn) Format(buf *TrackedBuffer) {
+	switch node.Kind {
+	case JSONTableOrdinalityStr:
+		buf.Myprintf("%v %s", node.Name, node.Kind)
+	case JSONTableNestedStr:
+		buf.Myprintf("%s %v columns(%v)", node.Kind, node.Path, node.Nested)
+	default:
+		buf.Myprintf("%v %v %s %v", node.Name, &node.Type, node.Kind, node.Path)
+		if node.OnEmpty != nil {
+			buf.Myprintf(" %v on empty", node.OnEmpty)
+		}
+		if node.OnError != nil {
+			buf.Myprintf(" %v on error", node.OnError)
+		}
+	}
+}
+
+func (node *JSONTableColumn) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Name,
+		node.Path,
+		node.OnEmpty,
+		node.OnError,
+		node.Nested,
+	)
+}
+
+// JSONTableOnResponse represents what a JSON_TABLE path column
+// returns when its path is missing (ON EMPTY) or can't be
+// converted (ON ERROR).
+type JSONTableOnResponse struct {
+	Type    string
+	Default Expr
+}
+
+// JSONTableOnResponse.Type
+const (
+	JSONResponseNullStr    = "null"
+	JSONResponseErrorStr   = "error"
+	JSONResponseDefaultStr = "default"
+)
+
+// Format formats the node.
+func (node *JSONTableOnResponse) Format(buf *TrackedBuffer) {
+	if node.Type == JSONResponseDefaultStr {
+		buf.Myprintf("%s %v", node.Type, node.Default)
+		return
+	}
+	buf.Myprintf("%s", node.Type)
+}
+
+func (node *JSONTableOnResponse) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Default)
+}
+
 // IndexHints represents a list of index hints.
 type IndexHints struct {
 	Type    string
@@ -2176,6 +3007,7 @@ func (ValTuple) iExpr()           {}
 func (*Subquery) iExpr()          {}
 func (ListArg) iExpr()            {}
 func (*BinaryExpr) iExpr()        {}
+func (*JSONExtractExpr) iExpr()   {}
 func (*UnaryExpr) iExpr()         {}
 func (*IntervalExpr) iExpr()      {}
 func (*CollateExpr) iExpr()       {}
@@ -2349,21 +3181,19 @@ type ComparisonExpr struct {
 
 // ComparisonExpr.Operator
 const (
-	EqualStr             = "="
-	LessThanStr          = "<"
-	GreaterThanStr       = ">"
-	LessEqualStr         = "<="
-	GreaterEqualStr      = ">="
-	NotEqualStr          = "!="
-	NullSafeEqualStr     = "<=>"
-	InStr                = "in"
-	NotInStr             = "not in"
-	LikeStr              = "like"
-	NotLikeStr           = "not like"
-	RegexpStr            = "regexp"
-	NotRegexpStr         = "not regexp"
-	JSONExtractOp        = "->"
-	JSONUnquoteExtractOp = "->>"
+	EqualStr         = "="
+	LessThanStr      = "<"
+	GreaterThanStr   = ">"
+	LessEqualStr     = "<="
+	GreaterEqualStr  = ">="
+	NotEqualStr      = "!="
+	NullSafeEqualStr = "<=>"
+	InStr            = "in"
+	NotInStr         = "not in"
+	LikeStr          = "like"
+	NotLikeStr       = "not like"
+	RegexpStr        = "regexp"
+	NotRegexpStr     = "not regexp"
 )
 
 // Format formats the node.
@@ -2814,6 +3644,40 @@ func (node *BinaryExpr) replace(from, to Expr) bool {
 	return replaceExprs(from, to, &node.Left, &node.Right)
 }
 
+// JSONExtractExpr represents the JSON column path operators,
+// col->path and col->>path.
+type JSONExtractExpr struct {
+	Operator string
+	Column   *ColName
+	Path     Expr
+}
+
+// JSONExtractExpr.Operator
+const (
+	JSONExtractOp        = "->"
+	JSONUnquoteExtractOp = "->>"
+)
+
+// Format formats the node.
+func (node *JSONExtractExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v %s %v", node.Column, node.Operator, node.Path)
+}
+
+func (node *JSONExtractExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Column,
+		node.Path,
+	)
+}
+
+func (node *JSONExtractExpr) replace(from, to Expr) bool {
+	return replaceExprs(from, to, &node.Path)
+}
+
 // UnaryExpr represents a unary value expression.
 type UnaryExpr struct {
 	Operator string
@@ -2964,12 +3828,14 @@ func (node *CollateExpr) replace(from, to Expr) bool {
 	return replaceExprs(from, to, &node.Expr)
 }
 
-// FuncExpr represents a function call.
+// FuncExpr represents a function call. Over is set
+// if it's called as a window function.
 type FuncExpr struct {
 	Qualifier TableIdent
 	Name      ColIdent
 	Distinct  bool
 	Exprs     SelectExprs
+	Over      *OverClause
 }
 
 // Format formats the node.
@@ -2984,7 +3850,7 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 	// Function names should not be back-quoted even
 	// if they match a reserved word. So, print the
 	// name as is.
-	buf.Myprintf("%s(%s%v)", node.Name.String(), distinct, node.Exprs)
+	buf.Myprintf("%s(%s%v)%v", node.Name.String(), distinct, node.Exprs, node.Over)
 }
 
 func (node *FuncExpr) walkSubtree(visit Visit) error {
@@ -2996,6 +3862,7 @@ func (node *FuncExpr) walkSubtree(visit Visit) error {
 		node.Qualifier,
 		node.Name,
 		node.Exprs,
+		node.Over,
 	)
 }
 
@@ -3009,6 +3876,9 @@ func (node *FuncExpr) replace(from, to Expr) bool {
 			return true
 		}
 	}
+	if node.Over != nil {
+		return node.Over.WindowSpec.replace(from, to)
+	}
 	return false
 }
 
@@ -3033,8 +3903,193 @@ var Aggregates = map[string]bool{
 }
 
 // IsAggregate returns true if the function is an aggregate.
+// Aggregates called as window functions don't group rows,
+// so they are not considered as such.
 func (node *FuncExpr) IsAggregate() bool {
-	return Aggregates[node.Name.Lowered()]
+	return node.Over == nil && Aggregates[node.Name.Lowered()]
+}
+
+// IsWindowFunc returns true if the function is called as a
+// window function.
+func (node *FuncExpr) IsWindowFunc() bool {
+	return node.Over != nil
+}
+
+// OverClause represents the OVER clause of a window function call.
+// It either refers to a named window, or specifies the window.
+type OverClause struct {
+	WindowName ColIdent
+	WindowSpec *WindowSpec
+}
+
+// Format formats the node.
+func (node *OverClause) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	if node.WindowSpec == nil {
+		buf.Myprintf(" over %v", node.WindowName)
+		return
+	}
+	buf.Myprintf(" over %v", node.WindowSpec)
+}
+
+func (node *OverClause) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.WindowName,
+		node.WindowSpec,
+	)
+}
+
+// WindowSpec represents a window specification: the window it
+// refines, if any, its partitions, the order of their rows and
+// the frame of each row.
+type WindowSpec struct {
+	Name        ColIdent
+	PartitionBy Exprs
+	OrderBy     OrderBy
+	Frame       *FrameClause
+}
+
+// Format formats the node.
+func (node *WindowSpec) Format(buf *TrackedBuffer) {
+	buf.Myprintf("(")
+	prefix := ""
+	if !node.Name.IsEmpty() {
+		buf.Myprintf("%v", node.Name)
+		prefix = " "
+	}
+	if len(node.PartitionBy) > 0 {
+		buf.Myprintf("%spartition by %v", prefix, node.PartitionBy)
+		prefix = " "
+	}
+	if len(node.OrderBy) > 0 {
+		buf.Myprintf("%sorder by ", prefix)
+		for i, order := range node.OrderBy {
+			if i > 0 {
+				buf.Myprintf(", ")
+			}
+			buf.Myprintf("%v", order)
+		}
+		prefix = " "
+	}
+	if node.Frame != nil {
+		buf.Myprintf("%s%v", prefix, node.Frame)
+	}
+	buf.Myprintf(")")
+}
+
+func (node *WindowSpec) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Name,
+		node.PartitionBy,
+		node.OrderBy,
+		node.Frame,
+	)
+}
+
+func (node *WindowSpec) replace(from, to Expr) bool {
+	if node == nil {
+		return false
+	}
+	for i := range node.PartitionBy {
+		if replaceExprs(from, to, &node.PartitionBy[i]) {
+			return true
+		}
+	}
+	for _, order := range node.OrderBy {
+		if replaceExprs(from, to, &order.Expr) {
+			return true
+		}
+	}
+	if node.Frame != nil {
+		return node.Frame.replace(from, to)
+	}
+	return false
+}
+
+// FrameClause represents the frame of a window: the rows of the
+// partition between Start and End. End is nil if the frame only
+// has a start, in which case it ends at the current row.
+type FrameClause struct {
+	Unit  string
+	Start *FramePoint
+	End   *FramePoint
+}
+
+// FrameClause.Unit
+const (
+	RowsStr  = "rows"
+	RangeStr = "range"
+)
+
+// Format formats the node.
+func (node *FrameClause) Format(buf *TrackedBuffer) {
+	if node.End == nil {
+		buf.Myprintf("%s %v", node.Unit, node.Start)
+		return
+	}
+	buf.Myprintf("%s between %v and %v", node.Unit, node.Start, node.End)
+}
+
+func (node *FrameClause) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Start,
+		node.End,
+	)
+}
+
+func (node *FrameClause) replace(from, to Expr) bool {
+	if node.Start != nil && replaceExprs(from, to, &node.Start.Expr) {
+		return true
+	}
+	return node.End != nil && replaceExprs(from, to, &node.End.Expr)
+}
+
+// FramePoint represents the start or the end of a window frame.
+// Expr is only set for the PrecedingStr and FollowingStr types.
+type FramePoint struct {
+	Type string
+	Expr Expr
+}
+
+// FramePoint.Type
+const (
+	CurrentRowStr         = "current row"
+	UnboundedPrecedingStr = "unbounded preceding"
+	UnboundedFollowingStr = "unbounded following"
+	PrecedingStr          = "preceding"
+	FollowingStr          = "following"
+)
+
+// Format formats the node.
+func (node *FramePoint) Format(buf *TrackedBuffer) {
+	if node.Expr != nil {
+		buf.Myprintf("%v ", node.Expr)
+	}
+	buf.Myprintf("%s", node.Type)
+}
+
+func (node *FramePoint) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Expr,
+	)
 }
 
 // GroupConcatExpr represents a call to GROUP_CONCAT
@@ -3387,6 +4442,49 @@ func (node GroupBy) walkSubtree(visit Visit) error {
 	return nil
 }
 
+// WindowDefs represents a WINDOW clause.
+type WindowDefs []*WindowDef
+
+// Format formats the node.
+func (node WindowDefs) Format(buf *TrackedBuffer) {
+	prefix := " window "
+	for _, n := range node {
+		buf.Myprintf("%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
+func (node WindowDefs) walkSubtree(visit Visit) error {
+	for _, n := range node {
+		if err := Walk(visit, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WindowDef represents a named window of a WINDOW clause.
+type WindowDef struct {
+	Name       ColIdent
+	WindowSpec *WindowSpec
+}
+
+// Format formats the node.
+func (node *WindowDef) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v as %v", node.Name, node.WindowSpec)
+}
+
+func (node *WindowDef) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Name,
+		node.WindowSpec,
+	)
+}
+
 // OrderBy represents an ORDER By clause.
 type OrderBy []*Order
 
@@ -0,0 +1,2114 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by rewriter_gen.go. DO NOT EDIT.
+
+package sqlparser
+
+func replaceAliasedExprExpr(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*AliasedExpr).Expr = node
+}
+
+func replaceAliasedExprAs(newNode, parent SQLNode) {
+	var node ColIdent
+	if newNode != nil {
+		node = newNode.(ColIdent)
+	}
+	parent.(*AliasedExpr).As = node
+}
+
+func replaceAliasedTableExprExpr(newNode, parent SQLNode) {
+	var node SimpleTableExpr
+	if newNode != nil {
+		node = newNode.(SimpleTableExpr)
+	}
+	parent.(*AliasedTableExpr).Expr = node
+}
+
+func replaceAliasedTableExprPartitions(newNode, parent SQLNode) {
+	var node Partitions
+	if newNode != nil {
+		node = newNode.(Partitions)
+	}
+	parent.(*AliasedTableExpr).Partitions = node
+}
+
+func replaceAliasedTableExprAs(newNode, parent SQLNode) {
+	var node TableIdent
+	if newNode != nil {
+		node = newNode.(TableIdent)
+	}
+	parent.(*AliasedTableExpr).As = node
+}
+
+func replaceAliasedTableExprHints(newNode, parent SQLNode) {
+	var node *IndexHints
+	if newNode != nil {
+		node = newNode.(*IndexHints)
+	}
+	parent.(*AliasedTableExpr).Hints = node
+}
+
+func replaceAlterSpecColumn(newNode, parent SQLNode) {
+	var node *ColumnDefinition
+	if newNode != nil {
+		node = newNode.(*ColumnDefinition)
+	}
+	parent.(*AlterSpec).Column = node
+}
+
+func replaceAlterSpecPosition(newNode, parent SQLNode) {
+	var node *ColumnPosition
+	if newNode != nil {
+		node = newNode.(*ColumnPosition)
+	}
+	parent.(*AlterSpec).Position = node
+}
+
+func replaceAlterSpecName(newNode, parent SQLNode) {
+	var node ColIdent
+	if newNode != nil {
+		node = newNode.(ColIdent)
+	}
+	parent.(*AlterSpec).Name = node
+}
+
+func replaceAlterSpecNewName(newNode, parent SQLNode) {
+	var node ColIdent
+	if newNode != nil {
+		node = newNode.(ColIdent)
+	}
+	parent.(*AlterSpec).NewName = node
+}
+
+func replaceAlterSpecNewTable(newNode, parent SQLNode) {
+	var node TableName
+	if newNode != nil {
+		node = newNode.(TableName)
+	}
+	parent.(*AlterSpec).NewTable = node
+}
+
+func replaceAlterSpecDefault(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*AlterSpec).Default = node
+}
+
+func replaceAlterSpecIndex(newNode, parent SQLNode) {
+	var node *IndexDefinition
+	if newNode != nil {
+		node = newNode.(*IndexDefinition)
+	}
+	parent.(*AlterSpec).Index = node
+}
+
+func replaceAlterSpecConstraint(newNode, parent SQLNode) {
+	var node *ConstraintDefinition
+	if newNode != nil {
+		node = newNode.(*ConstraintDefinition)
+	}
+	parent.(*AlterSpec).Constraint = node
+}
+
+func replaceAlterSpecOrderBy(newNode, parent SQLNode) {
+	var node OrderBy
+	if newNode != nil {
+		node = newNode.(OrderBy)
+	}
+	parent.(*AlterSpec).OrderBy = node
+}
+
+func replaceAndExprLeft(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*AndExpr).Left = node
+}
+
+func replaceAndExprRight(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*AndExpr).Right = node
+}
+
+func replaceBinaryExprLeft(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*BinaryExpr).Left = node
+}
+
+func replaceBinaryExprRight(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*BinaryExpr).Right = node
+}
+
+func replaceCaseExprExpr(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*CaseExpr).Expr = node
+}
+
+func replaceCaseExprElse(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*CaseExpr).Else = node
+}
+
+func replaceColNameName(newNode, parent SQLNode) {
+	var node ColIdent
+	if newNode != nil {
+		node = newNode.(ColIdent)
+	}
+	parent.(*ColName).Name = node
+}
+
+func replaceColNameQualifier(newNode, parent SQLNode) {
+	var node TableName
+	if newNode != nil {
+		node = newNode.(TableName)
+	}
+	parent.(*ColName).Qualifier = node
+}
+
+func replaceCollateExprExpr(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*CollateExpr).Expr = node
+}
+
+func replaceColumnDefinitionName(newNode, parent SQLNode) {
+	var node ColIdent
+	if newNode != nil {
+		node = newNode.(ColIdent)
+	}
+	parent.(*ColumnDefinition).Name = node
+}
+
+func replaceColumnDefinitionType(newNode, parent SQLNode) {
+	var node ColumnType
+	if newNode != nil {
+		node = *newNode.(*ColumnType)
+	}
+	parent.(*ColumnDefinition).Type = node
+}
+
+func replaceColumnPositionAfter(newNode, parent SQLNode) {
+	var node ColIdent
+	if newNode != nil {
+		node = newNode.(ColIdent)
+	}
+	parent.(*ColumnPosition).After = node
+}
+
+func replaceColumnTypeNotNull(newNode, parent SQLNode) {
+	var node BoolVal
+	if newNode != nil {
+		node = newNode.(BoolVal)
+	}
+	parent.(*ColumnType).NotNull = node
+}
+
+func replaceColumnTypeAutoincrement(newNode, parent SQLNode) {
+	var node BoolVal
+	if newNode != nil {
+		node = newNode.(BoolVal)
+	}
+	parent.(*ColumnType).Autoincrement = node
+}
+
+func replaceColumnTypeDefault(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*ColumnType).Default = node
+}
+
+func replaceColumnTypeOnUpdate(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*ColumnType).OnUpdate = node
+}
+
+func replaceColumnTypeComment(newNode, parent SQLNode) {
+	var node *SQLVal
+	if newNode != nil {
+		node = newNode.(*SQLVal)
+	}
+	parent.(*ColumnType).Comment = node
+}
+
+func replaceColumnTypeLength(newNode, parent SQLNode) {
+	var node *SQLVal
+	if newNode != nil {
+		node = newNode.(*SQLVal)
+	}
+	parent.(*ColumnType).Length = node
+}
+
+func replaceColumnTypeUnsigned(newNode, parent SQLNode) {
+	var node BoolVal
+	if newNode != nil {
+		node = newNode.(BoolVal)
+	}
+	parent.(*ColumnType).Unsigned = node
+}
+
+func replaceColumnTypeZerofill(newNode, parent SQLNode) {
+	var node BoolVal
+	if newNode != nil {
+		node = newNode.(BoolVal)
+	}
+	parent.(*ColumnType).Zerofill = node
+}
+
+func replaceColumnTypeScale(newNode, parent SQLNode) {
+	var node *SQLVal
+	if newNode != nil {
+		node = newNode.(*SQLVal)
+	}
+	parent.(*ColumnType).Scale = node
+}
+
+func replaceColumnTypeGenerated(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*ColumnType).Generated = node
+}
+
+func replaceColumnTypeStored(newNode, parent SQLNode) {
+	var node BoolVal
+	if newNode != nil {
+		node = newNode.(BoolVal)
+	}
+	parent.(*ColumnType).Stored = node
+}
+
+func replaceCommonTableExprName(newNode, parent SQLNode) {
+	var node TableIdent
+	if newNode != nil {
+		node = newNode.(TableIdent)
+	}
+	parent.(*CommonTableExpr).Name = node
+}
+
+func replaceCommonTableExprColumns(newNode, parent SQLNode) {
+	var node Columns
+	if newNode != nil {
+		node = newNode.(Columns)
+	}
+	parent.(*CommonTableExpr).Columns = node
+}
+
+func replaceCommonTableExprSubquery(newNode, parent SQLNode) {
+	var node *Subquery
+	if newNode != nil {
+		node = newNode.(*Subquery)
+	}
+	parent.(*CommonTableExpr).Subquery = node
+}
+
+func replaceComparisonExprLeft(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*ComparisonExpr).Left = node
+}
+
+func replaceComparisonExprRight(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*ComparisonExpr).Right = node
+}
+
+func replaceComparisonExprEscape(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*ComparisonExpr).Escape = node
+}
+
+func replaceConstraintDefinitionDetails(newNode, parent SQLNode) {
+	var node ConstraintInfo
+	if newNode != nil {
+		node = newNode.(ConstraintInfo)
+	}
+	parent.(*ConstraintDefinition).Details = node
+}
+
+func replaceConvertExprExpr(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*ConvertExpr).Expr = node
+}
+
+func replaceConvertExprType(newNode, parent SQLNode) {
+	var node *ConvertType
+	if newNode != nil {
+		node = newNode.(*ConvertType)
+	}
+	parent.(*ConvertExpr).Type = node
+}
+
+func replaceConvertTypeLength(newNode, parent SQLNode) {
+	var node *SQLVal
+	if newNode != nil {
+		node = newNode.(*SQLVal)
+	}
+	parent.(*ConvertType).Length = node
+}
+
+func replaceConvertTypeScale(newNode, parent SQLNode) {
+	var node *SQLVal
+	if newNode != nil {
+		node = newNode.(*SQLVal)
+	}
+	parent.(*ConvertType).Scale = node
+}
+
+func replaceConvertUsingExprExpr(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*ConvertUsingExpr).Expr = node
+}
+
+func replaceCurTimeFuncExprName(newNode, parent SQLNode) {
+	var node ColIdent
+	if newNode != nil {
+		node = newNode.(ColIdent)
+	}
+	parent.(*CurTimeFuncExpr).Name = node
+}
+
+func replaceCurTimeFuncExprFsp(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*CurTimeFuncExpr).Fsp = node
+}
+
+func replaceDDLFromTables(newNode, parent SQLNode) {
+	var node TableNames
+	if newNode != nil {
+		node = newNode.(TableNames)
+	}
+	parent.(*DDL).FromTables = node
+}
+
+func replaceDDLToTables(newNode, parent SQLNode) {
+	var node TableNames
+	if newNode != nil {
+		node = newNode.(TableNames)
+	}
+	parent.(*DDL).ToTables = node
+}
+
+func replaceDDLTable(newNode, parent SQLNode) {
+	var node TableName
+	if newNode != nil {
+		node = newNode.(TableName)
+	}
+	parent.(*DDL).Table = node
+}
+
+func replaceDDLTableSpec(newNode, parent SQLNode) {
+	var node *TableSpec
+	if newNode != nil {
+		node = newNode.(*TableSpec)
+	}
+	parent.(*DDL).TableSpec = node
+}
+
+func replaceDDLOptLike(newNode, parent SQLNode) {
+	var node *OptLike
+	if newNode != nil {
+		node = newNode.(*OptLike)
+	}
+	parent.(*DDL).OptLike = node
+}
+
+func replaceDDLPartitionSpec(newNode, parent SQLNode) {
+	var node *PartitionSpec
+	if newNode != nil {
+		node = newNode.(*PartitionSpec)
+	}
+	parent.(*DDL).PartitionSpec = node
+}
+
+func replaceDDLAlterSpecs(newNode, parent SQLNode) {
+	var node AlterSpecs
+	if newNode != nil {
+		node = newNode.(AlterSpecs)
+	}
+	parent.(*DDL).AlterSpecs = node
+}
+
+func replaceDDLVindexSpec(newNode, parent SQLNode) {
+	var node *VindexSpec
+	if newNode != nil {
+		node = newNode.(*VindexSpec)
+	}
+	parent.(*DDL).VindexSpec = node
+}
+
+func replaceDeleteComments(newNode, parent SQLNode) {
+	var node Comments
+	if newNode != nil {
+		node = newNode.(Comments)
+	}
+	parent.(*Delete).Comments = node
+}
+
+func replaceDeleteTargets(newNode, parent SQLNode) {
+	var node TableNames
+	if newNode != nil {
+		node = newNode.(TableNames)
+	}
+	parent.(*Delete).Targets = node
+}
+
+func replaceDeleteTableExprs(newNode, parent SQLNode) {
+	var node TableExprs
+	if newNode != nil {
+		node = newNode.(TableExprs)
+	}
+	parent.(*Delete).TableExprs = node
+}
+
+func replaceDeletePartitions(newNode, parent SQLNode) {
+	var node Partitions
+	if newNode != nil {
+		node = newNode.(Partitions)
+	}
+	parent.(*Delete).Partitions = node
+}
+
+func replaceDeleteWhere(newNode, parent SQLNode) {
+	var node *Where
+	if newNode != nil {
+		node = newNode.(*Where)
+	}
+	parent.(*Delete).Where = node
+}
+
+func replaceDeleteOrderBy(newNode, parent SQLNode) {
+	var node OrderBy
+	if newNode != nil {
+		node = newNode.(OrderBy)
+	}
+	parent.(*Delete).OrderBy = node
+}
+
+func replaceDeleteLimit(newNode, parent SQLNode) {
+	var node *Limit
+	if newNode != nil {
+		node = newNode.(*Limit)
+	}
+	parent.(*Delete).Limit = node
+}
+
+func replaceExistsExprSubquery(newNode, parent SQLNode) {
+	var node *Subquery
+	if newNode != nil {
+		node = newNode.(*Subquery)
+	}
+	parent.(*ExistsExpr).Subquery = node
+}
+
+func replaceForeignKeyDefinitionSource(newNode, parent SQLNode) {
+	var node Columns
+	if newNode != nil {
+		node = newNode.(Columns)
+	}
+	parent.(*ForeignKeyDefinition).Source = node
+}
+
+func replaceForeignKeyDefinitionReferencedTable(newNode, parent SQLNode) {
+	var node TableName
+	if newNode != nil {
+		node = newNode.(TableName)
+	}
+	parent.(*ForeignKeyDefinition).ReferencedTable = node
+}
+
+func replaceForeignKeyDefinitionReferencedColumns(newNode, parent SQLNode) {
+	var node Columns
+	if newNode != nil {
+		node = newNode.(Columns)
+	}
+	parent.(*ForeignKeyDefinition).ReferencedColumns = node
+}
+
+func replaceForeignKeyDefinitionOnDelete(newNode, parent SQLNode) {
+	var node ReferenceAction
+	if newNode != nil {
+		node = newNode.(ReferenceAction)
+	}
+	parent.(*ForeignKeyDefinition).OnDelete = node
+}
+
+func replaceForeignKeyDefinitionOnUpdate(newNode, parent SQLNode) {
+	var node ReferenceAction
+	if newNode != nil {
+		node = newNode.(ReferenceAction)
+	}
+	parent.(*ForeignKeyDefinition).OnUpdate = node
+}
+
+func replaceFrameClauseStart(newNode, parent SQLNode) {
+	var node *FramePoint
+	if newNode != nil {
+		node = newNode.(*FramePoint)
+	}
+	parent.(*FrameClause).Start = node
+}
+
+func replaceFrameClauseEnd(newNode, parent SQLNode) {
+	var node *FramePoint
+	if newNode != nil {
+		node = newNode.(*FramePoint)
+	}
+	parent.(*FrameClause).End = node
+}
+
+func replaceFramePointExpr(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*FramePoint).Expr = node
+}
+
+func replaceFuncExprQualifier(newNode, parent SQLNode) {
+	var node TableIdent
+	if newNode != nil {
+		node = newNode.(TableIdent)
+	}
+	parent.(*FuncExpr).Qualifier = node
+}
+
+func replaceFuncExprName(newNode, parent SQLNode) {
+	var node ColIdent
+	if newNode != nil {
+		node = newNode.(ColIdent)
+	}
+	parent.(*FuncExpr).Name = node
+}
+
+func replaceFuncExprExprs(newNode, parent SQLNode) {
+	var node SelectExprs
+	if newNode != nil {
+		node = newNode.(SelectExprs)
+	}
+	parent.(*FuncExpr).Exprs = node
+}
+
+func replaceFuncExprOver(newNode, parent SQLNode) {
+	var node *OverClause
+	if newNode != nil {
+		node = newNode.(*OverClause)
+	}
+	parent.(*FuncExpr).Over = node
+}
+
+func replaceGroupConcatExprExprs(newNode, parent SQLNode) {
+	var node SelectExprs
+	if newNode != nil {
+		node = newNode.(SelectExprs)
+	}
+	parent.(*GroupConcatExpr).Exprs = node
+}
+
+func replaceGroupConcatExprOrderBy(newNode, parent SQLNode) {
+	var node OrderBy
+	if newNode != nil {
+		node = newNode.(OrderBy)
+	}
+	parent.(*GroupConcatExpr).OrderBy = node
+}
+
+func replaceIndexDefinitionInfo(newNode, parent SQLNode) {
+	var node *IndexInfo
+	if newNode != nil {
+		node = newNode.(*IndexInfo)
+	}
+	parent.(*IndexDefinition).Info = node
+}
+
+func replaceIndexInfoName(newNode, parent SQLNode) {
+	var node ColIdent
+	if newNode != nil {
+		node = newNode.(ColIdent)
+	}
+	parent.(*IndexInfo).Name = node
+}
+
+func replaceInsertComments(newNode, parent SQLNode) {
+	var node Comments
+	if newNode != nil {
+		node = newNode.(Comments)
+	}
+	parent.(*Insert).Comments = node
+}
+
+func replaceInsertTable(newNode, parent SQLNode) {
+	var node TableName
+	if newNode != nil {
+		node = newNode.(TableName)
+	}
+	parent.(*Insert).Table = node
+}
+
+func replaceInsertPartitions(newNode, parent SQLNode) {
+	var node Partitions
+	if newNode != nil {
+		node = newNode.(Partitions)
+	}
+	parent.(*Insert).Partitions = node
+}
+
+func replaceInsertColumns(newNode, parent SQLNode) {
+	var node Columns
+	if newNode != nil {
+		node = newNode.(Columns)
+	}
+	parent.(*Insert).Columns = node
+}
+
+func replaceInsertRows(newNode, parent SQLNode) {
+	var node InsertRows
+	if newNode != nil {
+		node = newNode.(InsertRows)
+	}
+	parent.(*Insert).Rows = node
+}
+
+func replaceInsertOnDup(newNode, parent SQLNode) {
+	var node OnDup
+	if newNode != nil {
+		node = newNode.(OnDup)
+	}
+	parent.(*Insert).OnDup = node
+}
+
+func replaceIntervalExprExpr(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*IntervalExpr).Expr = node
+}
+
+func replaceIsExprExpr(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*IsExpr).Expr = node
+}
+
+func replaceJSONExtractExprColumn(newNode, parent SQLNode) {
+	var node *ColName
+	if newNode != nil {
+		node = newNode.(*ColName)
+	}
+	parent.(*JSONExtractExpr).Column = node
+}
+
+func replaceJSONExtractExprPath(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*JSONExtractExpr).Path = node
+}
+
+func replaceJSONTableColumnName(newNode, parent SQLNode) {
+	var node ColIdent
+	if newNode != nil {
+		node = newNode.(ColIdent)
+	}
+	parent.(*JSONTableColumn).Name = node
+}
+
+func replaceJSONTableColumnType(newNode, parent SQLNode) {
+	var node ColumnType
+	if newNode != nil {
+		node = *newNode.(*ColumnType)
+	}
+	parent.(*JSONTableColumn).Type = node
+}
+
+func replaceJSONTableColumnPath(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*JSONTableColumn).Path = node
+}
+
+func replaceJSONTableColumnOnEmpty(newNode, parent SQLNode) {
+	var node *JSONTableOnResponse
+	if newNode != nil {
+		node = newNode.(*JSONTableOnResponse)
+	}
+	parent.(*JSONTableColumn).OnEmpty = node
+}
+
+func replaceJSONTableColumnOnError(newNode, parent SQLNode) {
+	var node *JSONTableOnResponse
+	if newNode != nil {
+		node = newNode.(*JSONTableOnResponse)
+	}
+	parent.(*JSONTableColumn).OnError = node
+}
+
+func replaceJSONTableColumnNested(newNode, parent SQLNode) {
+	var node JSONTableColumns
+	if newNode != nil {
+		node = newNode.(JSONTableColumns)
+	}
+	parent.(*JSONTableColumn).Nested = node
+}
+
+func replaceJSONTableExprExpr(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*JSONTableExpr).Expr = node
+}
+
+func replaceJSONTableExprPath(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*JSONTableExpr).Path = node
+}
+
+func replaceJSONTableExprColumns(newNode, parent SQLNode) {
+	var node JSONTableColumns
+	if newNode != nil {
+		node = newNode.(JSONTableColumns)
+	}
+	parent.(*JSONTableExpr).Columns = node
+}
+
+func replaceJSONTableExprAs(newNode, parent SQLNode) {
+	var node TableIdent
+	if newNode != nil {
+		node = newNode.(TableIdent)
+	}
+	parent.(*JSONTableExpr).As = node
+}
+
+func replaceJSONTableOnResponseDefault(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*JSONTableOnResponse).Default = node
+}
+
+func replaceJoinConditionOn(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*JoinCondition).On = node
+}
+
+func replaceJoinConditionUsing(newNode, parent SQLNode) {
+	var node Columns
+	if newNode != nil {
+		node = newNode.(Columns)
+	}
+	parent.(*JoinCondition).Using = node
+}
+
+func replaceJoinTableExprLeftExpr(newNode, parent SQLNode) {
+	var node TableExpr
+	if newNode != nil {
+		node = newNode.(TableExpr)
+	}
+	parent.(*JoinTableExpr).LeftExpr = node
+}
+
+func replaceJoinTableExprRightExpr(newNode, parent SQLNode) {
+	var node TableExpr
+	if newNode != nil {
+		node = newNode.(TableExpr)
+	}
+	parent.(*JoinTableExpr).RightExpr = node
+}
+
+func replaceJoinTableExprCondition(newNode, parent SQLNode) {
+	var node JoinCondition
+	if newNode != nil {
+		node = newNode.(JoinCondition)
+	}
+	parent.(*JoinTableExpr).Condition = node
+}
+
+func replaceLimitOffset(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*Limit).Offset = node
+}
+
+func replaceLimitRowcount(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*Limit).Rowcount = node
+}
+
+func replaceLoadComments(newNode, parent SQLNode) {
+	var node Comments
+	if newNode != nil {
+		node = newNode.(Comments)
+	}
+	parent.(*Load).Comments = node
+}
+
+func replaceLoadFile(newNode, parent SQLNode) {
+	var node *SQLVal
+	if newNode != nil {
+		node = newNode.(*SQLVal)
+	}
+	parent.(*Load).File = node
+}
+
+func replaceLoadTable(newNode, parent SQLNode) {
+	var node TableName
+	if newNode != nil {
+		node = newNode.(TableName)
+	}
+	parent.(*Load).Table = node
+}
+
+func replaceLoadPartitions(newNode, parent SQLNode) {
+	var node Partitions
+	if newNode != nil {
+		node = newNode.(Partitions)
+	}
+	parent.(*Load).Partitions = node
+}
+
+func replaceLoadFields(newNode, parent SQLNode) {
+	var node *LoadFields
+	if newNode != nil {
+		node = newNode.(*LoadFields)
+	}
+	parent.(*Load).Fields = node
+}
+
+func replaceLoadLines(newNode, parent SQLNode) {
+	var node *LoadLines
+	if newNode != nil {
+		node = newNode.(*LoadLines)
+	}
+	parent.(*Load).Lines = node
+}
+
+func replaceLoadIgnoreLines(newNode, parent SQLNode) {
+	var node *SQLVal
+	if newNode != nil {
+		node = newNode.(*SQLVal)
+	}
+	parent.(*Load).IgnoreLines = node
+}
+
+func replaceLoadColumns(newNode, parent SQLNode) {
+	var node Columns
+	if newNode != nil {
+		node = newNode.(Columns)
+	}
+	parent.(*Load).Columns = node
+}
+
+func replaceLoadFieldsTerminatedBy(newNode, parent SQLNode) {
+	var node *SQLVal
+	if newNode != nil {
+		node = newNode.(*SQLVal)
+	}
+	parent.(*LoadFields).TerminatedBy = node
+}
+
+func replaceLoadFieldsEnclosedBy(newNode, parent SQLNode) {
+	var node *SQLVal
+	if newNode != nil {
+		node = newNode.(*SQLVal)
+	}
+	parent.(*LoadFields).EnclosedBy = node
+}
+
+func replaceLoadFieldsEscapedBy(newNode, parent SQLNode) {
+	var node *SQLVal
+	if newNode != nil {
+		node = newNode.(*SQLVal)
+	}
+	parent.(*LoadFields).EscapedBy = node
+}
+
+func replaceLoadLinesStartingBy(newNode, parent SQLNode) {
+	var node *SQLVal
+	if newNode != nil {
+		node = newNode.(*SQLVal)
+	}
+	parent.(*LoadLines).StartingBy = node
+}
+
+func replaceLoadLinesTerminatedBy(newNode, parent SQLNode) {
+	var node *SQLVal
+	if newNode != nil {
+		node = newNode.(*SQLVal)
+	}
+	parent.(*LoadLines).TerminatedBy = node
+}
+
+func replaceMatchExprColumns(newNode, parent SQLNode) {
+	var node SelectExprs
+	if newNode != nil {
+		node = newNode.(SelectExprs)
+	}
+	parent.(*MatchExpr).Columns = node
+}
+
+func replaceMatchExprExpr(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*MatchExpr).Expr = node
+}
+
+func replaceNextvalExpr(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*Nextval).Expr = node
+}
+
+func replaceNotExprExpr(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*NotExpr).Expr = node
+}
+
+func replaceOptLikeLikeTable(newNode, parent SQLNode) {
+	var node TableName
+	if newNode != nil {
+		node = newNode.(TableName)
+	}
+	parent.(*OptLike).LikeTable = node
+}
+
+func replaceOrExprLeft(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*OrExpr).Left = node
+}
+
+func replaceOrExprRight(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*OrExpr).Right = node
+}
+
+func replaceOrderExpr(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*Order).Expr = node
+}
+
+func replaceOverClauseWindowName(newNode, parent SQLNode) {
+	var node ColIdent
+	if newNode != nil {
+		node = newNode.(ColIdent)
+	}
+	parent.(*OverClause).WindowName = node
+}
+
+func replaceOverClauseWindowSpec(newNode, parent SQLNode) {
+	var node *WindowSpec
+	if newNode != nil {
+		node = newNode.(*WindowSpec)
+	}
+	parent.(*OverClause).WindowSpec = node
+}
+
+func replaceParenExprExpr(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*ParenExpr).Expr = node
+}
+
+func replaceParenSelectSelect(newNode, parent SQLNode) {
+	var node SelectStatement
+	if newNode != nil {
+		node = newNode.(SelectStatement)
+	}
+	parent.(*ParenSelect).Select = node
+}
+
+func replaceParenTableExprExprs(newNode, parent SQLNode) {
+	var node TableExprs
+	if newNode != nil {
+		node = newNode.(TableExprs)
+	}
+	parent.(*ParenTableExpr).Exprs = node
+}
+
+func replacePartitionByExpr(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*PartitionBy).Expr = node
+}
+
+func replacePartitionByColumns(newNode, parent SQLNode) {
+	var node Columns
+	if newNode != nil {
+		node = newNode.(Columns)
+	}
+	parent.(*PartitionBy).Columns = node
+}
+
+func replacePartitionByPartitions(newNode, parent SQLNode) {
+	var node *SQLVal
+	if newNode != nil {
+		node = newNode.(*SQLVal)
+	}
+	parent.(*PartitionBy).Partitions = node
+}
+
+func replacePartitionDefinitionName(newNode, parent SQLNode) {
+	var node ColIdent
+	if newNode != nil {
+		node = newNode.(ColIdent)
+	}
+	parent.(*PartitionDefinition).Name = node
+}
+
+func replacePartitionDefinitionLimit(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*PartitionDefinition).Limit = node
+}
+
+func replacePartitionDefinitionIn(newNode, parent SQLNode) {
+	var node ValTuple
+	if newNode != nil {
+		node = newNode.(ValTuple)
+	}
+	parent.(*PartitionDefinition).In = node
+}
+
+func replacePartitionSpecName(newNode, parent SQLNode) {
+	var node ColIdent
+	if newNode != nil {
+		node = newNode.(ColIdent)
+	}
+	parent.(*PartitionSpec).Name = node
+}
+
+func replacePartitionSpecNames(newNode, parent SQLNode) {
+	var node Columns
+	if newNode != nil {
+		node = newNode.(Columns)
+	}
+	parent.(*PartitionSpec).Names = node
+}
+
+func replacePartitionSpecNumber(newNode, parent SQLNode) {
+	var node *SQLVal
+	if newNode != nil {
+		node = newNode.(*SQLVal)
+	}
+	parent.(*PartitionSpec).Number = node
+}
+
+func replacePartitionSpecBy(newNode, parent SQLNode) {
+	var node *PartitionBy
+	if newNode != nil {
+		node = newNode.(*PartitionBy)
+	}
+	parent.(*PartitionSpec).By = node
+}
+
+func replaceRangeCondLeft(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*RangeCond).Left = node
+}
+
+func replaceRangeCondFrom(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*RangeCond).From = node
+}
+
+func replaceRangeCondTo(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*RangeCond).To = node
+}
+
+func replaceSelectWith(newNode, parent SQLNode) {
+	var node *With
+	if newNode != nil {
+		node = newNode.(*With)
+	}
+	parent.(*Select).With = node
+}
+
+func replaceSelectComments(newNode, parent SQLNode) {
+	var node Comments
+	if newNode != nil {
+		node = newNode.(Comments)
+	}
+	parent.(*Select).Comments = node
+}
+
+func replaceSelectSelectExprs(newNode, parent SQLNode) {
+	var node SelectExprs
+	if newNode != nil {
+		node = newNode.(SelectExprs)
+	}
+	parent.(*Select).SelectExprs = node
+}
+
+func replaceSelectFrom(newNode, parent SQLNode) {
+	var node TableExprs
+	if newNode != nil {
+		node = newNode.(TableExprs)
+	}
+	parent.(*Select).From = node
+}
+
+func replaceSelectWhere(newNode, parent SQLNode) {
+	var node *Where
+	if newNode != nil {
+		node = newNode.(*Where)
+	}
+	parent.(*Select).Where = node
+}
+
+func replaceSelectGroupBy(newNode, parent SQLNode) {
+	var node GroupBy
+	if newNode != nil {
+		node = newNode.(GroupBy)
+	}
+	parent.(*Select).GroupBy = node
+}
+
+func replaceSelectHaving(newNode, parent SQLNode) {
+	var node *Where
+	if newNode != nil {
+		node = newNode.(*Where)
+	}
+	parent.(*Select).Having = node
+}
+
+func replaceSelectWindows(newNode, parent SQLNode) {
+	var node WindowDefs
+	if newNode != nil {
+		node = newNode.(WindowDefs)
+	}
+	parent.(*Select).Windows = node
+}
+
+func replaceSelectOrderBy(newNode, parent SQLNode) {
+	var node OrderBy
+	if newNode != nil {
+		node = newNode.(OrderBy)
+	}
+	parent.(*Select).OrderBy = node
+}
+
+func replaceSelectLimit(newNode, parent SQLNode) {
+	var node *Limit
+	if newNode != nil {
+		node = newNode.(*Limit)
+	}
+	parent.(*Select).Limit = node
+}
+
+func replaceSetComments(newNode, parent SQLNode) {
+	var node Comments
+	if newNode != nil {
+		node = newNode.(Comments)
+	}
+	parent.(*Set).Comments = node
+}
+
+func replaceSetExprs(newNode, parent SQLNode) {
+	var node SetExprs
+	if newNode != nil {
+		node = newNode.(SetExprs)
+	}
+	parent.(*Set).Exprs = node
+}
+
+func replaceSetExprName(newNode, parent SQLNode) {
+	var node ColIdent
+	if newNode != nil {
+		node = newNode.(ColIdent)
+	}
+	parent.(*SetExpr).Name = node
+}
+
+func replaceSetExprExpr(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*SetExpr).Expr = node
+}
+
+func replaceShowOnTable(newNode, parent SQLNode) {
+	var node TableName
+	if newNode != nil {
+		node = newNode.(TableName)
+	}
+	parent.(*Show).OnTable = node
+}
+
+func replaceShowTable(newNode, parent SQLNode) {
+	var node TableName
+	if newNode != nil {
+		node = newNode.(TableName)
+	}
+	parent.(*Show).Table = node
+}
+
+func replaceShowFilterFilter(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*ShowFilter).Filter = node
+}
+
+func replaceStarExprTableName(newNode, parent SQLNode) {
+	var node TableName
+	if newNode != nil {
+		node = newNode.(TableName)
+	}
+	parent.(*StarExpr).TableName = node
+}
+
+func replaceStreamComments(newNode, parent SQLNode) {
+	var node Comments
+	if newNode != nil {
+		node = newNode.(Comments)
+	}
+	parent.(*Stream).Comments = node
+}
+
+func replaceStreamSelectExpr(newNode, parent SQLNode) {
+	var node SelectExpr
+	if newNode != nil {
+		node = newNode.(SelectExpr)
+	}
+	parent.(*Stream).SelectExpr = node
+}
+
+func replaceStreamTable(newNode, parent SQLNode) {
+	var node TableName
+	if newNode != nil {
+		node = newNode.(TableName)
+	}
+	parent.(*Stream).Table = node
+}
+
+func replaceSubquerySelect(newNode, parent SQLNode) {
+	var node SelectStatement
+	if newNode != nil {
+		node = newNode.(SelectStatement)
+	}
+	parent.(*Subquery).Select = node
+}
+
+func replaceSubstrExprName(newNode, parent SQLNode) {
+	var node *ColName
+	if newNode != nil {
+		node = newNode.(*ColName)
+	}
+	parent.(*SubstrExpr).Name = node
+}
+
+func replaceSubstrExprStrVal(newNode, parent SQLNode) {
+	var node *SQLVal
+	if newNode != nil {
+		node = newNode.(*SQLVal)
+	}
+	parent.(*SubstrExpr).StrVal = node
+}
+
+func replaceSubstrExprFrom(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*SubstrExpr).From = node
+}
+
+func replaceSubstrExprTo(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*SubstrExpr).To = node
+}
+
+func replaceTableNameName(newNode, parent SQLNode) {
+	var node TableIdent
+	if newNode != nil {
+		node = newNode.(TableIdent)
+	}
+	parent.(*TableName).Name = node
+}
+
+func replaceTableNameQualifier(newNode, parent SQLNode) {
+	var node TableIdent
+	if newNode != nil {
+		node = newNode.(TableIdent)
+	}
+	parent.(*TableName).Qualifier = node
+}
+
+func replaceTableSpecPartitionBy(newNode, parent SQLNode) {
+	var node *PartitionBy
+	if newNode != nil {
+		node = newNode.(*PartitionBy)
+	}
+	parent.(*TableSpec).PartitionBy = node
+}
+
+func replaceTimestampFuncExprExpr1(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*TimestampFuncExpr).Expr1 = node
+}
+
+func replaceTimestampFuncExprExpr2(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*TimestampFuncExpr).Expr2 = node
+}
+
+func replaceUnaryExprExpr(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*UnaryExpr).Expr = node
+}
+
+func replaceUnionWith(newNode, parent SQLNode) {
+	var node *With
+	if newNode != nil {
+		node = newNode.(*With)
+	}
+	parent.(*Union).With = node
+}
+
+func replaceUnionLeft(newNode, parent SQLNode) {
+	var node SelectStatement
+	if newNode != nil {
+		node = newNode.(SelectStatement)
+	}
+	parent.(*Union).Left = node
+}
+
+func replaceUnionRight(newNode, parent SQLNode) {
+	var node SelectStatement
+	if newNode != nil {
+		node = newNode.(SelectStatement)
+	}
+	parent.(*Union).Right = node
+}
+
+func replaceUnionOrderBy(newNode, parent SQLNode) {
+	var node OrderBy
+	if newNode != nil {
+		node = newNode.(OrderBy)
+	}
+	parent.(*Union).OrderBy = node
+}
+
+func replaceUnionLimit(newNode, parent SQLNode) {
+	var node *Limit
+	if newNode != nil {
+		node = newNode.(*Limit)
+	}
+	parent.(*Union).Limit = node
+}
+
+func replaceUpdateComments(newNode, parent SQLNode) {
+	var node Comments
+	if newNode != nil {
+		node = newNode.(Comments)
+	}
+	parent.(*Update).Comments = node
+}
+
+func replaceUpdateTableExprs(newNode, parent SQLNode) {
+	var node TableExprs
+	if newNode != nil {
+		node = newNode.(TableExprs)
+	}
+	parent.(*Update).TableExprs = node
+}
+
+func replaceUpdateExprs(newNode, parent SQLNode) {
+	var node UpdateExprs
+	if newNode != nil {
+		node = newNode.(UpdateExprs)
+	}
+	parent.(*Update).Exprs = node
+}
+
+func replaceUpdateWhere(newNode, parent SQLNode) {
+	var node *Where
+	if newNode != nil {
+		node = newNode.(*Where)
+	}
+	parent.(*Update).Where = node
+}
+
+func replaceUpdateOrderBy(newNode, parent SQLNode) {
+	var node OrderBy
+	if newNode != nil {
+		node = newNode.(OrderBy)
+	}
+	parent.(*Update).OrderBy = node
+}
+
+func replaceUpdateLimit(newNode, parent SQLNode) {
+	var node *Limit
+	if newNode != nil {
+		node = newNode.(*Limit)
+	}
+	parent.(*Update).Limit = node
+}
+
+func replaceUpdateExprName(newNode, parent SQLNode) {
+	var node *ColName
+	if newNode != nil {
+		node = newNode.(*ColName)
+	}
+	parent.(*UpdateExpr).Name = node
+}
+
+func replaceUpdateExprExpr(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*UpdateExpr).Expr = node
+}
+
+func replaceUseDBName(newNode, parent SQLNode) {
+	var node TableIdent
+	if newNode != nil {
+		node = newNode.(TableIdent)
+	}
+	parent.(*Use).DBName = node
+}
+
+func replaceValuesFuncExprName(newNode, parent SQLNode) {
+	var node *ColName
+	if newNode != nil {
+		node = newNode.(*ColName)
+	}
+	parent.(*ValuesFuncExpr).Name = node
+}
+
+func replaceVindexParamKey(newNode, parent SQLNode) {
+	var node ColIdent
+	if newNode != nil {
+		node = newNode.(ColIdent)
+	}
+	parent.(*VindexParam).Key = node
+}
+
+func replaceVindexSpecName(newNode, parent SQLNode) {
+	var node ColIdent
+	if newNode != nil {
+		node = newNode.(ColIdent)
+	}
+	parent.(*VindexSpec).Name = node
+}
+
+func replaceVindexSpecType(newNode, parent SQLNode) {
+	var node ColIdent
+	if newNode != nil {
+		node = newNode.(ColIdent)
+	}
+	parent.(*VindexSpec).Type = node
+}
+
+func replaceWhenCond(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*When).Cond = node
+}
+
+func replaceWhenVal(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*When).Val = node
+}
+
+func replaceWhereExpr(newNode, parent SQLNode) {
+	var node Expr
+	if newNode != nil {
+		node = newNode.(Expr)
+	}
+	parent.(*Where).Expr = node
+}
+
+func replaceWindowDefName(newNode, parent SQLNode) {
+	var node ColIdent
+	if newNode != nil {
+		node = newNode.(ColIdent)
+	}
+	parent.(*WindowDef).Name = node
+}
+
+func replaceWindowDefWindowSpec(newNode, parent SQLNode) {
+	var node *WindowSpec
+	if newNode != nil {
+		node = newNode.(*WindowSpec)
+	}
+	parent.(*WindowDef).WindowSpec = node
+}
+
+func replaceWindowSpecName(newNode, parent SQLNode) {
+	var node ColIdent
+	if newNode != nil {
+		node = newNode.(ColIdent)
+	}
+	parent.(*WindowSpec).Name = node
+}
+
+func replaceWindowSpecPartitionBy(newNode, parent SQLNode) {
+	var node Exprs
+	if newNode != nil {
+		node = newNode.(Exprs)
+	}
+	parent.(*WindowSpec).PartitionBy = node
+}
+
+func replaceWindowSpecOrderBy(newNode, parent SQLNode) {
+	var node OrderBy
+	if newNode != nil {
+		node = newNode.(OrderBy)
+	}
+	parent.(*WindowSpec).OrderBy = node
+}
+
+func replaceWindowSpecFrame(newNode, parent SQLNode) {
+	var node *FrameClause
+	if newNode != nil {
+		node = newNode.(*FrameClause)
+	}
+	parent.(*WindowSpec).Frame = node
+}
+
+// applyChildren applies the rewriter to the children of node,
+// and returns node, updated if it's a value that was changed.
+func (a *application) applyChildren(parent, node SQLNode, replacer replacerFunc) SQLNode {
+	switch n := node.(type) {
+	case *AliasedExpr:
+		a.apply(node, n.Expr, replaceAliasedExprExpr)
+		a.apply(node, n.As, replaceAliasedExprAs)
+	case *AliasedTableExpr:
+		a.apply(node, n.Expr, replaceAliasedTableExprExpr)
+		a.apply(node, n.Partitions, replaceAliasedTableExprPartitions)
+		a.apply(node, n.As, replaceAliasedTableExprAs)
+		a.apply(node, n.Hints, replaceAliasedTableExprHints)
+	case *AlterSpec:
+		a.apply(node, n.Column, replaceAlterSpecColumn)
+		a.apply(node, n.Position, replaceAlterSpecPosition)
+		a.apply(node, n.Name, replaceAlterSpecName)
+		a.apply(node, n.NewName, replaceAlterSpecNewName)
+		a.apply(node, n.NewTable, replaceAlterSpecNewTable)
+		a.apply(node, n.Default, replaceAlterSpecDefault)
+		a.apply(node, n.Index, replaceAlterSpecIndex)
+		a.apply(node, n.Constraint, replaceAlterSpecConstraint)
+		a.apply(node, n.OrderBy, replaceAlterSpecOrderBy)
+	case AlterSpecs:
+		return a.applyList(node, n, func(list interface{}) {
+			replacer(list.(AlterSpecs), parent)
+		}).(AlterSpecs)
+	case *AndExpr:
+		a.apply(node, n.Left, replaceAndExprLeft)
+		a.apply(node, n.Right, replaceAndExprRight)
+	case *BinaryExpr:
+		a.apply(node, n.Left, replaceBinaryExprLeft)
+		a.apply(node, n.Right, replaceBinaryExprRight)
+	case *CaseExpr:
+		a.apply(node, n.Expr, replaceCaseExprExpr)
+		a.applyList(node, n.Whens, func(list interface{}) {
+			n.Whens = list.([]*When)
+		})
+		a.apply(node, n.Else, replaceCaseExprElse)
+	case *ColName:
+		a.apply(node, n.Name, replaceColNameName)
+		a.apply(node, n.Qualifier, replaceColNameQualifier)
+	case *CollateExpr:
+		a.apply(node, n.Expr, replaceCollateExprExpr)
+	case *ColumnDefinition:
+		a.apply(node, n.Name, replaceColumnDefinitionName)
+		a.apply(node, &n.Type, replaceColumnDefinitionType)
+	case *ColumnPosition:
+		a.apply(node, n.After, replaceColumnPositionAfter)
+	case *ColumnType:
+		a.apply(node, n.NotNull, replaceColumnTypeNotNull)
+		a.apply(node, n.Autoincrement, replaceColumnTypeAutoincrement)
+		a.apply(node, n.Default, replaceColumnTypeDefault)
+		a.apply(node, n.OnUpdate, replaceColumnTypeOnUpdate)
+		a.apply(node, n.Comment, replaceColumnTypeComment)
+		a.apply(node, n.Length, replaceColumnTypeLength)
+		a.apply(node, n.Unsigned, replaceColumnTypeUnsigned)
+		a.apply(node, n.Zerofill, replaceColumnTypeZerofill)
+		a.apply(node, n.Scale, replaceColumnTypeScale)
+		a.apply(node, n.Generated, replaceColumnTypeGenerated)
+		a.apply(node, n.Stored, replaceColumnTypeStored)
+	case Columns:
+		return a.applyList(node, n, func(list interface{}) {
+			replacer(list.(Columns), parent)
+		}).(Columns)
+	case *CommonTableExpr:
+		a.apply(node, n.Name, replaceCommonTableExprName)
+		a.apply(node, n.Columns, replaceCommonTableExprColumns)
+		a.apply(node, n.Subquery, replaceCommonTableExprSubquery)
+	case *ComparisonExpr:
+		a.apply(node, n.Left, replaceComparisonExprLeft)
+		a.apply(node, n.Right, replaceComparisonExprRight)
+		a.apply(node, n.Escape, replaceComparisonExprEscape)
+	case *ConstraintDefinition:
+		a.apply(node, n.Details, replaceConstraintDefinitionDetails)
+	case *ConvertExpr:
+		a.apply(node, n.Expr, replaceConvertExprExpr)
+		a.apply(node, n.Type, replaceConvertExprType)
+	case *ConvertType:
+		a.apply(node, n.Length, replaceConvertTypeLength)
+		a.apply(node, n.Scale, replaceConvertTypeScale)
+	case *ConvertUsingExpr:
+		a.apply(node, n.Expr, replaceConvertUsingExprExpr)
+	case *CurTimeFuncExpr:
+		a.apply(node, n.Name, replaceCurTimeFuncExprName)
+		a.apply(node, n.Fsp, replaceCurTimeFuncExprFsp)
+	case *DDL:
+		a.apply(node, n.FromTables, replaceDDLFromTables)
+		a.apply(node, n.ToTables, replaceDDLToTables)
+		a.apply(node, n.Table, replaceDDLTable)
+		a.apply(node, n.TableSpec, replaceDDLTableSpec)
+		a.apply(node, n.OptLike, replaceDDLOptLike)
+		a.apply(node, n.PartitionSpec, replaceDDLPartitionSpec)
+		a.apply(node, n.AlterSpecs, replaceDDLAlterSpecs)
+		a.apply(node, n.VindexSpec, replaceDDLVindexSpec)
+		a.applyList(node, n.VindexCols, func(list interface{}) {
+			n.VindexCols = list.([]ColIdent)
+		})
+	case *Delete:
+		a.apply(node, n.Comments, replaceDeleteComments)
+		a.apply(node, n.Targets, replaceDeleteTargets)
+		a.apply(node, n.TableExprs, replaceDeleteTableExprs)
+		a.apply(node, n.Partitions, replaceDeletePartitions)
+		a.apply(node, n.Where, replaceDeleteWhere)
+		a.apply(node, n.OrderBy, replaceDeleteOrderBy)
+		a.apply(node, n.Limit, replaceDeleteLimit)
+	case *ExistsExpr:
+		a.apply(node, n.Subquery, replaceExistsExprSubquery)
+	case Exprs:
+		return a.applyList(node, n, func(list interface{}) {
+			replacer(list.(Exprs), parent)
+		}).(Exprs)
+	case *ForeignKeyDefinition:
+		a.apply(node, n.Source, replaceForeignKeyDefinitionSource)
+		a.apply(node, n.ReferencedTable, replaceForeignKeyDefinitionReferencedTable)
+		a.apply(node, n.ReferencedColumns, replaceForeignKeyDefinitionReferencedColumns)
+		a.apply(node, n.OnDelete, replaceForeignKeyDefinitionOnDelete)
+		a.apply(node, n.OnUpdate, replaceForeignKeyDefinitionOnUpdate)
+	case *FrameClause:
+		a.apply(node, n.Start, replaceFrameClauseStart)
+		a.apply(node, n.End, replaceFrameClauseEnd)
+	case *FramePoint:
+		a.apply(node, n.Expr, replaceFramePointExpr)
+	case *FuncExpr:
+		a.apply(node, n.Qualifier, replaceFuncExprQualifier)
+		a.apply(node, n.Name, replaceFuncExprName)
+		a.apply(node, n.Exprs, replaceFuncExprExprs)
+		a.apply(node, n.Over, replaceFuncExprOver)
+	case GroupBy:
+		return a.applyList(node, n, func(list interface{}) {
+			replacer(list.(GroupBy), parent)
+		}).(GroupBy)
+	case *GroupConcatExpr:
+		a.apply(node, n.Exprs, replaceGroupConcatExprExprs)
+		a.apply(node, n.OrderBy, replaceGroupConcatExprOrderBy)
+	case *IndexDefinition:
+		a.apply(node, n.Info, replaceIndexDefinitionInfo)
+	case *IndexHints:
+		a.applyList(node, n.Indexes, func(list interface{}) {
+			n.Indexes = list.([]ColIdent)
+		})
+	case *IndexInfo:
+		a.apply(node, n.Name, replaceIndexInfoName)
+	case *Insert:
+		a.apply(node, n.Comments, replaceInsertComments)
+		a.apply(node, n.Table, replaceInsertTable)
+		a.apply(node, n.Partitions, replaceInsertPartitions)
+		a.apply(node, n.Columns, replaceInsertColumns)
+		a.apply(node, n.Rows, replaceInsertRows)
+		a.apply(node, n.OnDup, replaceInsertOnDup)
+	case *IntervalExpr:
+		a.apply(node, n.Expr, replaceIntervalExprExpr)
+	case *IsExpr:
+		a.apply(node, n.Expr, replaceIsExprExpr)
+	case *JSONExtractExpr:
+		a.apply(node, n.Column, replaceJSONExtractExprColumn)
+		a.apply(node, n.Path, replaceJSONExtractExprPath)
+	case *JSONTableColumn:
+		a.apply(node, n.Name, replaceJSONTableColumnName)
+		a.apply(node, &n.Type, replaceJSONTableColumnType)
+		a.apply(node, n.Path, replaceJSONTableColumnPath)
+		a.apply(node, n.OnEmpty, replaceJSONTableColumnOnEmpty)
+		a.apply(node, n.OnError, replaceJSONTableColumnOnError)
+		a.apply(node, n.Nested, replaceJSONTableColumnNested)
+	case JSONTableColumns:
+		return a.applyList(node, n, func(list interface{}) {
+			replacer(list.(JSONTableColumns), parent)
+		}).(JSONTableColumns)
+	case *JSONTableExpr:
+		a.apply(node, n.Expr, replaceJSONTableExprExpr)
+		a.apply(node, n.Path, replaceJSONTableExprPath)
+		a.apply(node, n.Columns, replaceJSONTableExprColumns)
+		a.apply(node, n.As, replaceJSONTableExprAs)
+	case *JSONTableOnResponse:
+		a.apply(node, n.Default, replaceJSONTableOnResponseDefault)
+	case JoinCondition:
+		a.apply(node, n.On, func(newNode, _ SQLNode) {
+			replaceJoinConditionOn(newNode, &n)
+			replacer(n, parent)
+		})
+		a.apply(node, n.Using, func(newNode, _ SQLNode) {
+			replaceJoinConditionUsing(newNode, &n)
+			replacer(n, parent)
+		})
+		return n
+	case *JoinTableExpr:
+		a.apply(node, n.LeftExpr, replaceJoinTableExprLeftExpr)
+		a.apply(node, n.RightExpr, replaceJoinTableExprRightExpr)
+		a.apply(node, n.Condition, replaceJoinTableExprCondition)
+	case *Limit:
+		a.apply(node, n.Offset, replaceLimitOffset)
+		a.apply(node, n.Rowcount, replaceLimitRowcount)
+	case *Load:
+		a.apply(node, n.Comments, replaceLoadComments)
+		a.apply(node, n.File, replaceLoadFile)
+		a.apply(node, n.Table, replaceLoadTable)
+		a.apply(node, n.Partitions, replaceLoadPartitions)
+		a.apply(node, n.Fields, replaceLoadFields)
+		a.apply(node, n.Lines, replaceLoadLines)
+		a.apply(node, n.IgnoreLines, replaceLoadIgnoreLines)
+		a.apply(node, n.Columns, replaceLoadColumns)
+	case *LoadFields:
+		a.apply(node, n.TerminatedBy, replaceLoadFieldsTerminatedBy)
+		a.apply(node, n.EnclosedBy, replaceLoadFieldsEnclosedBy)
+		a.apply(node, n.EscapedBy, replaceLoadFieldsEscapedBy)
+	case *LoadLines:
+		a.apply(node, n.StartingBy, replaceLoadLinesStartingBy)
+		a.apply(node, n.TerminatedBy, replaceLoadLinesTerminatedBy)
+	case *MatchExpr:
+		a.apply(node, n.Columns, replaceMatchExprColumns)
+		a.apply(node, n.Expr, replaceMatchExprExpr)
+	case Nextval:
+		a.apply(node, n.Expr, func(newNode, _ SQLNode) {
+			replaceNextvalExpr(newNode, &n)
+			replacer(n, parent)
+		})
+		return n
+	case *NotExpr:
+		a.apply(node, n.Expr, replaceNotExprExpr)
+	case OnDup:
+		return a.applyList(node, n, func(list interface{}) {
+			replacer(list.(OnDup), parent)
+		}).(OnDup)
+	case *OptLike:
+		a.apply(node, n.LikeTable, replaceOptLikeLikeTable)
+	case *OrExpr:
+		a.apply(node, n.Left, replaceOrExprLeft)
+		a.apply(node, n.Right, replaceOrExprRight)
+	case *Order:
+		a.apply(node, n.Expr, replaceOrderExpr)
+	case OrderBy:
+		return a.applyList(node, n, func(list interface{}) {
+			replacer(list.(OrderBy), parent)
+		}).(OrderBy)
+	case *OverClause:
+		a.apply(node, n.WindowName, replaceOverClauseWindowName)
+		a.apply(node, n.WindowSpec, replaceOverClauseWindowSpec)
+	case *ParenExpr:
+		a.apply(node, n.Expr, replaceParenExprExpr)
+	case *ParenSelect:
+		a.apply(node, n.Select, replaceParenSelectSelect)
+	case *ParenTableExpr:
+		a.apply(node, n.Exprs, replaceParenTableExprExprs)
+	case *PartitionBy:
+		a.apply(node, n.Expr, replacePartitionByExpr)
+		a.apply(node, n.Columns, replacePartitionByColumns)
+		a.apply(node, n.Partitions, replacePartitionByPartitions)
+		a.applyList(node, n.Definitions, func(list interface{}) {
+			n.Definitions = list.([]*PartitionDefinition)
+		})
+	case *PartitionDefinition:
+		a.apply(node, n.Name, replacePartitionDefinitionName)
+		a.apply(node, n.Limit, replacePartitionDefinitionLimit)
+		a.apply(node, n.In, replacePartitionDefinitionIn)
+	case *PartitionSpec:
+		a.apply(node, n.Name, replacePartitionSpecName)
+		a.applyList(node, n.Definitions, func(list interface{}) {
+			n.Definitions = list.([]*PartitionDefinition)
+		})
+		a.apply(node, n.Names, replacePartitionSpecNames)
+		a.apply(node, n.Number, replacePartitionSpecNumber)
+		a.apply(node, n.By, replacePartitionSpecBy)
+	case Partitions:
+		return a.applyList(node, n, func(list interface{}) {
+			replacer(list.(Partitions), parent)
+		}).(Partitions)
+	case *RangeCond:
+		a.apply(node, n.Left, replaceRangeCondLeft)
+		a.apply(node, n.From, replaceRangeCondFrom)
+		a.apply(node, n.To, replaceRangeCondTo)
+	case *Select:
+		a.apply(node, n.With, replaceSelectWith)
+		a.apply(node, n.Comments, replaceSelectComments)
+		a.apply(node, n.SelectExprs, replaceSelectSelectExprs)
+		a.apply(node, n.From, replaceSelectFrom)
+		a.apply(node, n.Where, replaceSelectWhere)
+		a.apply(node, n.GroupBy, replaceSelectGroupBy)
+		a.apply(node, n.Having, replaceSelectHaving)
+		a.apply(node, n.Windows, replaceSelectWindows)
+		a.apply(node, n.OrderBy, replaceSelectOrderBy)
+		a.apply(node, n.Limit, replaceSelectLimit)
+	case SelectExprs:
+		return a.applyList(node, n, func(list interface{}) {
+			replacer(list.(SelectExprs), parent)
+		}).(SelectExprs)
+	case *Set:
+		a.apply(node, n.Comments, replaceSetComments)
+		a.apply(node, n.Exprs, replaceSetExprs)
+	case *SetExpr:
+		a.apply(node, n.Name, replaceSetExprName)
+		a.apply(node, n.Expr, replaceSetExprExpr)
+	case SetExprs:
+		return a.applyList(node, n, func(list interface{}) {
+			replacer(list.(SetExprs), parent)
+		}).(SetExprs)
+	case *Show:
+		a.apply(node, n.OnTable, replaceShowOnTable)
+		a.apply(node, n.Table, replaceShowTable)
+	case *ShowFilter:
+		a.apply(node, n.Filter, replaceShowFilterFilter)
+	case *StarExpr:
+		a.apply(node, n.TableName, replaceStarExprTableName)
+	case *Stream:
+		a.apply(node, n.Comments, replaceStreamComments)
+		a.apply(node, n.SelectExpr, replaceStreamSelectExpr)
+		a.apply(node, n.Table, replaceStreamTable)
+	case *Subquery:
+		a.apply(node, n.Select, replaceSubquerySelect)
+	case *SubstrExpr:
+		a.apply(node, n.Name, replaceSubstrExprName)
+		a.apply(node, n.StrVal, replaceSubstrExprStrVal)
+		a.apply(node, n.From, replaceSubstrExprFrom)
+		a.apply(node, n.To, replaceSubstrExprTo)
+	case TableExprs:
+		return a.applyList(node, n, func(list interface{}) {
+			replacer(list.(TableExprs), parent)
+		}).(TableExprs)
+	case TableName:
+		a.apply(node, n.Name, func(newNode, _ SQLNode) {
+			replaceTableNameName(newNode, &n)
+			replacer(n, parent)
+		})
+		a.apply(node, n.Qualifier, func(newNode, _ SQLNode) {
+			replaceTableNameQualifier(newNode, &n)
+			replacer(n, parent)
+		})
+		return n
+	case TableNames:
+		return a.applyList(node, n, func(list interface{}) {
+			replacer(list.(TableNames), parent)
+		}).(TableNames)
+	case *TableSpec:
+		a.applyList(node, n.Columns, func(list interface{}) {
+			n.Columns = list.([]*ColumnDefinition)
+		})
+		a.applyList(node, n.Indexes, func(list interface{}) {
+			n.Indexes = list.([]*IndexDefinition)
+		})
+		a.applyList(node, n.Constraints, func(list interface{}) {
+			n.Constraints = list.([]*ConstraintDefinition)
+		})
+		a.apply(node, n.PartitionBy, replaceTableSpecPartitionBy)
+	case *TimestampFuncExpr:
+		a.apply(node, n.Expr1, replaceTimestampFuncExprExpr1)
+		a.apply(node, n.Expr2, replaceTimestampFuncExprExpr2)
+	case *UnaryExpr:
+		a.apply(node, n.Expr, replaceUnaryExprExpr)
+	case *Union:
+		a.apply(node, n.With, replaceUnionWith)
+		a.apply(node, n.Left, replaceUnionLeft)
+		a.apply(node, n.Right, replaceUnionRight)
+		a.apply(node, n.OrderBy, replaceUnionOrderBy)
+		a.apply(node, n.Limit, replaceUnionLimit)
+	case *Update:
+		a.apply(node, n.Comments, replaceUpdateComments)
+		a.apply(node, n.TableExprs, replaceUpdateTableExprs)
+		a.apply(node, n.Exprs, replaceUpdateExprs)
+		a.apply(node, n.Where, replaceUpdateWhere)
+		a.apply(node, n.OrderBy, replaceUpdateOrderBy)
+		a.apply(node, n.Limit, replaceUpdateLimit)
+	case *UpdateExpr:
+		a.apply(node, n.Name, replaceUpdateExprName)
+		a.apply(node, n.Expr, replaceUpdateExprExpr)
+	case UpdateExprs:
+		return a.applyList(node, n, func(list interface{}) {
+			replacer(list.(UpdateExprs), parent)
+		}).(UpdateExprs)
+	case *Use:
+		a.apply(node, n.DBName, replaceUseDBName)
+	case ValTuple:
+		return a.applyList(node, n, func(list interface{}) {
+			replacer(list.(ValTuple), parent)
+		}).(ValTuple)
+	case Values:
+		return a.applyList(node, n, func(list interface{}) {
+			replacer(list.(Values), parent)
+		}).(Values)
+	case *ValuesFuncExpr:
+		a.apply(node, n.Name, replaceValuesFuncExprName)
+	case VindexParam:
+		a.apply(node, n.Key, func(newNode, _ SQLNode) {
+			replaceVindexParamKey(newNode, &n)
+			replacer(n, parent)
+		})
+		return n
+	case *VindexSpec:
+		a.apply(node, n.Name, replaceVindexSpecName)
+		a.apply(node, n.Type, replaceVindexSpecType)
+		a.applyList(node, n.Params, func(list interface{}) {
+			n.Params = list.([]VindexParam)
+		})
+	case *When:
+		a.apply(node, n.Cond, replaceWhenCond)
+		a.apply(node, n.Val, replaceWhenVal)
+	case *Where:
+		a.apply(node, n.Expr, replaceWhereExpr)
+	case *WindowDef:
+		a.apply(node, n.Name, replaceWindowDefName)
+		a.apply(node, n.WindowSpec, replaceWindowDefWindowSpec)
+	case WindowDefs:
+		return a.applyList(node, n, func(list interface{}) {
+			replacer(list.(WindowDefs), parent)
+		}).(WindowDefs)
+	case *WindowSpec:
+		a.apply(node, n.Name, replaceWindowSpecName)
+		a.apply(node, n.PartitionBy, replaceWindowSpecPartitionBy)
+		a.apply(node, n.OrderBy, replaceWindowSpecOrderBy)
+		a.apply(node, n.Frame, replaceWindowSpecFrame)
+	case *With:
+		a.applyList(node, n.CTEs, func(list interface{}) {
+			n.CTEs = list.([]*CommonTableExpr)
+		})
+	}
+	return node
+}
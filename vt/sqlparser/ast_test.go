@@ -19,6 +19,7 @@ package sqlparser
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"reflect"
 	"strings"
 	"testing"
@@ -199,8 +200,9 @@ func TestDDL(t *testing.T) {
 	}{{
 		query: "create table a",
 		output: &DDL{
-			Action: CreateStr,
-			Table:  TableName{Name: NewTableIdent("a")},
+			Action:  CreateStr,
+			Table:   TableName{Name: NewTableIdent("a")},
+			Partial: true,
 		},
 		affected: []string{"a"},
 	}, {
@@ -248,6 +250,59 @@ func TestDDL(t *testing.T) {
 			},
 		},
 		affected: []string{"a", "b"},
+	}, {
+		query: "alter table a drop index b, modify c int first",
+		output: &DDL{
+			Action: AlterStr,
+			Table:  TableName{Name: NewTableIdent("a")},
+			AlterSpecs: AlterSpecs{{
+				Action: DropIndexStr,
+				Name:   NewColIdent("b"),
+			}, {
+				Action: ModifyColumnStr,
+				Column: &ColumnDefinition{
+					Name: NewColIdent("c"),
+					Type: ColumnType{Type: "int"},
+				},
+				Position: &ColumnPosition{First: true},
+			}},
+		},
+		affected: []string{"a"},
+	}, {
+		// The specs that were parsed before an unsupported
+		// one are not part of the partial DDL.
+		query: "alter table a drop index b, drop fulltext index c",
+		output: &DDL{
+			Action:  AlterStr,
+			Table:   TableName{Name: NewTableIdent("a")},
+			Partial: true,
+		},
+		affected: []string{"a"},
+	}, {
+		query: "alter table a add column b int, add foo",
+		output: &DDL{
+			Action:  AlterStr,
+			Table:   TableName{Name: NewTableIdent("a")},
+			Partial: true,
+		},
+		affected: []string{"a"},
+	}, {
+		query: "alter table a add column b int, rename to c",
+		output: &DDL{
+			Action: AlterStr,
+			Table:  TableName{Name: NewTableIdent("a")},
+			AlterSpecs: AlterSpecs{{
+				Action: AddColumnStr,
+				Column: &ColumnDefinition{
+					Name: NewColIdent("b"),
+					Type: ColumnType{Type: "int"},
+				},
+			}, {
+				Action:   RenameTableStr,
+				NewTable: TableName{Name: NewTableIdent("c")},
+			}},
+		},
+		affected: []string{"a"},
 	}}
 	for _, tcase := range testcases {
 		got, err := Parse(tcase.query)
@@ -410,6 +465,69 @@ func TestIsAggregate(t *testing.T) {
 	if f.IsAggregate() {
 		t.Error("IsAggregate: true, want false")
 	}
+
+	f = FuncExpr{Name: NewColIdent("sum"), Over: &OverClause{WindowName: NewColIdent("w")}}
+	if f.IsAggregate() {
+		t.Error("IsAggregate: true, want false")
+	}
+}
+
+func TestVisitCTERefs(t *testing.T) {
+	tcases := []struct {
+		in   string
+		refs []string
+	}{{
+		in:   "with c as (select a from t) select * from c, t",
+		refs: []string{"c: select a from t"},
+	}, {
+		in:   "with c as (select 1 from t1) select * from c where a in (with c as (select 2 from t2) select * from c)",
+		refs: []string{"c: select 1 from t1", "c: select 2 from t2"},
+	}, {
+		in:   "with c as (select * from c) select * from c",
+		refs: []string{"c: select * from c"},
+	}, {
+		in:   "with a as (select * from b), b as (select * from a) select * from b",
+		refs: []string{"a: select * from b", "b: select * from a"},
+	}, {
+		in:   "with recursive c as (select 1 from dual union all select n from c) select * from c",
+		refs: []string{"c: select 1 from dual union all select n from c", "c: select 1 from dual union all select n from c"},
+	}, {
+		in:   "with c as (select 1 from dual) select * from db.c",
+		refs: nil,
+	}}
+	for _, tcase := range tcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var refs []string
+		err = VisitCTERefs(tree, func(ref *AliasedTableExpr, cte *CommonTableExpr) error {
+			refs = append(refs, fmt.Sprintf("%v: %v", String(ref.Expr), String(cte.Subquery.Select)))
+			return nil
+		})
+		if err != nil {
+			t.Errorf("VisitCTERefs(%s) failed: %v", tcase.in, err)
+		}
+		if !reflect.DeepEqual(refs, tcase.refs) {
+			t.Errorf("VisitCTERefs(%s):\n%q, want\n%q", tcase.in, refs, tcase.refs)
+		}
+	}
+}
+
+func TestJSONTableColumnNames(t *testing.T) {
+	tree, err := Parse("select * from json_table(doc, '$' columns(a for ordinality, b int path '$.b', nested path '$.c' columns(c int path '$', d int exists path '$.d'), e json path '$.e')) as jt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	jt := tree.(*Select).From[0].(*JSONTableExpr)
+	var got []string
+	for _, name := range jt.ColumnNames() {
+		got = append(got, name.String())
+	}
+	want := []string{"a", "b", "c", "d", "e"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ColumnNames: %v, want %v", got, want)
+	}
 }
 
 func TestIsImpossible(t *testing.T) {
@@ -562,6 +680,12 @@ func TestReplaceExpr(t *testing.T) {
 	}, {
 		in:  "select * from t where case a when b then c when d then c else (select a from b) end",
 		out: "case a when b then c when d then c else :a end",
+	}, {
+		in:  "select * from t where sum(a) over (partition by (select a from b) order by c asc)",
+		out: "sum(a) over (partition by :a order by c asc)",
+	}, {
+		in:  "select * from t where sum(a) over (order by c asc rows (select a from b) preceding)",
+		out: "sum(a) over (order by c asc rows :a preceding)",
 	}}
 	to := NewValArg([]byte(":a"))
 	for _, tcase := range tcases {
@@ -823,6 +947,9 @@ func TestSplitStatementToPieces(t *testing.T) {
 	}, {
 		input:  "select * from table where semi = ';';",
 		output: "select * from table where semi = ';'",
+	}, {
+		input:  "select * from table1; select * from table2;  \n",
+		output: "select * from table1; select * from table2",
 	}, {
 		input:  "select * from table1;--comment;\nselect * from table2;",
 		output: "select * from table1;--comment;\nselect * from table2",
@@ -852,3 +979,31 @@ func TestSplitStatementToPieces(t *testing.T) {
 		}
 	}
 }
+
+func TestCountPositionalArgs(t *testing.T) {
+	testcases := []struct {
+		input string
+		want  int
+	}{{
+		input: "select * from t",
+		want:  0,
+	}, {
+		input: "select * from t where id = ? and name = ?",
+		want:  2,
+	}, {
+		input: "select * from t where id = :v1 and name = ?",
+		want:  1,
+	}, {
+		input: "select '?', `?` from t /* ? */ where id = ? -- ?",
+		want:  1,
+	}, {
+		input: "insert into t values (?, ?, ?)",
+		want:  3,
+	}}
+
+	for _, tcase := range testcases {
+		if got := CountPositionalArgs(tcase.input); got != tcase.want {
+			t.Errorf("CountPositionalArgs(%s): %d, want %d", tcase.input, got, tcase.want)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Fingerprint returns the fingerprint of a statement, which is the
+// same for all the statements of the same shape, like the digest
+// texts of MySQL's performance_schema. It's the SQL of stmt with:
+//
+// - the comments stripped and the whitespace canonicalized,
+// - the literals and bind variables replaced with ?,
+// - the lists of values, like the ones of IN, collapsed to (...),
+// - the consecutive rows of inserts that have the same shape
+// collapsed to one.
+func Fingerprint(stmt Statement) string {
+	return NewTrackedBuffer(formatFingerprint).WriteNode(stmt).String()
+}
+
+// FingerprintSQL returns the fingerprint of a SQL statement.
+func FingerprintSQL(sql string) (string, error) {
+	sqlStripped, _ := SplitMarginComments(sql)
+	stmt, err := Parse(sqlStripped)
+	if err != nil {
+		return "", err
+	}
+	return Fingerprint(stmt), nil
+}
+
+// Digest returns the digest of a fingerprint, which is the hex
+// encoded SHA-256 of it, to key the stats of the statements.
+func Digest(fingerprint string) string {
+	sum := sha256.Sum256([]byte(fingerprint))
+	return hex.EncodeToString(sum[:])
+}
+
+// formatFingerprint formats the nodes for Fingerprint.
+func formatFingerprint(buf *TrackedBuffer, node SQLNode) {
+	switch node := node.(type) {
+	case Comments:
+		// The comments are stripped.
+	case *SQLVal:
+		buf.WriteString("?")
+	case ListArg:
+		buf.WriteString("(...)")
+	case ValTuple:
+		if isValueList(node) {
+			buf.WriteString("(...)")
+			return
+		}
+		node.Format(buf)
+	case Values:
+		prefix := "values "
+		var last string
+		for _, row := range node {
+			formatted := NewTrackedBuffer(formatFingerprint).WriteNode(row).String()
+			if formatted == last {
+				continue
+			}
+			buf.Myprintf("%s%s", prefix, formatted)
+			prefix = ", "
+			last = formatted
+		}
+	default:
+		node.Format(buf)
+	}
+}
+
+// isValueList returns true if node is a list of values, or of
+// tuples of values like in (a, b) in ((1, 2), (3, 4)).
+func isValueList(node Expr) bool {
+	tuple, ok := node.(ValTuple)
+	if !ok {
+		return false
+	}
+	for _, expr := range tuple {
+		if !IsValue(expr) && !isValueList(expr) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"testing"
+)
+
+func TestFingerprint(t *testing.T) {
+	testcases := []struct {
+		in, out string
+	}{{
+		in:  "select a, 'b', 1.5, 0x12 from t where c = 1 and d = :d limit 10",
+		out: "select a, ?, ?, ? from t where c = ? and d = ? limit ?",
+	}, {
+		in:  "select /* comment */ a from t where b in (1, 2, 3)",
+		out: "select a from t where b in (...)",
+	}, {
+		in:  "select a from t where b in (1)",
+		out: "select a from t where b in (...)",
+	}, {
+		in:  "select a from t where b in ::list",
+		out: "select a from t where b in (...)",
+	}, {
+		in:  "select a from t where b in (c, 1)",
+		out: "select a from t where b in (c, ?)",
+	}, {
+		in:  "select a from t where (b, c) in ((1, 2), (3, 4))",
+		out: "select a from t where (b, c) in (...)",
+	}, {
+		in:  "select a from t where b in (select c from u where d = 'e')",
+		out: "select a from t where b in (select c from u where d = ?)",
+	}, {
+		in:  "select a from t where b is null",
+		out: "select a from t where b is null",
+	}, {
+		in:  "insert into t(a, b) values (1, 'x'), (2, 'y'), (3, null)",
+		out: "insert into t(a, b) values (...), (?, null)",
+	}, {
+		in:  "update /* comment */ t set a = 1 where b = 'x'",
+		out: "update t set a = ? where b = ?",
+	}}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out := Fingerprint(stmt); out != tcase.out {
+			t.Errorf("Fingerprint(%s): %s, want %s", tcase.in, out, tcase.out)
+		}
+	}
+}
+
+func TestFingerprintSQL(t *testing.T) {
+	queries := []string{
+		"select a from t where b in (1, 2) and c = 'x'",
+		"/* leading */ SELECT a\n  FROM t\n  WHERE b IN (3, 4, 5) AND c = 'y' /* trailing */",
+		"select a from t where b in ::list and c = :c",
+	}
+	want := "select a from t where b in (...) and c = ?"
+	for _, query := range queries {
+		got, err := FingerprintSQL(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("FingerprintSQL(%q): %s, want %s", query, got, want)
+		}
+	}
+
+	if _, err := FingerprintSQL("select"); err == nil {
+		t.Errorf("FingerprintSQL(select) succeeded, want a syntax error")
+	}
+}
+
+func TestDigest(t *testing.T) {
+	digest := Digest("select a from t where b in (...)")
+	if len(digest) != 64 {
+		t.Errorf("Digest: %s, want 64 hex digits", digest)
+	}
+	if other := Digest("select a from t where b = ?"); other == digest {
+		t.Errorf("Digest of different fingerprints: %s, want different digests", other)
+	}
+	if again := Digest("select a from t where b in (...)"); again != digest {
+		t.Errorf("Digest: %s, want %s", again, digest)
+	}
+}
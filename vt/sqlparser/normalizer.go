@@ -69,6 +69,12 @@ func (nz *normalizer) WalkStatement(node SQLNode) (bool, error) {
 		nz.convertSQLVal(node)
 	case *ComparisonExpr:
 		nz.convertComparison(node)
+	case *JSONExtractExpr:
+		// JSON paths must be literals.
+		return false, nil
+	case *JSONTableExpr:
+		_ = Walk(nz.WalkStatement, node.Expr)
+		return false, nil
 	case *ColName, TableName:
 		// Common node types that never contain SQLVals or ListArgs but create a lot of object
 		// allocations.
@@ -84,6 +90,12 @@ func (nz *normalizer) WalkSelect(node SQLNode) (bool, error) {
 		nz.convertSQLValDedup(node)
 	case *ComparisonExpr:
 		nz.convertComparison(node)
+	case *JSONExtractExpr:
+		// JSON paths must be literals.
+		return false, nil
+	case *JSONTableExpr:
+		_ = Walk(nz.WalkSelect, node.Expr)
+		return false, nil
 	case *ColName, TableName:
 		// Common node types that never contain SQLVals or ListArgs but create a lot of object
 		// allocations.
@@ -162,6 +162,21 @@ func TestNormalize(t *testing.T) {
 		in:      "select * from t where v1 = v2",
 		outstmt: "select * from t where v1 = v2",
 		outbv:   map[string]*querypb.BindVariable{},
+	}, {
+		// JSON paths are not normalized
+		in:      "select a->>'$.b' from t where a->'$.c' = 'd'",
+		outstmt: "select a ->> '$.b' from t where a -> '$.c' = :bv1",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.BytesBindVariable([]byte("d")),
+		},
+	}, {
+		// JSON_TABLE document is normalized, but not its paths
+		in:      "select * from json_table('[1]', '$[*]' columns(a int path '$' default '0' on empty)) as jt where a = 1",
+		outstmt: "select * from json_table(:bv1, '$[*]' columns(a int path '$' default '0' on empty)) as jt where a = :bv2",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.BytesBindVariable([]byte("[1]")),
+			"bv2": sqltypes.Int64BindVariable(1),
+		},
 	}, {
 		// IN clause with existing bv
 		in:      "select * from t where v1 in ::list",
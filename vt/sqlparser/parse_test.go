@@ -819,16 +819,23 @@ var (
 	}, {
 		input: "set sql_safe_updates = 1",
 	}, {
-		input:  "alter ignore table a add foo",
-		output: "alter table a",
+		input:  "alter ignore table a add foo int",
+		output: "alter table a add column foo int",
 	}, {
-		input:  "alter table a add foo",
-		output: "alter table a",
+		input:  "alter table a add foo int",
+		output: "alter table a add column foo int",
+	}, {
+		input: "alter table a add spatial key foo (column1)",
+	}, {
+		input: "alter table a add unique key foo (column1)",
 	}, {
-		input:  "alter table a add spatial key foo (column1)",
+		input:  "alter table `By` add foo int",
+		output: "alter table `By` add column foo int",
+	}, {
+		input:  "alter ignore table a add foo",
 		output: "alter table a",
 	}, {
-		input:  "alter table a add unique key foo (column1)",
+		input:  "alter table a add foo",
 		output: "alter table a",
 	}, {
 		input:  "alter table `By` add foo",
@@ -842,9 +849,6 @@ var (
 	}, {
 		input:  "alter table a modify foo",
 		output: "alter table a",
-	}, {
-		input:  "alter table a drop foo",
-		output: "alter table a",
 	}, {
 		input:  "alter table a disable foo",
 		output: "alter table a",
@@ -852,17 +856,79 @@ var (
 		input:  "alter table a enable foo",
 		output: "alter table a",
 	}, {
-		input:  "alter table a order foo",
+		input:  "alter table a discard foo",
 		output: "alter table a",
 	}, {
-		input:  "alter table a default foo",
+		input:  "alter table a import foo",
 		output: "alter table a",
 	}, {
-		input:  "alter table a discard foo",
+		input:  "alter table a alter foo set default 1",
+		output: "alter table a alter column foo set default 1",
+	}, {
+		input: "alter table a alter column foo drop default",
+	}, {
+		input:  "alter table a change foo bar int",
+		output: "alter table a change column foo bar int",
+	}, {
+		input: "alter table a change column foo bar varchar(10) not null default '' first",
+	}, {
+		input:  "alter table a modify foo int",
+		output: "alter table a modify column foo int",
+	}, {
+		input: "alter table a modify column foo int unsigned after bar",
+	}, {
+		input:  "alter table t add column after int",
+		output: "alter table t add column `after` int",
+	}, {
+		input:  "alter table t modify first bigint first",
+		output: "alter table t modify column `first` bigint first",
+	}, {
+		input:  "alter table t change coalesce concurrent int after modify",
+		output: "alter table t change column `coalesce` `concurrent` int after `modify`",
+	}, {
+		input:  "alter table t drop column concurrent",
+		output: "alter table t drop column `concurrent`",
+	}, {
+		input:  "alter table t add column error int",
+		output: "alter table t add column `error` int",
+	}, {
+		input:  "alter table t add (path varchar(255), empty bool)",
+		output: "alter table t add column `path` varchar(255), add column `empty` bool",
+	}, {
+		input:  "alter table t change nested ordinality int after path",
+		output: "alter table t change column `nested` `ordinality` int after `path`",
+	}, {
+		input:  "alter table t add column current int, modify row bigint after following",
+		output: "alter table t add column `current` int, modify column `row` bigint after `following`",
+	}, {
+		input:  "alter table t change preceding unbounded int first",
+		output: "alter table t change column `preceding` `unbounded` int first",
+	}, {
+		input:  "alter table t add column data json after id",
+		output: "alter table t add column `data` json after id",
+	}, {
+		input:  "alter table t change local local int",
+		output: "alter table t change column `local` `local` int",
+	}, {
+		input:  "alter table t add column connection int",
+		output: "alter table t add column `connection` int",
+	}, {
+		input:  "alter table a drop foo",
+		output: "alter table a drop column foo",
+	}, {
+		input: "alter table a disable keys",
+	}, {
+		input: "alter table a enable keys",
+	}, {
+		input:  "alter table a order foo",
 		output: "alter table a",
 	}, {
-		input:  "alter table a import foo",
+		input:  "alter table a default foo",
 		output: "alter table a",
+	}, {
+		input: "alter table a discard tablespace",
+	}, {
+		input: "alter table a import tablespace",
 	}, {
 		input:  "alter table a rename b",
 		output: "rename table a to b",
@@ -876,41 +942,129 @@ var (
 		input:  "alter table a rename as b",
 		output: "rename table a to b",
 	}, {
-		input:  "alter table a rename index foo to bar",
-		output: "alter table a",
+		input: "alter table a rename index foo to bar",
 	}, {
 		input:  "alter table a rename key foo to bar",
-		output: "alter table a",
+		output: "alter table a rename index foo to bar",
 	}, {
 		input:  "alter table e auto_increment = 20",
-		output: "alter table e",
+		output: "alter table e auto_increment=20",
 	}, {
 		input:  "alter table e character set = 'ascii'",
-		output: "alter table e",
+		output: "alter table e character set='ascii'",
 	}, {
 		input:  "alter table e default character set = 'ascii'",
-		output: "alter table e",
+		output: "alter table e default character set='ascii'",
 	}, {
 		input:  "alter table e comment = 'hello'",
+		output: "alter table e comment='hello'",
+	}, {
+		input:  "alter table e engine = InnoDB, row_format compressed",
+		output: "alter table e engine=InnoDB, row_format=compressed",
+	}, {
+		input:  "alter table e engine MyISAM comment 'y'",
+		output: "alter table e engine=MyISAM, comment='y'",
+	}, {
+		input:  "alter table e default character set utf8mb4 collate utf8mb4_bin",
+		output: "alter table e default character set=utf8mb4, collate=utf8mb4_bin",
+	}, {
+		input:  "alter table e add column c int, lock=none, algorithm=inplace",
+		output: "alter table e add column c int, lock=none, algorithm=inplace",
+	}, {
+		input:  "alter table e data directory '/tmp'",
+		output: "alter table e data directory='/tmp'",
+	}, {
+		input:  "alter table e foo = bar",
+		output: "alter table e",
+	}, {
+		input:  "alter table e add column c int, rename to u",
+		output: "alter table e add column c int, rename to u",
+	}, {
+		input: "alter table e rename column a to b",
+	}, {
+		input:  "alter table e add column (a int, b int not null)",
+		output: "alter table e add column a int, add column b int not null",
+	}, {
+		input:  "alter table e add index i using btree (a)",
+		output: "alter table e add index i (a) using btree",
+	}, {
+		input:  "alter table e add column c int generated always as (a + b) stored not null",
+		output: "alter table e add column c int generated always as (a + b) stored not null",
+	}, {
+		input:  "alter table e add column c int as (a + b)",
+		output: "alter table e add column c int generated always as (a + b) virtual",
+	}, {
+		input: "alter table e drop check c",
+	}, {
+		input: "alter table e drop constraint c",
+	}, {
+		input: "alter table e alter index i invisible",
+	}, {
+		input: "alter table e alter index i visible",
+	}, {
+		input:  "alter table e alter index i hidden",
+		output: "alter table e",
+	}, {
+		input:  "alter table e convert to character set utf8mb4 collate utf8mb4_bin",
+		output: "alter table e convert to character set utf8mb4 collate utf8mb4_bin",
+	}, {
+		input:  "alter table e convert to charset utf8",
+		output: "alter table e convert to character set utf8",
+	}, {
+		input: "alter table e force",
+	}, {
+		input:  "alter table e add column c int, order by a, b desc",
+		output: "alter table e add column c int, order by a asc, b desc",
+	}, {
+		input:  "alter table e order by a",
+		output: "alter table e order by a asc",
+	}, {
+		input: "alter table e coalesce partition 2",
+	}, {
+		input: "alter table e add column c int partition by hash (c) partitions 4",
+	}, {
+		input: "alter table e partition by linear key (a, b) partitions 4",
+	}, {
+		input: "alter table e partition by key ()",
+	}, {
+		input: "alter table e partition by list columns (a) (partition p0 values in (1, 2), partition p1 values in (3))",
+	}, {
+		input:  "alter table e partition by range columns (a) (partition p0 values less than (10) engine InnoDB, partition p1 values less than maxvalue)",
+		output: "alter table e partition by range columns (a) (partition p0 values less than (10) engine=InnoDB, partition p1 values less than (maxvalue))",
+	}, {
+		input:  "alter table e partition by range columns (a) (partition p0 values less than (10) engine = InnoDB, partition p1 values less than (maxvalue))",
+		output: "alter table e partition by range columns (a) (partition p0 values less than (10) engine=InnoDB, partition p1 values less than (maxvalue))",
+	}, {
+		input:  "alter table e partition by foo (a)",
 		output: "alter table e",
 	}, {
 		input:  "alter table a reorganize partition b into (partition c values less than (?), partition d values less than (maxvalue))",
 		output: "alter table a reorganize partition b into (partition c values less than (:v1), partition d values less than (maxvalue))",
 	}, {
-		input:  "alter table a partition by range (id) (partition p0 values less than (10), partition p1 values less than (maxvalue))",
-		output: "alter table a",
+		input: "alter table a partition by range (id) (partition p0 values less than (10), partition p1 values less than (maxvalue))",
 	}, {
-		input:  "alter table a add column id int",
-		output: "alter table a",
+		input: "alter table a add partition (partition p3 values less than (30), partition p4 values less than (maxvalue))",
 	}, {
-		input:  "alter table a add index idx (id)",
-		output: "alter table a",
+		input: "alter table a truncate partition p1, p2",
 	}, {
-		input:  "alter table a add fulltext index idx (id)",
-		output: "alter table a",
+		input: "alter table a add column id int",
 	}, {
-		input:  "alter table a add spatial index idx (id)",
-		output: "alter table a",
+		input: "alter table a add column id int not null auto_increment first",
+	}, {
+		input: "alter table a add index idx (id)",
+	}, {
+		input: "alter table a add fulltext index idx (id)",
+	}, {
+		input: "alter table a add spatial index idx (id)",
+	}, {
+		input: "alter table a add foreign key (id) references b (a_id)",
+	}, {
+		input: "alter table a add primary key (id)",
+	}, {
+		input: "alter table a add constraint fk foreign key (id) references b (a_id) on delete cascade",
+	}, {
+		input:  "alter table a add id int",
+		output: "alter table a add column id int",
 	}, {
 		input:  "alter table a add foreign key",
 		output: "alter table a",
@@ -927,8 +1081,7 @@ var (
 		input:  "alter table a drop column id int",
 		output: "alter table a",
 	}, {
-		input:  "alter table a drop partition p2712",
-		output: "alter table a",
+		input: "alter table a drop partition p2712",
 	}, {
 		input:  "alter table a drop index idx (id)",
 		output: "alter table a",
@@ -938,18 +1091,24 @@ var (
 	}, {
 		input:  "alter table a drop spatial index idx (id)",
 		output: "alter table a",
+	}, {
+		input: "alter table a drop foreign key fk",
 	}, {
 		input:  "alter table a drop foreign key",
 		output: "alter table a",
 	}, {
-		input:  "alter table a drop primary key",
-		output: "alter table a",
+		input: "alter table a drop primary key",
 	}, {
 		input:  "alter table a drop constraint",
 		output: "alter table a",
 	}, {
 		input:  "alter table a drop id",
-		output: "alter table a",
+		output: "alter table a drop column id",
+	}, {
+		input:  "alter table a drop key idx, add unique key idx (id, b), add b int default 0 after id",
+		output: "alter table a drop index idx, add unique key idx (id, b), add column b int default 0 after id",
+	}, {
+		input: "alter table a add column `first` int after `after`",
 	}, {
 		input: "create table a",
 	}, {
@@ -1059,7 +1218,7 @@ var (
 		output: "drop table if exists a",
 	}, {
 		input:  "drop index b on a",
-		output: "alter table a",
+		output: "alter table a drop index b",
 	}, {
 		input:  "analyze table a",
 		output: "alter table a",
@@ -1287,6 +1446,26 @@ var (
 	}, {
 		input:  "use `ks:-80@master`",
 		output: "use `ks:-80@master`",
+	}, {
+		input:  "kill 1",
+		output: "kill connection 1",
+	}, {
+		input: "kill connection 3",
+	}, {
+		input: "kill query 12",
+	}, {
+		input: "load data infile '/tmp/t.txt' into table t",
+	}, {
+		input:  "LOAD DATA LOCAL INFILE 'data.csv' INTO TABLE db.t",
+		output: "load data local infile 'data.csv' into table db.t",
+	}, {
+		input: "load /* comment */ data low_priority local infile 'data.csv' replace into table t partition (p0) character set utf8 fields terminated by ',' optionally enclosed by '\\\"' escaped by '\\\\' lines starting by 'x' terminated by '\\r\\n' ignore 1 lines (a, b, c)",
+	}, {
+		input:  "load data concurrent infile 'data.csv' ignore into table t columns escaped by '' enclosed by '\\\"' terminated by '\\t' lines terminated by '\\n' starting by ''",
+		output: "load data concurrent infile 'data.csv' ignore into table t fields terminated by '\\t' enclosed by '\\\"' escaped by '' lines starting by '' terminated by '\\n'",
+	}, {
+		input:  "select data, local, lines, load from t",
+		output: "select `data`, `local`, `lines`, `load` from t",
 	}, {
 		input:  "describe foobar",
 		output: "otherread",
@@ -1387,6 +1566,48 @@ var (
 		input: "select name, group_concat(score) from t group by name",
 	}, {
 		input: "select name, group_concat(distinct id, score order by id desc separator ':') from t group by name",
+	}, {
+		input: "select id, row_number() over () from t",
+	}, {
+		input: "select id, rank() over (partition by a, b order by c desc) from t",
+	}, {
+		input: "select id, sum(a) over (order by id asc rows between unbounded preceding and current row) from t",
+	}, {
+		input: "select id, avg(a) over (partition by b order by c asc range between interval 1 day preceding and 2 following) from t",
+	}, {
+		input: "select id, lag(a, 1, 0) over (order by id asc rows 3 preceding), lead(a) over (rows between current row and unbounded following) from t",
+	}, {
+		input:  "select unbounded from t",
+		output: "select `unbounded` from t",
+	}, {
+		input:  "select id, sum(unbounded) over (order by unbounded asc rows unbounded preceding) from t",
+		output: "select id, sum(`unbounded`) over (order by `unbounded` asc rows unbounded preceding) from t",
+	}, {
+		input: "select id, count(*) over w, first_value(a) over (w order by b asc) from t window w as (partition by c), w2 as (w) order by id asc",
+	}, {
+		input: "select id from t order by row_number() over (partition by a) asc",
+	}, {
+		input: "with c as (select a from t) select * from c",
+	}, {
+		input: "with c(x, y) as (select a, b from t where a = 1), d as (select x from c) select * from c join d on c.x = d.x",
+	}, {
+		input: "with recursive c(n) as (select 1 from dual union all select n + 1 from c where n < 5) select n from c",
+	}, {
+		input: "with c as (select a from t) select a from c union select a from t order by a asc limit 1",
+	}, {
+		input: "select * from t where a in (with c as (select a from t2) select a from c)",
+	}, {
+		input: "select * from (with c as (select 1 from dual) select * from c) as d",
+	}, {
+		input: "select a -> '$.b' from t where a ->> '$.c' = 'd'",
+	}, {
+		input:  "select * from json_table('[{\\\"a\\\": 1}]', '$[*]' columns (a int path '$.a')) jt",
+		output: "select * from json_table('[{\\\"a\\\": 1}]', '$[*]' columns(a int path '$.a')) as jt",
+	}, {
+		input: "select * from t, json_table(t.doc, '$[*]' columns(id for ordinality, a varchar(10) path '$.a' null on empty error on error, b json path '$.b' default '{}' on error, c int exists path '$.c', nested path '$.d[*]' columns(d int path '$'))) as jt",
+	}, {
+		input:  "select * from t join json_table(t.doc, '$' columns(a int path '$.a' error on empty, nested '$.b' columns(b int path '$'))) as jt on t.id = jt.a",
+		output: "select * from t join json_table(t.doc, '$' columns(a int path '$.a' error on empty, nested path '$.b' columns(b int path '$'))) as jt on t.id = jt.a",
 	}, {
 		input: "select * from t partition (p0)",
 	}, {
@@ -1524,6 +1745,9 @@ func TestCaseSensitivity(t *testing.T) {
 	}, {
 		input:  "create index b on A",
 		output: "alter table A",
+	}, {
+		input:  "alter table A add foo int",
+		output: "alter table A add column foo int",
 	}, {
 		input:  "alter table A foo",
 		output: "alter table A",
@@ -1547,7 +1771,7 @@ func TestCaseSensitivity(t *testing.T) {
 		output: "drop table if exists B",
 	}, {
 		input:  "drop index b on A",
-		output: "alter table A",
+		output: "alter table A drop index b",
 	}, {
 		input: "select a from B",
 	}, {
@@ -1693,6 +1917,12 @@ func TestKeywords(t *testing.T) {
 	}, {
 		input:  "select variables from t",
 		output: "select `variables` from t",
+	}, {
+		input:  "select /* window frame keywords as cols */ current, row, preceding, following from t",
+		output: "select /* window frame keywords as cols */ `current`, `row`, `preceding`, `following` from t",
+	}, {
+		input:  "select /* json_table keywords as cols */ path, ordinality, nested, empty, error from t",
+		output: "select /* json_table keywords as cols */ `path`, `ordinality`, `nested`, `empty`, `error` from t",
 	}}
 
 	for _, tcase := range validSQL {
@@ -1904,6 +2134,7 @@ func TestCreateTable(t *testing.T) {
 			"	col_timestamp timestamp,\n" +
 			"	col_datetime datetime,\n" +
 			"	col_year year,\n" +
+			"	col_year4 year(4),\n" +
 			"	col_char char,\n" +
 			"	col_char2 char(2),\n" +
 			"	col_char3 char(3) character set ascii,\n" +
@@ -2062,6 +2293,27 @@ func TestCreateTable(t *testing.T) {
 			"	b1 bool not null,\n" +
 			"	b2 boolean\n" +
 			")",
+
+		// generated columns
+		"create table t (\n" +
+			"	id int,\n" +
+			"	a int generated always as (id + 1) virtual,\n" +
+			"	b varchar(10) generated always as (concat(id, 'b')) stored not null comment 'b'\n" +
+			")",
+
+		// partitions
+		"create table t (\n" +
+			"	id int\n" +
+			") engine InnoDB\n" +
+			"partition by range (id) (partition p0 values less than (10) engine=InnoDB, partition p1 values less than (maxvalue) engine=InnoDB)",
+		"create table t (\n" +
+			"	id int\n" +
+			")\n" +
+			"partition by linear hash (id) partitions 4",
+		"create table t (\n" +
+			"	id int\n" +
+			")\n" +
+			"partition by list columns (id) (partition p0 values in (1, 3), partition p1 values in (2, 4))",
 	}
 	for _, sql := range validSQL {
 		sql = strings.TrimSpace(sql)
@@ -2107,6 +2359,30 @@ func TestCreateTable(t *testing.T) {
 			"	unique key by_username2 (username) key_block_size 8,\n" +
 			"	unique by_username3 (username) key_block_size 4\n" +
 			")",
+	}, {
+		// test using before the index columns
+		input: "create table t (\n" +
+			"	id int,\n" +
+			"	primary key using btree (id),\n" +
+			"	key by_id using hash (id) comment 'hi'\n" +
+			")",
+		output: "create table t (\n" +
+			"	id int,\n" +
+			"	primary key (id) using btree,\n" +
+			"	key by_id (id) using hash comment 'hi'\n" +
+			")",
+	}, {
+		// test partitions after mysql's table options
+		input: "CREATE TABLE `t` (\n" +
+			"  `id` int(11) NOT NULL\n" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8\n" +
+			"/*!50100 PARTITION BY RANGE (`id`)\n" +
+			"(PARTITION p0 VALUES LESS THAN (10) ENGINE = InnoDB,\n" +
+			" PARTITION p1 VALUES LESS THAN MAXVALUE ENGINE = InnoDB) */",
+		output: "create table t (\n" +
+			"	id int(11) not null\n" +
+			") ENGINE=InnoDB default charset=utf8\n" +
+			"partition by range (id) (partition p0 values less than (10) engine=InnoDB, partition p1 values less than (maxvalue) engine=InnoDB)",
 	}, {
 		// test defaults
 		input: "create table t (\n" +
@@ -2332,6 +2608,46 @@ func TestCreateTableEscaped(t *testing.T) {
 			"\t`update` int,\n" +
 			"\tprimary key (`delete`)\n" +
 			")",
+	}, {
+		input: "create table t (id int, connection int)",
+		output: "create table t (\n" +
+			"\tid int,\n" +
+			"\t`connection` int\n" +
+			")",
+	}, {
+		input: "create table t (id int, data json, local tinyint)",
+		output: "create table t (\n" +
+			"\tid int,\n" +
+			"\t`data` json,\n" +
+			"\t`local` tinyint\n" +
+			")",
+	}, {
+		input: "create table t (current int, row int, following int, preceding int, unbounded int)",
+		output: "create table t (\n" +
+			"\t`current` int,\n" +
+			"\t`row` int,\n" +
+			"\t`following` int,\n" +
+			"\t`preceding` int,\n" +
+			"\t`unbounded` int\n" +
+			")",
+	}, {
+		input: "create table t (path int, error int, empty int, nested int, ordinality int)",
+		output: "create table t (\n" +
+			"\t`path` int,\n" +
+			"\t`error` int,\n" +
+			"\t`empty` int,\n" +
+			"\t`nested` int,\n" +
+			"\t`ordinality` int\n" +
+			")",
+	}, {
+		input: "create table t (first int, after int, modify int, coalesce int, concurrent int)",
+		output: "create table t (\n" +
+			"\t`first` int,\n" +
+			"\t`after` int,\n" +
+			"\t`modify` int,\n" +
+			"\t`coalesce` int,\n" +
+			"\t`concurrent` int\n" +
+			")",
 	}}
 	for _, tcase := range testCases {
 		tree, err := ParseStrictDDL(tcase.input)
@@ -2365,6 +2681,24 @@ var (
 	}, {
 		input:  "select x'777' from t",
 		output: "syntax error at position 14 near '777'",
+	}, {
+		input:  "select rank() over (rows between) from t",
+		output: "syntax error at position 34",
+	}, {
+		input:  "with c as select 1 from dual select * from c",
+		output: "syntax error at position 17 near 'select'",
+	}, {
+		input:  "with recursive select 1 from dual",
+		output: "syntax error at position 22 near 'select'",
+	}, {
+		input:  "select * from json_table('[]', '$[*]' columns(a int path '$.a'))",
+		output: "Every table function must have an alias at position 65",
+	}, {
+		input:  "select * from json_table('[]', '$[*]' columns(a int path '$.a' on empty)) as jt",
+		output: "syntax error at position 66 near 'on'",
+	}, {
+		input:  "select * from t window",
+		output: "syntax error at position 23",
 	}, {
 		input:  "select * from t where :1 = 2",
 		output: "syntax error at position 24 near ':'",
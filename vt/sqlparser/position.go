@@ -0,0 +1,162 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+// position.go contains the functions to locate nodes and errors
+// in the SQL they were parsed from.
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Span is the range of bytes [Start, End) of a node in the SQL
+// it was parsed from.
+type Span struct {
+	Start, End int
+}
+
+// Positions maps the nodes of a parsed statement to their span.
+// The parser tracks the statements, table expressions, select
+// expressions and expressions it builds, as long as they are
+// pointers.
+type Positions map[SQLNode]Span
+
+// ParseWithPositions parses the SQL like Parse, and also returns
+// the positions of the nodes of the statement.
+func ParseWithPositions(sql string) (Statement, Positions, error) {
+	tokenizer := NewStringTokenizer(sql)
+	tokenizer.positions = make(Positions)
+	stmt, err := parseTokenizer(sql, tokenizer)
+	if err != nil {
+		return nil, nil, err
+	}
+	return stmt, tokenizer.positions, nil
+}
+
+// Locate adds the line, the column and an excerpt of sql to err
+// if it's a NodeError for a node of p, and sql spans multiple lines.
+// Otherwise, err is returned unchanged.
+func (p Positions) Locate(sql string, err error) error {
+	nodeErr, ok := err.(*NodeError)
+	if !ok {
+		return err
+	}
+	// Only pointers can be map keys: the other nodes, like ValTuple,
+	// are never tracked.
+	if nodeErr.Node == nil || reflect.ValueOf(nodeErr.Node).Kind() != reflect.Ptr {
+		return err
+	}
+	span, ok := p[nodeErr.Node]
+	if !ok {
+		return err
+	}
+	location := describeLocation(sql, span.Start)
+	if location == "" {
+		return err
+	}
+	return &NodeError{
+		Node: nodeErr.Node,
+		Msg:  nodeErr.Msg + location,
+	}
+}
+
+// NodeError is an error caused by a node of a statement.
+type NodeError struct {
+	Node SQLNode
+	Msg  string
+}
+
+// NewNodeError returns a NodeError for node, with a message
+// formatted like fmt.Sprintf.
+func NewNodeError(node SQLNode, format string, args ...interface{}) *NodeError {
+	return &NodeError{
+		Node: node,
+		Msg:  fmt.Sprintf(format, args...),
+	}
+}
+
+// Error implements the error interface.
+func (e *NodeError) Error() string {
+	return e.Msg
+}
+
+// LineColumn returns the line and the column, both starting at 1,
+// of the byte at offset in sql.
+func LineColumn(sql string, offset int) (line, column int) {
+	if offset > len(sql) {
+		offset = len(sql)
+	}
+	lineStart := strings.LastIndexByte(sql[:offset], '\n') + 1
+	return strings.Count(sql[:lineStart], "\n") + 1, offset - lineStart + 1
+}
+
+// Excerpt returns the line of sql that contains the byte at offset,
+// followed by a line with a caret under that byte.
+func Excerpt(sql string, offset int) string {
+	if offset > len(sql) {
+		offset = len(sql)
+	}
+	lineStart := strings.LastIndexByte(sql[:offset], '\n') + 1
+	lineEnd := strings.IndexByte(sql[offset:], '\n')
+	if lineEnd == -1 {
+		lineEnd = len(sql)
+	} else {
+		lineEnd += offset
+	}
+	buf := bytes.NewBufferString(sql[lineStart:lineEnd])
+	buf.WriteByte('\n')
+	// Keep the tabs so that the caret lines up with the line.
+	for _, ch := range []byte(sql[lineStart:offset]) {
+		if ch == '\t' {
+			buf.WriteByte('\t')
+		} else {
+			buf.WriteByte(' ')
+		}
+	}
+	buf.WriteByte('^')
+	return buf.String()
+}
+
+// describeLocation returns the line, the column and an excerpt of
+// sql for the byte at offset, to be appended to an error message.
+// Positions are enough to locate errors in single line statements,
+// so it returns an empty string for them.
+func describeLocation(sql string, offset int) string {
+	if !strings.Contains(sql, "\n") {
+		return ""
+	}
+	line, column := LineColumn(sql, offset)
+	return fmt.Sprintf(" (line %d, column %d):\n%s", line, column, Excerpt(sql, offset))
+}
+
+// setPos records the span of node, which starts at start and ends
+// with the last token consumed by the parser. If the parser already
+// read the next token to decide to reduce the rule of node, that
+// token is not part of the node.
+func (tkn *Tokenizer) setPos(node SQLNode, start int) {
+	if node == nil || reflect.ValueOf(node).Kind() != reflect.Ptr {
+		return
+	}
+	end := tkn.lastEnd
+	if tkn.parser != nil && tkn.parser.Lookahead() >= 0 {
+		end = tkn.prevEnd
+	}
+	tkn.positions[node] = Span{Start: start, End: end}
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestParseWithPositions(t *testing.T) {
+	sql := "select a, b + 1 as c, count(*) from t join u on t.id = u.id where d in (select e from v) order by a"
+	stmt, positions, err := ParseWithPositions(sql)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fragments := make(map[string]bool)
+	for node, span := range positions {
+		fragments[fmt.Sprintf("%T", node)+" "+sql[span.Start:span.End]] = true
+	}
+	want := []string{
+		"*sqlparser.Select " + sql,
+		"*sqlparser.ColName a",
+		"*sqlparser.AliasedExpr b + 1 as c",
+		"*sqlparser.BinaryExpr b + 1",
+		"*sqlparser.SQLVal 1",
+		"*sqlparser.FuncExpr count(*)",
+		"*sqlparser.StarExpr *",
+		"*sqlparser.AliasedTableExpr t",
+		"*sqlparser.JoinTableExpr t join u on t.id = u.id",
+		"*sqlparser.ComparisonExpr t.id = u.id",
+		"*sqlparser.ColName u.id",
+		"*sqlparser.ComparisonExpr d in (select e from v)",
+		"*sqlparser.Subquery (select e from v)",
+		"*sqlparser.Select select e from v",
+		"*sqlparser.AliasedTableExpr v",
+	}
+	for _, fragment := range want {
+		if !fragments[fragment] {
+			t.Errorf("positions of %s: %v not found", sql, fragment)
+		}
+	}
+	if span, want := positions[stmt], (Span{Start: 0, End: len(sql)}); span != want {
+		t.Errorf("positions[stmt]: %v, want %v", span, want)
+	}
+
+	// Parse doesn't track positions.
+	tokenizer := NewStringTokenizer(sql)
+	if yyParsePooled(tokenizer) != 0 || tokenizer.positions != nil {
+		t.Errorf("Parse tracked positions")
+	}
+}
+
+func TestLineColumn(t *testing.T) {
+	sql := "select a\nfrom t\n\twhere b = 1"
+	testcases := []struct {
+		offset, line, column int
+		excerpt              string
+	}{{
+		offset:  0,
+		line:    1,
+		column:  1,
+		excerpt: "select a\n^",
+	}, {
+		offset:  7,
+		line:    1,
+		column:  8,
+		excerpt: "select a\n       ^",
+	}, {
+		offset:  14,
+		line:    2,
+		column:  6,
+		excerpt: "from t\n     ^",
+	}, {
+		offset:  23,
+		line:    3,
+		column:  8,
+		excerpt: "\twhere b = 1\n\t      ^",
+	}, {
+		offset:  len(sql),
+		line:    3,
+		column:  13,
+		excerpt: "\twhere b = 1\n\t           ^",
+	}}
+	for _, tcase := range testcases {
+		line, column := LineColumn(sql, tcase.offset)
+		if line != tcase.line || column != tcase.column {
+			t.Errorf("LineColumn(%d): %d, %d, want %d, %d", tcase.offset, line, column, tcase.line, tcase.column)
+		}
+		if got := Excerpt(sql, tcase.offset); got != tcase.excerpt {
+			t.Errorf("Excerpt(%d):\n%s\nwant:\n%s", tcase.offset, got, tcase.excerpt)
+		}
+	}
+}
+
+func TestMultiLineSyntaxError(t *testing.T) {
+	_, err := Parse("select a\nfrom t\nwher b = 1")
+	want := "syntax error at position 23 near 'b' (line 3, column 6):\nwher b = 1\n     ^"
+	if err == nil || err.Error() != want {
+		t.Errorf("Parse: %v, want %s", err, want)
+	}
+
+	// Single line statements keep the position only.
+	_, err = Parse("select a from t wher b = 1")
+	want = "syntax error at position 23 near 'b'"
+	if err == nil || err.Error() != want {
+		t.Errorf("Parse: %v, want %s", err, want)
+	}
+}
+
+func TestLocate(t *testing.T) {
+	sql := "select a\nfrom t\nwhere b in (select c from u)"
+	stmt, positions, err := ParseWithPositions(sql)
+	if err != nil {
+		t.Fatal(err)
+	}
+	subquery := stmt.(*Select).Where.Expr.(*ComparisonExpr).Right
+
+	err = positions.Locate(sql, NewNodeError(subquery, "unsupported: %s", "subquery"))
+	want := "unsupported: subquery (line 3, column 12):\nwhere b in (select c from u)\n           ^"
+	if err.Error() != want {
+		t.Errorf("Locate:\n%v\nwant:\n%s", err, want)
+	}
+	if nodeErr, ok := err.(*NodeError); !ok || nodeErr.Node != subquery {
+		t.Errorf("Locate: %#v, want a NodeError for the subquery", err)
+	}
+
+	// Errors without nodes, or with unknown nodes, are unchanged.
+	for _, err := range []error{
+		errors.New("unsupported"),
+		NewNodeError(&ColName{Name: NewColIdent("a")}, "unsupported"),
+		NewNodeError(ValTuple{NewIntVal([]byte("1"))}, "unsupported"),
+	} {
+		if got := positions.Locate(sql, err); got != err {
+			t.Errorf("Locate(%v): %v, want unchanged", err, got)
+		}
+		if got := Positions(nil).Locate(sql, err); got != err {
+			t.Errorf("Locate(%v) without positions: %v, want unchanged", err, got)
+		}
+	}
+
+	// Single line statements are unchanged too.
+	sql = "select a from t where b in (select c from u)"
+	stmt, positions, err = ParseWithPositions(sql)
+	if err != nil {
+		t.Fatal(err)
+	}
+	subquery = stmt.(*Select).Where.Expr.(*ComparisonExpr).Right
+	nodeErr := NewNodeError(subquery, "unsupported")
+	if got := positions.Locate(sql, nodeErr); got != nodeErr {
+		t.Errorf("Locate(%v): %v, want unchanged", nodeErr, got)
+	}
+}
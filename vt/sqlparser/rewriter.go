@@ -0,0 +1,247 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+//go:generate go run rewriter_gen.go
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// An ApplyFunc is invoked by Rewrite for each node, before and/or
+// after the node's children, using a Cursor describing the current
+// node and providing operations on it.
+//
+// The return value of ApplyFunc controls the syntax tree traversal.
+// See Rewrite for details.
+type ApplyFunc func(*Cursor) bool
+
+// Rewrite traverses a syntax tree recursively, starting with root,
+// and calling pre and post for each node as described below.
+// Rewrite returns the syntax tree, possibly modified.
+//
+// If pre is not nil, it is called for each node before the node's
+// children are traversed (pre-order). If pre returns false, no
+// children are traversed, and post is not called for that node.
+//
+// If post is not nil, and a prior call of pre didn't return false,
+// post is called for each node after its children are traversed
+// (post-order). If post returns false, traversal is terminated and
+// Rewrite returns immediately.
+//
+// Nodes that pre replaces are not traversed, and post is called
+// for their replacement, unless they were deleted. The nodes
+// inserted around the current node are not traversed either.
+//
+// Only fields that refer to AST nodes are considered children;
+// i.e., fields of basic types (strings, []byte, etc.) are ignored.
+func Rewrite(root SQLNode, pre, post ApplyFunc) (result SQLNode) {
+	parent := &struct{ SQLNode }{root}
+	defer func() {
+		if r := recover(); r != nil && r != abort {
+			panic(r)
+		}
+		result = parent.SQLNode
+	}()
+
+	a := &application{
+		pre:  pre,
+		post: post,
+	}
+	a.apply(parent, root, func(newNode, parent SQLNode) {
+		parent.(*struct{ SQLNode }).SQLNode = newNode
+	})
+	return parent.SQLNode
+}
+
+// A Cursor describes a node encountered during Rewrite.
+// Information about the node and its parent is available
+// from the Node, Parent and Index methods.
+//
+// The methods Replace, Delete, InsertBefore and InsertAfter
+// can be used to change the syntax tree.
+type Cursor struct {
+	parent   SQLNode
+	replacer replacerFunc
+	node     SQLNode
+	// list is the list that contains node, if any.
+	list *nodeList
+	// replaced is set once node was replaced or deleted.
+	replaced bool
+}
+
+// Node returns the current Node.
+func (c *Cursor) Node() SQLNode { return c.node }
+
+// Parent returns the parent of the current Node.
+// The parent of the elements of a list like SelectExprs is the list.
+func (c *Cursor) Parent() SQLNode { return c.parent }
+
+// Index reports the index of the current Node in its list,
+// or a value < 0 if the node is not part of a list.
+func (c *Cursor) Index() int {
+	if c.list == nil {
+		return -1
+	}
+	return c.list.index
+}
+
+// Replace replaces the current Node with newNode. The replacement
+// is not traversed by Rewrite. Replacing a node with nil deletes it.
+func (c *Cursor) Replace(newNode SQLNode) {
+	if c.list != nil && newNode == nil {
+		c.list.splice(c.list.index, 1)
+		c.list.step--
+	} else {
+		c.replacer(newNode, c.parent)
+	}
+	c.node = newNode
+	c.replaced = true
+}
+
+// Delete deletes the current Node from its list. If the node is
+// not part of a list, it's removed from its parent: the field
+// that refers to it is cleared.
+func (c *Cursor) Delete() {
+	c.Replace(nil)
+}
+
+// InsertBefore inserts newNode before the current Node in its list.
+// InsertBefore panics if the current Node is not part of a list.
+func (c *Cursor) InsertBefore(newNode SQLNode) {
+	if c.list == nil {
+		panic("sqlparser: InsertBefore of a node that is not part of a list")
+	}
+	c.list.splice(c.list.index, 0, newNode)
+	c.list.index++
+}
+
+// InsertAfter inserts newNode after the current Node in its list.
+// InsertAfter panics if the current Node is not part of a list.
+func (c *Cursor) InsertAfter(newNode SQLNode) {
+	if c.list == nil {
+		panic("sqlparser: InsertAfter of a node that is not part of a list")
+	}
+	c.list.splice(c.list.index+1, 0, newNode)
+	c.list.step++
+}
+
+// replacerFunc replaces a child of parent with newNode.
+type replacerFunc func(newNode, parent SQLNode)
+
+// application carries the state of Rewrite.
+type application struct {
+	pre, post ApplyFunc
+	cursor    Cursor
+}
+
+// abort is the panic value that terminates Rewrite.
+var abort = new(int)
+
+// apply applies the rewriter to node, a child of parent that
+// replacer replaces.
+func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
+	a.applyNode(parent, node, replacer, nil)
+}
+
+func (a *application) applyNode(parent, node SQLNode, replacer replacerFunc, list *nodeList) {
+	if node == nil || isNilValue(node) {
+		return
+	}
+	saved := a.cursor
+	a.cursor = Cursor{
+		parent:   parent,
+		replacer: replacer,
+		node:     node,
+		list:     list,
+	}
+	if a.pre != nil && !a.pre(&a.cursor) {
+		a.cursor = saved
+		return
+	}
+	if !a.cursor.replaced {
+		a.cursor.node = a.applyChildren(parent, node, replacer)
+	}
+	if a.post != nil && a.cursor.node != nil && !a.post(&a.cursor) {
+		panic(abort)
+	}
+	a.cursor = saved
+}
+
+// applyList applies the rewriter to the elements of list, a slice
+// of nodes. When elements are deleted or inserted, the new list is
+// passed to set, to be stored in parent. applyList returns the list,
+// with its changes.
+func (a *application) applyList(parent SQLNode, list interface{}, set func(list interface{})) interface{} {
+	l := &nodeList{
+		value: reflect.ValueOf(list),
+		set:   set,
+	}
+	for l.index = 0; l.index < l.value.Len(); l.index += l.step {
+		l.step = 1
+		node, _ := l.value.Index(l.index).Interface().(SQLNode)
+		a.applyNode(parent, node, l.replace, l)
+	}
+	return l.value.Interface()
+}
+
+// nodeList is a list of nodes that Rewrite is traversing.
+type nodeList struct {
+	value reflect.Value
+	set   func(list interface{})
+	// index is the index of the current element, and step
+	// is the offset of the next one.
+	index, step int
+}
+
+// elem returns node as an element of the list.
+func (l *nodeList) elem(node SQLNode) reflect.Value {
+	value := reflect.ValueOf(node)
+	if !value.Type().AssignableTo(l.value.Type().Elem()) {
+		panic(fmt.Sprintf("sqlparser: cannot use %T as an element of %v", node, l.value.Type()))
+	}
+	return value
+}
+
+// replace replaces the current element of the list with newNode.
+func (l *nodeList) replace(newNode, _ SQLNode) {
+	l.value.Index(l.index).Set(l.elem(newNode))
+}
+
+// splice replaces the deleted elements at index with the inserted
+// nodes, and stores the new list in its parent.
+func (l *nodeList) splice(index, deleted int, inserted ...SQLNode) {
+	value := reflect.MakeSlice(l.value.Type(), 0, l.value.Len()-deleted+len(inserted))
+	value = reflect.AppendSlice(value, l.value.Slice(0, index))
+	for _, node := range inserted {
+		value = reflect.Append(value, l.elem(node))
+	}
+	value = reflect.AppendSlice(value, l.value.Slice(index+deleted, l.value.Len()))
+	l.value = value
+	l.set(value.Interface())
+}
+
+// isNilValue returns true if node is a nil pointer or slice.
+func isNilValue(node SQLNode) bool {
+	value := reflect.ValueOf(node)
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Slice:
+		return value.IsNil()
+	}
+	return false
+}
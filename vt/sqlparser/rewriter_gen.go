@@ -0,0 +1,290 @@
+//go:build ignore
+// +build ignore
+
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// rewriter_gen generates ast_rewrite.go, the part of Rewrite that
+// depends on the nodes of ast.go. Run it with go generate after
+// changing the nodes.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"sort"
+)
+
+const header = `/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by rewriter_gen.go. DO NOT EDIT.
+
+package sqlparser
+
+`
+
+// generator reads the nodes of ast.go.
+type generator struct {
+	// types are the type declarations of ast.go.
+	types map[string]ast.Expr
+	// nodes maps the types that implement SQLNode to whether
+	// their methods have pointer receivers.
+	nodes map[string]bool
+	// interfaces are the interfaces that embed SQLNode.
+	interfaces map[string]bool
+
+	replacers bytes.Buffer
+	cases     bytes.Buffer
+}
+
+func main() {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "ast.go", nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	g := &generator{
+		types:      make(map[string]ast.Expr),
+		nodes:      make(map[string]bool),
+		interfaces: map[string]bool{"SQLNode": true},
+	}
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				if spec, ok := spec.(*ast.TypeSpec); ok {
+					g.types[spec.Name.Name] = spec.Type
+				}
+			}
+		case *ast.FuncDecl:
+			// Every node implements walkSubtree.
+			if decl.Recv == nil || decl.Name.Name != "walkSubtree" {
+				continue
+			}
+			switch recv := decl.Recv.List[0].Type.(type) {
+			case *ast.Ident:
+				g.nodes[recv.Name] = false
+			case *ast.StarExpr:
+				g.nodes[recv.X.(*ast.Ident).Name] = true
+			}
+		}
+	}
+	for name := range g.types {
+		g.isInterface(name)
+	}
+
+	var names []string
+	for name := range g.nodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		g.generate(name)
+	}
+
+	out := bytes.NewBufferString(header)
+	out.Write(g.replacers.Bytes())
+	fmt.Fprintf(out, `// applyChildren applies the rewriter to the children of node,
+// and returns node, updated if it's a value that was changed.
+func (a *application) applyChildren(parent, node SQLNode, replacer replacerFunc) SQLNode {
+	switch n := node.(type) {
+%s	}
+	return node
+}
+`, g.cases.Bytes())
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("ast_rewrite.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// isInterface returns true if name is an interface that embeds SQLNode.
+func (g *generator) isInterface(name string) bool {
+	if g.interfaces[name] {
+		return true
+	}
+	iface, ok := g.types[name].(*ast.InterfaceType)
+	if !ok {
+		return false
+	}
+	for _, method := range iface.Methods.List {
+		if embedded, ok := method.Type.(*ast.Ident); ok && len(method.Names) == 0 && g.isInterface(embedded.Name) {
+			g.interfaces[name] = true
+			return true
+		}
+	}
+	return false
+}
+
+// isNode returns true if the values of typ implement SQLNode.
+func (g *generator) isNode(typ ast.Expr) bool {
+	switch typ := typ.(type) {
+	case *ast.Ident:
+		ptr, ok := g.nodes[typ.Name]
+		return ok && !ptr || g.interfaces[typ.Name]
+	case *ast.StarExpr:
+		ident, ok := typ.X.(*ast.Ident)
+		if !ok {
+			return false
+		}
+		_, ok = g.nodes[ident.Name]
+		return ok
+	}
+	return false
+}
+
+// isStruct returns true if typ is a struct whose pointers implement
+// SQLNode.
+func (g *generator) isStruct(typ ast.Expr) bool {
+	ident, ok := typ.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	ptr, ok := g.nodes[ident.Name]
+	return ok && ptr
+}
+
+// elem returns the element type of typ if it's a slice of nodes,
+// and nil otherwise.
+func (g *generator) elem(typ ast.Expr) ast.Expr {
+	for {
+		switch t := typ.(type) {
+		case *ast.Ident:
+			// Look through named slices like ValTuple.
+			underlying, ok := g.types[t.Name]
+			if !ok {
+				return nil
+			}
+			typ = underlying
+		case *ast.ArrayType:
+			if t.Len != nil || !g.isNode(t.Elt) {
+				return nil
+			}
+			return t.Elt
+		default:
+			return nil
+		}
+	}
+}
+
+// generate generates the replacers of the fields of the node name,
+// and the case of applyChildren that applies the rewriter to them.
+func (g *generator) generate(name string) {
+	ptr := g.nodes[name]
+	var body bytes.Buffer
+	switch typ := g.types[name].(type) {
+	case *ast.StructType:
+		for _, field := range typ.Fields.List {
+			for _, fieldName := range field.Names {
+				g.generateField(&body, name, ptr, fieldName.Name, field.Type)
+			}
+		}
+		if !ptr && body.Len() != 0 {
+			// The children of values are replaced in a copy,
+			// that then replaces the node in its parent.
+			body.WriteString("\t\treturn n\n")
+		}
+	default:
+		if g.elem(typ) != nil {
+			fmt.Fprintf(&body, "\t\treturn a.applyList(node, n, func(list interface{}) {\n")
+			fmt.Fprintf(&body, "\t\t\treplacer(list.(%s), parent)\n", name)
+			fmt.Fprintf(&body, "\t\t}).(%s)\n", name)
+		}
+	}
+	if body.Len() == 0 {
+		return
+	}
+	if ptr {
+		fmt.Fprintf(&g.cases, "\tcase *%s:\n", name)
+	} else {
+		fmt.Fprintf(&g.cases, "\tcase %s:\n", name)
+	}
+	g.cases.Write(body.Bytes())
+}
+
+// generateField generates the replacer of a field of the node
+// name, and writes the code that applies the rewriter to it in body.
+func (g *generator) generateField(body *bytes.Buffer, name string, ptr bool, field string, typ ast.Expr) {
+	var buf bytes.Buffer
+	format.Node(&buf, token.NewFileSet(), typ)
+	typeName := buf.String()
+	replacer := fmt.Sprintf("replace%s%s", name, field)
+
+	switch {
+	case g.isNode(typ):
+		g.generateReplacer(replacer, name, field, typeName, false)
+		if ptr {
+			fmt.Fprintf(body, "\t\ta.apply(node, n.%s, %s)\n", field, replacer)
+			return
+		}
+		fmt.Fprintf(body, "\t\ta.apply(node, n.%s, func(newNode, _ SQLNode) {\n", field)
+		fmt.Fprintf(body, "\t\t\t%s(newNode, &n)\n", replacer)
+		fmt.Fprintf(body, "\t\t\treplacer(n, parent)\n")
+		fmt.Fprintf(body, "\t\t})\n")
+	case ptr && g.isStruct(typ):
+		// Structs like ColumnType are nodes through their address.
+		g.generateReplacer(replacer, name, field, typeName, true)
+		fmt.Fprintf(body, "\t\ta.apply(node, &n.%s, %s)\n", field, replacer)
+	case g.elem(typ) != nil:
+		// Slices that are not nodes themselves, like []*ColumnDefinition.
+		fmt.Fprintf(body, "\t\ta.applyList(node, n.%s, func(list interface{}) {\n", field)
+		fmt.Fprintf(body, "\t\t\tn.%s = list.(%s)\n", field, typeName)
+		if !ptr {
+			fmt.Fprintf(body, "\t\t\treplacer(n, parent)\n")
+		}
+		fmt.Fprintf(body, "\t\t})\n")
+	}
+}
+
+// generateReplacer generates the replacer of a field of the node
+// name. If deref is set, the nodes that replace the field are
+// pointers to its type.
+func (g *generator) generateReplacer(replacer, name, field, typeName string, deref bool) {
+	fmt.Fprintf(&g.replacers, "func %s(newNode, parent SQLNode) {\n", replacer)
+	fmt.Fprintf(&g.replacers, "\tvar node %s\n", typeName)
+	fmt.Fprintf(&g.replacers, "\tif newNode != nil {\n")
+	if deref {
+		fmt.Fprintf(&g.replacers, "\t\tnode = *newNode.(*%s)\n", typeName)
+	} else {
+		fmt.Fprintf(&g.replacers, "\t\tnode = newNode.(%s)\n", typeName)
+	}
+	fmt.Fprintf(&g.replacers, "\t}\n")
+	fmt.Fprintf(&g.replacers, "\tparent.(*%s).%s = node\n", name, field)
+	fmt.Fprintf(&g.replacers, "}\n\n")
+}
@@ -0,0 +1,290 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestRewriteVisitsWalkedNodes(t *testing.T) {
+	for _, tcase := range validSQL {
+		tree, err := Parse(tcase.input)
+		if err != nil {
+			t.Errorf("Parse(%q) err: %v, want nil", tcase.input, err)
+			continue
+		}
+		walked := make(map[string]int)
+		Walk(func(node SQLNode) (bool, error) {
+			if !isNilValue(node) {
+				walked[fmt.Sprintf("%T", node)]++
+			}
+			return true, nil
+		}, tree)
+		rewritten := make(map[string]int)
+		result := Rewrite(tree, func(cursor *Cursor) bool {
+			rewritten[fmt.Sprintf("%T", cursor.Node())]++
+			return true
+		}, nil)
+		if result != tree {
+			t.Errorf("Rewrite(%q) returned a new tree", tcase.input)
+		}
+		// Walk skips a few nodes, like the limit of unions, and walks
+		// the elements of ValTuple and OnDup as Exprs and UpdateExprs.
+		rewritten["sqlparser.Exprs"] += rewritten["sqlparser.ValTuple"]
+		rewritten["sqlparser.UpdateExprs"] += rewritten["sqlparser.OnDup"]
+		for typ, count := range walked {
+			if rewritten[typ] < count {
+				t.Errorf("Rewrite(%q) visited %d %s, want %d", tcase.input, rewritten[typ], typ, count)
+			}
+		}
+	}
+}
+
+func TestRewriteReplaceEveryNode(t *testing.T) {
+	for _, tcase := range validSQL {
+		if tcase.output == "" {
+			tcase.output = tcase.input
+		}
+		tree, err := Parse(tcase.input)
+		if err != nil {
+			t.Errorf("Parse(%q) err: %v, want nil", tcase.input, err)
+			continue
+		}
+		result := Rewrite(tree, nil, func(cursor *Cursor) bool {
+			cursor.Replace(cursor.Node())
+			return true
+		})
+		if out := String(result); out != tcase.output {
+			t.Errorf("Rewrite(%q) = %q, want: %q", tcase.input, out, tcase.output)
+		}
+	}
+}
+
+func TestRewriteReplace(t *testing.T) {
+	testcases := []struct {
+		in, out   string
+		pre, post ApplyFunc
+	}{{
+		// Anonymize the literals.
+		in:  "select a, 'b' from t where c = 1 and d in (2, 3) limit 4",
+		out: "select a, :v from t where c = :v and d in (:v, :v) limit :v",
+		pre: func(cursor *Cursor) bool {
+			if _, ok := cursor.Node().(*SQLVal); ok {
+				cursor.Replace(NewValArg([]byte(":v")))
+			}
+			return true
+		},
+	}, {
+		// Add a condition to the where clauses.
+		in:  "select a from t where b = 1 and c in (select c from u where d = 2)",
+		out: "select a from t where tenant = 1 and (b = 1 and c in (select c from u where tenant = 1 and (d = 2)))",
+		post: func(cursor *Cursor) bool {
+			if where, ok := cursor.Node().(*Where); ok {
+				cursor.Replace(NewWhere(where.Type, &AndExpr{
+					Left: &ComparisonExpr{
+						Operator: EqualStr,
+						Left:     testColName("", "tenant"),
+						Right:    NewIntVal([]byte("1")),
+					},
+					Right: &ParenExpr{Expr: where.Expr},
+				}))
+			}
+			return true
+		},
+	}, {
+		// Replace the conditions of joins, which are values.
+		in:  "select * from a join b on a.id = b.id join c using (id)",
+		out: "select * from a join b on a.id = b.id and a.tenant = b.tenant join c using (tenant)",
+		pre: func(cursor *Cursor) bool {
+			switch node := cursor.Node().(type) {
+			case *ComparisonExpr:
+				cursor.Replace(&AndExpr{
+					Left:  node,
+					Right: &ComparisonExpr{Operator: EqualStr, Left: testColName("a", "tenant"), Right: testColName("b", "tenant")},
+				})
+			case ColIdent:
+				if node.EqualString("id") {
+					cursor.Replace(NewColIdent("tenant"))
+				}
+			}
+			return true
+		},
+	}, {
+		// Rename the tables of a list of values.
+		in:  "drop table a, b",
+		out: "drop table c, b",
+		pre: func(cursor *Cursor) bool {
+			if name, ok := cursor.Node().(TableIdent); ok && name.String() == "a" {
+				cursor.Replace(NewTableIdent("c"))
+			}
+			return true
+		},
+	}, {
+		// Replace nodes that are structs.
+		in:  "create table t (a int)",
+		out: "create table t (\n\ta bigint\n)",
+		pre: func(cursor *Cursor) bool {
+			if _, ok := cursor.Node().(*ColumnType); ok {
+				cursor.Replace(&ColumnType{Type: "bigint"})
+			}
+			return true
+		},
+	}, {
+		// Replace the root.
+		in:  "select a from t",
+		out: "select b from t",
+		pre: func(cursor *Cursor) bool {
+			if _, ok := cursor.Node().(*Select); ok {
+				stmt, _ := Parse("select b from t")
+				cursor.Replace(stmt)
+			}
+			return true
+		},
+	}}
+	for _, tcase := range testcases {
+		tree, err := Parse(tcase.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out := String(Rewrite(tree, tcase.pre, tcase.post)); out != tcase.out {
+			t.Errorf("Rewrite(%q): %q, want %q", tcase.in, out, tcase.out)
+		}
+	}
+}
+
+func TestRewriteDelete(t *testing.T) {
+	tree, err := Parse("select a, b, c, b from t where d = 1 order by b, e")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var indexes []int
+	result := Rewrite(tree, func(cursor *Cursor) bool {
+		switch node := cursor.Node().(type) {
+		case *AliasedExpr:
+			indexes = append(indexes, cursor.Index())
+			if String(node) == "b" {
+				cursor.Delete()
+			}
+		case *Order:
+			if String(node.Expr) == "b" {
+				cursor.Delete()
+			}
+		case *Where:
+			cursor.Delete()
+		}
+		return true
+	}, nil)
+	want := "select a, c from t order by e asc"
+	if out := String(result); out != want {
+		t.Errorf("Rewrite: %q, want %q", out, want)
+	}
+	// The deleted elements don't shift the index of the next ones.
+	if want := []int{0, 1, 1, 2}; !reflect.DeepEqual(indexes, want) {
+		t.Errorf("Index: %v, want %v", indexes, want)
+	}
+}
+
+func TestRewriteInsert(t *testing.T) {
+	tree, err := Parse("select a, b from t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var visited []string
+	result := Rewrite(tree, nil, func(cursor *Cursor) bool {
+		if node, ok := cursor.Node().(*AliasedExpr); ok {
+			visited = append(visited, String(node))
+			cursor.InsertBefore(&AliasedExpr{Expr: testColName("", "before_"+String(node))})
+			cursor.InsertAfter(&AliasedExpr{Expr: testColName("", "after_"+String(node))})
+		}
+		return true
+	})
+	want := "select before_a, a, after_a, before_b, b, after_b from t"
+	if out := String(result); out != want {
+		t.Errorf("Rewrite: %q, want %q", out, want)
+	}
+	// The inserted nodes are not traversed.
+	if want := []string{"a", "b"}; !reflect.DeepEqual(visited, want) {
+		t.Errorf("visited: %v, want %v", visited, want)
+	}
+
+	defer func() {
+		want := "sqlparser: InsertBefore of a node that is not part of a list"
+		if r := recover(); r != want {
+			t.Errorf("InsertBefore: %v, want panic %s", r, want)
+		}
+	}()
+	Rewrite(tree, func(cursor *Cursor) bool {
+		if _, ok := cursor.Node().(*Where); ok {
+			cursor.InsertBefore(&Where{})
+		}
+		return true
+	}, nil)
+	Rewrite(&Select{Where: &Where{}}, func(cursor *Cursor) bool {
+		if _, ok := cursor.Node().(*Where); ok {
+			cursor.InsertBefore(&Where{})
+		}
+		return true
+	}, nil)
+}
+
+func TestRewriteAbort(t *testing.T) {
+	tree, err := Parse("select a, b, c from t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var visited []string
+	result := Rewrite(tree, func(cursor *Cursor) bool {
+		// The children of the expressions are skipped.
+		_, ok := cursor.Node().(*AliasedExpr)
+		return !ok
+	}, func(cursor *Cursor) bool {
+		node, ok := cursor.Node().(*AliasedExpr)
+		if !ok {
+			return true
+		}
+		visited = append(visited, String(node))
+		return String(node) != "b"
+	})
+	if result != tree {
+		t.Errorf("Rewrite returned a new tree")
+	}
+	if len(visited) != 0 {
+		t.Errorf("visited: %v, want none", visited)
+	}
+
+	visited = nil
+	Rewrite(tree, nil, func(cursor *Cursor) bool {
+		node, ok := cursor.Node().(*AliasedExpr)
+		if !ok {
+			return true
+		}
+		visited = append(visited, String(node))
+		return String(node) != "b"
+	})
+	if want := []string{"a", "b"}; !reflect.DeepEqual(visited, want) {
+		t.Errorf("visited: %v, want %v", visited, want)
+	}
+}
+
+func testColName(qualifier, name string) *ColName {
+	return &ColName{
+		Name:      NewColIdent(name),
+		Qualifier: TableName{Name: NewTableIdent(qualifier)},
+	}
+}
@@ -7,6 +7,11 @@ import __yyfmt__ "fmt"
 
 //line sql.y:18
 
+import (
+	"strconv"
+	"strings"
+)
+
 func setParseTree(yylex interface{}, stmt Statement) {
 	yylex.(*Tokenizer).ParseTree = stmt
 }
@@ -31,6 +36,14 @@ func decNesting(yylex interface{}) {
 	yylex.(*Tokenizer).nesting--
 }
 
+// setPos records the span of node if the tokenizer tracks positions.
+// start is the offset of the first token of the node.
+func setPos(yylex interface{}, node SQLNode, start int) {
+	if yylex.(*Tokenizer).positions != nil {
+		yylex.(*Tokenizer).setPos(node, start)
+	}
+}
+
 // skipToEnd forces the lexer to end prematurely. Not all SQL statements
 // are supported by the Parser, thus calling skipToEnd will make the lexer
 // return EOF early.
@@ -38,9 +51,11 @@ func skipToEnd(yylex interface{}) {
 	yylex.(*Tokenizer).SkipToEnd = true
 }
 
-//line sql.y:53
+//line sql.y:66
 type yySymType struct {
-	yys                  int
+	yys int
+	// pos is the offset of the first token of the symbol.
+	pos                  int
 	empty                struct{}
 	statement            Statement
 	selStmt              SelectStatement
@@ -89,6 +104,7 @@ type yySymType struct {
 	optVal               Expr
 	LengthScaleOption    LengthScaleOption
 	columnDefinition     *ColumnDefinition
+	columnDefinitions    []*ColumnDefinition
 	indexDefinition      *IndexDefinition
 	indexInfo            *IndexInfo
 	indexOption          *IndexOption
@@ -101,10 +117,28 @@ type yySymType struct {
 	partDefs             []*PartitionDefinition
 	partDef              *PartitionDefinition
 	partSpec             *PartitionSpec
+	partBy               *PartitionBy
+	alterSpec            *AlterSpec
+	alterSpecs           AlterSpecs
+	colPosition          *ColumnPosition
 	vindexParam          VindexParam
 	vindexParams         []VindexParam
 	showFilter           *ShowFilter
 	optLike              *OptLike
+	loadFields           *LoadFields
+	loadLines            *LoadLines
+	overClause           *OverClause
+	windowSpec           *WindowSpec
+	frameClause          *FrameClause
+	framePoint           *FramePoint
+	windowDefs           WindowDefs
+	windowDef            *WindowDef
+	with                 *With
+	ctes                 []*CommonTableExpr
+	cte                  *CommonTableExpr
+	jsonTableColumns     JSONTableColumns
+	jsonTableColumn      *JSONTableColumn
+	jsonOnResponse       *JSONTableOnResponse
 }
 
 const LEX_ERROR = 57346
@@ -199,162 +233,203 @@ const BINARY = 57434
 const UNDERSCORE_BINARY = 57435
 const UNDERSCORE_UTF8MB4 = 57436
 const INTERVAL = 57437
-const JSON_EXTRACT_OP = 57438
-const JSON_UNQUOTE_EXTRACT_OP = 57439
-const CREATE = 57440
-const ALTER = 57441
-const DROP = 57442
-const RENAME = 57443
-const ANALYZE = 57444
-const ADD = 57445
-const FLUSH = 57446
-const SCHEMA = 57447
-const TABLE = 57448
-const INDEX = 57449
-const VIEW = 57450
-const TO = 57451
-const IGNORE = 57452
-const IF = 57453
-const UNIQUE = 57454
-const PRIMARY = 57455
-const COLUMN = 57456
-const SPATIAL = 57457
-const FULLTEXT = 57458
-const KEY_BLOCK_SIZE = 57459
-const ACTION = 57460
-const CASCADE = 57461
-const CONSTRAINT = 57462
-const FOREIGN = 57463
-const NO = 57464
-const REFERENCES = 57465
-const RESTRICT = 57466
-const SHOW = 57467
-const DESCRIBE = 57468
-const EXPLAIN = 57469
-const DATE = 57470
-const ESCAPE = 57471
-const REPAIR = 57472
-const OPTIMIZE = 57473
-const TRUNCATE = 57474
-const MAXVALUE = 57475
-const PARTITION = 57476
-const REORGANIZE = 57477
-const LESS = 57478
-const THAN = 57479
-const PROCEDURE = 57480
-const TRIGGER = 57481
-const VINDEX = 57482
-const VINDEXES = 57483
-const STATUS = 57484
-const VARIABLES = 57485
-const WARNINGS = 57486
-const BEGIN = 57487
-const START = 57488
-const TRANSACTION = 57489
-const COMMIT = 57490
-const ROLLBACK = 57491
-const BIT = 57492
-const TINYINT = 57493
-const SMALLINT = 57494
-const MEDIUMINT = 57495
-const INT = 57496
-const INTEGER = 57497
-const BIGINT = 57498
-const INTNUM = 57499
-const REAL = 57500
-const DOUBLE = 57501
-const FLOAT_TYPE = 57502
-const DECIMAL = 57503
-const NUMERIC = 57504
-const TIME = 57505
-const TIMESTAMP = 57506
-const DATETIME = 57507
-const YEAR = 57508
-const CHAR = 57509
-const VARCHAR = 57510
-const BOOL = 57511
-const CHARACTER = 57512
-const VARBINARY = 57513
-const NCHAR = 57514
-const TEXT = 57515
-const TINYTEXT = 57516
-const MEDIUMTEXT = 57517
-const LONGTEXT = 57518
-const BLOB = 57519
-const TINYBLOB = 57520
-const MEDIUMBLOB = 57521
-const LONGBLOB = 57522
-const JSON = 57523
-const ENUM = 57524
-const GEOMETRY = 57525
-const POINT = 57526
-const LINESTRING = 57527
-const POLYGON = 57528
-const GEOMETRYCOLLECTION = 57529
-const MULTIPOINT = 57530
-const MULTILINESTRING = 57531
-const MULTIPOLYGON = 57532
-const NULLX = 57533
-const AUTO_INCREMENT = 57534
-const APPROXNUM = 57535
-const SIGNED = 57536
-const UNSIGNED = 57537
-const ZEROFILL = 57538
-const COLLATION = 57539
-const DATABASES = 57540
-const SCHEMAS = 57541
-const TABLES = 57542
-const VITESS_KEYSPACES = 57543
-const VITESS_SHARDS = 57544
-const VITESS_TABLETS = 57545
-const VSCHEMA = 57546
-const VSCHEMA_TABLES = 57547
-const VITESS_TARGET = 57548
-const FULL = 57549
-const PROCESSLIST = 57550
-const COLUMNS = 57551
-const FIELDS = 57552
-const ENGINES = 57553
-const PLUGINS = 57554
-const NAMES = 57555
-const CHARSET = 57556
-const GLOBAL = 57557
-const SESSION = 57558
-const ISOLATION = 57559
-const LEVEL = 57560
-const READ = 57561
-const WRITE = 57562
-const ONLY = 57563
-const REPEATABLE = 57564
-const COMMITTED = 57565
-const UNCOMMITTED = 57566
-const SERIALIZABLE = 57567
-const CURRENT_TIMESTAMP = 57568
-const DATABASE = 57569
-const CURRENT_DATE = 57570
-const CURRENT_TIME = 57571
-const LOCALTIME = 57572
-const LOCALTIMESTAMP = 57573
-const UTC_DATE = 57574
-const UTC_TIME = 57575
-const UTC_TIMESTAMP = 57576
-const REPLACE = 57577
-const CONVERT = 57578
-const CAST = 57579
-const SUBSTR = 57580
-const SUBSTRING = 57581
-const GROUP_CONCAT = 57582
-const SEPARATOR = 57583
-const TIMESTAMPADD = 57584
-const TIMESTAMPDIFF = 57585
-const MATCH = 57586
-const AGAINST = 57587
-const BOOLEAN = 57588
-const LANGUAGE = 57589
-const WITH = 57590
-const QUERY = 57591
-const EXPANSION = 57592
-const UNUSED = 57593
+const PARTITION = 57438
+const TABLE_OPTIONS = 57439
+const UNBOUNDED = 57440
+const PRECEDING = 57441
+const FOLLOWING = 57442
+const JSON_EXTRACT_OP = 57443
+const JSON_UNQUOTE_EXTRACT_OP = 57444
+const CREATE = 57445
+const ALTER = 57446
+const DROP = 57447
+const RENAME = 57448
+const ANALYZE = 57449
+const ADD = 57450
+const FLUSH = 57451
+const SCHEMA = 57452
+const TABLE = 57453
+const INDEX = 57454
+const VIEW = 57455
+const TO = 57456
+const IGNORE = 57457
+const IF = 57458
+const UNIQUE = 57459
+const PRIMARY = 57460
+const COLUMN = 57461
+const SPATIAL = 57462
+const FULLTEXT = 57463
+const KEY_BLOCK_SIZE = 57464
+const MODIFY = 57465
+const CHANGE = 57466
+const FIRST = 57467
+const AFTER = 57468
+const CHECK = 57469
+const GENERATED = 57470
+const STORED = 57471
+const VIRTUAL = 57472
+const ACTION = 57473
+const CASCADE = 57474
+const CONSTRAINT = 57475
+const FOREIGN = 57476
+const NO = 57477
+const REFERENCES = 57478
+const RESTRICT = 57479
+const SHOW = 57480
+const DESCRIBE = 57481
+const EXPLAIN = 57482
+const DATE = 57483
+const ESCAPE = 57484
+const REPAIR = 57485
+const OPTIMIZE = 57486
+const TRUNCATE = 57487
+const MAXVALUE = 57488
+const REORGANIZE = 57489
+const COALESCE = 57490
+const LINEAR = 57491
+const LESS = 57492
+const THAN = 57493
+const PROCEDURE = 57494
+const TRIGGER = 57495
+const VINDEX = 57496
+const VINDEXES = 57497
+const STATUS = 57498
+const VARIABLES = 57499
+const WARNINGS = 57500
+const BEGIN = 57501
+const START = 57502
+const TRANSACTION = 57503
+const COMMIT = 57504
+const ROLLBACK = 57505
+const KILL = 57506
+const CONNECTION = 57507
+const LOAD = 57508
+const DATA = 57509
+const LOW_PRIORITY = 57510
+const CONCURRENT = 57511
+const LOCAL = 57512
+const INFILE = 57513
+const LINES = 57514
+const TERMINATED = 57515
+const OPTIONALLY = 57516
+const ENCLOSED = 57517
+const ESCAPED = 57518
+const STARTING = 57519
+const BIT = 57520
+const TINYINT = 57521
+const SMALLINT = 57522
+const MEDIUMINT = 57523
+const INT = 57524
+const INTEGER = 57525
+const BIGINT = 57526
+const INTNUM = 57527
+const REAL = 57528
+const DOUBLE = 57529
+const FLOAT_TYPE = 57530
+const DECIMAL = 57531
+const NUMERIC = 57532
+const TIME = 57533
+const TIMESTAMP = 57534
+const DATETIME = 57535
+const YEAR = 57536
+const CHAR = 57537
+const VARCHAR = 57538
+const BOOL = 57539
+const CHARACTER = 57540
+const VARBINARY = 57541
+const NCHAR = 57542
+const TEXT = 57543
+const TINYTEXT = 57544
+const MEDIUMTEXT = 57545
+const LONGTEXT = 57546
+const BLOB = 57547
+const TINYBLOB = 57548
+const MEDIUMBLOB = 57549
+const LONGBLOB = 57550
+const JSON = 57551
+const ENUM = 57552
+const GEOMETRY = 57553
+const POINT = 57554
+const LINESTRING = 57555
+const POLYGON = 57556
+const GEOMETRYCOLLECTION = 57557
+const MULTIPOINT = 57558
+const MULTILINESTRING = 57559
+const MULTIPOLYGON = 57560
+const NULLX = 57561
+const AUTO_INCREMENT = 57562
+const APPROXNUM = 57563
+const SIGNED = 57564
+const UNSIGNED = 57565
+const ZEROFILL = 57566
+const COLLATION = 57567
+const DATABASES = 57568
+const SCHEMAS = 57569
+const TABLES = 57570
+const VITESS_KEYSPACES = 57571
+const VITESS_SHARDS = 57572
+const VITESS_TABLETS = 57573
+const VSCHEMA = 57574
+const VSCHEMA_TABLES = 57575
+const VITESS_TARGET = 57576
+const FULL = 57577
+const PROCESSLIST = 57578
+const COLUMNS = 57579
+const FIELDS = 57580
+const ENGINES = 57581
+const PLUGINS = 57582
+const NAMES = 57583
+const CHARSET = 57584
+const GLOBAL = 57585
+const SESSION = 57586
+const ISOLATION = 57587
+const LEVEL = 57588
+const READ = 57589
+const WRITE = 57590
+const ONLY = 57591
+const REPEATABLE = 57592
+const COMMITTED = 57593
+const UNCOMMITTED = 57594
+const SERIALIZABLE = 57595
+const CURRENT_TIMESTAMP = 57596
+const DATABASE = 57597
+const CURRENT_DATE = 57598
+const CURRENT_TIME = 57599
+const LOCALTIME = 57600
+const LOCALTIMESTAMP = 57601
+const UTC_DATE = 57602
+const UTC_TIME = 57603
+const UTC_TIMESTAMP = 57604
+const REPLACE = 57605
+const CONVERT = 57606
+const CAST = 57607
+const SUBSTR = 57608
+const SUBSTRING = 57609
+const GROUP_CONCAT = 57610
+const SEPARATOR = 57611
+const TIMESTAMPADD = 57612
+const TIMESTAMPDIFF = 57613
+const MATCH = 57614
+const AGAINST = 57615
+const BOOLEAN = 57616
+const LANGUAGE = 57617
+const WITH = 57618
+const QUERY = 57619
+const EXPANSION = 57620
+const OVER = 57621
+const WINDOW = 57622
+const ROWS = 57623
+const RANGE = 57624
+const ROW = 57625
+const CURRENT = 57626
+const RECURSIVE = 57627
+const JSON_TABLE = 57628
+const ORDINALITY = 57629
+const PATH = 57630
+const NESTED = 57631
+const EMPTY = 57632
+const ERROR = 57633
+const UNUSED = 57634
 
 var yyToknames = [...]string{
 	"$end",
@@ -469,6 +544,11 @@ var yyToknames = [...]string{
 	"UNDERSCORE_UTF8MB4",
 	"INTERVAL",
 	"'.'",
+	"PARTITION",
+	"TABLE_OPTIONS",
+	"UNBOUNDED",
+	"PRECEDING",
+	"FOLLOWING",
 	"JSON_EXTRACT_OP",
 	"JSON_UNQUOTE_EXTRACT_OP",
 	"CREATE",
@@ -491,6 +571,14 @@ var yyToknames = [...]string{
 	"SPATIAL",
 	"FULLTEXT",
 	"KEY_BLOCK_SIZE",
+	"MODIFY",
+	"CHANGE",
+	"FIRST",
+	"AFTER",
+	"CHECK",
+	"GENERATED",
+	"STORED",
+	"VIRTUAL",
 	"ACTION",
 	"CASCADE",
 	"CONSTRAINT",
@@ -507,8 +595,9 @@ var yyToknames = [...]string{
 	"OPTIMIZE",
 	"TRUNCATE",
 	"MAXVALUE",
-	"PARTITION",
 	"REORGANIZE",
+	"COALESCE",
+	"LINEAR",
 	"LESS",
 	"THAN",
 	"PROCEDURE",
@@ -523,6 +612,20 @@ var yyToknames = [...]string{
 	"TRANSACTION",
 	"COMMIT",
 	"ROLLBACK",
+	"KILL",
+	"CONNECTION",
+	"LOAD",
+	"DATA",
+	"LOW_PRIORITY",
+	"CONCURRENT",
+	"LOCAL",
+	"INFILE",
+	"LINES",
+	"TERMINATED",
+	"OPTIONALLY",
+	"ENCLOSED",
+	"ESCAPED",
+	"STARTING",
 	"BIT",
 	"TINYINT",
 	"SMALLINT",
@@ -624,6 +727,19 @@ var yyToknames = [...]string{
 	"WITH",
 	"QUERY",
 	"EXPANSION",
+	"OVER",
+	"WINDOW",
+	"ROWS",
+	"RANGE",
+	"ROW",
+	"CURRENT",
+	"RECURSIVE",
+	"JSON_TABLE",
+	"ORDINALITY",
+	"PATH",
+	"NESTED",
+	"EMPTY",
+	"ERROR",
 	"UNUSED",
 	"';'",
 }
@@ -639,1388 +755,2243 @@ var yyExca = [...]int{
 	1, -1,
 	-2, 0,
 	-1, 3,
-	5, 29,
+	5, 39,
 	-2, 4,
-	-1, 37,
-	159, 296,
-	160, 296,
-	-2, 286,
-	-1, 270,
-	112, 635,
-	-2, 631,
-	-1, 271,
-	112, 636,
-	-2, 632,
-	-1, 336,
-	82, 810,
-	-2, 60,
-	-1, 337,
-	82, 766,
-	-2, 61,
-	-1, 342,
-	82, 745,
-	-2, 597,
-	-1, 344,
-	82, 787,
-	-2, 599,
-	-1, 614,
-	1, 348,
-	5, 348,
-	12, 348,
-	13, 348,
-	14, 348,
-	15, 348,
-	17, 348,
-	19, 348,
-	30, 348,
-	31, 348,
-	42, 348,
-	43, 348,
-	44, 348,
-	45, 348,
-	46, 348,
-	48, 348,
-	49, 348,
-	52, 348,
-	53, 348,
-	55, 348,
-	56, 348,
-	269, 348,
-	-2, 366,
-	-1, 617,
-	53, 43,
-	55, 43,
-	-2, 45,
-	-1, 759,
-	112, 638,
-	-2, 634,
-	-1, 982,
-	5, 30,
-	-2, 432,
-	-1, 1012,
-	5, 29,
-	-2, 571,
-	-1, 1252,
-	5, 30,
-	-2, 572,
-	-1, 1304,
-	5, 29,
-	-2, 574,
-	-1, 1381,
-	5, 30,
-	-2, 575,
+	-1, 42,
+	173, 379,
+	174, 379,
+	-2, 369,
+	-1, 71,
+	5, 39,
+	-2, 30,
+	-1, 352,
+	112, 781,
+	-2, 777,
+	-1, 353,
+	112, 782,
+	-2, 778,
+	-1, 418,
+	82, 992,
+	-2, 70,
+	-1, 419,
+	82, 934,
+	-2, 71,
+	-1, 424,
+	82, 901,
+	-2, 753,
+	-1, 426,
+	82, 963,
+	-2, 755,
+	-1, 571,
+	113, 0,
+	-2, 973,
+	-1, 767,
+	1, 464,
+	5, 464,
+	12, 464,
+	13, 464,
+	14, 464,
+	15, 464,
+	17, 464,
+	19, 464,
+	30, 464,
+	31, 464,
+	42, 464,
+	43, 464,
+	44, 464,
+	45, 464,
+	46, 464,
+	48, 464,
+	49, 464,
+	52, 464,
+	53, 464,
+	55, 464,
+	56, 464,
+	297, 464,
+	310, 464,
+	-2, 499,
+	-1, 771,
+	53, 53,
+	55, 53,
+	-2, 55,
+	-1, 972,
+	112, 784,
+	-2, 780,
+	-1, 1212,
+	5, 40,
+	-2, 565,
+	-1, 1242,
+	5, 39,
+	-2, 727,
+	-1, 1507,
+	5, 40,
+	-2, 728,
+	-1, 1579,
+	5, 39,
+	-2, 730,
+	-1, 1638,
+	1, 467,
+	5, 467,
+	12, 467,
+	13, 467,
+	14, 467,
+	15, 467,
+	17, 467,
+	19, 467,
+	30, 467,
+	31, 467,
+	42, 467,
+	43, 467,
+	44, 467,
+	45, 467,
+	46, 467,
+	48, 467,
+	49, 467,
+	52, 467,
+	53, 467,
+	55, 467,
+	56, 467,
+	297, 467,
+	310, 467,
+	-2, 499,
+	-1, 1673,
+	5, 40,
+	-2, 731,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 12928
+const yyLast = 21134
 
 var yyAct = [...]int{
 
-	271, 1415, 1405, 1215, 1369, 1104, 1015, 275, 570, 1285,
-	868, 1316, 1155, 845, 1033, 1189, 57, 288, 1156, 1152,
-	249, 301, 891, 1016, 947, 911, 1272, 1039, 877, 1058,
-	1162, 867, 81, 864, 784, 1168, 210, 974, 341, 210,
-	1127, 724, 794, 1084, 240, 1075, 812, 843, 881, 847,
-	832, 502, 610, 761, 508, 627, 907, 442, 626, 335,
-	825, 258, 611, 471, 817, 522, 273, 210, 81, 791,
-	332, 330, 210, 277, 210, 584, 569, 3, 56, 897,
-	1408, 1392, 1403, 1379, 514, 1400, 956, 1216, 1391, 241,
-	242, 243, 244, 585, 1378, 247, 1144, 61, 1244, 447,
-	628, 930, 629, 1184, 1185, 313, 262, 319, 320, 317,
-	318, 316, 315, 314, 1183, 929, 475, 205, 201, 202,
-	203, 321, 322, 63, 64, 65, 66, 67, 248, 1046,
-	858, 793, 1045, 859, 860, 1047, 492, 197, 496, 199,
-	246, 245, 1066, 934, 493, 490, 491, 890, 1275, 1291,
-	898, 1235, 928, 1344, 535, 534, 544, 545, 537, 538,
-	539, 540, 541, 542, 543, 536, 460, 239, 546, 1233,
-	1402, 1128, 485, 486, 1107, 699, 1106, 697, 1399, 1370,
-	1103, 826, 477, 1362, 479, 882, 1317, 199, 1419, 1423,
-	461, 1034, 1036, 449, 1108, 703, 884, 495, 210, 1319,
-	884, 210, 925, 922, 923, 1325, 921, 210, 1130, 698,
-	690, 1178, 1177, 210, 476, 478, 81, 1100, 81, 81,
-	884, 81, 81, 1102, 81, 1059, 81, 1176, 445, 443,
-	700, 452, 212, 198, 200, 81, 1351, 932, 935, 204,
-	991, 1132, 988, 1136, 1255, 1131, 1114, 1129, 941, 457,
-	1001, 940, 1134, 537, 538, 539, 540, 541, 542, 543,
-	536, 1133, 968, 546, 1091, 81, 733, 1318, 1035, 510,
-	558, 559, 526, 927, 1135, 1137, 467, 536, 264, 898,
-	546, 480, 481, 865, 482, 483, 546, 484, 473, 487,
-	498, 499, 883, 1089, 1417, 926, 883, 1418, 497, 1416,
-	725, 880, 878, 474, 879, 730, 1326, 1324, 1377, 876,
-	882, 1201, 454, 500, 455, 521, 883, 456, 1146, 1101,
-	1360, 1099, 70, 1334, 520, 519, 1345, 1166, 210, 210,
-	210, 511, 949, 630, 81, 736, 737, 302, 51, 931,
-	81, 521, 558, 559, 558, 559, 556, 539, 540, 541,
-	542, 543, 536, 512, 933, 546, 338, 813, 71, 519,
-	1090, 1064, 1202, 768, 692, 1095, 1092, 1085, 1093, 1088,
-	463, 464, 465, 1086, 1087, 521, 609, 766, 767, 765,
-	813, 726, 998, 520, 519, 1365, 516, 1094, 1383, 51,
-	587, 589, 591, 593, 595, 597, 598, 254, 520, 519,
-	521, 618, 614, 54, 624, 1148, 987, 448, 588, 590,
-	948, 594, 596, 764, 599, 521, 535, 534, 544, 545,
-	537, 538, 539, 540, 541, 542, 543, 536, 1424, 443,
-	546, 534, 544, 545, 537, 538, 539, 540, 541, 542,
-	543, 536, 210, 887, 546, 1281, 986, 81, 985, 888,
-	196, 501, 210, 210, 81, 1385, 520, 519, 210, 1358,
-	1280, 210, 441, 1079, 210, 520, 519, 1425, 210, 975,
-	81, 81, 785, 521, 786, 81, 81, 81, 210, 81,
-	81, 1078, 521, 1067, 450, 451, 81, 81, 535, 534,
-	544, 545, 537, 538, 539, 540, 541, 542, 543, 536,
-	1361, 1298, 546, 751, 753, 754, 712, 22, 1278, 752,
-	689, 965, 966, 967, 81, 327, 328, 696, 210, 1048,
-	1111, 1049, 1076, 732, 81, 1322, 1401, 1387, 501, 505,
-	509, 738, 704, 713, 714, 1322, 1373, 501, 715, 716,
-	717, 1218, 719, 720, 1322, 501, 527, 1322, 1352, 721,
-	722, 762, 1059, 472, 710, 472, 472, 1054, 472, 472,
-	731, 472, 1331, 472, 787, 759, 709, 253, 81, 1322,
-	1321, 1330, 472, 708, 740, 693, 739, 520, 519, 1270,
-	1269, 571, 1257, 501, 1198, 803, 806, 1254, 501, 755,
-	582, 814, 51, 691, 521, 291, 290, 293, 294, 295,
-	296, 81, 81, 763, 292, 297, 688, 555, 210, 469,
-	557, 1208, 1207, 757, 1204, 1205, 210, 210, 1204, 1203,
-	210, 210, 338, 462, 81, 788, 789, 980, 501, 829,
-	501, 796, 501, 1153, 795, 797, 1165, 81, 568, 1040,
-	572, 573, 574, 575, 576, 577, 578, 579, 580, 798,
-	583, 586, 586, 586, 592, 586, 586, 592, 586, 600,
-	601, 602, 603, 604, 605, 810, 615, 853, 24, 893,
-	894, 895, 896, 822, 637, 636, 885, 621, 851, 1040,
-	58, 1117, 829, 855, 856, 904, 905, 906, 1165, 614,
-	24, 210, 81, 614, 81, 872, 1303, 796, 81, 81,
-	210, 210, 1250, 210, 210, 799, 800, 210, 81, 805,
-	808, 809, 1333, 913, 1010, 828, 54, 24, 1011, 622,
-	980, 620, 1165, 829, 210, 980, 210, 210, 852, 210,
-	620, 899, 900, 901, 821, 1206, 823, 824, 54, 1105,
-	829, 909, 910, 1050, 544, 545, 537, 538, 539, 540,
-	541, 542, 543, 536, 857, 917, 546, 919, 1004, 1003,
-	300, 980, 620, 623, 734, 54, 915, 702, 54, 1393,
-	255, 945, 759, 727, 1287, 834, 837, 838, 839, 835,
-	892, 836, 840, 1262, 472, 1169, 1170, 762, 912, 957,
-	958, 472, 79, 1194, 1169, 1170, 1410, 1053, 748, 749,
-	908, 903, 902, 1406, 1196, 1172, 1153, 472, 472, 1080,
-	728, 706, 472, 472, 472, 758, 472, 472, 54, 970,
-	1027, 1025, 1175, 472, 472, 1028, 1026, 746, 340, 1174,
-	210, 210, 210, 210, 210, 1029, 1017, 838, 839, 763,
-	1024, 1023, 210, 259, 260, 210, 1397, 1390, 1113, 210,
-	953, 571, 977, 210, 801, 802, 978, 515, 997, 1395,
-	963, 962, 1071, 982, 983, 984, 1367, 635, 81, 470,
-	990, 1063, 513, 993, 994, 1366, 1301, 964, 1051, 1000,
-	503, 1042, 1018, 1002, 1061, 1021, 1005, 1006, 1007, 1008,
-	1055, 1030, 504, 1019, 1020, 51, 1022, 1248, 1038, 1283,
-	1041, 918, 1012, 614, 614, 614, 614, 614, 1032, 863,
-	572, 1060, 338, 1043, 705, 842, 81, 81, 614, 256,
-	257, 798, 515, 250, 979, 869, 614, 1338, 1056, 1057,
-	251, 1070, 58, 1072, 1073, 1074, 1337, 834, 837, 838,
-	839, 835, 995, 836, 840, 81, 961, 1289, 1040, 1077,
-	494, 1412, 1411, 844, 960, 992, 989, 615, 723, 517,
-	210, 1412, 1348, 1276, 729, 1083, 1096, 60, 62, 81,
-	619, 55, 1, 1068, 1069, 1404, 340, 1217, 340, 340,
-	1082, 340, 340, 1284, 340, 924, 340, 1368, 1110, 1315,
-	1188, 875, 866, 69, 440, 340, 68, 1359, 874, 873,
-	1323, 1274, 886, 1065, 889, 1195, 1062, 1364, 1109, 1121,
-	954, 955, 1145, 509, 81, 81, 268, 1154, 1017, 643,
-	641, 1138, 758, 1126, 642, 524, 640, 645, 1139, 472,
-	644, 472, 639, 1157, 224, 333, 841, 631, 81, 1120,
-	759, 914, 518, 1164, 1125, 472, 72, 1098, 1097, 920,
-	488, 81, 489, 81, 81, 226, 1180, 1173, 554, 959,
-	1044, 339, 1160, 1187, 735, 507, 1336, 1288, 1179, 1182,
-	996, 581, 811, 276, 750, 981, 289, 286, 287, 741,
-	1009, 210, 1186, 1191, 528, 274, 1192, 1193, 266, 613,
-	1159, 606, 999, 833, 340, 831, 969, 830, 210, 1171,
-	632, 1167, 1199, 1200, 81, 612, 1116, 81, 81, 210,
-	1243, 1343, 745, 26, 59, 81, 261, 19, 210, 18,
-	17, 20, 16, 15, 14, 458, 30, 21, 13, 1210,
-	12, 11, 10, 9, 8, 1222, 7, 6, 5, 4,
-	252, 1211, 23, 1213, 1223, 2, 0, 0, 0, 0,
-	0, 1228, 1229, 0, 1230, 0, 869, 1232, 1231, 1234,
-	0, 0, 0, 1013, 1014, 1224, 0, 615, 615, 615,
-	615, 615, 0, 1017, 1249, 0, 0, 0, 0, 0,
-	0, 1258, 844, 0, 1037, 1225, 0, 81, 0, 1259,
-	615, 614, 1227, 0, 0, 81, 0, 1051, 0, 0,
-	0, 1268, 0, 1236, 1237, 0, 0, 340, 0, 0,
-	81, 0, 0, 1271, 340, 0, 0, 81, 0, 0,
-	0, 0, 0, 1251, 1252, 1253, 0, 1256, 1112, 0,
-	340, 340, 0, 0, 0, 340, 340, 340, 0, 340,
-	340, 0, 0, 0, 1267, 0, 340, 340, 0, 0,
-	0, 0, 0, 0, 472, 81, 81, 1119, 81, 0,
-	0, 0, 0, 81, 0, 81, 81, 81, 210, 1302,
-	0, 81, 1157, 1282, 742, 0, 0, 1147, 0, 1309,
-	0, 1314, 472, 1277, 524, 1279, 1320, 340, 81, 1310,
-	1149, 1311, 1312, 1313, 1327, 560, 561, 562, 563, 564,
-	565, 566, 567, 0, 0, 0, 0, 0, 1297, 1290,
-	0, 1328, 0, 1329, 1335, 0, 1349, 0, 0, 1181,
-	0, 0, 0, 81, 0, 1157, 0, 1357, 790, 1356,
-	1304, 0, 0, 0, 81, 81, 0, 0, 0, 869,
-	0, 869, 0, 1371, 815, 1372, 0, 1375, 0, 1158,
-	0, 51, 81, 0, 0, 1380, 1017, 1339, 1340, 1341,
-	1342, 819, 820, 210, 1346, 1347, 0, 0, 0, 0,
-	0, 81, 0, 0, 0, 0, 1353, 1354, 1355, 1389,
-	0, 0, 1350, 0, 340, 0, 0, 0, 0, 0,
-	0, 0, 1394, 1396, 81, 0, 0, 340, 0, 0,
-	0, 0, 0, 1119, 0, 0, 0, 1409, 1376, 0,
-	0, 0, 0, 0, 1420, 1381, 0, 0, 0, 0,
-	1398, 0, 0, 0, 0, 0, 0, 1245, 0, 0,
-	0, 0, 0, 1386, 0, 0, 0, 571, 0, 0,
-	0, 0, 0, 0, 0, 1260, 0, 0, 1261, 0,
-	0, 1263, 340, 0, 340, 615, 0, 0, 936, 937,
-	0, 506, 0, 0, 0, 0, 0, 0, 340, 0,
-	0, 0, 0, 0, 0, 869, 0, 0, 1421, 1422,
-	0, 0, 1242, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 340, 0, 0, 208, 0, 0,
-	238, 0, 0, 0, 0, 1286, 0, 0, 0, 0,
-	0, 0, 0, 0, 1264, 1265, 1266, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 1247, 0, 208, 0,
-	0, 0, 0, 208, 0, 208, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 760, 660, 472, 769, 770,
-	771, 772, 773, 774, 775, 776, 777, 778, 779, 780,
-	781, 782, 783, 0, 535, 534, 544, 545, 537, 538,
-	539, 540, 541, 542, 543, 536, 0, 0, 546, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1158, 815,
-	0, 1305, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 818, 0, 0, 1246, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1374, 571, 0, 0,
-	0, 1332, 1286, 869, 648, 0, 0, 0, 340, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1158, 0, 51, 535, 534, 544, 545, 537, 538,
-	539, 540, 541, 542, 543, 536, 0, 0, 546, 208,
-	0, 661, 208, 0, 0, 0, 0, 1241, 208, 0,
-	0, 0, 0, 0, 208, 0, 1081, 340, 0, 0,
-	0, 0, 0, 674, 677, 678, 679, 680, 681, 682,
-	0, 683, 684, 685, 686, 687, 662, 663, 664, 665,
-	646, 647, 675, 0, 649, 340, 650, 651, 652, 653,
-	654, 655, 656, 657, 658, 659, 666, 667, 668, 669,
-	670, 671, 672, 673, 0, 0, 0, 0, 0, 340,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1407, 535, 534, 544, 545, 537, 538, 539, 540, 541,
-	542, 543, 536, 0, 0, 546, 0, 0, 616, 0,
-	0, 0, 340, 0, 0, 0, 0, 0, 0, 0,
-	0, 815, 0, 0, 1161, 1163, 0, 0, 0, 676,
-	0, 0, 0, 971, 972, 973, 0, 0, 0, 208,
-	208, 208, 0, 0, 207, 0, 0, 0, 1163, 221,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 340, 0, 340, 1190, 0, 0, 1240, 0, 0,
-	0, 0, 0, 234, 0, 331, 530, 0, 533, 0,
-	444, 0, 446, 0, 547, 548, 549, 550, 551, 552,
-	553, 0, 531, 532, 529, 535, 534, 544, 545, 537,
-	538, 539, 540, 541, 542, 543, 536, 0, 0, 546,
-	0, 0, 0, 0, 1214, 0, 0, 1219, 1220, 0,
-	0, 0, 0, 0, 213, 340, 0, 0, 0, 0,
-	0, 216, 0, 0, 0, 0, 0, 0, 0, 225,
-	220, 535, 534, 544, 545, 537, 538, 539, 540, 541,
-	542, 543, 536, 208, 0, 546, 0, 0, 0, 0,
-	0, 0, 0, 208, 208, 0, 0, 0, 0, 208,
-	0, 223, 208, 0, 0, 208, 815, 233, 0, 711,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 208,
-	0, 0, 0, 0, 0, 0, 0, 340, 0, 0,
-	0, 0, 0, 214, 0, 1273, 453, 0, 0, 459,
-	0, 0, 0, 0, 0, 466, 0, 0, 0, 0,
-	340, 468, 0, 0, 0, 1239, 0, 340, 0, 208,
-	227, 217, 218, 0, 228, 229, 230, 232, 711, 231,
-	237, 0, 1123, 1124, 219, 222, 0, 215, 236, 235,
-	0, 0, 0, 0, 0, 1140, 1141, 0, 1142, 1143,
-	0, 0, 0, 0, 0, 1306, 1307, 0, 1308, 0,
-	1150, 1151, 1238, 1273, 0, 1273, 1273, 1273, 0, 0,
-	265, 1190, 0, 0, 0, 265, 265, 0, 0, 265,
-	265, 265, 0, 0, 0, 816, 0, 0, 1273, 535,
-	534, 544, 545, 537, 538, 539, 540, 541, 542, 543,
-	536, 0, 0, 546, 265, 265, 265, 265, 0, 208,
-	0, 0, 0, 0, 0, 0, 0, 208, 849, 0,
-	1197, 208, 208, 1363, 0, 0, 608, 0, 617, 0,
-	0, 0, 0, 1122, 340, 340, 535, 534, 544, 545,
-	537, 538, 539, 540, 541, 542, 543, 536, 0, 815,
-	546, 0, 1382, 535, 534, 544, 545, 537, 538, 539,
-	540, 541, 542, 543, 536, 0, 0, 546, 0, 0,
-	0, 1388, 0, 0, 0, 0, 0, 0, 0, 1226,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 976,
-	0, 0, 208, 0, 1273, 0, 0, 0, 0, 0,
-	0, 208, 208, 0, 208, 208, 0, 0, 208, 535,
-	534, 544, 545, 537, 538, 539, 540, 541, 542, 543,
-	536, 0, 0, 546, 0, 208, 0, 950, 951, 0,
-	208, 0, 0, 0, 0, 711, 0, 0, 0, 0,
-	638, 0, 0, 0, 0, 0, 0, 265, 0, 0,
-	694, 695, 0, 0, 0, 0, 701, 0, 0, 331,
-	0, 0, 707, 0, 0, 0, 0, 24, 25, 52,
-	27, 28, 0, 0, 0, 0, 718, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 43, 0, 0, 0,
-	0, 29, 48, 49, 265, 1292, 1293, 1294, 1295, 1296,
-	0, 0, 0, 1299, 1300, 0, 0, 0, 0, 0,
-	0, 38, 265, 0, 0, 54, 747, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	816, 208, 208, 208, 208, 208, 0, 0, 0, 0,
-	0, 0, 0, 1031, 0, 0, 208, 0, 0, 0,
-	849, 0, 0, 0, 208, 535, 534, 544, 545, 537,
-	538, 539, 540, 541, 542, 543, 536, 0, 0, 546,
-	0, 0, 0, 0, 0, 0, 31, 32, 34, 33,
-	36, 0, 50, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 37, 44, 45, 827, 0, 46, 47,
-	35, 0, 0, 0, 0, 0, 0, 0, 0, 854,
-	0, 0, 0, 39, 40, 0, 41, 42, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 208, 0, 0, 1413, 0, 0, 0, 0, 0,
-	0, 265, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 0, 0, 0, 0, 0, 0, 916,
-	0, 0, 0, 0, 0, 0, 0, 0, 938, 939,
-	0, 942, 943, 711, 0, 944, 0, 0, 0, 0,
-	0, 0, 816, 53, 0, 0, 0, 0, 0, 0,
-	0, 0, 946, 0, 0, 0, 0, 952, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 208, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 208,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	208, 0, 0, 0, 0, 0, 0, 0, 0, 208,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 816, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1115, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 849,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	816, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 208, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1209,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1212, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1221, 0, 428,
-	417, 0, 388, 431, 366, 380, 439, 381, 382, 410,
-	352, 396, 139, 378, 0, 369, 347, 375, 348, 367,
-	390, 103, 393, 365, 419, 399, 430, 120, 437, 122,
-	404, 0, 160, 131, 0, 0, 392, 421, 394, 415,
-	387, 411, 357, 403, 432, 379, 408, 433, 0, 0,
-	0, 80, 0, 870, 871, 0, 0, 0, 0, 0,
-	96, 0, 406, 427, 377, 407, 409, 346, 405, 0,
-	350, 353, 438, 423, 372, 373, 1052, 0, 0, 0,
-	0, 0, 0, 391, 395, 412, 385, 0, 0, 0,
-	0, 0, 0, 0, 0, 370, 0, 402, 0, 0,
-	0, 354, 351, 0, 0, 389, 0, 0, 0, 356,
-	0, 371, 413, 0, 345, 109, 416, 422, 386, 211,
-	426, 384, 383, 429, 146, 0, 163, 111, 119, 83,
-	90, 0, 110, 137, 151, 155, 420, 368, 376, 99,
-	374, 153, 141, 176, 401, 142, 152, 123, 168, 147,
-	175, 183, 184, 165, 182, 191, 84, 164, 174, 97,
-	156, 86, 172, 162, 129, 115, 116, 85, 0, 150,
-	102, 107, 101, 138, 169, 170, 100, 194, 91, 181,
-	88, 92, 180, 136, 167, 173, 130, 127, 87, 171,
-	128, 126, 118, 105, 112, 144, 125, 145, 113, 133,
-	132, 134, 0, 349, 0, 161, 178, 195, 94, 364,
-	157, 166, 185, 186, 187, 188, 189, 190, 0, 0,
-	95, 108, 104, 143, 135, 93, 114, 158, 117, 124,
-	149, 193, 140, 154, 98, 177, 159, 360, 363, 358,
-	359, 397, 398, 434, 435, 436, 414, 355, 0, 361,
-	362, 1384, 418, 424, 425, 400, 82, 89, 121, 192,
-	148, 106, 179, 428, 417, 0, 388, 431, 366, 380,
-	439, 381, 382, 410, 352, 396, 139, 378, 0, 369,
-	347, 375, 348, 367, 390, 103, 393, 365, 419, 399,
-	430, 120, 437, 122, 404, 0, 160, 131, 0, 0,
-	392, 421, 394, 415, 387, 411, 357, 403, 432, 379,
-	408, 433, 0, 0, 0, 80, 0, 870, 871, 0,
-	0, 0, 0, 0, 96, 0, 406, 427, 377, 407,
-	409, 346, 405, 0, 350, 353, 438, 423, 372, 373,
-	0, 0, 0, 0, 0, 0, 0, 391, 395, 412,
-	385, 0, 0, 0, 0, 0, 0, 0, 0, 370,
-	0, 402, 0, 0, 0, 354, 351, 0, 0, 389,
-	0, 0, 0, 356, 0, 371, 413, 0, 345, 109,
-	416, 422, 386, 211, 426, 384, 383, 429, 146, 0,
-	163, 111, 119, 83, 90, 0, 110, 137, 151, 155,
-	420, 368, 376, 99, 374, 153, 141, 176, 401, 142,
-	152, 123, 168, 147, 175, 183, 184, 165, 182, 191,
-	84, 164, 174, 97, 156, 86, 172, 162, 129, 115,
-	116, 85, 0, 150, 102, 107, 101, 138, 169, 170,
-	100, 194, 91, 181, 88, 92, 180, 136, 167, 173,
-	130, 127, 87, 171, 128, 126, 118, 105, 112, 144,
-	125, 145, 113, 133, 132, 134, 0, 349, 0, 161,
-	178, 195, 94, 364, 157, 166, 185, 186, 187, 188,
-	189, 190, 0, 0, 95, 108, 104, 143, 135, 93,
-	114, 158, 117, 124, 149, 193, 140, 154, 98, 177,
-	159, 360, 363, 358, 359, 397, 398, 434, 435, 436,
-	414, 355, 0, 361, 362, 0, 418, 424, 425, 400,
-	82, 89, 121, 192, 148, 106, 179, 428, 417, 0,
-	388, 431, 366, 380, 439, 381, 382, 410, 352, 396,
-	139, 378, 0, 369, 347, 375, 348, 367, 390, 103,
-	393, 365, 419, 399, 430, 120, 437, 122, 404, 0,
-	160, 131, 0, 0, 392, 421, 394, 415, 387, 411,
-	357, 403, 432, 379, 408, 433, 54, 0, 0, 80,
-	0, 0, 0, 0, 0, 0, 0, 0, 96, 0,
-	406, 427, 377, 407, 409, 346, 405, 0, 350, 353,
-	438, 423, 372, 373, 0, 0, 0, 0, 0, 0,
-	0, 391, 395, 412, 385, 0, 0, 0, 0, 0,
-	0, 0, 0, 370, 0, 402, 0, 0, 0, 354,
-	351, 0, 0, 389, 0, 0, 0, 356, 0, 371,
-	413, 0, 345, 109, 416, 422, 386, 211, 426, 384,
-	383, 429, 146, 0, 163, 111, 119, 83, 90, 0,
-	110, 137, 151, 155, 420, 368, 376, 99, 374, 153,
-	141, 176, 401, 142, 152, 123, 168, 147, 175, 183,
-	184, 165, 182, 191, 84, 164, 174, 97, 156, 86,
-	172, 162, 129, 115, 116, 85, 0, 150, 102, 107,
-	101, 138, 169, 170, 100, 194, 91, 181, 88, 92,
-	180, 136, 167, 173, 130, 127, 87, 171, 128, 126,
-	118, 105, 112, 144, 125, 145, 113, 133, 132, 134,
-	0, 349, 0, 161, 178, 195, 94, 364, 157, 166,
-	185, 186, 187, 188, 189, 190, 0, 0, 95, 108,
-	104, 143, 135, 93, 114, 158, 117, 124, 149, 193,
-	140, 154, 98, 177, 159, 360, 363, 358, 359, 397,
-	398, 434, 435, 436, 414, 355, 0, 361, 362, 0,
-	418, 424, 425, 400, 82, 89, 121, 192, 148, 106,
-	179, 428, 417, 0, 388, 431, 366, 380, 439, 381,
-	382, 410, 352, 396, 139, 378, 0, 369, 347, 375,
-	348, 367, 390, 103, 393, 365, 419, 399, 430, 120,
-	437, 122, 404, 0, 160, 131, 0, 0, 392, 421,
-	394, 415, 387, 411, 357, 403, 432, 379, 408, 433,
-	0, 0, 0, 80, 0, 0, 0, 0, 0, 0,
-	0, 0, 96, 0, 406, 427, 377, 407, 409, 346,
-	405, 0, 350, 353, 438, 423, 372, 373, 0, 0,
-	0, 0, 0, 0, 0, 391, 395, 412, 385, 0,
-	0, 0, 0, 0, 0, 1118, 0, 370, 0, 402,
-	0, 0, 0, 354, 351, 0, 0, 389, 0, 0,
-	0, 356, 0, 371, 413, 0, 345, 109, 416, 422,
-	386, 211, 426, 384, 383, 429, 146, 0, 163, 111,
-	119, 83, 90, 0, 110, 137, 151, 155, 420, 368,
-	376, 99, 374, 153, 141, 176, 401, 142, 152, 123,
-	168, 147, 175, 183, 184, 165, 182, 191, 84, 164,
-	174, 97, 156, 86, 172, 162, 129, 115, 116, 85,
-	0, 150, 102, 107, 101, 138, 169, 170, 100, 194,
-	91, 181, 88, 92, 180, 136, 167, 173, 130, 127,
-	87, 171, 128, 126, 118, 105, 112, 144, 125, 145,
-	113, 133, 132, 134, 0, 349, 0, 161, 178, 195,
-	94, 364, 157, 166, 185, 186, 187, 188, 189, 190,
-	0, 0, 95, 108, 104, 143, 135, 93, 114, 158,
-	117, 124, 149, 193, 140, 154, 98, 177, 159, 360,
-	363, 358, 359, 397, 398, 434, 435, 436, 414, 355,
-	0, 361, 362, 0, 418, 424, 425, 400, 82, 89,
-	121, 192, 148, 106, 179, 428, 417, 0, 388, 431,
-	366, 380, 439, 381, 382, 410, 352, 396, 139, 378,
-	0, 369, 347, 375, 348, 367, 390, 103, 393, 365,
-	419, 399, 430, 120, 437, 122, 404, 0, 160, 131,
-	0, 0, 392, 421, 394, 415, 387, 411, 357, 403,
-	432, 379, 408, 433, 0, 0, 0, 270, 0, 0,
-	0, 0, 0, 0, 0, 0, 96, 0, 406, 427,
-	377, 407, 409, 346, 405, 0, 350, 353, 438, 423,
-	372, 373, 0, 0, 0, 0, 0, 0, 0, 391,
-	395, 412, 385, 0, 0, 0, 0, 0, 0, 756,
-	0, 370, 0, 402, 0, 0, 0, 354, 351, 0,
-	0, 389, 0, 0, 0, 356, 0, 371, 413, 0,
-	345, 109, 416, 422, 386, 211, 426, 384, 383, 429,
-	146, 0, 163, 111, 119, 83, 90, 0, 110, 137,
-	151, 155, 420, 368, 376, 99, 374, 153, 141, 176,
-	401, 142, 152, 123, 168, 147, 175, 183, 184, 165,
-	182, 191, 84, 164, 174, 97, 156, 86, 172, 162,
-	129, 115, 116, 85, 0, 150, 102, 107, 101, 138,
-	169, 170, 100, 194, 91, 181, 88, 92, 180, 136,
-	167, 173, 130, 127, 87, 171, 128, 126, 118, 105,
-	112, 144, 125, 145, 113, 133, 132, 134, 0, 349,
-	0, 161, 178, 195, 94, 364, 157, 166, 185, 186,
-	187, 188, 189, 190, 0, 0, 95, 108, 104, 143,
-	135, 93, 114, 158, 117, 124, 149, 193, 140, 154,
-	98, 177, 159, 360, 363, 358, 359, 397, 398, 434,
-	435, 436, 414, 355, 0, 361, 362, 0, 418, 424,
-	425, 400, 82, 89, 121, 192, 148, 106, 179, 428,
-	417, 0, 388, 431, 366, 380, 439, 381, 382, 410,
-	352, 396, 139, 378, 0, 369, 347, 375, 348, 367,
-	390, 103, 393, 365, 419, 399, 430, 120, 437, 122,
-	404, 0, 160, 131, 0, 0, 392, 421, 394, 415,
-	387, 411, 357, 403, 432, 379, 408, 433, 0, 0,
-	0, 80, 0, 0, 0, 0, 0, 0, 0, 0,
-	96, 0, 406, 427, 377, 407, 409, 346, 405, 0,
-	350, 353, 438, 423, 372, 373, 0, 0, 0, 0,
-	0, 0, 0, 391, 395, 412, 385, 0, 0, 0,
-	0, 0, 0, 0, 0, 370, 0, 402, 0, 0,
-	0, 354, 351, 0, 0, 389, 0, 0, 0, 356,
-	0, 371, 413, 0, 345, 109, 416, 422, 386, 211,
-	426, 384, 383, 429, 146, 0, 163, 111, 119, 83,
-	90, 0, 110, 137, 151, 155, 420, 368, 376, 99,
-	374, 153, 141, 176, 401, 142, 152, 123, 168, 147,
-	175, 183, 184, 165, 182, 191, 84, 164, 174, 97,
-	156, 86, 172, 162, 129, 115, 116, 85, 0, 150,
-	102, 107, 101, 138, 169, 170, 100, 194, 91, 181,
-	88, 92, 180, 136, 167, 173, 130, 127, 87, 171,
-	128, 126, 118, 105, 112, 144, 125, 145, 113, 133,
-	132, 134, 0, 349, 0, 161, 178, 195, 94, 364,
-	157, 166, 185, 186, 187, 188, 189, 190, 0, 0,
-	95, 108, 104, 143, 135, 93, 114, 158, 117, 124,
-	149, 193, 140, 154, 98, 177, 159, 360, 363, 358,
-	359, 397, 398, 434, 435, 436, 414, 355, 0, 361,
-	362, 0, 418, 424, 425, 400, 82, 89, 121, 192,
-	148, 106, 179, 428, 417, 0, 388, 431, 366, 380,
-	439, 381, 382, 410, 352, 396, 139, 378, 0, 369,
-	347, 375, 348, 367, 390, 103, 393, 365, 419, 399,
-	430, 120, 437, 122, 404, 0, 160, 131, 0, 0,
-	392, 421, 394, 415, 387, 411, 357, 403, 432, 379,
-	408, 433, 0, 0, 0, 270, 0, 0, 0, 0,
-	0, 0, 0, 0, 96, 0, 406, 427, 377, 407,
-	409, 346, 405, 0, 350, 353, 438, 423, 372, 373,
-	0, 0, 0, 0, 0, 0, 0, 391, 395, 412,
-	385, 0, 0, 0, 0, 0, 0, 0, 0, 370,
-	0, 402, 0, 0, 0, 354, 351, 0, 0, 389,
-	0, 0, 0, 356, 0, 371, 413, 0, 345, 109,
-	416, 422, 386, 211, 426, 384, 383, 429, 146, 0,
-	163, 111, 119, 83, 90, 0, 110, 137, 151, 155,
-	420, 368, 376, 99, 374, 153, 141, 176, 401, 142,
-	152, 123, 168, 147, 175, 183, 184, 165, 182, 191,
-	84, 164, 174, 97, 156, 86, 172, 162, 129, 115,
-	116, 85, 0, 150, 102, 107, 101, 138, 169, 170,
-	100, 194, 91, 181, 88, 92, 180, 136, 167, 173,
-	130, 127, 87, 171, 128, 126, 118, 105, 112, 144,
-	125, 145, 113, 133, 132, 134, 0, 349, 0, 161,
-	178, 195, 94, 364, 157, 166, 185, 186, 187, 188,
-	189, 190, 0, 0, 95, 108, 104, 143, 135, 93,
-	114, 158, 117, 124, 149, 193, 140, 154, 98, 177,
-	159, 360, 363, 358, 359, 397, 398, 434, 435, 436,
-	414, 355, 0, 361, 362, 0, 418, 424, 425, 400,
-	82, 89, 121, 192, 148, 106, 179, 428, 417, 0,
-	388, 431, 366, 380, 439, 381, 382, 410, 352, 396,
-	139, 378, 0, 369, 347, 375, 348, 367, 390, 103,
-	393, 365, 419, 399, 430, 120, 437, 122, 404, 0,
-	160, 131, 0, 0, 392, 421, 394, 415, 387, 411,
-	357, 403, 432, 379, 408, 433, 0, 0, 0, 80,
-	0, 0, 0, 0, 0, 0, 0, 0, 96, 0,
-	406, 427, 377, 407, 409, 346, 405, 0, 350, 353,
-	438, 423, 372, 373, 0, 0, 0, 0, 0, 0,
-	0, 391, 395, 412, 385, 0, 0, 0, 0, 0,
-	0, 0, 0, 370, 0, 402, 0, 0, 0, 354,
-	351, 0, 0, 389, 0, 0, 0, 356, 0, 371,
-	413, 0, 345, 109, 416, 422, 386, 211, 426, 384,
-	383, 429, 146, 0, 163, 111, 119, 83, 90, 0,
-	110, 137, 151, 155, 420, 368, 376, 99, 374, 153,
-	141, 176, 401, 142, 152, 123, 168, 147, 175, 183,
-	184, 165, 182, 191, 84, 164, 174, 97, 156, 86,
-	172, 162, 129, 115, 116, 85, 0, 150, 102, 107,
-	101, 138, 169, 170, 100, 194, 91, 181, 88, 343,
-	180, 136, 167, 173, 130, 127, 87, 171, 128, 126,
-	118, 105, 112, 144, 125, 145, 113, 133, 132, 134,
-	0, 349, 0, 161, 178, 195, 94, 364, 157, 166,
-	185, 186, 187, 188, 189, 190, 0, 0, 95, 108,
-	104, 143, 344, 342, 114, 158, 117, 124, 149, 193,
-	140, 154, 98, 177, 159, 360, 363, 358, 359, 397,
-	398, 434, 435, 436, 414, 355, 0, 361, 362, 0,
-	418, 424, 425, 400, 82, 89, 121, 192, 148, 106,
-	179, 428, 417, 0, 388, 431, 366, 380, 439, 381,
-	382, 410, 352, 396, 139, 378, 0, 369, 347, 375,
-	348, 367, 390, 103, 393, 365, 419, 399, 430, 120,
-	437, 122, 404, 0, 160, 131, 0, 0, 392, 421,
-	394, 415, 387, 411, 357, 403, 432, 379, 408, 433,
-	0, 0, 0, 209, 0, 0, 0, 0, 0, 0,
-	0, 0, 96, 0, 406, 427, 377, 407, 409, 346,
-	405, 0, 350, 353, 438, 423, 372, 373, 0, 0,
-	0, 0, 0, 0, 0, 391, 395, 412, 385, 0,
-	0, 0, 0, 0, 0, 0, 0, 370, 0, 402,
-	0, 0, 0, 354, 351, 0, 0, 389, 0, 0,
-	0, 356, 0, 371, 413, 0, 345, 109, 416, 422,
-	386, 211, 426, 384, 383, 429, 146, 0, 163, 111,
-	119, 83, 90, 0, 110, 137, 151, 155, 420, 368,
-	376, 99, 374, 153, 141, 176, 401, 142, 152, 123,
-	168, 147, 175, 183, 184, 165, 182, 191, 84, 164,
-	174, 97, 156, 86, 172, 162, 129, 115, 116, 85,
-	0, 150, 102, 107, 101, 138, 169, 170, 100, 194,
-	91, 181, 88, 92, 180, 136, 167, 173, 130, 127,
-	87, 171, 128, 126, 118, 105, 112, 144, 125, 145,
-	113, 133, 132, 134, 0, 349, 0, 161, 178, 195,
-	94, 364, 157, 166, 185, 186, 187, 188, 189, 190,
-	0, 0, 95, 108, 104, 143, 135, 93, 114, 158,
-	117, 124, 149, 193, 140, 154, 98, 177, 159, 360,
-	363, 358, 359, 397, 398, 434, 435, 436, 414, 355,
-	0, 361, 362, 0, 418, 424, 425, 400, 82, 89,
-	121, 192, 148, 106, 179, 428, 417, 0, 388, 431,
-	366, 380, 439, 381, 382, 410, 352, 396, 139, 378,
-	0, 369, 347, 375, 348, 367, 390, 103, 393, 365,
-	419, 399, 430, 120, 437, 122, 404, 0, 160, 131,
-	0, 0, 392, 421, 394, 415, 387, 411, 357, 403,
-	432, 379, 408, 433, 0, 0, 0, 80, 0, 0,
-	0, 0, 0, 0, 0, 0, 96, 0, 406, 427,
-	377, 407, 409, 346, 405, 0, 350, 353, 438, 423,
-	372, 373, 0, 0, 0, 0, 0, 0, 0, 391,
-	395, 412, 385, 0, 0, 0, 0, 0, 0, 0,
-	0, 370, 0, 402, 0, 0, 0, 354, 351, 0,
-	0, 389, 0, 0, 0, 356, 0, 371, 413, 0,
-	345, 109, 416, 422, 386, 211, 426, 384, 383, 429,
-	146, 0, 163, 111, 119, 83, 90, 0, 110, 137,
-	151, 155, 420, 368, 376, 99, 374, 153, 141, 176,
-	401, 142, 152, 123, 168, 147, 175, 183, 184, 165,
-	182, 191, 84, 164, 625, 97, 156, 86, 172, 162,
-	129, 115, 116, 85, 0, 150, 102, 107, 101, 138,
-	169, 170, 100, 194, 91, 181, 88, 343, 180, 136,
-	167, 173, 130, 127, 87, 171, 128, 126, 118, 105,
-	112, 144, 125, 145, 113, 133, 132, 134, 0, 349,
-	0, 161, 178, 195, 94, 364, 157, 166, 185, 186,
-	187, 188, 189, 190, 0, 0, 95, 108, 104, 143,
-	344, 342, 114, 158, 117, 124, 149, 193, 140, 154,
-	98, 177, 159, 360, 363, 358, 359, 397, 398, 434,
-	435, 436, 414, 355, 0, 361, 362, 0, 418, 424,
-	425, 400, 82, 89, 121, 192, 148, 106, 179, 428,
-	417, 0, 388, 431, 366, 380, 439, 381, 382, 410,
-	352, 396, 139, 378, 0, 369, 347, 375, 348, 367,
-	390, 103, 393, 365, 419, 399, 430, 120, 437, 122,
-	404, 0, 160, 131, 0, 0, 392, 421, 394, 415,
-	387, 411, 357, 403, 432, 379, 408, 433, 0, 0,
-	0, 80, 0, 0, 0, 0, 0, 0, 0, 0,
-	96, 0, 406, 427, 377, 407, 409, 346, 405, 0,
-	350, 353, 438, 423, 372, 373, 0, 0, 0, 0,
-	0, 0, 0, 391, 395, 412, 385, 0, 0, 0,
-	0, 0, 0, 0, 0, 370, 0, 402, 0, 0,
-	0, 354, 351, 0, 0, 389, 0, 0, 0, 356,
-	0, 371, 413, 0, 345, 109, 416, 422, 386, 211,
-	426, 384, 383, 429, 146, 0, 163, 111, 119, 83,
-	90, 0, 110, 137, 151, 155, 420, 368, 376, 99,
-	374, 153, 141, 176, 401, 142, 152, 123, 168, 147,
-	175, 183, 184, 165, 182, 191, 84, 164, 334, 97,
-	156, 86, 172, 162, 129, 115, 116, 85, 0, 150,
-	102, 107, 101, 138, 169, 170, 100, 194, 91, 181,
-	88, 343, 180, 136, 167, 173, 130, 127, 87, 171,
-	128, 126, 118, 105, 112, 144, 125, 145, 113, 133,
-	132, 134, 0, 349, 0, 161, 178, 195, 94, 364,
-	157, 166, 185, 186, 187, 188, 189, 190, 0, 0,
-	95, 108, 104, 143, 344, 342, 337, 336, 117, 124,
-	149, 193, 140, 154, 98, 177, 159, 360, 363, 358,
-	359, 397, 398, 434, 435, 436, 414, 355, 0, 361,
-	362, 0, 418, 424, 425, 400, 82, 89, 121, 192,
-	148, 106, 179, 139, 0, 0, 0, 0, 272, 0,
-	0, 0, 103, 0, 269, 0, 0, 0, 120, 312,
-	122, 0, 0, 160, 131, 0, 0, 0, 0, 303,
-	304, 0, 0, 0, 0, 0, 0, 861, 0, 54,
-	0, 0, 270, 291, 290, 293, 294, 295, 296, 0,
-	0, 96, 292, 297, 298, 299, 862, 0, 0, 267,
-	284, 0, 311, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 281, 282, 0, 0, 0, 0, 325, 0,
-	283, 0, 0, 278, 279, 280, 285, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 109, 0, 0, 0,
-	211, 0, 0, 323, 0, 146, 0, 163, 111, 119,
-	83, 90, 0, 110, 137, 151, 155, 0, 0, 0,
-	99, 0, 153, 141, 176, 0, 142, 152, 123, 168,
-	147, 175, 183, 184, 165, 182, 191, 84, 164, 174,
-	97, 156, 86, 172, 162, 129, 115, 116, 85, 0,
-	150, 102, 107, 101, 138, 169, 170, 100, 194, 91,
-	181, 88, 92, 180, 136, 167, 173, 130, 127, 87,
-	171, 128, 126, 118, 105, 112, 144, 125, 145, 113,
-	133, 132, 134, 0, 0, 0, 161, 178, 195, 94,
-	0, 157, 166, 185, 186, 187, 188, 189, 190, 0,
-	0, 95, 108, 104, 143, 135, 93, 114, 158, 117,
-	124, 149, 193, 140, 154, 98, 177, 159, 313, 324,
-	319, 320, 317, 318, 316, 315, 314, 326, 305, 306,
-	307, 308, 310, 0, 321, 322, 309, 82, 89, 121,
-	192, 148, 106, 179, 139, 0, 0, 792, 0, 272,
-	0, 0, 0, 103, 0, 269, 0, 0, 0, 120,
-	312, 122, 0, 0, 160, 131, 0, 0, 0, 0,
-	303, 304, 0, 0, 0, 0, 0, 0, 0, 0,
-	54, 0, 0, 270, 291, 290, 293, 294, 295, 296,
-	0, 0, 96, 292, 297, 298, 299, 0, 0, 0,
-	267, 284, 0, 311, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 281, 282, 263, 0, 0, 0, 325,
-	0, 283, 0, 0, 278, 279, 280, 285, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 109, 0, 0,
-	0, 211, 0, 0, 323, 0, 146, 0, 163, 111,
-	119, 83, 90, 0, 110, 137, 151, 155, 0, 0,
-	0, 99, 0, 153, 141, 176, 0, 142, 152, 123,
-	168, 147, 175, 183, 184, 165, 182, 191, 84, 164,
-	174, 97, 156, 86, 172, 162, 129, 115, 116, 85,
-	0, 150, 102, 107, 101, 138, 169, 170, 100, 194,
-	91, 181, 88, 92, 180, 136, 167, 173, 130, 127,
-	87, 171, 128, 126, 118, 105, 112, 144, 125, 145,
-	113, 133, 132, 134, 0, 0, 0, 161, 178, 195,
-	94, 0, 157, 166, 185, 186, 187, 188, 189, 190,
-	0, 0, 95, 108, 104, 143, 135, 93, 114, 158,
-	117, 124, 149, 193, 140, 154, 98, 177, 159, 313,
-	324, 319, 320, 317, 318, 316, 315, 314, 326, 305,
-	306, 307, 308, 310, 0, 321, 322, 309, 82, 89,
-	121, 192, 148, 106, 179, 139, 0, 0, 0, 0,
-	272, 0, 0, 0, 103, 0, 269, 0, 0, 0,
-	120, 312, 122, 0, 0, 160, 131, 0, 0, 0,
-	0, 303, 304, 0, 0, 0, 0, 0, 0, 0,
-	0, 54, 0, 501, 270, 291, 290, 293, 294, 295,
-	296, 0, 0, 96, 292, 297, 298, 299, 0, 0,
-	0, 267, 284, 0, 311, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 281, 282, 0, 0, 0, 0,
-	325, 0, 283, 0, 0, 278, 279, 280, 285, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 109, 0,
-	0, 0, 211, 0, 0, 323, 0, 146, 0, 163,
-	111, 119, 83, 90, 0, 110, 137, 151, 155, 0,
-	0, 0, 99, 0, 153, 141, 176, 0, 142, 152,
-	123, 168, 147, 175, 183, 184, 165, 182, 191, 84,
-	164, 174, 97, 156, 86, 172, 162, 129, 115, 116,
-	85, 0, 150, 102, 107, 101, 138, 169, 170, 100,
-	194, 91, 181, 88, 92, 180, 136, 167, 173, 130,
-	127, 87, 171, 128, 126, 118, 105, 112, 144, 125,
-	145, 113, 133, 132, 134, 0, 0, 0, 161, 178,
-	195, 94, 0, 157, 166, 185, 186, 187, 188, 189,
-	190, 0, 0, 95, 108, 104, 143, 135, 93, 114,
-	158, 117, 124, 149, 193, 140, 154, 98, 177, 159,
-	313, 324, 319, 320, 317, 318, 316, 315, 314, 326,
-	305, 306, 307, 308, 310, 0, 321, 322, 309, 82,
-	89, 121, 192, 148, 106, 179, 139, 0, 0, 0,
-	0, 272, 0, 0, 0, 103, 0, 269, 0, 0,
-	0, 120, 312, 122, 0, 0, 160, 131, 0, 0,
-	0, 0, 303, 304, 0, 0, 0, 0, 0, 0,
-	0, 0, 54, 0, 0, 270, 291, 290, 293, 294,
-	295, 296, 0, 0, 96, 292, 297, 298, 299, 0,
-	0, 0, 267, 284, 0, 311, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 281, 282, 263, 0, 0,
-	0, 325, 0, 283, 0, 0, 278, 279, 280, 285,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 109,
-	0, 0, 0, 211, 0, 0, 323, 0, 146, 0,
-	163, 111, 119, 83, 90, 0, 110, 137, 151, 155,
-	0, 0, 0, 99, 0, 153, 141, 176, 0, 142,
-	152, 123, 168, 147, 175, 183, 184, 165, 182, 191,
-	84, 164, 174, 97, 156, 86, 172, 162, 129, 115,
-	116, 85, 0, 150, 102, 107, 101, 138, 169, 170,
-	100, 194, 91, 181, 88, 92, 180, 136, 167, 173,
-	130, 127, 87, 171, 128, 126, 118, 105, 112, 144,
-	125, 145, 113, 133, 132, 134, 0, 0, 0, 161,
-	178, 195, 94, 0, 157, 166, 185, 186, 187, 188,
-	189, 190, 0, 0, 95, 108, 104, 143, 135, 93,
-	114, 158, 117, 124, 149, 193, 140, 154, 98, 177,
-	159, 313, 324, 319, 320, 317, 318, 316, 315, 314,
-	326, 305, 306, 307, 308, 310, 0, 321, 322, 309,
-	82, 89, 121, 192, 148, 106, 179, 139, 0, 0,
-	0, 0, 272, 0, 0, 0, 103, 0, 269, 0,
-	0, 0, 120, 312, 122, 0, 0, 160, 131, 0,
-	0, 0, 0, 303, 304, 0, 0, 0, 0, 0,
-	0, 0, 0, 54, 0, 0, 270, 291, 807, 293,
-	294, 295, 296, 0, 0, 96, 292, 297, 298, 299,
-	0, 0, 0, 267, 284, 0, 311, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 281, 282, 263, 0,
-	0, 0, 325, 0, 283, 0, 0, 278, 279, 280,
-	285, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	109, 0, 0, 0, 211, 0, 0, 323, 0, 146,
-	0, 163, 111, 119, 83, 90, 0, 110, 137, 151,
-	155, 0, 0, 0, 99, 0, 153, 141, 176, 0,
-	142, 152, 123, 168, 147, 175, 183, 184, 165, 182,
-	191, 84, 164, 174, 97, 156, 86, 172, 162, 129,
-	115, 116, 85, 0, 150, 102, 107, 101, 138, 169,
-	170, 100, 194, 91, 181, 88, 92, 180, 136, 167,
-	173, 130, 127, 87, 171, 128, 126, 118, 105, 112,
-	144, 125, 145, 113, 133, 132, 134, 0, 0, 0,
-	161, 178, 195, 94, 0, 157, 166, 185, 186, 187,
-	188, 189, 190, 0, 0, 95, 108, 104, 143, 135,
-	93, 114, 158, 117, 124, 149, 193, 140, 154, 98,
-	177, 159, 313, 324, 319, 320, 317, 318, 316, 315,
-	314, 326, 305, 306, 307, 308, 310, 0, 321, 322,
-	309, 82, 89, 121, 192, 148, 106, 179, 139, 0,
-	0, 0, 0, 272, 0, 0, 0, 103, 0, 269,
-	0, 0, 0, 120, 312, 122, 0, 0, 160, 131,
-	0, 0, 0, 0, 303, 304, 0, 0, 0, 0,
-	0, 0, 0, 0, 54, 0, 0, 270, 291, 804,
-	293, 294, 295, 296, 0, 0, 96, 292, 297, 298,
-	299, 0, 0, 0, 267, 284, 0, 311, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 281, 282, 263,
-	0, 0, 0, 325, 0, 283, 0, 0, 278, 279,
-	280, 285, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 109, 0, 0, 0, 211, 0, 0, 323, 0,
-	146, 0, 163, 111, 119, 83, 90, 0, 110, 137,
-	151, 155, 0, 0, 0, 99, 0, 153, 141, 176,
-	0, 142, 152, 123, 168, 147, 175, 183, 184, 165,
-	182, 191, 84, 164, 174, 97, 156, 86, 172, 162,
-	129, 115, 116, 85, 0, 150, 102, 107, 101, 138,
-	169, 170, 100, 194, 91, 181, 88, 92, 180, 136,
-	167, 173, 130, 127, 87, 171, 128, 126, 118, 105,
-	112, 144, 125, 145, 113, 133, 132, 134, 0, 0,
-	0, 161, 178, 195, 94, 0, 157, 166, 185, 186,
-	187, 188, 189, 190, 0, 0, 95, 108, 104, 143,
-	135, 93, 114, 158, 117, 124, 149, 193, 140, 154,
-	98, 177, 159, 313, 324, 319, 320, 317, 318, 316,
-	315, 314, 326, 305, 306, 307, 308, 310, 24, 321,
-	322, 309, 82, 89, 121, 192, 148, 106, 179, 0,
-	139, 0, 0, 0, 0, 272, 0, 0, 0, 103,
-	0, 269, 0, 0, 0, 120, 312, 122, 0, 0,
-	160, 131, 0, 0, 0, 0, 303, 304, 0, 0,
-	0, 0, 0, 0, 0, 0, 54, 0, 0, 270,
-	291, 290, 293, 294, 295, 296, 0, 0, 96, 292,
-	297, 298, 299, 0, 0, 0, 267, 284, 0, 311,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 281,
-	282, 0, 0, 0, 0, 325, 0, 283, 0, 0,
-	278, 279, 280, 285, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 109, 0, 0, 0, 211, 0, 0,
-	323, 0, 146, 0, 163, 111, 119, 83, 90, 0,
-	110, 137, 151, 155, 0, 0, 0, 99, 0, 153,
-	141, 176, 0, 142, 152, 123, 168, 147, 175, 183,
-	184, 165, 182, 191, 84, 164, 174, 97, 156, 86,
-	172, 162, 129, 115, 116, 85, 0, 150, 102, 107,
-	101, 138, 169, 170, 100, 194, 91, 181, 88, 92,
-	180, 136, 167, 173, 130, 127, 87, 171, 128, 126,
-	118, 105, 112, 144, 125, 145, 113, 133, 132, 134,
-	0, 0, 0, 161, 178, 195, 94, 0, 157, 166,
-	185, 186, 187, 188, 189, 190, 0, 0, 95, 108,
-	104, 143, 135, 93, 114, 158, 117, 124, 149, 193,
-	140, 154, 98, 177, 159, 313, 324, 319, 320, 317,
-	318, 316, 315, 314, 326, 305, 306, 307, 308, 310,
-	0, 321, 322, 309, 82, 89, 121, 192, 148, 106,
-	179, 139, 0, 0, 0, 0, 272, 0, 0, 0,
-	103, 0, 269, 0, 0, 0, 120, 312, 122, 0,
-	0, 160, 131, 0, 0, 0, 0, 303, 304, 0,
-	0, 0, 0, 0, 0, 0, 0, 54, 0, 0,
-	270, 291, 290, 293, 294, 295, 296, 0, 0, 96,
-	292, 297, 298, 299, 0, 0, 0, 267, 284, 0,
-	311, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	281, 282, 0, 0, 0, 0, 325, 0, 283, 0,
-	0, 278, 279, 280, 285, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 109, 0, 0, 0, 211, 0,
-	0, 323, 0, 146, 0, 163, 111, 119, 83, 90,
-	0, 110, 137, 151, 155, 0, 0, 0, 99, 0,
-	153, 141, 176, 0, 142, 152, 123, 168, 147, 175,
-	183, 184, 165, 182, 191, 84, 164, 174, 97, 156,
-	86, 172, 162, 129, 115, 116, 85, 0, 150, 102,
-	107, 101, 138, 169, 170, 100, 194, 91, 181, 88,
-	92, 180, 136, 167, 173, 130, 127, 87, 171, 128,
-	126, 118, 105, 112, 144, 125, 145, 113, 133, 132,
-	134, 0, 0, 0, 161, 178, 195, 94, 0, 157,
-	166, 185, 186, 187, 188, 189, 190, 0, 0, 95,
-	108, 104, 143, 135, 93, 114, 158, 117, 124, 149,
-	193, 140, 154, 98, 177, 159, 313, 324, 319, 320,
-	317, 318, 316, 315, 314, 326, 305, 306, 307, 308,
-	310, 0, 321, 322, 309, 82, 89, 121, 192, 148,
-	106, 179, 139, 0, 0, 0, 0, 0, 0, 0,
-	0, 103, 0, 0, 0, 0, 0, 120, 312, 122,
-	0, 0, 160, 131, 0, 0, 0, 0, 303, 304,
-	0, 0, 0, 0, 0, 0, 0, 0, 54, 0,
-	0, 270, 291, 290, 293, 294, 295, 296, 0, 0,
-	96, 292, 297, 298, 299, 0, 0, 0, 0, 284,
-	0, 311, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 281, 282, 0, 0, 0, 0, 325, 0, 283,
-	0, 0, 278, 279, 280, 285, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 109, 0, 0, 0, 211,
-	0, 0, 323, 0, 146, 0, 163, 111, 119, 83,
-	90, 0, 110, 137, 151, 155, 0, 0, 0, 99,
-	0, 153, 141, 176, 1414, 142, 152, 123, 168, 147,
-	175, 183, 184, 165, 182, 191, 84, 164, 174, 97,
-	156, 86, 172, 162, 129, 115, 116, 85, 0, 150,
-	102, 107, 101, 138, 169, 170, 100, 194, 91, 181,
-	88, 92, 180, 136, 167, 173, 130, 127, 87, 171,
-	128, 126, 118, 105, 112, 144, 125, 145, 113, 133,
-	132, 134, 0, 0, 0, 161, 178, 195, 94, 0,
-	157, 166, 185, 186, 187, 188, 189, 190, 0, 0,
-	95, 108, 104, 143, 135, 93, 114, 158, 117, 124,
-	149, 193, 140, 154, 98, 177, 159, 313, 324, 319,
-	320, 317, 318, 316, 315, 314, 326, 305, 306, 307,
-	308, 310, 0, 321, 322, 309, 82, 89, 121, 192,
-	148, 106, 179, 139, 0, 0, 0, 0, 0, 0,
-	0, 0, 103, 0, 0, 0, 0, 0, 120, 312,
-	122, 0, 0, 160, 131, 0, 0, 0, 0, 303,
-	304, 0, 0, 0, 0, 0, 0, 0, 0, 54,
-	0, 501, 270, 291, 290, 293, 294, 295, 296, 0,
-	0, 96, 292, 297, 298, 299, 0, 0, 0, 0,
-	284, 0, 311, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 281, 282, 0, 0, 0, 0, 325, 0,
-	283, 0, 0, 278, 279, 280, 285, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 109, 0, 0, 0,
-	211, 0, 0, 323, 0, 146, 0, 163, 111, 119,
-	83, 90, 0, 110, 137, 151, 155, 0, 0, 0,
-	99, 0, 153, 141, 176, 0, 142, 152, 123, 168,
-	147, 175, 183, 184, 165, 182, 191, 84, 164, 174,
-	97, 156, 86, 172, 162, 129, 115, 116, 85, 0,
-	150, 102, 107, 101, 138, 169, 170, 100, 194, 91,
-	181, 88, 92, 180, 136, 167, 173, 130, 127, 87,
-	171, 128, 126, 118, 105, 112, 144, 125, 145, 113,
-	133, 132, 134, 0, 0, 0, 161, 178, 195, 94,
-	0, 157, 166, 185, 186, 187, 188, 189, 190, 0,
-	0, 95, 108, 104, 143, 135, 93, 114, 158, 117,
-	124, 149, 193, 140, 154, 98, 177, 159, 313, 324,
-	319, 320, 317, 318, 316, 315, 314, 326, 305, 306,
-	307, 308, 310, 0, 321, 322, 309, 82, 89, 121,
-	192, 148, 106, 179, 139, 0, 0, 0, 0, 0,
-	0, 0, 0, 103, 0, 0, 0, 0, 0, 120,
-	312, 122, 0, 0, 160, 131, 0, 0, 0, 0,
-	303, 304, 0, 0, 0, 0, 0, 0, 0, 0,
-	54, 0, 0, 270, 291, 290, 293, 294, 295, 296,
-	0, 0, 96, 292, 297, 298, 299, 0, 0, 0,
-	0, 284, 0, 311, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 281, 282, 0, 0, 0, 0, 325,
-	0, 283, 0, 0, 278, 279, 280, 285, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 109, 0, 0,
-	0, 211, 0, 0, 323, 0, 146, 0, 163, 111,
-	119, 83, 90, 0, 110, 137, 151, 155, 0, 0,
-	0, 99, 0, 153, 141, 176, 0, 142, 152, 123,
-	168, 147, 175, 183, 184, 165, 182, 191, 84, 164,
-	174, 97, 156, 86, 172, 162, 129, 115, 116, 85,
-	0, 150, 102, 107, 101, 138, 169, 170, 100, 194,
-	91, 181, 88, 92, 180, 136, 167, 173, 130, 127,
-	87, 171, 128, 126, 118, 105, 112, 144, 125, 145,
-	113, 133, 132, 134, 0, 0, 0, 161, 178, 195,
-	94, 0, 157, 166, 185, 186, 187, 188, 189, 190,
-	0, 0, 95, 108, 104, 143, 135, 93, 114, 158,
-	117, 124, 149, 193, 140, 154, 98, 177, 159, 313,
-	324, 319, 320, 317, 318, 316, 315, 314, 326, 305,
-	306, 307, 308, 310, 0, 321, 322, 309, 82, 89,
-	121, 192, 148, 106, 179, 139, 0, 0, 0, 0,
-	0, 0, 0, 0, 103, 0, 0, 0, 0, 0,
-	120, 0, 122, 0, 0, 160, 131, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 80, 0, 0, 0, 0, 0,
-	0, 0, 0, 96, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	535, 534, 544, 545, 537, 538, 539, 540, 541, 542,
-	543, 536, 0, 0, 546, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 109, 0,
-	0, 0, 211, 0, 0, 0, 0, 146, 0, 163,
-	111, 119, 83, 90, 0, 110, 137, 151, 155, 0,
-	0, 0, 99, 0, 153, 141, 176, 0, 142, 152,
-	123, 168, 147, 175, 183, 184, 165, 182, 191, 84,
-	164, 174, 97, 156, 86, 172, 162, 129, 115, 116,
-	85, 0, 150, 102, 107, 101, 138, 169, 170, 100,
-	194, 91, 181, 88, 92, 180, 136, 167, 173, 130,
-	127, 87, 171, 128, 126, 118, 105, 112, 144, 125,
-	145, 113, 133, 132, 134, 0, 0, 0, 161, 178,
-	195, 94, 0, 157, 166, 185, 186, 187, 188, 189,
-	190, 0, 0, 95, 108, 104, 143, 135, 93, 114,
-	158, 117, 124, 149, 193, 140, 154, 98, 177, 159,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	89, 121, 192, 148, 106, 179, 139, 0, 0, 0,
-	523, 0, 0, 0, 0, 103, 0, 0, 0, 0,
-	0, 120, 0, 122, 0, 0, 160, 131, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 80, 0, 525, 0, 0,
-	0, 0, 0, 0, 96, 0, 0, 0, 0, 0,
-	520, 519, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 521, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 109,
-	0, 0, 0, 211, 0, 0, 0, 0, 146, 0,
-	163, 111, 119, 83, 90, 0, 110, 137, 151, 155,
-	0, 0, 0, 99, 0, 153, 141, 176, 0, 142,
-	152, 123, 168, 147, 175, 183, 184, 165, 182, 191,
-	84, 164, 174, 97, 156, 86, 172, 162, 129, 115,
-	116, 85, 0, 150, 102, 107, 101, 138, 169, 170,
-	100, 194, 91, 181, 88, 92, 180, 136, 167, 173,
-	130, 127, 87, 171, 128, 126, 118, 105, 112, 144,
-	125, 145, 113, 133, 132, 134, 0, 0, 0, 161,
-	178, 195, 94, 0, 157, 166, 185, 186, 187, 188,
-	189, 190, 0, 0, 95, 108, 104, 143, 135, 93,
-	114, 158, 117, 124, 149, 193, 140, 154, 98, 177,
-	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 139, 0,
-	82, 89, 121, 192, 148, 106, 179, 103, 0, 0,
-	0, 0, 0, 120, 0, 122, 0, 0, 160, 131,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 80, 0, 0,
-	0, 0, 0, 0, 0, 0, 96, 0, 0, 0,
-	0, 0, 74, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 109, 76, 77, 0, 73, 0, 0, 0, 78,
-	146, 0, 163, 111, 119, 83, 90, 0, 110, 137,
-	151, 155, 0, 0, 0, 99, 0, 153, 141, 176,
-	0, 142, 152, 123, 168, 147, 175, 183, 184, 165,
-	182, 191, 84, 164, 174, 97, 156, 86, 172, 162,
-	129, 115, 116, 85, 0, 150, 102, 107, 101, 138,
-	169, 170, 100, 194, 91, 181, 88, 92, 180, 136,
-	167, 173, 130, 127, 87, 171, 128, 126, 118, 105,
-	112, 144, 125, 145, 113, 133, 132, 134, 0, 0,
-	0, 161, 178, 195, 94, 0, 157, 166, 185, 186,
-	187, 188, 189, 190, 0, 0, 95, 108, 104, 143,
-	135, 93, 114, 158, 117, 124, 149, 193, 140, 154,
-	98, 177, 159, 0, 75, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 89, 121, 192, 148, 106, 179, 139,
-	0, 0, 0, 848, 0, 0, 0, 0, 103, 0,
-	0, 0, 0, 0, 120, 0, 122, 0, 0, 160,
-	131, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 209, 0,
-	850, 0, 0, 0, 0, 0, 0, 96, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 109, 0, 0, 0, 211, 0, 0, 0,
-	0, 146, 0, 163, 111, 119, 83, 90, 0, 110,
-	137, 151, 155, 0, 0, 0, 99, 0, 153, 141,
-	176, 0, 142, 152, 123, 168, 147, 175, 183, 184,
-	165, 182, 191, 84, 164, 174, 97, 156, 86, 172,
-	162, 129, 115, 116, 85, 0, 150, 102, 107, 101,
-	138, 169, 170, 100, 194, 91, 181, 88, 92, 180,
-	136, 167, 173, 130, 127, 87, 171, 128, 126, 118,
-	105, 112, 144, 125, 145, 113, 133, 132, 134, 0,
-	0, 0, 161, 178, 195, 94, 0, 157, 166, 185,
-	186, 187, 188, 189, 190, 0, 0, 95, 108, 104,
-	143, 135, 93, 114, 158, 117, 124, 149, 193, 140,
-	154, 98, 177, 159, 0, 0, 0, 0, 0, 24,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 139, 0, 82, 89, 121, 192, 148, 106, 179,
-	103, 0, 0, 0, 0, 0, 120, 0, 122, 0,
-	0, 160, 131, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 54, 0, 0,
-	80, 0, 0, 0, 0, 0, 0, 0, 0, 96,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 109, 0, 0, 0, 211, 0,
-	0, 0, 0, 146, 0, 163, 111, 119, 83, 90,
-	0, 110, 137, 151, 155, 0, 0, 0, 99, 0,
-	153, 141, 176, 0, 142, 152, 123, 168, 147, 175,
-	183, 184, 165, 182, 191, 84, 164, 174, 97, 156,
-	86, 172, 162, 129, 115, 116, 85, 0, 150, 102,
-	107, 101, 138, 169, 170, 100, 194, 91, 181, 88,
-	92, 180, 136, 167, 173, 130, 127, 87, 171, 128,
-	126, 118, 105, 112, 144, 125, 145, 113, 133, 132,
-	134, 0, 0, 0, 161, 178, 195, 94, 0, 157,
-	166, 185, 186, 187, 188, 189, 190, 0, 0, 95,
-	108, 104, 143, 135, 93, 114, 158, 117, 124, 149,
-	193, 140, 154, 98, 177, 159, 0, 0, 0, 0,
-	0, 24, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 139, 0, 82, 89, 121, 192, 148,
-	106, 179, 103, 0, 0, 0, 0, 0, 120, 0,
-	122, 0, 0, 160, 131, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 54,
-	0, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	0, 96, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 109, 0, 0, 0,
-	211, 0, 0, 0, 0, 146, 0, 163, 111, 119,
-	83, 90, 0, 110, 137, 151, 155, 0, 0, 0,
-	99, 0, 153, 141, 176, 0, 142, 152, 123, 168,
-	147, 175, 183, 184, 165, 182, 191, 84, 164, 174,
-	97, 156, 86, 172, 162, 129, 115, 116, 85, 0,
-	150, 102, 107, 101, 138, 169, 170, 100, 194, 91,
-	181, 88, 92, 180, 136, 167, 173, 130, 127, 87,
-	171, 128, 126, 118, 105, 112, 144, 125, 145, 113,
-	133, 132, 134, 0, 0, 0, 161, 178, 195, 94,
-	0, 157, 166, 185, 186, 187, 188, 189, 190, 0,
-	0, 95, 108, 104, 143, 135, 93, 114, 158, 117,
-	124, 149, 193, 140, 154, 98, 177, 159, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 89, 121,
-	192, 148, 106, 179, 139, 0, 0, 0, 848, 0,
-	0, 0, 0, 103, 0, 0, 0, 0, 0, 120,
-	0, 122, 0, 0, 160, 131, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 209, 0, 850, 0, 0, 0, 0,
-	0, 0, 96, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 109, 0, 0,
-	0, 211, 0, 0, 0, 0, 146, 0, 163, 111,
-	119, 83, 90, 0, 110, 137, 151, 155, 0, 0,
-	0, 99, 0, 153, 141, 176, 0, 846, 152, 123,
-	168, 147, 175, 183, 184, 165, 182, 191, 84, 164,
-	174, 97, 156, 86, 172, 162, 129, 115, 116, 85,
-	0, 150, 102, 107, 101, 138, 169, 170, 100, 194,
-	91, 181, 88, 92, 180, 136, 167, 173, 130, 127,
-	87, 171, 128, 126, 118, 105, 112, 144, 125, 145,
-	113, 133, 132, 134, 0, 0, 0, 161, 178, 195,
-	94, 0, 157, 166, 185, 186, 187, 188, 189, 190,
-	0, 0, 95, 108, 104, 143, 135, 93, 114, 158,
-	117, 124, 149, 193, 140, 154, 98, 177, 159, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 139, 0, 82, 89,
-	121, 192, 148, 106, 179, 103, 0, 0, 0, 0,
-	0, 120, 0, 122, 0, 0, 160, 131, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 80, 0, 0, 743, 0,
-	0, 744, 0, 0, 96, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 109,
-	0, 0, 0, 211, 0, 0, 0, 0, 146, 0,
-	163, 111, 119, 83, 90, 0, 110, 137, 151, 155,
-	0, 0, 0, 99, 0, 153, 141, 176, 0, 142,
-	152, 123, 168, 147, 175, 183, 184, 165, 182, 191,
-	84, 164, 174, 97, 156, 86, 172, 162, 129, 115,
-	116, 85, 0, 150, 102, 107, 101, 138, 169, 170,
-	100, 194, 91, 181, 88, 92, 180, 136, 167, 173,
-	130, 127, 87, 171, 128, 126, 118, 105, 112, 144,
-	125, 145, 113, 133, 132, 134, 0, 0, 0, 161,
-	178, 195, 94, 0, 157, 166, 185, 186, 187, 188,
-	189, 190, 0, 0, 95, 108, 104, 143, 135, 93,
-	114, 158, 117, 124, 149, 193, 140, 154, 98, 177,
-	159, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 139, 0,
-	82, 89, 121, 192, 148, 106, 179, 103, 0, 634,
-	0, 0, 0, 120, 0, 122, 0, 0, 160, 131,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 80, 0, 633,
-	0, 0, 0, 0, 0, 0, 96, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	382, 1397, 1755, 1760, 1561, 634, 1734, 1692, 1586, 1101,
+	1644, 1690, 1592, 1677, 1446, 1466, 793, 1039, 1662, 1337,
+	1597, 1245, 619, 1055, 1088, 65, 723, 1264, 1449, 880,
+	357, 1553, 383, 1392, 1562, 770, 88, 721, 3, 370,
+	1448, 1539, 1393, 1079, 1060, 331, 1246, 1389, 617, 1057,
+	1116, 1174, 1134, 1131, 1132, 1399, 359, 1135, 1405, 1083,
+	1270, 1082, 1364, 423, 1007, 997, 71, 1004, 1095, 931,
+	1204, 859, 1301, 1062, 246, 1046, 281, 422, 781, 763,
+	534, 549, 974, 1026, 654, 660, 555, 764, 870, 1112,
+	855, 551, 550, 666, 780, 355, 326, 417, 1186, 420,
+	674, 340, 329, 412, 421, 659, 414, 237, 239, 737,
+	69, 413, 318, 327, 1758, 64, 535, 1827, 537, 1727,
+	738, 1793, 1794, 1706, 1742, 1725, 560, 1718, 887, 1664,
+	1665, 1659, 1361, 1774, 1700, 314, 1753, 344, 1671, 1740,
+	72, 73, 74, 75, 76, 27, 1467, 1699, 1670, 1381,
+	1499, 606, 538, 1756, 1558, 1421, 316, 885, 1422, 1423,
+	1073, 533, 621, 595, 319, 320, 321, 322, 1074, 1075,
+	325, 636, 637, 279, 275, 276, 277, 898, 62, 1006,
+	1629, 687, 686, 696, 697, 689, 690, 691, 692, 693,
+	694, 695, 688, 62, 395, 698, 401, 402, 399, 400,
+	398, 397, 396, 1277, 62, 782, 1276, 783, 1587, 1278,
+	403, 404, 27, 29, 60, 31, 32, 638, 576, 256,
+	899, 897, 324, 323, 1292, 639, 636, 637, 1094, 1526,
+	642, 50, 270, 623, 273, 625, 33, 55, 56, 1102,
+	1566, 1490, 1488, 534, 564, 1804, 1791, 315, 1346, 1785,
+	1787, 1786, 1788, 1799, 1181, 1784, 43, 1798, 422, 1783,
+	62, 1769, 1771, 1770, 1772, 1812, 886, 1767, 937, 938,
+	645, 594, 317, 1546, 622, 624, 631, 632, 1340, 1339,
+	589, 905, 903, 1605, 1538, 62, 1139, 577, 1777, 607,
+	422, 1781, 422, 422, 540, 422, 422, 265, 422, 266,
+	422, 1764, 1557, 641, 1599, 1598, 598, 1594, 1365, 422,
+	605, 543, 860, 861, 580, 593, 612, 587, 278, 273,
+	1341, 577, 614, 1450, 904, 258, 35, 37, 39, 38,
+	41, 844, 57, 1161, 260, 1160, 1452, 1265, 1267, 910,
+	626, 627, 563, 628, 629, 586, 630, 676, 633, 585,
+	1089, 271, 563, 1416, 1415, 563, 1414, 643, 1367, 1537,
+	662, 42, 51, 52, 536, 1657, 53, 54, 40, 1707,
+	566, 871, 906, 603, 663, 264, 286, 620, 1154, 274,
+	581, 1630, 46, 47, 1157, 48, 49, 44, 1282, 45,
+	1669, 602, 1636, 1757, 1651, 1221, 1091, 269, 1102, 888,
+	1168, 1726, 1092, 1167, 1149, 1040, 1369, 261, 1373, 1451,
+	1368, 708, 1366, 1595, 1593, 1622, 422, 1371, 1218, 1266,
+	1762, 544, 786, 1763, 1133, 1761, 1370, 710, 711, 609,
+	610, 611, 59, 1719, 1720, 573, 646, 647, 420, 1372,
+	1374, 1510, 651, 562, 572, 761, 249, 771, 664, 546,
+	267, 1080, 268, 562, 1349, 1231, 562, 1198, 584, 599,
+	762, 600, 946, 582, 601, 678, 698, 767, 574, 613,
+	578, 579, 688, 1090, 943, 698, 1158, 79, 673, 249,
+	1613, 932, 1540, 263, 1403, 1176, 61, 687, 686, 696,
+	697, 689, 690, 691, 692, 693, 694, 695, 688, 59,
+	1434, 698, 710, 711, 784, 259, 740, 742, 744, 746,
+	748, 750, 751, 80, 1027, 772, 1383, 741, 743, 539,
+	747, 749, 778, 752, 846, 710, 711, 262, 696, 697,
+	689, 690, 691, 692, 693, 694, 695, 688, 422, 1027,
+	698, 1228, 1739, 1290, 964, 966, 967, 854, 534, 534,
+	965, 1435, 1645, 1205, 689, 690, 691, 692, 693, 694,
+	695, 688, 933, 1175, 698, 1778, 874, 875, 792, 1091,
+	878, 879, 881, 881, 668, 1092, 1797, 534, 848, 890,
+	891, 892, 893, 894, 672, 671, 843, 671, 25, 422,
+	691, 692, 693, 694, 695, 688, 1826, 62, 698, 422,
+	1609, 673, 882, 673, 1779, 541, 542, 977, 1216, 1534,
+	1215, 589, 1533, 949, 950, 1825, 422, 422, 272, 895,
+	981, 422, 422, 422, 649, 422, 422, 672, 671, 856,
+	858, 1322, 422, 422, 979, 980, 978, 1305, 907, 908,
+	672, 671, 413, 1304, 673, 914, 1217, 902, 900, 872,
+	1293, 853, 849, 850, 881, 883, 335, 673, 889, 925,
+	644, 672, 671, 945, 920, 921, 955, 1824, 1821, 922,
+	923, 924, 1413, 926, 927, 1820, 676, 919, 673, 422,
+	928, 929, 1818, 941, 867, 868, 869, 672, 671, 672,
+	671, 896, 409, 410, 1385, 1817, 672, 671, 1816, 1815,
+	944, 971, 1809, 1807, 673, 960, 673, 1806, 951, 940,
+	911, 1776, 917, 673, 975, 1759, 1741, 672, 671, 1729,
+	1003, 687, 686, 696, 697, 689, 690, 691, 692, 693,
+	694, 695, 688, 1680, 673, 698, 1573, 1029, 976, 1195,
+	1196, 1197, 972, 1544, 1721, 1722, 1531, 373, 372, 375,
+	376, 377, 378, 939, 1033, 1034, 374, 379, 998, 1519,
+	999, 1017, 1020, 1012, 1474, 1344, 953, 1028, 1279, 1302,
+	1280, 1447, 1151, 1608, 1445, 1542, 968, 970, 422, 1509,
+	649, 1145, 1712, 1703, 649, 1145, 1656, 1145, 649, 1066,
+	1469, 422, 534, 1289, 649, 649, 1041, 1447, 1145, 1637,
+	420, 1150, 1145, 1551, 1145, 1550, 1001, 1002, 1145, 1548,
+	1069, 1313, 649, 589, 1159, 1103, 1104, 1105, 1316, 1545,
+	1512, 649, 1604, 1068, 1036, 767, 1147, 1024, 1145, 1462,
+	767, 687, 686, 696, 697, 689, 690, 691, 692, 693,
+	694, 695, 688, 1145, 1455, 698, 422, 871, 422, 1441,
+	1440, 1603, 534, 564, 1437, 1438, 1437, 1436, 534, 1210,
+	649, 1431, 1130, 1137, 1136, 1316, 1315, 1127, 1311, 1097,
+	1098, 1099, 1100, 1084, 1071, 1070, 1043, 649, 1144, 1000,
+	1121, 1127, 1126, 1086, 1085, 1108, 1109, 1110, 1111, 1010,
+	649, 534, 1118, 916, 1122, 915, 1124, 865, 847, 845,
+	842, 791, 790, 66, 1163, 1164, 1013, 1014, 615, 775,
+	1019, 1022, 1023, 608, 597, 422, 1048, 1051, 1052, 1053,
+	1049, 596, 1050, 1054, 1271, 1087, 1406, 1407, 1114, 1115,
+	1129, 1390, 27, 1271, 1402, 1035, 1715, 1037, 1038, 856,
+	27, 1165, 1166, 1210, 1402, 1169, 1170, 422, 1128, 1171,
+	1010, 776, 1138, 774, 1338, 1505, 1140, 1141, 1142, 1612,
+	1578, 1155, 1162, 1172, 1240, 1352, 1173, 1043, 1241, 971,
+	1043, 1179, 1156, 682, 1502, 685, 1402, 1439, 1072, 566,
+	62, 699, 700, 701, 702, 703, 704, 705, 62, 683,
+	684, 681, 687, 686, 696, 697, 689, 690, 691, 692,
+	693, 694, 695, 688, 1042, 1234, 698, 1501, 1233, 1210,
+	972, 975, 687, 686, 696, 697, 689, 690, 691, 692,
+	693, 694, 695, 688, 1210, 1067, 698, 774, 27, 1043,
+	774, 1145, 947, 1187, 777, 976, 1188, 650, 909, 864,
+	863, 337, 652, 1029, 62, 687, 686, 696, 697, 689,
+	690, 691, 692, 693, 694, 695, 688, 1653, 1555, 698,
+	1517, 1200, 686, 696, 697, 689, 690, 691, 692, 693,
+	694, 695, 688, 1247, 62, 698, 62, 1242, 1048, 1051,
+	1052, 1053, 1049, 422, 1050, 1054, 1096, 1117, 1330, 62,
+	1406, 1407, 959, 1326, 1324, 90, 1012, 1318, 1152, 1143,
+	767, 767, 767, 767, 767, 589, 1113, 1294, 1295, 1227,
+	1107, 1106, 1496, 1120, 1822, 767, 1775, 1272, 1746, 1735,
+	1427, 1307, 422, 1248, 767, 1409, 1251, 1390, 534, 1273,
+	1260, 1306, 1249, 1250, 1314, 1252, 284, 1269, 1194, 284,
+	935, 913, 1284, 1412, 881, 1296, 1320, 1298, 1299, 1300,
+	1411, 1274, 1257, 881, 1255, 284, 1283, 1258, 1254, 1256,
+	1253, 1334, 1335, 1259, 1723, 1052, 1053, 1698, 353, 1348,
+	1308, 284, 422, 1319, 341, 342, 284, 1183, 284, 1193,
+	1303, 1192, 1328, 667, 1297, 1209, 687, 686, 696, 697,
+	689, 690, 691, 692, 693, 694, 695, 688, 665, 422,
+	698, 655, 789, 901, 1225, 1317, 1336, 616, 592, 1310,
+	1333, 1503, 1332, 656, 1287, 1647, 1646, 1576, 873, 877,
+	1342, 1354, 240, 876, 866, 1350, 1615, 1125, 1331, 1123,
+	667, 912, 422, 1716, 1056, 1430, 265, 1347, 266, 338,
+	339, 1029, 1191, 1343, 1398, 1400, 332, 1560, 1819, 1814,
+	1190, 1382, 1813, 1808, 1386, 1805, 1803, 1802, 255, 1801,
+	1800, 1792, 1391, 1790, 258, 1789, 1623, 1355, 1618, 1400,
+	575, 1247, 1356, 260, 1394, 1363, 545, 333, 66, 1617,
+	1376, 1396, 1375, 422, 1271, 640, 422, 1748, 1747, 68,
+	1222, 1219, 930, 669, 1748, 972, 1418, 1633, 1527, 942,
+	722, 4, 70, 773, 63, 589, 1401, 1, 589, 1733,
+	1410, 1468, 1552, 257, 264, 247, 248, 1136, 236, 1136,
+	1146, 1281, 884, 238, 1417, 881, 553, 881, 252, 852,
+	253, 881, 851, 1420, 1081, 78, 269, 531, 1432, 1433,
+	1470, 1471, 1442, 77, 1424, 1425, 261, 250, 251, 1525,
+	422, 1291, 1093, 1426, 1458, 1286, 1461, 797, 795, 796,
+	1464, 794, 1480, 1443, 799, 798, 284, 298, 1444, 415,
+	284, 1454, 1354, 785, 1453, 1456, 284, 1472, 1119, 670,
+	81, 583, 284, 1795, 1780, 1782, 1766, 1768, 1749, 267,
+	1556, 268, 1180, 936, 313, 1473, 635, 300, 706, 1189,
+	1275, 1689, 1477, 1029, 1475, 1658, 1663, 1360, 948, 767,
+	1616, 1559, 1476, 1226, 734, 1025, 358, 963, 371, 368,
+	1486, 369, 263, 954, 284, 422, 1239, 1513, 353, 353,
+	680, 356, 348, 1247, 1483, 1484, 766, 1485, 759, 1504,
+	1487, 1047, 1489, 422, 259, 353, 1530, 589, 1532, 1514,
+	1045, 1495, 1044, 1408, 1404, 765, 353, 353, 353, 353,
+	353, 353, 353, 353, 1524, 1351, 262, 1498, 422, 1628,
+	958, 30, 67, 343, 22, 21, 20, 1541, 23, 19,
+	353, 18, 1563, 17, 1284, 16, 15, 604, 254, 353,
+	1554, 1535, 36, 1543, 1565, 34, 24, 14, 13, 12,
+	11, 10, 9, 8, 7, 284, 284, 284, 6, 5,
+	1581, 1582, 1754, 1583, 1676, 28, 334, 26, 881, 2,
+	0, 881, 881, 881, 0, 687, 686, 696, 697, 689,
+	690, 691, 692, 693, 694, 695, 688, 0, 1394, 698,
+	0, 0, 0, 350, 1031, 0, 1579, 1585, 1577, 0,
+	1588, 1589, 1590, 0, 0, 0, 881, 0, 0, 1584,
+	0, 0, 0, 0, 0, 384, 58, 1596, 1591, 0,
+	0, 1606, 0, 0, 1601, 0, 1602, 0, 0, 0,
+	0, 0, 1611, 0, 0, 1614, 1610, 0, 1620, 0,
+	0, 0, 0, 0, 58, 0, 0, 0, 1621, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1394, 1634, 422, 422, 0, 0, 0, 1635, 1643,
+	0, 1652, 1648, 0, 58, 0, 0, 0, 284, 0,
+	0, 0, 0, 336, 1029, 1554, 589, 1674, 284, 1541,
+	1678, 353, 0, 0, 1654, 1661, 0, 1666, 0, 0,
+	1667, 0, 0, 0, 881, 1672, 0, 0, 0, 0,
+	1691, 1681, 1675, 0, 1247, 0, 1685, 0, 0, 0,
+	1682, 0, 0, 1655, 0, 0, 1687, 0, 0, 284,
+	0, 0, 0, 1686, 0, 1697, 0, 0, 0, 0,
+	0, 0, 1708, 1688, 0, 1705, 0, 0, 284, 284,
+	0, 1717, 284, 1713, 1678, 284, 1714, 1709, 0, 284,
+	0, 0, 0, 0, 0, 1728, 1691, 1724, 0, 284,
+	0, 0, 1730, 0, 1736, 1731, 1732, 1737, 0, 1494,
+	0, 0, 0, 0, 0, 0, 353, 0, 1743, 1563,
+	1745, 1744, 1750, 1751, 0, 0, 284, 1752, 0, 0,
+	0, 0, 1765, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1493, 0, 0, 284, 0, 353, 353, 0,
+	0, 0, 0, 0, 353, 0, 353, 0, 0, 353,
+	353, 353, 353, 353, 353, 353, 353, 353, 353, 353,
+	353, 353, 353, 353, 0, 0, 1810, 1398, 1811, 0,
+	0, 0, 0, 687, 686, 696, 697, 689, 690, 691,
+	692, 693, 694, 695, 688, 0, 353, 698, 0, 0,
+	0, 353, 353, 353, 353, 353, 353, 353, 353, 0,
+	0, 0, 353, 0, 0, 353, 687, 686, 696, 697,
+	689, 690, 691, 692, 693, 694, 695, 688, 0, 0,
+	698, 353, 353, 353, 353, 618, 284, 618, 618, 0,
+	618, 618, 0, 618, 284, 618, 284, 0, 0, 284,
+	284, 648, 0, 0, 618, 0, 0, 0, 0, 0,
+	353, 687, 686, 696, 697, 689, 690, 691, 692, 693,
+	694, 695, 688, 0, 0, 698, 653, 0, 0, 0,
+	0, 1357, 58, 0, 712, 713, 714, 715, 716, 717,
+	718, 719, 0, 0, 0, 0, 0, 707, 0, 0,
+	709, 687, 686, 696, 697, 689, 690, 691, 692, 693,
+	694, 695, 688, 0, 0, 698, 0, 0, 0, 0,
+	284, 0, 0, 0, 0, 0, 0, 0, 720, 0,
+	725, 726, 727, 728, 729, 730, 731, 732, 733, 1206,
+	736, 739, 739, 739, 745, 739, 739, 745, 739, 753,
+	754, 755, 756, 757, 758, 0, 768, 0, 0, 687,
+	686, 696, 697, 689, 690, 691, 692, 693, 694, 695,
+	688, 0, 0, 698, 0, 0, 0, 0, 0, 0,
+	0, 284, 284, 0, 0, 284, 284, 0, 0, 284,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 284, 0, 284, 284,
+	0, 284, 0, 0, 0, 0, 0, 295, 346, 0,
+	353, 353, 353, 353, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 353, 0, 0, 0, 0, 0,
+	0, 308, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 353, 353, 353, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 353, 0, 618, 0, 0, 353, 0, 0, 0,
+	0, 0, 287, 0, 0, 0, 0, 0, 862, 0,
+	353, 0, 0, 353, 290, 0, 0, 0, 0, 0,
+	0, 0, 299, 294, 0, 0, 0, 0, 353, 284,
+	284, 284, 284, 284, 0, 0, 0, 0, 0, 0,
+	0, 284, 0, 0, 284, 353, 0, 0, 0, 284,
+	0, 0, 0, 284, 618, 0, 0, 0, 0, 0,
+	0, 0, 0, 297, 0, 0, 0, 0, 0, 307,
+	0, 618, 618, 0, 0, 0, 618, 618, 618, 0,
+	618, 618, 0, 0, 0, 0, 0, 618, 618, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 952, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 709, 288,
+	0, 0, 0, 0, 973, 0, 0, 982, 983, 984,
+	985, 986, 987, 988, 989, 990, 991, 992, 993, 994,
+	995, 996, 0, 0, 353, 0, 301, 291, 292, 353,
+	302, 303, 304, 306, 0, 305, 311, 0, 0, 0,
+	293, 296, 0, 289, 310, 309, 1008, 1009, 1011, 0,
+	0, 0, 353, 0, 0, 58, 0, 0, 0, 0,
+	0, 0, 0, 1032, 0, 284, 0, 0, 0, 0,
+	0, 725, 0, 0, 0, 353, 0, 0, 0, 0,
+	0, 353, 353, 0, 0, 0, 353, 0, 0, 0,
+	0, 0, 0, 0, 353, 353, 0, 353, 353, 0,
+	0, 0, 0, 0, 353, 0, 0, 353, 0, 353,
+	353, 0, 0, 0, 1058, 1059, 353, 0, 0, 768,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 240, 0, 0, 0, 353, 0, 0,
+	0, 657, 661, 0, 0, 0, 0, 265, 0, 266,
+	0, 0, 0, 353, 353, 0, 0, 0, 679, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 255,
+	0, 0, 284, 0, 0, 258, 0, 0, 0, 0,
+	0, 618, 0, 618, 260, 0, 0, 0, 0, 0,
+	0, 353, 0, 724, 0, 0, 0, 0, 353, 0,
+	0, 0, 735, 0, 0, 0, 0, 284, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 284, 0,
+	0, 1148, 0, 353, 1153, 264, 0, 0, 0, 0,
+	0, 249, 0, 0, 0, 0, 0, 0, 0, 252,
+	243, 253, 0, 242, 0, 0, 0, 269, 0, 0,
+	618, 353, 0, 0, 0, 0, 1182, 261, 250, 251,
+	0, 353, 0, 0, 0, 0, 0, 0, 353, 353,
+	0, 0, 353, 0, 0, 353, 0, 0, 0, 0,
+	244, 0, 241, 245, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1201, 1202, 1203, 0, 0, 0, 0,
+	267, 0, 268, 0, 0, 0, 0, 1199, 0, 0,
+	0, 0, 0, 0, 0, 1207, 0, 0, 0, 1208,
+	0, 0, 0, 0, 0, 0, 0, 1212, 1213, 1214,
+	0, 0, 0, 263, 1220, 0, 0, 1223, 1224, 0,
+	0, 0, 0, 1230, 0, 0, 0, 1232, 0, 0,
+	1235, 1236, 1237, 1238, 661, 259, 0, 0, 0, 353,
+	353, 353, 353, 353, 0, 0, 0, 353, 353, 0,
+	0, 0, 1262, 0, 0, 1243, 1244, 262, 0, 768,
+	768, 768, 768, 768, 0, 0, 0, 0, 0, 284,
+	0, 0, 0, 0, 1058, 353, 0, 1268, 0, 254,
+	0, 0, 0, 768, 0, 0, 0, 0, 0, 0,
+	0, 0, 284, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1288, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1312, 0, 0, 934,
+	0, 0, 0, 0, 0, 0, 0, 618, 0, 0,
+	0, 1309, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 353, 0, 0, 0, 0, 0, 0,
+	961, 962, 1321, 353, 353, 0, 1325, 0, 0, 353,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 353,
+	0, 0, 0, 0, 0, 0, 0, 618, 658, 0,
+	0, 0, 0, 0, 0, 0, 353, 0, 0, 1358,
+	1359, 0, 0, 284, 1362, 0, 0, 0, 0, 353,
+	0, 0, 1377, 1378, 724, 1379, 1380, 1015, 1016, 0,
+	0, 284, 0, 0, 0, 0, 0, 1387, 1388, 282,
+	0, 0, 312, 0, 0, 0, 0, 0, 0, 353,
+	0, 0, 0, 0, 0, 0, 0, 0, 330, 0,
+	0, 0, 0, 0, 0, 0, 0, 1395, 0, 58,
+	0, 347, 0, 0, 282, 0, 0, 0, 0, 282,
+	0, 282, 0, 1078, 0, 0, 0, 0, 0, 0,
+	0, 1428, 0, 0, 0, 353, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1457, 0,
+	0, 0, 0, 0, 1463, 0, 1465, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1478, 1479, 0, 0, 0, 0, 0, 0, 0, 1482,
+	0, 0, 0, 709, 0, 0, 0, 0, 768, 0,
+	1491, 1492, 0, 0, 0, 0, 0, 1481, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1506, 1507, 1508, 0, 1511, 0, 0, 1497, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1523, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1528, 0, 0, 0, 0, 0,
+	1520, 1521, 1522, 1184, 1185, 0, 661, 0, 1536, 0,
+	0, 0, 0, 0, 0, 0, 1529, 0, 0, 0,
+	0, 1547, 0, 0, 1549, 0, 0, 0, 618, 282,
+	0, 0, 0, 282, 0, 0, 0, 0, 0, 282,
+	0, 0, 0, 0, 0, 282, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1567, 1568, 1569,
+	1570, 1571, 0, 1572, 0, 1574, 1575, 0, 0, 1211,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1229, 330, 0, 0,
+	0, 1395, 0, 0, 1580, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1263, 0,
+	0, 0, 0, 0, 1607, 0, 1619, 0, 0, 0,
+	0, 0, 1624, 1625, 1626, 1627, 0, 0, 0, 1631,
+	1632, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1638, 0, 1640, 1641, 1642, 0, 0, 0, 0,
+	0, 0, 0, 0, 1395, 1649, 58, 0, 282, 282,
+	282, 0, 0, 1639, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1650, 0, 1668, 0, 0, 0, 0, 0, 0,
+	1673, 0, 0, 0, 0, 0, 0, 1323, 0, 0,
+	0, 1704, 1329, 0, 1683, 0, 0, 0, 0, 0,
+	0, 0, 814, 0, 0, 0, 0, 1696, 0, 0,
+	0, 0, 0, 0, 0, 1345, 0, 0, 0, 0,
+	0, 1702, 0, 0, 0, 0, 0, 0, 1710, 1711,
+	0, 0, 0, 0, 0, 0, 0, 1696, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 109, 0, 0, 0, 211, 0, 0, 0, 0,
-	146, 0, 163, 111, 119, 83, 90, 0, 110, 137,
-	151, 155, 0, 0, 0, 99, 0, 153, 141, 176,
-	0, 142, 152, 123, 168, 147, 175, 183, 184, 165,
-	182, 191, 84, 164, 174, 97, 156, 86, 172, 162,
-	129, 115, 116, 85, 0, 150, 102, 107, 101, 138,
-	169, 170, 100, 194, 91, 181, 88, 92, 180, 136,
-	167, 173, 130, 127, 87, 171, 128, 126, 118, 105,
-	112, 144, 125, 145, 113, 133, 132, 134, 0, 0,
-	0, 161, 178, 195, 94, 0, 157, 166, 185, 186,
-	187, 188, 189, 190, 0, 0, 95, 108, 104, 143,
-	135, 93, 114, 158, 117, 124, 149, 193, 140, 154,
-	98, 177, 159, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	139, 0, 82, 89, 121, 192, 148, 106, 179, 103,
-	0, 0, 0, 0, 0, 120, 0, 122, 0, 0,
-	160, 131, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 54, 0, 0, 209,
-	0, 0, 0, 0, 0, 0, 0, 0, 96, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1384, 0, 0,
+	0, 282, 0, 0, 0, 0, 0, 0, 0, 0,
+	802, 282, 1738, 1696, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1773, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1419, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 282, 0, 0, 0, 0, 1429, 0, 0,
+	815, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 282, 0, 0, 282, 1796, 0, 282, 814,
+	0, 0, 918, 0, 0, 0, 1823, 0, 0, 0,
+	0, 0, 282, 0, 1459, 0, 0, 828, 831, 832,
+	833, 834, 835, 836, 0, 837, 838, 839, 840, 841,
+	816, 817, 818, 819, 800, 801, 829, 0, 803, 330,
+	804, 805, 806, 807, 808, 809, 810, 811, 812, 813,
+	820, 821, 822, 823, 824, 825, 826, 827, 282, 0,
+	0, 0, 0, 0, 0, 0, 0, 918, 0, 0,
+	0, 0, 0, 0, 1500, 0, 0, 802, 0, 0,
+	0, 0, 0, 0, 724, 0, 0, 0, 0, 0,
+	0, 0, 1515, 0, 0, 1516, 0, 0, 1518, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 347,
+	0, 0, 0, 830, 0, 347, 347, 0, 0, 347,
+	347, 347, 0, 0, 0, 1030, 0, 815, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 347, 347, 347, 347, 0, 282,
+	0, 0, 0, 0, 0, 0, 0, 282, 0, 1064,
+	0, 0, 282, 282, 828, 831, 832, 833, 834, 835,
+	836, 0, 837, 838, 839, 840, 841, 816, 817, 818,
+	819, 800, 801, 829, 0, 803, 0, 804, 805, 806,
+	807, 808, 809, 810, 811, 812, 813, 820, 821, 822,
+	823, 824, 825, 826, 827, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1600, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 282, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 109, 0, 0, 0, 211, 0, 0,
-	0, 0, 146, 0, 163, 111, 119, 83, 90, 0,
-	110, 137, 151, 155, 0, 0, 0, 99, 0, 153,
-	141, 176, 0, 142, 152, 123, 168, 147, 175, 183,
-	184, 165, 182, 191, 84, 164, 174, 97, 156, 86,
-	172, 162, 129, 115, 116, 85, 0, 150, 102, 107,
-	101, 138, 169, 170, 100, 194, 91, 181, 88, 92,
-	180, 136, 167, 173, 130, 127, 87, 171, 128, 126,
-	118, 105, 112, 144, 125, 145, 113, 133, 132, 134,
-	0, 0, 0, 161, 178, 195, 94, 0, 157, 166,
-	185, 186, 187, 188, 189, 190, 0, 0, 95, 108,
-	104, 143, 135, 93, 114, 158, 117, 124, 149, 193,
-	140, 154, 98, 177, 159, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 139, 0, 82, 89, 121, 192, 148, 106,
-	179, 103, 0, 0, 0, 0, 0, 120, 0, 122,
-	0, 0, 160, 131, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 209, 0, 850, 0, 0, 0, 0, 0, 0,
-	96, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	830, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 282, 724, 0, 282, 282,
+	0, 0, 282, 0, 0, 0, 1660, 724, 0, 0,
+	0, 0, 724, 0, 0, 0, 0, 0, 0, 282,
+	0, 1177, 1178, 0, 282, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 918, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 347, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 109, 0, 0, 0, 211,
-	0, 0, 0, 0, 146, 0, 163, 111, 119, 83,
-	90, 0, 110, 137, 151, 155, 0, 0, 0, 99,
-	0, 153, 141, 176, 0, 142, 152, 123, 168, 147,
-	175, 183, 184, 165, 182, 191, 84, 164, 174, 97,
-	156, 86, 172, 162, 129, 115, 116, 85, 0, 150,
-	102, 107, 101, 138, 169, 170, 100, 194, 91, 181,
-	88, 92, 180, 136, 167, 173, 130, 127, 87, 171,
-	128, 126, 118, 105, 112, 144, 125, 145, 113, 133,
-	132, 134, 0, 0, 0, 161, 178, 195, 94, 0,
-	157, 166, 185, 186, 187, 188, 189, 190, 0, 0,
-	95, 108, 104, 143, 135, 93, 114, 158, 117, 124,
-	149, 193, 140, 154, 98, 177, 159, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 139, 0, 82, 89, 121, 192,
-	148, 106, 179, 103, 0, 0, 0, 0, 0, 120,
-	0, 122, 0, 0, 160, 131, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 80, 0, 525, 0, 0, 0, 0,
-	0, 0, 96, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 347, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 347, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1030, 282, 282, 282, 282, 282, 0, 0, 0,
+	0, 0, 0, 0, 1261, 0, 0, 282, 0, 0,
+	0, 0, 1064, 0, 0, 0, 282, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 109, 0, 0,
-	0, 211, 0, 0, 0, 0, 146, 0, 163, 111,
-	119, 83, 90, 0, 110, 137, 151, 155, 0, 0,
-	0, 99, 0, 153, 141, 176, 0, 142, 152, 123,
-	168, 147, 175, 183, 184, 165, 182, 191, 84, 164,
-	174, 97, 156, 86, 172, 162, 129, 115, 116, 85,
-	0, 150, 102, 107, 101, 138, 169, 170, 100, 194,
-	91, 181, 88, 92, 180, 136, 167, 173, 130, 127,
-	87, 171, 128, 126, 118, 105, 112, 144, 125, 145,
-	113, 133, 132, 134, 0, 0, 0, 161, 178, 195,
-	94, 0, 157, 166, 185, 186, 187, 188, 189, 190,
-	0, 0, 95, 108, 104, 143, 135, 93, 114, 158,
-	117, 124, 149, 193, 140, 154, 98, 177, 159, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 139, 82, 89,
-	121, 192, 148, 106, 179, 607, 103, 0, 0, 0,
-	0, 0, 120, 0, 122, 0, 0, 160, 131, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 0, 96, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	109, 0, 0, 0, 211, 0, 0, 0, 0, 146,
-	0, 163, 111, 119, 83, 90, 0, 110, 137, 151,
-	155, 0, 0, 0, 99, 0, 153, 141, 176, 0,
-	142, 152, 123, 168, 147, 175, 183, 184, 165, 182,
-	191, 84, 164, 174, 97, 156, 86, 172, 162, 129,
-	115, 116, 85, 0, 150, 102, 107, 101, 138, 169,
-	170, 100, 194, 91, 181, 88, 92, 180, 136, 167,
-	173, 130, 127, 87, 171, 128, 126, 118, 105, 112,
-	144, 125, 145, 113, 133, 132, 134, 0, 0, 0,
-	161, 178, 195, 94, 0, 157, 166, 185, 186, 187,
-	188, 189, 190, 0, 0, 95, 108, 104, 143, 135,
-	93, 114, 158, 117, 124, 149, 193, 140, 154, 98,
-	177, 159, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 329, 0, 0, 0, 0, 0, 0, 139,
-	0, 82, 89, 121, 192, 148, 106, 179, 103, 0,
-	0, 0, 0, 0, 120, 0, 122, 0, 0, 160,
-	131, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 0, 96, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 282, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 347, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 347,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	918, 0, 0, 0, 0, 0, 0, 0, 0, 1030,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 109, 0, 0, 0, 211, 0, 0, 0,
-	0, 146, 0, 163, 111, 119, 83, 90, 0, 110,
-	137, 151, 155, 0, 0, 0, 99, 0, 153, 141,
-	176, 0, 142, 152, 123, 168, 147, 175, 183, 184,
-	165, 182, 191, 84, 164, 174, 97, 156, 86, 172,
-	162, 129, 115, 116, 85, 0, 150, 102, 107, 101,
-	138, 169, 170, 100, 194, 91, 181, 88, 92, 180,
-	136, 167, 173, 130, 127, 87, 171, 128, 126, 118,
-	105, 112, 144, 125, 145, 113, 133, 132, 134, 0,
-	0, 0, 161, 178, 195, 94, 0, 157, 166, 185,
-	186, 187, 188, 189, 190, 0, 0, 95, 108, 104,
-	143, 135, 93, 114, 158, 117, 124, 149, 193, 140,
-	154, 98, 177, 159, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 139, 0, 82, 89, 121, 192, 148, 106, 179,
-	103, 0, 0, 0, 0, 0, 120, 0, 122, 0,
-	0, 160, 131, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 0, 96,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 282, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 109, 0, 206, 0, 211, 0,
-	0, 0, 0, 146, 0, 163, 111, 119, 83, 90,
-	0, 110, 137, 151, 155, 0, 0, 0, 99, 0,
-	153, 141, 176, 0, 142, 152, 123, 168, 147, 175,
-	183, 184, 165, 182, 191, 84, 164, 174, 97, 156,
-	86, 172, 162, 129, 115, 116, 85, 0, 150, 102,
-	107, 101, 138, 169, 170, 100, 194, 91, 181, 88,
-	92, 180, 136, 167, 173, 130, 127, 87, 171, 128,
-	126, 118, 105, 112, 144, 125, 145, 113, 133, 132,
-	134, 0, 0, 0, 161, 178, 195, 94, 0, 157,
-	166, 185, 186, 187, 188, 189, 190, 0, 0, 95,
-	108, 104, 143, 135, 93, 114, 158, 117, 124, 149,
-	193, 140, 154, 98, 177, 159, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 139, 0, 82, 89, 121, 192, 148,
-	106, 179, 103, 0, 0, 0, 0, 0, 120, 0,
-	122, 0, 0, 160, 131, 0, 0, 0, 0, 0,
+	282, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 80, 0, 0, 0, 0, 0, 0, 0,
-	0, 96, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1030, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 109, 0, 0, 0,
-	211, 0, 0, 0, 0, 146, 0, 163, 111, 119,
-	83, 90, 0, 110, 137, 151, 155, 0, 0, 0,
-	99, 0, 153, 141, 176, 0, 142, 152, 123, 168,
-	147, 175, 183, 184, 165, 182, 191, 84, 164, 174,
-	97, 156, 86, 172, 162, 129, 115, 116, 85, 0,
-	150, 102, 107, 101, 138, 169, 170, 100, 194, 91,
-	181, 88, 92, 180, 136, 167, 173, 130, 127, 87,
-	171, 128, 126, 118, 105, 112, 144, 125, 145, 113,
-	133, 132, 134, 0, 0, 0, 161, 178, 195, 94,
-	0, 157, 166, 185, 186, 187, 188, 189, 190, 0,
-	0, 95, 108, 104, 143, 135, 93, 114, 158, 117,
-	124, 149, 193, 140, 154, 98, 177, 159, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 139, 0, 82, 89, 121,
-	192, 148, 106, 179, 103, 0, 0, 0, 0, 0,
-	120, 0, 122, 0, 0, 160, 131, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 0, 96, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 109, 0,
-	0, 0, 211, 0, 0, 0, 0, 146, 0, 163,
-	111, 119, 83, 90, 0, 110, 137, 151, 155, 0,
-	0, 0, 99, 0, 153, 141, 176, 0, 142, 152,
-	123, 168, 147, 175, 183, 184, 165, 182, 191, 84,
-	164, 174, 97, 156, 86, 172, 162, 129, 115, 116,
-	85, 0, 150, 102, 107, 101, 138, 169, 170, 100,
-	194, 91, 181, 88, 92, 180, 136, 167, 173, 130,
-	127, 87, 171, 128, 126, 118, 105, 112, 144, 125,
-	145, 113, 133, 132, 134, 0, 0, 0, 161, 178,
-	195, 94, 0, 157, 166, 185, 186, 187, 188, 189,
-	190, 0, 0, 95, 108, 104, 143, 135, 93, 114,
-	158, 117, 124, 149, 193, 140, 154, 98, 177, 159,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 139, 0, 82,
-	89, 121, 192, 148, 106, 179, 103, 0, 0, 0,
-	0, 0, 120, 0, 122, 0, 0, 160, 131, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 270, 0, 0, 0,
-	0, 0, 0, 0, 0, 96, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1064, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	109, 0, 0, 0, 211, 0, 0, 0, 0, 146,
-	0, 163, 111, 119, 83, 90, 0, 110, 137, 151,
-	155, 0, 0, 0, 99, 0, 153, 141, 176, 0,
-	142, 152, 123, 168, 147, 175, 183, 184, 165, 182,
-	191, 84, 164, 174, 97, 156, 86, 172, 162, 129,
-	115, 116, 85, 0, 150, 102, 107, 101, 138, 169,
-	170, 100, 194, 91, 181, 88, 92, 180, 136, 167,
-	173, 130, 127, 87, 171, 128, 126, 118, 105, 112,
-	144, 125, 145, 113, 133, 132, 134, 0, 0, 0,
-	161, 178, 195, 94, 0, 157, 166, 185, 186, 187,
-	188, 189, 190, 0, 0, 95, 108, 104, 143, 135,
-	93, 114, 158, 117, 124, 149, 193, 140, 154, 98,
-	177, 159, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 89, 121, 192, 148, 106, 179,
+	0, 0, 0, 517, 506, 282, 472, 520, 450, 464,
+	528, 465, 466, 495, 434, 481, 170, 462, 0, 453,
+	429, 459, 430, 451, 474, 118, 478, 449, 508, 484,
+	519, 143, 526, 146, 489, 0, 196, 160, 0, 0,
+	476, 510, 479, 503, 471, 496, 441, 488, 521, 463,
+	493, 522, 0, 0, 0, 89, 0, 590, 591, 0,
+	0, 0, 0, 0, 107, 0, 491, 516, 461, 492,
+	494, 428, 490, 0, 432, 435, 527, 512, 456, 457,
+	588, 0, 0, 0, 0, 0, 0, 475, 480, 500,
+	469, 0, 1030, 0, 0, 0, 0, 0, 0, 454,
+	0, 487, 0, 0, 0, 438, 433, 0, 0, 473,
+	0, 175, 0, 216, 180, 130, 282, 0, 440, 0,
+	455, 501, 0, 427, 129, 505, 511, 470, 285, 515,
+	468, 467, 518, 181, 1701, 199, 132, 142, 161, 436,
+	128, 93, 437, 133, 203, 225, 92, 100, 0, 131,
+	168, 186, 190, 509, 452, 460, 114, 458, 188, 172,
+	215, 486, 187, 104, 149, 147, 207, 182, 214, 223,
+	224, 202, 222, 232, 94, 200, 213, 108, 191, 144,
+	111, 152, 113, 156, 110, 153, 137, 150, 206, 173,
+	120, 124, 201, 96, 211, 198, 158, 138, 139, 95,
+	0, 185, 117, 126, 116, 169, 208, 209, 115, 234,
+	101, 221, 98, 102, 220, 166, 205, 212, 159, 155,
+	97, 210, 157, 154, 141, 122, 134, 178, 151, 179,
+	135, 163, 162, 164, 0, 431, 0, 197, 218, 235,
+	105, 448, 193, 204, 226, 227, 228, 229, 230, 231,
+	0, 0, 106, 127, 121, 177, 165, 103, 136, 194,
+	140, 148, 184, 233, 171, 189, 109, 217, 195, 444,
+	447, 442, 443, 482, 483, 523, 524, 525, 502, 439,
+	0, 445, 446, 0, 507, 513, 514, 485, 91, 99,
+	145, 530, 183, 125, 497, 529, 504, 498, 192, 112,
+	499, 477, 174, 176, 167, 119, 123, 219, 517, 506,
+	0, 472, 520, 450, 464, 528, 465, 466, 495, 434,
+	481, 170, 462, 0, 453, 429, 459, 430, 451, 474,
+	118, 478, 449, 508, 484, 519, 143, 526, 146, 489,
+	0, 196, 160, 0, 0, 476, 510, 479, 503, 471,
+	496, 441, 488, 521, 463, 493, 522, 0, 0, 0,
+	89, 0, 590, 591, 0, 0, 0, 0, 0, 107,
+	0, 491, 516, 461, 492, 494, 428, 490, 0, 432,
+	435, 527, 512, 456, 457, 0, 0, 0, 0, 0,
+	0, 0, 475, 480, 500, 469, 0, 0, 0, 0,
+	0, 0, 0, 0, 454, 0, 487, 0, 0, 0,
+	438, 433, 0, 0, 473, 0, 175, 0, 216, 180,
+	130, 0, 0, 440, 0, 455, 501, 0, 427, 129,
+	505, 511, 470, 285, 515, 468, 467, 518, 181, 0,
+	199, 132, 142, 161, 436, 128, 93, 437, 133, 203,
+	225, 92, 100, 0, 131, 168, 186, 190, 509, 452,
+	460, 114, 458, 188, 172, 215, 486, 187, 104, 149,
+	147, 207, 182, 214, 223, 224, 202, 222, 232, 94,
+	200, 213, 108, 191, 144, 111, 152, 113, 156, 110,
+	153, 137, 150, 206, 173, 120, 124, 201, 96, 211,
+	198, 158, 138, 139, 95, 0, 185, 117, 126, 116,
+	169, 208, 209, 115, 234, 101, 221, 98, 102, 220,
+	166, 205, 212, 159, 155, 97, 210, 157, 154, 141,
+	122, 134, 178, 151, 179, 135, 163, 162, 164, 0,
+	431, 0, 197, 218, 235, 105, 448, 193, 204, 226,
+	227, 228, 229, 230, 231, 0, 0, 106, 127, 121,
+	177, 165, 103, 136, 194, 140, 148, 184, 233, 171,
+	189, 109, 217, 195, 444, 447, 442, 443, 482, 483,
+	523, 524, 525, 502, 439, 0, 445, 446, 0, 507,
+	513, 514, 485, 91, 99, 145, 530, 183, 125, 497,
+	529, 504, 498, 192, 112, 499, 477, 174, 176, 167,
+	119, 123, 219, 517, 506, 0, 472, 520, 450, 464,
+	528, 465, 466, 495, 434, 481, 170, 462, 0, 453,
+	429, 459, 430, 451, 474, 118, 478, 449, 508, 484,
+	519, 143, 526, 146, 489, 0, 196, 160, 0, 0,
+	476, 510, 479, 503, 471, 496, 441, 488, 521, 463,
+	493, 522, 0, 0, 0, 89, 0, 590, 591, 0,
+	0, 0, 0, 0, 107, 0, 491, 516, 461, 492,
+	494, 428, 490, 0, 432, 435, 527, 512, 456, 457,
+	1285, 0, 0, 0, 0, 0, 0, 475, 480, 500,
+	469, 0, 0, 0, 0, 0, 0, 0, 0, 454,
+	0, 487, 0, 0, 0, 438, 433, 0, 0, 473,
+	0, 0, 0, 216, 180, 130, 0, 0, 440, 0,
+	455, 501, 0, 427, 129, 505, 511, 470, 285, 515,
+	468, 467, 518, 181, 0, 199, 132, 142, 161, 436,
+	128, 93, 437, 133, 203, 225, 92, 100, 0, 131,
+	168, 186, 190, 509, 452, 460, 114, 458, 188, 172,
+	215, 486, 187, 104, 149, 147, 207, 182, 214, 223,
+	224, 202, 222, 232, 94, 200, 213, 108, 191, 144,
+	111, 152, 113, 156, 110, 153, 137, 150, 206, 173,
+	120, 124, 201, 96, 211, 198, 158, 138, 139, 95,
+	0, 185, 117, 126, 116, 169, 208, 209, 115, 234,
+	101, 221, 98, 102, 220, 166, 205, 212, 159, 155,
+	97, 210, 157, 154, 141, 122, 134, 178, 151, 179,
+	135, 163, 162, 164, 0, 431, 0, 197, 218, 235,
+	105, 448, 193, 204, 226, 227, 228, 229, 230, 231,
+	0, 0, 106, 127, 121, 177, 165, 103, 136, 194,
+	140, 148, 184, 233, 171, 189, 109, 217, 195, 444,
+	447, 442, 443, 482, 483, 523, 524, 525, 502, 439,
+	0, 445, 446, 0, 507, 513, 514, 485, 91, 99,
+	145, 530, 183, 125, 497, 529, 504, 498, 192, 112,
+	499, 477, 174, 176, 167, 119, 123, 219, 517, 506,
+	0, 472, 520, 450, 464, 528, 465, 466, 495, 434,
+	481, 170, 462, 0, 453, 429, 459, 430, 451, 474,
+	118, 478, 449, 508, 484, 519, 143, 526, 146, 489,
+	0, 196, 160, 0, 0, 476, 510, 479, 503, 471,
+	496, 441, 488, 521, 463, 493, 522, 62, 0, 0,
+	89, 0, 0, 0, 0, 0, 0, 0, 0, 107,
+	0, 491, 516, 461, 492, 494, 428, 490, 0, 432,
+	435, 527, 512, 456, 457, 0, 0, 0, 0, 0,
+	0, 0, 475, 480, 500, 469, 0, 0, 0, 0,
+	0, 0, 0, 0, 454, 0, 487, 0, 0, 0,
+	438, 433, 0, 0, 473, 0, 175, 0, 216, 180,
+	130, 0, 0, 440, 0, 455, 501, 0, 427, 129,
+	505, 511, 470, 285, 515, 468, 467, 518, 181, 0,
+	199, 132, 142, 161, 436, 128, 93, 437, 133, 203,
+	225, 92, 100, 0, 131, 168, 186, 190, 509, 452,
+	460, 114, 458, 188, 172, 215, 486, 187, 104, 149,
+	147, 207, 182, 214, 223, 224, 202, 222, 232, 94,
+	200, 213, 108, 191, 144, 111, 152, 113, 156, 110,
+	153, 137, 150, 206, 173, 120, 124, 201, 96, 211,
+	198, 158, 138, 139, 95, 0, 185, 117, 126, 116,
+	169, 208, 209, 115, 234, 101, 221, 98, 102, 220,
+	166, 205, 212, 159, 155, 97, 210, 157, 154, 141,
+	122, 134, 178, 151, 179, 135, 163, 162, 164, 0,
+	431, 0, 197, 218, 235, 105, 448, 193, 204, 226,
+	227, 228, 229, 230, 231, 0, 0, 106, 127, 121,
+	177, 165, 103, 136, 194, 140, 148, 184, 233, 171,
+	189, 109, 217, 195, 444, 447, 442, 443, 482, 483,
+	523, 524, 525, 502, 439, 0, 445, 446, 0, 507,
+	513, 514, 485, 91, 99, 145, 530, 183, 125, 497,
+	529, 504, 498, 192, 112, 499, 477, 174, 176, 167,
+	119, 123, 219, 517, 506, 0, 472, 520, 450, 464,
+	528, 465, 466, 495, 434, 481, 170, 462, 0, 453,
+	429, 459, 430, 451, 474, 118, 478, 449, 508, 484,
+	519, 143, 526, 146, 489, 0, 196, 160, 0, 0,
+	476, 510, 479, 503, 471, 496, 441, 488, 521, 463,
+	493, 522, 0, 0, 0, 89, 0, 0, 0, 0,
+	0, 0, 0, 0, 107, 0, 491, 516, 461, 492,
+	494, 428, 490, 0, 432, 435, 527, 512, 456, 457,
+	0, 0, 0, 0, 0, 0, 0, 475, 480, 500,
+	469, 0, 0, 0, 0, 0, 0, 1353, 0, 454,
+	0, 487, 0, 0, 0, 438, 433, 0, 0, 473,
+	0, 175, 0, 216, 180, 130, 0, 0, 440, 0,
+	455, 501, 0, 427, 129, 505, 511, 470, 285, 515,
+	468, 467, 518, 181, 0, 199, 132, 142, 161, 436,
+	128, 93, 437, 133, 203, 225, 92, 100, 0, 131,
+	168, 186, 190, 509, 452, 460, 114, 458, 188, 172,
+	215, 486, 187, 104, 149, 147, 207, 182, 214, 223,
+	224, 202, 222, 232, 94, 200, 213, 108, 191, 144,
+	111, 152, 113, 156, 110, 153, 137, 150, 206, 173,
+	120, 124, 201, 96, 211, 198, 158, 138, 139, 95,
+	0, 185, 117, 126, 116, 169, 208, 209, 115, 234,
+	101, 221, 98, 102, 220, 166, 205, 212, 159, 155,
+	97, 210, 157, 154, 141, 122, 134, 178, 151, 179,
+	135, 163, 162, 164, 0, 431, 0, 197, 218, 235,
+	105, 448, 193, 204, 226, 227, 228, 229, 230, 231,
+	0, 0, 106, 127, 121, 177, 165, 103, 136, 194,
+	140, 148, 184, 233, 171, 189, 109, 217, 195, 444,
+	447, 442, 443, 482, 483, 523, 524, 525, 502, 439,
+	0, 445, 446, 0, 507, 513, 514, 485, 91, 99,
+	145, 530, 183, 125, 497, 529, 504, 498, 192, 112,
+	499, 477, 174, 176, 167, 119, 123, 219, 517, 506,
+	0, 472, 520, 450, 464, 528, 465, 466, 495, 434,
+	481, 170, 462, 0, 453, 429, 459, 430, 451, 474,
+	118, 478, 449, 508, 484, 519, 143, 526, 146, 489,
+	0, 196, 160, 0, 0, 476, 510, 479, 503, 471,
+	496, 441, 488, 521, 463, 493, 522, 0, 0, 0,
+	89, 0, 590, 591, 0, 0, 0, 0, 0, 107,
+	0, 491, 516, 461, 492, 494, 428, 490, 0, 432,
+	435, 527, 512, 456, 457, 0, 0, 0, 0, 0,
+	0, 0, 475, 480, 500, 469, 0, 0, 0, 0,
+	0, 0, 0, 0, 454, 0, 487, 0, 0, 0,
+	438, 433, 0, 0, 473, 0, 0, 0, 216, 180,
+	130, 0, 0, 440, 0, 455, 501, 0, 427, 129,
+	505, 511, 470, 285, 515, 468, 467, 518, 181, 0,
+	199, 132, 142, 161, 436, 128, 93, 437, 133, 203,
+	225, 92, 100, 0, 131, 168, 186, 190, 509, 452,
+	460, 114, 458, 188, 172, 215, 486, 187, 104, 149,
+	147, 207, 182, 214, 223, 224, 202, 222, 232, 94,
+	200, 213, 108, 191, 144, 111, 152, 113, 156, 110,
+	153, 137, 150, 206, 173, 120, 124, 201, 96, 211,
+	198, 158, 138, 139, 95, 0, 185, 117, 126, 116,
+	169, 208, 209, 115, 234, 101, 221, 98, 102, 220,
+	166, 205, 212, 159, 155, 97, 210, 157, 154, 141,
+	122, 134, 178, 151, 179, 135, 163, 162, 164, 0,
+	431, 0, 197, 218, 235, 105, 448, 193, 204, 226,
+	227, 228, 229, 230, 231, 0, 0, 106, 127, 121,
+	177, 165, 103, 136, 194, 140, 148, 184, 233, 171,
+	189, 109, 217, 195, 444, 447, 442, 443, 482, 483,
+	523, 524, 525, 502, 439, 0, 445, 446, 0, 507,
+	513, 514, 485, 91, 99, 145, 530, 183, 125, 497,
+	529, 504, 498, 192, 112, 499, 477, 174, 176, 167,
+	119, 123, 219, 517, 506, 0, 472, 520, 450, 464,
+	528, 465, 466, 495, 434, 481, 170, 462, 0, 453,
+	429, 459, 430, 451, 474, 118, 478, 449, 508, 484,
+	519, 143, 526, 146, 489, 0, 196, 160, 0, 0,
+	476, 510, 479, 503, 471, 496, 441, 488, 521, 463,
+	493, 522, 0, 0, 0, 352, 0, 0, 0, 0,
+	0, 0, 0, 0, 107, 0, 491, 516, 461, 492,
+	494, 428, 490, 0, 432, 435, 527, 512, 456, 457,
+	0, 0, 0, 0, 0, 0, 0, 475, 480, 500,
+	469, 0, 0, 0, 0, 0, 0, 969, 0, 454,
+	0, 487, 0, 0, 0, 438, 433, 0, 0, 473,
+	0, 175, 0, 216, 180, 130, 0, 0, 440, 0,
+	455, 501, 0, 427, 129, 505, 511, 470, 285, 515,
+	468, 467, 518, 181, 0, 199, 132, 142, 161, 436,
+	128, 93, 437, 133, 203, 225, 92, 100, 0, 131,
+	168, 186, 190, 509, 452, 460, 114, 458, 188, 172,
+	215, 486, 187, 104, 149, 147, 207, 182, 214, 223,
+	224, 202, 222, 232, 94, 200, 213, 108, 191, 144,
+	111, 152, 113, 156, 110, 153, 137, 150, 206, 173,
+	120, 124, 201, 96, 211, 198, 158, 138, 139, 95,
+	0, 185, 117, 126, 116, 169, 208, 209, 115, 234,
+	101, 221, 98, 102, 220, 166, 205, 212, 159, 155,
+	97, 210, 157, 154, 141, 122, 134, 178, 151, 179,
+	135, 163, 162, 164, 0, 431, 0, 197, 218, 235,
+	105, 448, 193, 204, 226, 227, 228, 229, 230, 231,
+	0, 0, 106, 127, 121, 177, 165, 103, 136, 194,
+	140, 148, 184, 233, 171, 189, 109, 217, 195, 444,
+	447, 442, 443, 482, 483, 523, 524, 525, 502, 439,
+	0, 445, 446, 0, 507, 513, 514, 485, 91, 99,
+	145, 530, 183, 125, 497, 529, 504, 498, 192, 112,
+	499, 477, 174, 176, 167, 119, 123, 219, 517, 506,
+	0, 472, 520, 450, 464, 528, 465, 466, 495, 434,
+	481, 170, 462, 0, 453, 429, 459, 430, 451, 474,
+	118, 478, 449, 508, 484, 519, 143, 526, 146, 489,
+	0, 196, 160, 0, 0, 476, 510, 479, 503, 471,
+	496, 441, 488, 521, 463, 493, 522, 0, 0, 0,
+	89, 0, 0, 0, 0, 0, 0, 0, 0, 107,
+	0, 491, 516, 461, 492, 494, 428, 490, 0, 432,
+	435, 527, 512, 456, 457, 0, 0, 0, 0, 0,
+	0, 0, 475, 480, 500, 469, 0, 0, 0, 0,
+	0, 0, 0, 0, 454, 0, 487, 0, 0, 0,
+	438, 433, 0, 0, 473, 0, 175, 0, 216, 180,
+	130, 0, 0, 440, 0, 455, 501, 0, 427, 129,
+	505, 511, 470, 285, 515, 468, 467, 518, 181, 0,
+	199, 132, 142, 161, 436, 128, 93, 437, 133, 203,
+	225, 92, 100, 0, 131, 168, 186, 190, 509, 452,
+	460, 114, 458, 188, 172, 215, 486, 187, 104, 149,
+	147, 207, 182, 214, 223, 224, 202, 222, 232, 94,
+	200, 213, 108, 191, 144, 111, 152, 113, 156, 110,
+	153, 137, 150, 206, 173, 120, 124, 201, 96, 211,
+	198, 158, 138, 139, 95, 0, 185, 117, 126, 116,
+	169, 208, 209, 115, 234, 101, 221, 98, 102, 220,
+	166, 205, 212, 159, 155, 97, 210, 157, 154, 141,
+	122, 134, 178, 151, 179, 135, 163, 162, 164, 0,
+	431, 0, 197, 218, 235, 105, 448, 193, 204, 226,
+	227, 228, 229, 230, 231, 0, 0, 106, 127, 121,
+	177, 165, 103, 136, 194, 140, 148, 184, 233, 171,
+	189, 109, 217, 195, 444, 447, 442, 443, 482, 483,
+	523, 524, 525, 502, 439, 0, 445, 446, 0, 507,
+	513, 514, 485, 91, 99, 145, 530, 183, 125, 497,
+	529, 504, 498, 192, 112, 499, 477, 174, 176, 167,
+	119, 123, 219, 517, 506, 0, 472, 520, 450, 464,
+	528, 465, 466, 495, 434, 481, 170, 462, 0, 453,
+	429, 459, 430, 451, 474, 118, 478, 449, 508, 484,
+	519, 143, 526, 146, 489, 0, 196, 160, 0, 0,
+	476, 510, 479, 503, 471, 496, 441, 488, 521, 463,
+	493, 522, 0, 0, 0, 352, 0, 0, 0, 0,
+	0, 0, 0, 0, 107, 0, 491, 516, 461, 492,
+	494, 428, 490, 0, 432, 435, 527, 512, 456, 457,
+	0, 0, 0, 0, 0, 0, 0, 475, 480, 500,
+	469, 0, 0, 0, 0, 0, 0, 0, 0, 454,
+	0, 487, 0, 0, 0, 438, 433, 0, 0, 473,
+	0, 175, 0, 216, 180, 130, 0, 0, 440, 0,
+	455, 501, 0, 427, 129, 505, 511, 470, 285, 515,
+	468, 467, 518, 181, 0, 199, 132, 142, 161, 436,
+	128, 93, 437, 133, 203, 225, 92, 100, 0, 131,
+	168, 186, 190, 509, 452, 460, 114, 458, 188, 172,
+	215, 486, 187, 104, 149, 147, 207, 182, 214, 223,
+	224, 202, 222, 232, 94, 200, 213, 108, 191, 144,
+	111, 152, 113, 156, 110, 153, 137, 150, 206, 173,
+	120, 124, 201, 96, 211, 198, 158, 138, 139, 95,
+	0, 185, 117, 126, 116, 169, 208, 209, 115, 234,
+	101, 221, 98, 102, 220, 166, 205, 212, 159, 155,
+	97, 210, 157, 154, 141, 122, 134, 178, 151, 179,
+	135, 163, 162, 164, 0, 431, 0, 197, 218, 235,
+	105, 448, 193, 204, 226, 227, 228, 229, 230, 231,
+	0, 0, 106, 127, 121, 177, 165, 103, 136, 194,
+	140, 148, 184, 233, 171, 189, 109, 217, 195, 444,
+	447, 442, 443, 482, 483, 523, 524, 525, 502, 439,
+	0, 445, 446, 0, 507, 513, 514, 485, 91, 99,
+	145, 530, 183, 125, 497, 529, 504, 498, 192, 112,
+	499, 477, 174, 176, 167, 119, 123, 219, 517, 506,
+	0, 472, 520, 450, 464, 528, 465, 466, 495, 434,
+	481, 170, 462, 0, 453, 429, 459, 430, 451, 474,
+	118, 478, 449, 508, 484, 519, 143, 526, 146, 489,
+	0, 196, 160, 0, 0, 476, 510, 479, 503, 471,
+	496, 441, 488, 521, 463, 493, 522, 0, 0, 0,
+	89, 0, 0, 0, 0, 0, 0, 0, 0, 107,
+	0, 491, 516, 461, 492, 494, 428, 490, 0, 432,
+	435, 527, 512, 456, 457, 0, 0, 0, 0, 0,
+	0, 0, 475, 480, 500, 469, 0, 0, 0, 0,
+	0, 0, 0, 0, 454, 0, 487, 0, 0, 0,
+	438, 433, 0, 0, 473, 0, 175, 0, 216, 180,
+	130, 0, 0, 440, 0, 455, 501, 0, 427, 129,
+	505, 511, 470, 285, 515, 468, 467, 518, 181, 0,
+	199, 132, 142, 161, 436, 128, 93, 437, 133, 203,
+	225, 92, 100, 0, 131, 168, 186, 190, 509, 452,
+	460, 114, 458, 188, 172, 215, 486, 187, 104, 149,
+	147, 207, 182, 214, 223, 224, 202, 222, 232, 94,
+	200, 213, 108, 191, 144, 111, 152, 113, 156, 110,
+	153, 137, 150, 206, 173, 120, 124, 201, 96, 211,
+	198, 158, 138, 139, 95, 0, 185, 117, 126, 116,
+	169, 208, 209, 115, 234, 101, 221, 98, 425, 220,
+	166, 205, 212, 159, 155, 97, 210, 157, 154, 141,
+	122, 134, 178, 151, 179, 135, 163, 162, 164, 0,
+	431, 0, 197, 218, 235, 105, 448, 193, 204, 226,
+	227, 228, 229, 230, 231, 0, 0, 106, 127, 121,
+	177, 426, 424, 136, 194, 140, 148, 184, 233, 171,
+	189, 109, 217, 195, 444, 447, 442, 443, 482, 483,
+	523, 524, 525, 502, 439, 0, 445, 446, 0, 507,
+	513, 514, 485, 91, 99, 145, 530, 183, 125, 497,
+	529, 504, 498, 192, 112, 499, 477, 174, 176, 167,
+	119, 123, 219, 517, 506, 0, 472, 520, 450, 464,
+	528, 465, 466, 495, 434, 481, 170, 462, 0, 453,
+	429, 459, 430, 451, 474, 118, 478, 449, 508, 484,
+	519, 143, 526, 146, 489, 0, 196, 160, 0, 0,
+	476, 510, 479, 503, 471, 496, 441, 488, 521, 463,
+	493, 522, 0, 0, 0, 283, 0, 0, 0, 0,
+	0, 0, 0, 0, 107, 0, 491, 516, 461, 492,
+	494, 428, 490, 0, 432, 435, 527, 512, 456, 457,
+	0, 0, 0, 0, 0, 0, 0, 475, 480, 500,
+	469, 0, 0, 0, 0, 0, 0, 0, 0, 454,
+	0, 487, 0, 0, 0, 438, 433, 0, 0, 473,
+	0, 175, 0, 216, 180, 130, 0, 0, 440, 0,
+	455, 501, 0, 427, 129, 505, 511, 470, 285, 515,
+	468, 467, 518, 181, 0, 199, 132, 142, 161, 436,
+	128, 93, 437, 133, 203, 225, 92, 100, 0, 131,
+	168, 186, 190, 509, 452, 460, 114, 458, 188, 172,
+	215, 486, 187, 104, 149, 147, 207, 182, 214, 223,
+	224, 202, 222, 232, 94, 200, 213, 108, 191, 144,
+	111, 152, 113, 156, 110, 153, 137, 150, 206, 173,
+	120, 124, 201, 96, 211, 198, 158, 138, 139, 95,
+	0, 185, 117, 126, 116, 169, 208, 209, 115, 234,
+	101, 221, 98, 102, 220, 166, 205, 212, 159, 155,
+	97, 210, 157, 154, 141, 122, 134, 178, 151, 179,
+	135, 163, 162, 164, 0, 431, 0, 197, 218, 235,
+	105, 448, 193, 204, 226, 227, 228, 229, 230, 231,
+	0, 0, 106, 127, 121, 177, 165, 103, 136, 194,
+	140, 148, 184, 233, 171, 189, 109, 217, 195, 444,
+	447, 442, 443, 482, 483, 523, 524, 525, 502, 439,
+	0, 445, 446, 0, 507, 513, 514, 485, 91, 99,
+	145, 530, 183, 125, 497, 529, 504, 498, 192, 112,
+	499, 477, 174, 176, 167, 119, 123, 219, 517, 506,
+	0, 472, 520, 450, 464, 528, 465, 466, 495, 434,
+	481, 170, 462, 0, 453, 429, 459, 430, 451, 474,
+	118, 478, 449, 508, 484, 519, 143, 526, 146, 489,
+	0, 196, 160, 0, 0, 476, 510, 479, 503, 471,
+	496, 441, 488, 521, 463, 493, 522, 0, 0, 0,
+	89, 0, 0, 0, 0, 0, 0, 0, 0, 107,
+	0, 491, 516, 461, 492, 494, 428, 490, 0, 432,
+	435, 527, 512, 456, 457, 0, 0, 0, 0, 0,
+	0, 0, 475, 480, 500, 469, 0, 0, 0, 0,
+	0, 0, 0, 0, 454, 0, 487, 0, 0, 0,
+	438, 433, 0, 0, 473, 0, 175, 0, 216, 180,
+	130, 0, 0, 440, 0, 455, 501, 0, 427, 129,
+	505, 511, 470, 285, 515, 468, 467, 518, 181, 0,
+	199, 132, 142, 161, 436, 128, 93, 437, 133, 203,
+	225, 92, 100, 0, 131, 168, 186, 190, 509, 452,
+	460, 114, 458, 188, 172, 215, 486, 187, 104, 149,
+	147, 207, 182, 214, 223, 224, 202, 222, 232, 94,
+	200, 779, 108, 191, 144, 111, 152, 113, 156, 110,
+	153, 137, 150, 206, 173, 120, 124, 201, 96, 211,
+	198, 158, 138, 139, 95, 0, 185, 117, 126, 116,
+	169, 208, 209, 115, 234, 101, 221, 98, 425, 220,
+	166, 205, 212, 159, 155, 97, 210, 157, 154, 141,
+	122, 134, 178, 151, 179, 135, 163, 162, 164, 0,
+	431, 0, 197, 218, 235, 105, 448, 193, 204, 226,
+	227, 228, 229, 230, 231, 0, 0, 106, 127, 121,
+	177, 426, 424, 136, 194, 140, 148, 184, 233, 171,
+	189, 109, 217, 195, 444, 447, 442, 443, 482, 483,
+	523, 524, 525, 502, 439, 0, 445, 446, 0, 507,
+	513, 514, 485, 91, 99, 145, 530, 183, 125, 497,
+	529, 504, 498, 192, 112, 499, 477, 174, 176, 167,
+	119, 123, 219, 517, 506, 0, 472, 520, 450, 464,
+	528, 465, 466, 495, 434, 481, 170, 462, 0, 453,
+	429, 459, 430, 451, 474, 118, 478, 449, 508, 484,
+	519, 143, 526, 146, 489, 0, 196, 160, 0, 0,
+	476, 510, 479, 503, 471, 496, 441, 488, 521, 463,
+	493, 522, 0, 0, 0, 89, 0, 0, 0, 0,
+	0, 0, 0, 0, 107, 0, 491, 516, 461, 492,
+	494, 428, 490, 0, 432, 435, 527, 512, 456, 457,
+	0, 0, 0, 0, 0, 0, 0, 475, 480, 500,
+	469, 0, 0, 0, 0, 0, 0, 0, 0, 454,
+	0, 487, 0, 0, 0, 438, 433, 0, 0, 473,
+	0, 175, 0, 216, 180, 130, 0, 0, 440, 0,
+	455, 501, 0, 427, 129, 505, 511, 470, 285, 515,
+	468, 467, 518, 181, 0, 199, 132, 142, 161, 436,
+	128, 93, 437, 133, 203, 225, 92, 100, 0, 131,
+	168, 186, 190, 509, 452, 460, 114, 458, 188, 172,
+	215, 486, 187, 104, 149, 147, 207, 182, 214, 223,
+	224, 202, 222, 232, 94, 200, 416, 108, 191, 144,
+	111, 152, 113, 156, 110, 153, 137, 150, 206, 173,
+	120, 124, 201, 96, 211, 198, 158, 138, 139, 95,
+	0, 185, 117, 126, 116, 169, 208, 209, 115, 234,
+	101, 221, 98, 425, 220, 166, 205, 212, 159, 155,
+	97, 210, 157, 154, 141, 122, 134, 178, 151, 179,
+	135, 163, 162, 164, 0, 431, 0, 197, 218, 235,
+	105, 448, 193, 204, 226, 227, 228, 229, 230, 231,
+	0, 0, 106, 127, 121, 177, 426, 424, 419, 418,
+	140, 148, 184, 233, 171, 189, 109, 217, 195, 444,
+	447, 442, 443, 482, 483, 523, 524, 525, 502, 439,
+	0, 445, 446, 0, 507, 513, 514, 485, 91, 99,
+	145, 530, 183, 125, 497, 529, 504, 498, 192, 112,
+	499, 477, 174, 176, 167, 119, 123, 219, 170, 0,
+	0, 0, 0, 354, 0, 0, 0, 118, 0, 351,
+	0, 0, 0, 143, 394, 146, 0, 0, 196, 160,
+	0, 0, 0, 0, 385, 386, 0, 0, 0, 0,
+	0, 0, 1076, 0, 62, 0, 0, 352, 373, 372,
+	375, 376, 377, 378, 0, 0, 107, 374, 379, 380,
+	381, 1077, 0, 0, 349, 366, 0, 393, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 363, 364, 0,
+	0, 0, 0, 407, 0, 365, 0, 0, 360, 361,
+	362, 367, 0, 175, 0, 216, 180, 130, 0, 0,
+	0, 0, 0, 0, 0, 0, 129, 0, 0, 0,
+	285, 0, 0, 405, 0, 181, 0, 199, 132, 142,
+	161, 0, 128, 93, 0, 133, 203, 225, 92, 100,
+	0, 131, 168, 186, 190, 0, 0, 0, 114, 0,
+	188, 172, 215, 0, 187, 104, 149, 147, 207, 182,
+	214, 223, 224, 202, 222, 232, 94, 200, 213, 108,
+	191, 144, 111, 152, 113, 156, 110, 153, 137, 150,
+	206, 173, 120, 124, 201, 96, 211, 198, 158, 138,
+	139, 95, 0, 185, 117, 126, 116, 169, 208, 209,
+	115, 234, 101, 221, 98, 102, 220, 166, 205, 212,
+	159, 155, 97, 210, 157, 154, 141, 122, 134, 178,
+	151, 179, 135, 163, 162, 164, 0, 0, 0, 197,
+	218, 235, 105, 0, 193, 204, 226, 227, 228, 229,
+	230, 231, 0, 0, 106, 127, 121, 177, 165, 103,
+	136, 194, 140, 148, 184, 233, 171, 189, 109, 217,
+	195, 395, 406, 401, 402, 399, 400, 398, 397, 396,
+	408, 387, 388, 389, 390, 392, 0, 403, 404, 391,
+	91, 99, 145, 0, 183, 125, 0, 0, 27, 0,
+	192, 112, 0, 0, 174, 176, 167, 119, 123, 219,
+	170, 0, 0, 0, 0, 354, 0, 0, 0, 118,
+	0, 351, 0, 0, 0, 143, 394, 146, 0, 0,
+	196, 160, 0, 0, 0, 0, 385, 386, 0, 0,
+	0, 0, 0, 0, 0, 0, 62, 0, 0, 352,
+	373, 372, 375, 376, 377, 378, 0, 0, 107, 374,
+	379, 380, 381, 0, 0, 0, 349, 366, 0, 393,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 363,
+	364, 0, 0, 0, 0, 407, 0, 365, 0, 0,
+	360, 361, 362, 367, 0, 175, 0, 216, 180, 130,
+	0, 0, 0, 0, 0, 0, 0, 0, 129, 0,
+	0, 0, 285, 0, 0, 405, 0, 181, 0, 199,
+	132, 142, 161, 0, 128, 93, 0, 133, 203, 225,
+	92, 100, 0, 131, 168, 186, 190, 0, 0, 0,
+	114, 0, 188, 172, 215, 0, 187, 104, 149, 147,
+	207, 182, 214, 223, 224, 202, 222, 232, 94, 200,
+	213, 108, 191, 144, 111, 152, 113, 156, 110, 153,
+	137, 150, 206, 173, 120, 124, 201, 96, 211, 198,
+	158, 138, 139, 95, 0, 185, 117, 126, 116, 169,
+	208, 209, 115, 234, 101, 221, 98, 102, 220, 166,
+	205, 212, 159, 155, 97, 210, 157, 154, 141, 122,
+	134, 178, 151, 179, 135, 163, 162, 164, 0, 0,
+	0, 197, 218, 235, 105, 0, 193, 204, 226, 227,
+	228, 229, 230, 231, 0, 0, 106, 127, 121, 177,
+	165, 103, 136, 194, 140, 148, 184, 233, 171, 189,
+	109, 217, 195, 395, 406, 401, 402, 399, 400, 398,
+	397, 396, 408, 387, 388, 389, 390, 392, 0, 403,
+	404, 391, 91, 99, 145, 59, 183, 125, 0, 0,
+	0, 0, 192, 112, 0, 0, 174, 176, 167, 119,
+	123, 219, 170, 0, 0, 1005, 0, 354, 0, 0,
+	0, 118, 0, 351, 0, 0, 0, 143, 394, 146,
+	0, 0, 196, 160, 0, 0, 0, 0, 385, 386,
+	0, 0, 0, 0, 0, 0, 0, 0, 62, 0,
+	0, 352, 373, 372, 375, 376, 377, 378, 0, 0,
+	107, 374, 379, 380, 381, 0, 0, 0, 349, 366,
+	0, 393, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 363, 364, 345, 0, 0, 0, 407, 0, 365,
+	0, 0, 360, 361, 362, 367, 0, 175, 0, 216,
+	180, 130, 0, 0, 0, 0, 0, 0, 0, 0,
+	129, 0, 0, 0, 285, 0, 0, 405, 0, 181,
+	0, 199, 132, 142, 161, 0, 128, 93, 0, 133,
+	203, 225, 92, 100, 0, 131, 168, 186, 190, 0,
+	0, 0, 114, 0, 188, 172, 215, 0, 187, 104,
+	149, 147, 207, 182, 214, 223, 224, 202, 222, 232,
+	94, 200, 213, 108, 191, 144, 111, 152, 113, 156,
+	110, 153, 137, 150, 206, 173, 120, 124, 201, 96,
+	211, 198, 158, 138, 139, 95, 0, 185, 117, 126,
+	116, 169, 208, 209, 115, 234, 101, 221, 98, 102,
+	220, 166, 205, 212, 159, 155, 97, 210, 157, 154,
+	141, 122, 134, 178, 151, 179, 135, 163, 162, 164,
+	0, 0, 0, 197, 218, 235, 105, 0, 193, 204,
+	226, 227, 228, 229, 230, 231, 0, 0, 106, 127,
+	121, 177, 165, 103, 136, 194, 140, 148, 184, 233,
+	171, 189, 109, 217, 195, 395, 406, 401, 402, 399,
+	400, 398, 397, 396, 408, 387, 388, 389, 390, 392,
+	0, 403, 404, 391, 91, 99, 145, 0, 183, 125,
+	0, 0, 0, 0, 192, 112, 0, 0, 174, 176,
+	167, 119, 123, 219, 170, 0, 0, 0, 0, 354,
+	0, 0, 0, 118, 0, 351, 0, 0, 0, 143,
+	394, 146, 0, 0, 196, 160, 0, 0, 0, 0,
+	385, 386, 0, 0, 0, 0, 0, 0, 0, 0,
+	62, 0, 649, 352, 373, 372, 375, 376, 377, 378,
+	0, 0, 107, 374, 379, 380, 381, 0, 0, 0,
+	349, 366, 0, 393, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 363, 364, 0, 0, 0, 0, 407,
+	0, 365, 0, 0, 360, 361, 362, 367, 0, 175,
+	0, 216, 180, 130, 0, 0, 0, 0, 0, 0,
+	0, 0, 129, 0, 0, 0, 285, 0, 0, 405,
+	0, 181, 0, 199, 132, 142, 161, 0, 128, 93,
+	0, 133, 203, 225, 92, 100, 0, 131, 168, 186,
+	190, 0, 0, 0, 114, 0, 188, 172, 215, 0,
+	187, 104, 149, 147, 207, 182, 214, 223, 224, 202,
+	222, 232, 94, 200, 213, 108, 191, 144, 111, 152,
+	113, 156, 110, 153, 137, 150, 206, 173, 120, 124,
+	201, 96, 211, 198, 158, 138, 139, 95, 0, 185,
+	117, 126, 116, 169, 208, 209, 115, 234, 101, 221,
+	98, 102, 220, 166, 205, 212, 159, 155, 97, 210,
+	157, 154, 141, 122, 134, 178, 151, 179, 135, 163,
+	162, 164, 0, 0, 0, 197, 218, 235, 105, 0,
+	193, 204, 226, 227, 228, 229, 230, 231, 0, 0,
+	106, 127, 121, 177, 165, 103, 136, 194, 140, 148,
+	184, 233, 171, 189, 109, 217, 195, 395, 406, 401,
+	402, 399, 400, 398, 397, 396, 408, 387, 388, 389,
+	390, 392, 0, 403, 404, 391, 91, 99, 145, 0,
+	183, 125, 0, 0, 0, 0, 192, 112, 0, 0,
+	174, 176, 167, 119, 123, 219, 170, 0, 0, 0,
+	0, 354, 0, 0, 0, 118, 0, 351, 0, 0,
+	0, 143, 394, 146, 0, 0, 196, 160, 0, 0,
+	0, 0, 385, 386, 0, 0, 0, 0, 0, 0,
+	0, 0, 62, 0, 0, 352, 373, 372, 375, 376,
+	377, 378, 0, 0, 107, 374, 379, 380, 381, 0,
+	0, 0, 349, 366, 0, 393, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 363, 364, 345, 0, 0,
+	0, 407, 0, 365, 0, 0, 360, 361, 362, 367,
+	0, 175, 0, 216, 180, 130, 0, 0, 0, 0,
+	0, 0, 0, 0, 129, 0, 0, 0, 285, 0,
+	0, 405, 0, 181, 0, 199, 132, 142, 161, 0,
+	128, 93, 0, 133, 203, 225, 92, 100, 0, 131,
+	168, 186, 190, 0, 0, 0, 114, 0, 188, 172,
+	215, 0, 187, 104, 149, 147, 207, 182, 214, 223,
+	224, 202, 222, 232, 94, 200, 213, 108, 191, 144,
+	111, 152, 113, 156, 110, 153, 137, 150, 206, 173,
+	120, 124, 201, 96, 211, 198, 158, 138, 139, 95,
+	0, 185, 117, 126, 116, 169, 208, 209, 115, 234,
+	101, 221, 98, 102, 220, 166, 205, 212, 159, 155,
+	97, 210, 157, 154, 141, 122, 134, 178, 151, 179,
+	135, 163, 162, 164, 0, 0, 0, 197, 218, 235,
+	105, 0, 193, 204, 226, 227, 228, 229, 230, 231,
+	0, 0, 106, 127, 121, 177, 165, 103, 136, 194,
+	140, 148, 184, 233, 171, 189, 109, 217, 195, 395,
+	406, 401, 402, 399, 400, 398, 397, 396, 408, 387,
+	388, 389, 390, 392, 0, 403, 404, 391, 91, 99,
+	145, 0, 183, 125, 0, 0, 0, 0, 192, 112,
+	0, 0, 174, 176, 167, 119, 123, 219, 170, 0,
+	0, 0, 0, 354, 0, 0, 0, 118, 0, 351,
+	0, 0, 0, 143, 394, 146, 0, 0, 196, 160,
+	0, 0, 0, 0, 385, 386, 0, 0, 0, 0,
+	0, 0, 0, 0, 62, 0, 0, 352, 373, 1021,
+	375, 376, 377, 378, 0, 0, 107, 374, 379, 380,
+	381, 0, 0, 0, 349, 366, 0, 393, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 363, 364, 345,
+	0, 0, 0, 407, 0, 365, 0, 0, 360, 361,
+	362, 367, 0, 175, 0, 216, 180, 130, 0, 0,
+	0, 0, 0, 0, 0, 0, 129, 0, 0, 0,
+	285, 0, 0, 405, 0, 181, 0, 199, 132, 142,
+	161, 0, 128, 93, 0, 133, 203, 225, 92, 100,
+	0, 131, 168, 186, 190, 0, 0, 0, 114, 0,
+	188, 172, 215, 0, 187, 104, 149, 147, 207, 182,
+	214, 223, 224, 202, 222, 232, 94, 200, 213, 108,
+	191, 144, 111, 152, 113, 156, 110, 153, 137, 150,
+	206, 173, 120, 124, 201, 96, 211, 198, 158, 138,
+	139, 95, 0, 185, 117, 126, 116, 169, 208, 209,
+	115, 234, 101, 221, 98, 102, 220, 166, 205, 212,
+	159, 155, 97, 210, 157, 154, 141, 122, 134, 178,
+	151, 179, 135, 163, 162, 164, 0, 0, 0, 197,
+	218, 235, 105, 0, 193, 204, 226, 227, 228, 229,
+	230, 231, 0, 0, 106, 127, 121, 177, 165, 103,
+	136, 194, 140, 148, 184, 233, 171, 189, 109, 217,
+	195, 395, 406, 401, 402, 399, 400, 398, 397, 396,
+	408, 387, 388, 389, 390, 392, 0, 403, 404, 391,
+	91, 99, 145, 0, 183, 125, 0, 0, 0, 0,
+	192, 112, 0, 0, 174, 176, 167, 119, 123, 219,
+	170, 0, 0, 0, 0, 354, 0, 0, 0, 118,
+	0, 351, 0, 0, 0, 143, 394, 146, 0, 0,
+	196, 160, 0, 0, 0, 0, 385, 386, 0, 0,
+	0, 0, 0, 0, 0, 0, 62, 0, 0, 352,
+	373, 1018, 375, 376, 377, 378, 0, 0, 107, 374,
+	379, 380, 381, 0, 0, 0, 349, 366, 0, 393,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 363,
+	364, 345, 0, 0, 0, 407, 0, 365, 0, 0,
+	360, 361, 362, 367, 0, 175, 0, 216, 180, 130,
+	0, 0, 0, 0, 0, 0, 0, 0, 129, 0,
+	0, 0, 285, 0, 0, 405, 0, 181, 0, 199,
+	132, 142, 161, 0, 128, 93, 0, 133, 203, 225,
+	92, 100, 0, 131, 168, 186, 190, 0, 0, 0,
+	114, 0, 188, 172, 215, 0, 187, 104, 149, 147,
+	207, 182, 214, 223, 224, 202, 222, 232, 94, 200,
+	213, 108, 191, 144, 111, 152, 113, 156, 110, 153,
+	137, 150, 206, 173, 120, 124, 201, 96, 211, 198,
+	158, 138, 139, 95, 0, 185, 117, 126, 116, 169,
+	208, 209, 115, 234, 101, 221, 98, 102, 220, 166,
+	205, 212, 159, 155, 97, 210, 157, 154, 141, 122,
+	134, 178, 151, 179, 135, 163, 162, 164, 0, 0,
+	0, 197, 218, 235, 105, 0, 193, 204, 226, 227,
+	228, 229, 230, 231, 0, 0, 106, 127, 121, 177,
+	165, 103, 136, 194, 140, 148, 184, 233, 171, 189,
+	109, 217, 195, 395, 406, 401, 402, 399, 400, 398,
+	397, 396, 408, 387, 388, 389, 390, 392, 0, 403,
+	404, 391, 91, 99, 145, 0, 183, 125, 0, 0,
+	0, 0, 192, 112, 0, 0, 174, 176, 167, 119,
+	123, 219, 170, 0, 0, 0, 0, 354, 0, 0,
+	0, 118, 0, 351, 0, 0, 0, 143, 394, 146,
+	0, 0, 196, 160, 0, 0, 0, 0, 385, 386,
+	0, 0, 0, 0, 0, 0, 0, 0, 62, 0,
+	0, 352, 373, 372, 375, 376, 377, 378, 0, 0,
+	107, 374, 379, 380, 381, 0, 0, 0, 349, 366,
+	0, 393, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 363, 364, 0, 0, 0, 0, 407, 0, 365,
+	0, 0, 360, 361, 362, 367, 0, 175, 0, 216,
+	180, 130, 0, 0, 0, 0, 0, 0, 0, 0,
+	129, 0, 0, 0, 285, 0, 0, 405, 0, 181,
+	0, 199, 132, 142, 161, 0, 128, 93, 0, 133,
+	203, 225, 92, 100, 0, 131, 168, 186, 190, 0,
+	0, 0, 114, 0, 188, 172, 215, 0, 187, 104,
+	149, 147, 207, 182, 214, 223, 224, 202, 222, 232,
+	94, 200, 213, 108, 191, 144, 111, 152, 113, 156,
+	110, 153, 137, 150, 206, 173, 120, 124, 201, 96,
+	211, 198, 158, 138, 139, 95, 0, 185, 117, 126,
+	116, 169, 208, 209, 115, 234, 101, 221, 98, 102,
+	220, 166, 205, 212, 159, 155, 97, 210, 157, 154,
+	141, 122, 134, 178, 151, 179, 135, 163, 162, 164,
+	0, 0, 0, 197, 218, 235, 105, 0, 193, 204,
+	226, 227, 228, 229, 230, 231, 0, 0, 106, 127,
+	121, 177, 165, 103, 136, 194, 140, 148, 184, 233,
+	171, 189, 109, 217, 195, 395, 406, 401, 402, 399,
+	400, 398, 397, 396, 408, 387, 388, 389, 390, 392,
+	0, 403, 404, 391, 91, 99, 145, 0, 183, 125,
+	0, 0, 0, 0, 192, 112, 170, 0, 174, 176,
+	167, 119, 123, 219, 0, 118, 0, 0, 0, 0,
+	0, 143, 394, 146, 0, 0, 196, 160, 0, 0,
+	0, 0, 385, 386, 0, 0, 0, 0, 0, 0,
+	0, 0, 62, 0, 0, 352, 373, 372, 375, 376,
+	377, 378, 0, 0, 107, 374, 379, 380, 381, 0,
+	0, 0, 0, 366, 1693, 393, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 363, 364, 0, 0, 0,
+	0, 407, 0, 365, 0, 0, 360, 361, 362, 367,
+	0, 175, 0, 1695, 180, 130, 0, 0, 0, 0,
+	0, 0, 0, 0, 129, 0, 0, 0, 285, 0,
+	0, 405, 0, 181, 0, 199, 132, 142, 161, 0,
+	128, 93, 0, 133, 203, 225, 92, 100, 0, 131,
+	168, 186, 190, 0, 0, 0, 114, 0, 188, 172,
+	215, 0, 187, 104, 149, 147, 207, 182, 214, 223,
+	224, 202, 222, 232, 94, 200, 213, 108, 191, 144,
+	111, 152, 113, 156, 110, 153, 137, 150, 206, 173,
+	120, 124, 201, 96, 211, 198, 158, 138, 139, 95,
+	0, 185, 117, 126, 116, 169, 208, 209, 115, 234,
+	101, 221, 98, 102, 220, 166, 205, 212, 159, 155,
+	97, 210, 157, 154, 141, 122, 134, 178, 151, 179,
+	135, 163, 162, 164, 0, 0, 0, 197, 218, 235,
+	105, 0, 193, 204, 226, 227, 228, 229, 230, 231,
+	0, 0, 106, 127, 121, 177, 165, 103, 136, 194,
+	140, 148, 184, 233, 171, 189, 109, 217, 195, 395,
+	406, 401, 402, 399, 400, 398, 397, 396, 408, 387,
+	388, 389, 390, 392, 0, 403, 404, 391, 91, 99,
+	145, 0, 183, 125, 0, 0, 0, 0, 192, 1694,
+	170, 0, 174, 176, 167, 119, 123, 219, 0, 118,
+	0, 0, 0, 0, 0, 143, 394, 146, 0, 0,
+	196, 160, 0, 0, 0, 0, 385, 386, 0, 0,
+	0, 0, 0, 0, 0, 0, 62, 0, 0, 352,
+	373, 372, 375, 376, 377, 378, 0, 0, 107, 374,
+	379, 380, 381, 0, 0, 0, 0, 366, 0, 393,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 363,
+	364, 0, 0, 0, 0, 407, 0, 365, 0, 0,
+	360, 361, 362, 367, 0, 175, 0, 216, 180, 130,
+	0, 0, 0, 0, 0, 0, 0, 0, 129, 0,
+	0, 0, 285, 0, 0, 405, 0, 181, 0, 199,
+	132, 142, 161, 0, 128, 93, 0, 133, 203, 225,
+	92, 100, 0, 131, 168, 186, 190, 0, 0, 0,
+	114, 0, 188, 172, 215, 1684, 187, 104, 149, 147,
+	207, 182, 214, 223, 224, 202, 222, 232, 94, 200,
+	213, 108, 191, 144, 111, 152, 113, 156, 110, 153,
+	137, 150, 206, 173, 120, 124, 201, 96, 211, 198,
+	158, 138, 139, 95, 0, 185, 117, 126, 116, 169,
+	208, 209, 115, 234, 101, 221, 98, 102, 220, 166,
+	205, 212, 159, 155, 97, 210, 157, 154, 141, 122,
+	134, 178, 151, 179, 135, 163, 162, 164, 0, 0,
+	0, 197, 218, 235, 105, 0, 193, 204, 226, 227,
+	228, 229, 230, 231, 0, 0, 106, 127, 121, 177,
+	165, 103, 136, 194, 140, 148, 184, 233, 171, 189,
+	109, 217, 195, 395, 406, 401, 402, 399, 400, 398,
+	397, 396, 408, 387, 388, 389, 390, 392, 0, 403,
+	404, 391, 91, 99, 145, 0, 183, 125, 0, 0,
+	0, 0, 192, 112, 170, 0, 174, 176, 167, 119,
+	123, 219, 0, 118, 0, 0, 0, 0, 0, 143,
+	394, 146, 0, 0, 196, 160, 0, 0, 0, 0,
+	385, 386, 0, 0, 0, 0, 0, 0, 0, 0,
+	62, 0, 649, 352, 373, 372, 375, 376, 377, 378,
+	0, 0, 107, 374, 379, 380, 381, 0, 0, 0,
+	0, 366, 0, 393, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 363, 364, 0, 0, 0, 0, 407,
+	0, 365, 0, 0, 360, 361, 362, 367, 0, 175,
+	0, 216, 180, 130, 0, 0, 0, 0, 0, 0,
+	0, 0, 129, 0, 0, 0, 285, 0, 0, 405,
+	0, 181, 0, 199, 132, 142, 161, 0, 128, 93,
+	0, 133, 203, 225, 92, 100, 0, 131, 168, 186,
+	190, 0, 0, 0, 114, 0, 188, 172, 215, 0,
+	187, 104, 149, 147, 207, 182, 214, 223, 224, 202,
+	222, 232, 94, 200, 213, 108, 191, 144, 111, 152,
+	113, 156, 110, 153, 137, 150, 206, 173, 120, 124,
+	201, 96, 211, 198, 158, 138, 139, 95, 0, 185,
+	117, 126, 116, 169, 208, 209, 115, 234, 101, 221,
+	98, 102, 220, 166, 205, 212, 159, 155, 97, 210,
+	157, 154, 141, 122, 134, 178, 151, 179, 135, 163,
+	162, 164, 0, 0, 0, 197, 218, 235, 105, 0,
+	193, 204, 226, 227, 228, 229, 230, 231, 0, 0,
+	106, 127, 121, 177, 165, 103, 136, 194, 140, 148,
+	184, 233, 171, 189, 109, 217, 195, 395, 406, 401,
+	402, 399, 400, 398, 397, 396, 408, 387, 388, 389,
+	390, 392, 0, 403, 404, 391, 91, 99, 145, 0,
+	183, 125, 0, 0, 0, 0, 192, 112, 170, 0,
+	174, 176, 167, 119, 123, 219, 0, 118, 0, 0,
+	0, 0, 0, 143, 394, 146, 0, 0, 196, 160,
+	0, 0, 0, 0, 385, 386, 0, 0, 0, 0,
+	0, 0, 0, 0, 62, 0, 0, 352, 373, 372,
+	375, 376, 377, 378, 0, 0, 107, 374, 379, 380,
+	381, 0, 0, 0, 0, 366, 0, 393, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 363, 364, 0,
+	0, 0, 0, 407, 0, 365, 0, 0, 360, 361,
+	362, 367, 0, 175, 0, 1695, 180, 130, 0, 0,
+	0, 0, 0, 0, 0, 0, 129, 0, 0, 0,
+	285, 0, 0, 405, 0, 181, 0, 199, 132, 142,
+	161, 0, 128, 93, 0, 133, 203, 225, 92, 100,
+	0, 131, 168, 186, 190, 0, 0, 0, 114, 0,
+	188, 172, 215, 0, 187, 104, 149, 147, 207, 182,
+	214, 223, 224, 202, 222, 232, 94, 200, 213, 108,
+	191, 144, 111, 152, 113, 156, 110, 153, 137, 150,
+	206, 173, 120, 124, 201, 96, 211, 198, 158, 138,
+	139, 95, 0, 185, 117, 126, 116, 169, 208, 209,
+	115, 234, 101, 221, 98, 102, 220, 166, 205, 212,
+	159, 155, 97, 210, 157, 154, 141, 122, 134, 178,
+	151, 179, 135, 163, 162, 164, 0, 0, 0, 197,
+	218, 235, 105, 0, 193, 204, 226, 227, 228, 229,
+	230, 231, 0, 0, 106, 127, 121, 177, 165, 103,
+	136, 194, 140, 148, 184, 233, 171, 189, 109, 217,
+	195, 395, 406, 401, 402, 399, 400, 398, 397, 396,
+	408, 387, 388, 389, 390, 392, 0, 403, 404, 391,
+	91, 99, 145, 0, 183, 125, 0, 0, 0, 0,
+	192, 1694, 170, 0, 174, 176, 167, 119, 123, 219,
+	0, 118, 0, 0, 0, 0, 0, 143, 394, 146,
+	0, 0, 196, 160, 0, 0, 0, 0, 385, 386,
+	0, 0, 0, 0, 0, 0, 0, 0, 62, 0,
+	0, 352, 373, 372, 375, 376, 377, 378, 0, 0,
+	107, 374, 379, 380, 381, 0, 0, 0, 0, 366,
+	0, 393, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 363, 364, 0, 0, 0, 0, 407, 0, 365,
+	0, 0, 360, 361, 362, 367, 0, 175, 0, 216,
+	180, 130, 0, 0, 0, 0, 0, 0, 0, 0,
+	129, 0, 0, 0, 285, 0, 0, 405, 0, 181,
+	0, 199, 132, 142, 161, 0, 128, 93, 0, 133,
+	203, 225, 92, 100, 0, 131, 168, 186, 190, 0,
+	0, 0, 114, 0, 188, 172, 215, 0, 187, 104,
+	149, 147, 207, 182, 214, 223, 224, 202, 222, 232,
+	94, 200, 213, 108, 191, 144, 111, 152, 113, 156,
+	110, 153, 137, 150, 206, 173, 120, 124, 201, 96,
+	211, 198, 158, 138, 139, 95, 0, 185, 117, 126,
+	116, 169, 208, 209, 115, 234, 101, 221, 98, 102,
+	220, 166, 205, 212, 159, 155, 97, 210, 157, 154,
+	141, 122, 134, 178, 151, 179, 135, 163, 162, 164,
+	0, 0, 0, 197, 218, 235, 105, 0, 193, 204,
+	226, 227, 228, 229, 230, 231, 0, 0, 106, 127,
+	121, 177, 165, 103, 136, 194, 140, 148, 184, 233,
+	171, 189, 109, 217, 195, 395, 406, 401, 402, 399,
+	400, 398, 397, 396, 408, 387, 388, 389, 390, 392,
+	0, 403, 404, 391, 91, 99, 145, 0, 183, 125,
+	0, 0, 0, 0, 192, 112, 170, 0, 174, 176,
+	167, 119, 123, 219, 0, 118, 0, 0, 0, 0,
+	0, 143, 0, 146, 0, 0, 196, 160, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 89, 0, 0, 0, 0,
+	0, 0, 0, 0, 107, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 687, 686, 696, 697, 689, 690, 691, 692, 693,
+	694, 695, 688, 0, 0, 698, 0, 0, 0, 0,
+	0, 175, 0, 216, 180, 130, 0, 0, 0, 0,
+	0, 0, 0, 0, 129, 0, 0, 0, 285, 0,
+	0, 0, 0, 181, 0, 199, 132, 142, 161, 0,
+	128, 93, 0, 133, 203, 225, 92, 100, 0, 131,
+	168, 186, 190, 0, 0, 0, 114, 0, 188, 172,
+	215, 0, 187, 104, 149, 147, 207, 182, 214, 223,
+	224, 202, 222, 232, 94, 200, 213, 108, 191, 144,
+	111, 152, 113, 156, 110, 153, 137, 150, 206, 173,
+	120, 124, 201, 96, 211, 198, 158, 138, 139, 95,
+	0, 185, 117, 126, 116, 169, 208, 209, 115, 234,
+	101, 221, 98, 102, 220, 166, 205, 212, 159, 155,
+	97, 210, 157, 154, 141, 122, 134, 178, 151, 179,
+	135, 163, 162, 164, 0, 0, 0, 197, 218, 235,
+	105, 0, 193, 204, 226, 227, 228, 229, 230, 231,
+	0, 0, 106, 127, 121, 177, 165, 103, 136, 194,
+	140, 148, 184, 233, 171, 189, 109, 217, 195, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 91, 99,
+	145, 0, 183, 125, 0, 0, 0, 0, 192, 112,
+	170, 0, 174, 176, 167, 119, 123, 219, 0, 118,
+	563, 0, 0, 0, 0, 143, 0, 146, 0, 0,
+	196, 160, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 547, 0, 0, 89,
+	0, 0, 0, 0, 0, 0, 0, 0, 107, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 175, 0, 216, 180, 130,
+	0, 0, 0, 0, 0, 0, 0, 0, 129, 0,
+	0, 562, 285, 0, 0, 0, 559, 556, 548, 557,
+	558, 142, 161, 0, 128, 93, 0, 133, 203, 225,
+	92, 100, 554, 561, 168, 186, 190, 0, 0, 0,
+	114, 0, 188, 172, 215, 0, 187, 104, 149, 147,
+	207, 182, 214, 223, 224, 202, 222, 232, 94, 200,
+	213, 108, 191, 144, 111, 152, 113, 156, 110, 153,
+	137, 150, 206, 173, 120, 124, 201, 96, 211, 198,
+	158, 138, 139, 95, 0, 185, 117, 126, 116, 169,
+	208, 209, 115, 234, 101, 221, 98, 102, 220, 166,
+	205, 212, 159, 155, 97, 210, 157, 154, 141, 122,
+	134, 178, 151, 179, 135, 163, 162, 164, 0, 0,
+	0, 197, 218, 235, 105, 0, 193, 204, 226, 227,
+	228, 229, 230, 231, 0, 0, 106, 127, 121, 177,
+	165, 103, 136, 194, 140, 148, 184, 233, 171, 189,
+	109, 217, 195, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 91, 99, 145, 0, 183, 125, 0, 0,
+	0, 0, 192, 112, 170, 0, 174, 176, 167, 119,
+	123, 219, 0, 118, 563, 0, 0, 0, 0, 143,
+	0, 146, 0, 0, 196, 160, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	547, 0, 0, 89, 0, 0, 0, 0, 0, 0,
+	0, 0, 107, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 552,
+	0, 216, 180, 130, 0, 0, 0, 0, 0, 0,
+	0, 0, 129, 0, 0, 562, 285, 0, 0, 0,
+	559, 556, 548, 557, 558, 142, 161, 0, 128, 93,
+	0, 133, 203, 225, 92, 100, 554, 561, 168, 186,
+	190, 0, 0, 0, 114, 0, 188, 172, 215, 0,
+	187, 104, 149, 147, 207, 182, 214, 223, 224, 202,
+	222, 232, 94, 200, 213, 108, 191, 144, 111, 152,
+	113, 156, 110, 153, 137, 150, 206, 173, 120, 124,
+	201, 96, 211, 198, 158, 138, 139, 95, 0, 185,
+	117, 126, 116, 169, 208, 209, 115, 234, 101, 221,
+	98, 102, 220, 166, 205, 212, 159, 155, 97, 210,
+	157, 154, 141, 122, 134, 178, 151, 179, 135, 163,
+	162, 164, 0, 0, 0, 197, 218, 235, 105, 0,
+	193, 204, 226, 227, 228, 229, 230, 231, 0, 0,
+	106, 127, 121, 177, 165, 103, 136, 194, 140, 148,
+	184, 233, 171, 189, 109, 217, 195, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 91, 99, 145, 0,
+	183, 125, 0, 0, 0, 0, 192, 112, 170, 0,
+	174, 176, 167, 119, 123, 219, 0, 118, 563, 0,
+	0, 0, 0, 143, 0, 146, 0, 0, 196, 160,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 89, 0, 0,
+	0, 0, 0, 0, 0, 0, 107, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 175, 0, 216, 180, 130, 0, 0,
+	0, 0, 0, 0, 0, 0, 129, 0, 0, 562,
+	285, 0, 0, 0, 0, 567, 565, 199, 132, 142,
+	161, 0, 128, 93, 569, 133, 203, 225, 92, 100,
+	570, 568, 168, 186, 190, 0, 0, 0, 114, 0,
+	188, 172, 215, 0, 187, 104, 149, 147, 207, 182,
+	214, 223, 224, 202, 222, 232, 94, 200, 213, 108,
+	191, 144, 111, 152, 113, 156, 110, 153, 137, 150,
+	206, 173, 120, 124, 201, 96, 211, 198, 158, 138,
+	139, 95, 0, 185, 117, 126, 116, 169, 208, 209,
+	115, 234, 101, 221, 98, 102, 220, 166, 205, 212,
+	159, 155, 97, 210, 157, 154, 141, 122, 134, 178,
+	151, 179, 135, 163, 162, 164, 0, 0, 0, 197,
+	218, 235, 105, 0, 193, 204, 226, 227, 228, 229,
+	230, 231, 0, 0, 106, 127, 121, 177, 165, 103,
+	136, 194, 140, 148, 184, 233, 171, 189, 109, 217,
+	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	91, 99, 145, 0, 183, 125, 0, 0, 0, 0,
+	192, 112, 0, 0, 174, 176, 167, 119, 123, 219,
+	170, 0, 0, 0, 675, 0, 0, 0, 0, 118,
+	0, 0, 0, 0, 0, 143, 0, 146, 0, 0,
+	196, 160, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 89,
+	0, 677, 0, 0, 0, 0, 0, 0, 107, 0,
+	0, 0, 0, 0, 672, 671, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 673, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 175, 0, 216, 180, 130,
+	0, 0, 0, 0, 0, 0, 0, 0, 129, 0,
+	0, 0, 285, 0, 0, 0, 0, 181, 0, 199,
+	132, 142, 161, 0, 128, 93, 0, 133, 203, 225,
+	92, 100, 0, 131, 168, 186, 190, 0, 0, 0,
+	114, 0, 188, 172, 215, 0, 187, 104, 149, 147,
+	207, 182, 214, 223, 224, 202, 222, 232, 94, 200,
+	213, 108, 191, 144, 111, 152, 113, 156, 110, 153,
+	137, 150, 206, 173, 120, 124, 201, 96, 211, 198,
+	158, 138, 139, 95, 0, 185, 117, 126, 116, 169,
+	208, 209, 115, 234, 101, 221, 98, 102, 220, 166,
+	205, 212, 159, 155, 97, 210, 157, 154, 141, 122,
+	134, 178, 151, 179, 135, 163, 162, 164, 0, 0,
+	0, 197, 218, 235, 105, 0, 193, 204, 226, 227,
+	228, 229, 230, 231, 0, 0, 106, 127, 121, 177,
+	165, 103, 136, 194, 140, 148, 184, 233, 171, 189,
+	109, 217, 195, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 91, 99, 145, 0, 183, 125, 0, 0,
+	0, 0, 192, 112, 170, 0, 174, 176, 167, 119,
+	123, 219, 0, 118, 563, 0, 0, 0, 0, 143,
+	0, 146, 0, 0, 196, 160, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 89, 0, 0, 0, 0, 0, 0,
+	0, 0, 107, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 571,
+	0, 216, 180, 130, 0, 0, 0, 0, 0, 0,
+	0, 0, 129, 0, 0, 562, 285, 0, 0, 0,
+	0, 567, 565, 199, 132, 142, 161, 0, 128, 93,
+	569, 133, 203, 225, 92, 100, 570, 568, 168, 186,
+	190, 0, 0, 0, 114, 0, 188, 172, 215, 0,
+	187, 104, 149, 147, 207, 182, 214, 223, 224, 202,
+	222, 232, 94, 200, 213, 108, 191, 144, 111, 152,
+	113, 156, 110, 153, 137, 150, 206, 173, 120, 124,
+	201, 96, 211, 198, 158, 138, 139, 95, 0, 185,
+	117, 126, 116, 169, 208, 209, 115, 234, 101, 221,
+	98, 102, 220, 166, 205, 212, 159, 155, 97, 210,
+	157, 154, 141, 122, 134, 178, 151, 179, 135, 163,
+	162, 164, 0, 0, 0, 197, 218, 235, 105, 0,
+	193, 204, 226, 227, 228, 229, 230, 231, 0, 0,
+	106, 127, 121, 177, 165, 103, 136, 194, 140, 148,
+	184, 233, 171, 189, 109, 217, 195, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 91, 99, 145, 0,
+	183, 125, 0, 0, 0, 0, 192, 112, 170, 0,
+	174, 176, 167, 119, 123, 219, 0, 118, 0, 0,
+	0, 0, 0, 143, 0, 146, 0, 0, 196, 160,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 89, 0, 0,
+	0, 0, 0, 0, 0, 0, 107, 0, 0, 0,
+	0, 0, 83, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 175, 0, 216, 180, 130, 0, 0,
+	0, 0, 0, 0, 0, 0, 129, 85, 86, 0,
+	82, 0, 0, 0, 87, 181, 0, 199, 132, 142,
+	161, 0, 128, 93, 0, 133, 203, 225, 92, 100,
+	0, 131, 168, 186, 190, 0, 0, 0, 114, 0,
+	188, 172, 215, 0, 187, 104, 149, 147, 207, 182,
+	214, 223, 224, 202, 222, 232, 94, 200, 213, 108,
+	191, 144, 111, 152, 113, 156, 110, 153, 137, 150,
+	206, 173, 120, 124, 201, 96, 211, 198, 158, 138,
+	139, 95, 0, 185, 117, 126, 116, 169, 208, 209,
+	115, 234, 101, 221, 98, 102, 220, 166, 205, 212,
+	159, 155, 97, 210, 157, 154, 141, 122, 134, 178,
+	151, 179, 135, 163, 162, 164, 0, 0, 0, 197,
+	218, 235, 105, 0, 193, 204, 226, 227, 228, 229,
+	230, 231, 0, 0, 106, 127, 121, 177, 165, 103,
+	136, 194, 140, 148, 184, 233, 171, 189, 109, 217,
+	195, 0, 84, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	91, 99, 145, 0, 183, 125, 0, 0, 27, 0,
+	192, 112, 0, 0, 174, 176, 167, 119, 123, 219,
+	170, 0, 0, 0, 0, 0, 0, 0, 0, 118,
+	0, 0, 0, 0, 0, 143, 0, 146, 0, 0,
+	196, 160, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 62, 0, 0, 283,
+	0, 0, 0, 0, 0, 0, 0, 0, 107, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 175, 0, 216, 180, 130,
+	0, 0, 0, 0, 0, 0, 0, 0, 129, 0,
+	0, 0, 285, 0, 0, 0, 0, 181, 0, 199,
+	132, 142, 161, 0, 128, 93, 0, 133, 203, 225,
+	92, 100, 0, 131, 168, 186, 190, 0, 0, 0,
+	114, 0, 188, 172, 215, 0, 187, 104, 149, 147,
+	207, 182, 214, 223, 224, 202, 222, 232, 94, 200,
+	213, 108, 191, 144, 111, 152, 113, 156, 110, 153,
+	137, 150, 206, 173, 120, 124, 201, 96, 211, 198,
+	158, 138, 139, 95, 0, 185, 117, 126, 116, 169,
+	208, 209, 115, 234, 101, 221, 98, 102, 220, 166,
+	205, 212, 159, 155, 97, 210, 157, 154, 141, 122,
+	134, 178, 151, 179, 135, 163, 162, 164, 0, 0,
+	0, 197, 218, 235, 105, 0, 193, 204, 226, 227,
+	228, 229, 230, 231, 0, 0, 106, 127, 121, 177,
+	165, 103, 136, 194, 140, 148, 184, 233, 171, 189,
+	109, 217, 195, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 91, 99, 145, 59, 183, 125, 0, 0,
+	0, 0, 192, 112, 170, 769, 174, 176, 167, 119,
+	123, 219, 0, 118, 563, 0, 0, 0, 0, 143,
+	0, 146, 0, 0, 196, 160, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 89, 0, 0, 0, 0, 0, 0,
+	0, 0, 107, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 175,
+	0, 216, 180, 130, 0, 0, 0, 0, 0, 0,
+	0, 0, 129, 0, 0, 562, 285, 0, 0, 0,
+	559, 556, 0, 557, 558, 142, 161, 0, 128, 93,
+	0, 133, 203, 225, 92, 100, 554, 561, 168, 186,
+	190, 0, 0, 0, 114, 0, 188, 172, 215, 0,
+	187, 104, 149, 147, 207, 182, 214, 223, 224, 202,
+	222, 232, 94, 200, 213, 108, 191, 144, 111, 152,
+	113, 156, 110, 153, 137, 150, 206, 173, 120, 124,
+	201, 96, 211, 198, 158, 138, 139, 95, 0, 185,
+	117, 126, 116, 169, 208, 209, 115, 234, 101, 221,
+	98, 102, 220, 166, 205, 212, 159, 155, 97, 210,
+	157, 154, 141, 122, 134, 178, 151, 179, 135, 163,
+	162, 164, 0, 0, 0, 197, 218, 235, 105, 0,
+	193, 204, 226, 227, 228, 229, 230, 231, 0, 0,
+	106, 127, 121, 177, 165, 103, 136, 194, 140, 148,
+	184, 233, 171, 189, 109, 217, 195, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 91, 99, 145, 0,
+	183, 125, 0, 0, 0, 0, 192, 112, 0, 0,
+	174, 176, 167, 119, 123, 219, 170, 0, 0, 0,
+	1063, 0, 0, 0, 0, 118, 0, 0, 0, 0,
+	0, 143, 0, 146, 0, 0, 196, 160, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 283, 0, 1065, 0, 0,
+	0, 0, 0, 0, 107, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 175, 0, 216, 180, 130, 0, 0, 0, 0,
+	0, 0, 0, 0, 129, 0, 0, 0, 285, 0,
+	0, 0, 0, 181, 0, 199, 132, 142, 161, 0,
+	128, 93, 0, 133, 203, 225, 92, 100, 0, 131,
+	168, 186, 190, 0, 0, 0, 114, 0, 188, 172,
+	215, 0, 187, 104, 149, 147, 207, 182, 214, 223,
+	224, 202, 222, 232, 94, 200, 213, 108, 191, 144,
+	111, 152, 113, 156, 110, 153, 137, 150, 206, 173,
+	120, 124, 201, 96, 211, 198, 158, 138, 139, 95,
+	0, 185, 117, 126, 116, 169, 208, 209, 115, 234,
+	101, 221, 98, 102, 220, 166, 205, 212, 159, 155,
+	97, 210, 157, 154, 141, 122, 134, 178, 151, 179,
+	135, 163, 162, 164, 0, 0, 0, 197, 218, 235,
+	105, 0, 193, 204, 226, 227, 228, 229, 230, 231,
+	0, 0, 106, 127, 121, 177, 165, 103, 136, 194,
+	140, 148, 184, 233, 171, 189, 109, 217, 195, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 91, 99,
+	145, 0, 183, 125, 0, 0, 0, 0, 192, 112,
+	170, 0, 174, 176, 167, 119, 123, 219, 0, 118,
+	0, 0, 0, 0, 0, 143, 0, 146, 0, 0,
+	196, 160, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 62, 0, 0, 283,
+	0, 0, 0, 0, 0, 0, 0, 0, 107, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 175, 0, 216, 180, 130,
+	0, 0, 0, 0, 0, 0, 0, 0, 129, 0,
+	0, 0, 285, 0, 0, 0, 0, 181, 0, 199,
+	132, 142, 161, 0, 128, 93, 0, 133, 203, 225,
+	92, 100, 0, 131, 168, 186, 190, 0, 0, 0,
+	114, 0, 188, 172, 215, 0, 187, 104, 149, 147,
+	207, 182, 214, 223, 224, 202, 222, 232, 94, 200,
+	213, 108, 191, 144, 111, 152, 113, 156, 110, 153,
+	137, 150, 206, 173, 120, 124, 201, 96, 211, 198,
+	158, 138, 139, 95, 0, 185, 117, 126, 116, 169,
+	208, 209, 115, 234, 101, 221, 98, 102, 220, 166,
+	205, 212, 159, 155, 97, 210, 157, 154, 141, 122,
+	134, 178, 151, 179, 135, 163, 162, 164, 0, 0,
+	0, 197, 218, 235, 105, 0, 193, 204, 226, 227,
+	228, 229, 230, 231, 0, 0, 106, 127, 121, 177,
+	165, 103, 136, 194, 140, 148, 184, 233, 171, 189,
+	109, 217, 195, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 91, 99, 145, 0, 183, 125, 0, 0,
+	27, 0, 192, 112, 0, 769, 174, 176, 167, 119,
+	123, 219, 170, 0, 0, 0, 0, 0, 0, 0,
+	0, 118, 0, 0, 0, 0, 0, 143, 0, 146,
+	0, 0, 196, 160, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 62, 0,
+	0, 89, 0, 0, 0, 0, 0, 0, 0, 0,
+	107, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 175, 0, 216,
+	180, 130, 0, 0, 0, 0, 0, 0, 0, 0,
+	129, 0, 0, 0, 285, 0, 0, 0, 0, 181,
+	0, 199, 132, 142, 161, 0, 128, 93, 0, 133,
+	203, 225, 92, 100, 0, 131, 168, 186, 190, 0,
+	0, 0, 114, 0, 188, 172, 215, 0, 187, 104,
+	149, 147, 207, 182, 214, 223, 224, 202, 222, 232,
+	94, 200, 213, 108, 191, 144, 111, 152, 113, 156,
+	110, 153, 137, 150, 206, 173, 120, 124, 201, 96,
+	211, 198, 158, 138, 139, 95, 0, 185, 117, 126,
+	116, 169, 208, 209, 115, 234, 101, 221, 98, 102,
+	220, 166, 205, 212, 159, 155, 97, 210, 157, 154,
+	141, 122, 134, 178, 151, 179, 135, 163, 162, 164,
+	0, 0, 0, 197, 218, 235, 105, 0, 193, 204,
+	226, 227, 228, 229, 230, 231, 0, 0, 106, 127,
+	121, 177, 165, 103, 136, 194, 140, 148, 184, 233,
+	171, 189, 109, 217, 195, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 91, 99, 145, 0, 183, 125,
+	0, 0, 0, 0, 192, 112, 0, 0, 174, 176,
+	167, 119, 123, 219, 170, 0, 0, 0, 1063, 0,
+	0, 0, 0, 118, 0, 0, 0, 0, 0, 143,
+	0, 146, 0, 0, 196, 160, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 283, 0, 1065, 0, 0, 0, 0,
+	0, 0, 107, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1061,
+	0, 216, 180, 130, 0, 0, 0, 0, 0, 0,
+	0, 0, 129, 0, 0, 0, 285, 0, 0, 0,
+	0, 181, 0, 199, 132, 142, 161, 0, 128, 93,
+	0, 133, 203, 225, 92, 100, 0, 131, 168, 186,
+	190, 0, 0, 0, 114, 0, 188, 172, 215, 0,
+	187, 104, 149, 147, 207, 182, 214, 223, 224, 202,
+	222, 232, 94, 200, 213, 108, 191, 144, 111, 152,
+	113, 156, 110, 153, 137, 150, 206, 173, 120, 124,
+	201, 96, 211, 198, 158, 138, 139, 95, 0, 185,
+	117, 126, 116, 169, 208, 209, 115, 234, 101, 221,
+	98, 102, 220, 166, 205, 212, 159, 155, 97, 210,
+	157, 154, 141, 122, 134, 178, 151, 179, 135, 163,
+	162, 164, 0, 0, 0, 197, 218, 235, 105, 0,
+	193, 204, 226, 227, 228, 229, 230, 231, 0, 0,
+	106, 127, 121, 177, 165, 103, 136, 194, 140, 148,
+	184, 233, 171, 189, 109, 217, 195, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 91, 99, 145, 0,
+	183, 125, 0, 0, 0, 0, 192, 112, 170, 0,
+	174, 176, 167, 119, 123, 219, 0, 118, 0, 0,
+	0, 0, 0, 143, 0, 146, 0, 0, 196, 160,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 89, 0, 0,
+	956, 0, 0, 957, 0, 0, 107, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 175, 0, 216, 180, 130, 0, 0,
+	0, 0, 0, 0, 0, 0, 129, 0, 0, 0,
+	285, 0, 0, 0, 0, 181, 0, 199, 132, 142,
+	161, 0, 128, 93, 0, 133, 203, 225, 92, 100,
+	0, 131, 168, 186, 190, 0, 0, 0, 114, 0,
+	188, 172, 215, 0, 187, 104, 149, 147, 207, 182,
+	214, 223, 224, 202, 222, 232, 94, 200, 213, 108,
+	191, 144, 111, 152, 113, 156, 110, 153, 137, 150,
+	206, 173, 120, 124, 201, 96, 211, 198, 158, 138,
+	139, 95, 0, 185, 117, 126, 116, 169, 208, 209,
+	115, 234, 101, 221, 98, 102, 220, 166, 205, 212,
+	159, 155, 97, 210, 157, 154, 141, 122, 134, 178,
+	151, 179, 135, 163, 162, 164, 0, 0, 0, 197,
+	218, 235, 105, 0, 193, 204, 226, 227, 228, 229,
+	230, 231, 0, 0, 106, 127, 121, 177, 165, 103,
+	136, 194, 140, 148, 184, 233, 171, 189, 109, 217,
+	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	91, 99, 145, 0, 183, 125, 0, 0, 0, 0,
+	192, 112, 170, 0, 174, 176, 167, 119, 123, 219,
+	0, 118, 0, 788, 0, 0, 0, 143, 0, 146,
+	0, 0, 196, 160, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 89, 0, 787, 0, 0, 0, 0, 0, 0,
+	107, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 175, 0, 216,
+	180, 130, 0, 0, 0, 0, 0, 0, 0, 0,
+	129, 0, 0, 0, 285, 0, 0, 0, 0, 181,
+	0, 199, 132, 142, 161, 0, 128, 93, 0, 133,
+	203, 225, 92, 100, 0, 131, 168, 186, 190, 0,
+	0, 0, 114, 0, 188, 172, 215, 0, 187, 104,
+	149, 147, 207, 182, 214, 223, 224, 202, 222, 232,
+	94, 200, 213, 108, 191, 144, 111, 152, 113, 156,
+	110, 153, 137, 150, 206, 173, 120, 124, 201, 96,
+	211, 198, 158, 138, 139, 95, 0, 185, 117, 126,
+	116, 169, 208, 209, 115, 234, 101, 221, 98, 102,
+	220, 166, 205, 212, 159, 155, 97, 210, 157, 154,
+	141, 122, 134, 178, 151, 179, 135, 163, 162, 164,
+	0, 0, 0, 197, 218, 235, 105, 0, 193, 204,
+	226, 227, 228, 229, 230, 231, 0, 0, 106, 127,
+	121, 177, 165, 103, 136, 194, 140, 148, 184, 233,
+	171, 189, 109, 217, 195, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 91, 99, 145, 0, 183, 125,
+	0, 0, 0, 0, 192, 112, 170, 0, 174, 176,
+	167, 119, 123, 219, 0, 118, 0, 0, 0, 0,
+	0, 143, 0, 146, 0, 0, 196, 160, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 62, 0, 0, 89, 0, 0, 0, 0,
+	0, 0, 0, 0, 107, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 175, 0, 216, 180, 130, 0, 0, 0, 0,
+	0, 0, 0, 0, 129, 0, 0, 0, 285, 0,
+	0, 0, 0, 181, 0, 199, 132, 142, 161, 0,
+	128, 93, 0, 133, 203, 225, 92, 100, 0, 131,
+	168, 186, 190, 0, 0, 0, 114, 0, 188, 172,
+	215, 0, 187, 104, 149, 147, 207, 182, 214, 223,
+	224, 202, 222, 232, 94, 200, 213, 108, 191, 144,
+	111, 152, 113, 156, 110, 153, 137, 150, 206, 173,
+	120, 124, 201, 96, 211, 198, 158, 138, 139, 95,
+	0, 185, 117, 126, 116, 169, 208, 209, 115, 234,
+	101, 221, 98, 102, 220, 166, 205, 212, 159, 155,
+	97, 210, 157, 154, 141, 122, 134, 178, 151, 179,
+	135, 163, 162, 164, 0, 0, 0, 197, 218, 235,
+	105, 0, 193, 204, 226, 227, 228, 229, 230, 231,
+	0, 0, 106, 127, 121, 177, 165, 103, 136, 194,
+	140, 148, 184, 233, 171, 189, 109, 217, 195, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 91, 99,
+	145, 0, 183, 125, 0, 0, 0, 0, 192, 112,
+	170, 0, 174, 176, 167, 119, 123, 219, 0, 118,
+	0, 0, 0, 0, 0, 143, 0, 146, 0, 0,
+	196, 160, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1460, 89,
+	0, 0, 0, 0, 0, 0, 0, 0, 107, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 175, 0, 216, 180, 130,
+	0, 0, 0, 0, 0, 0, 0, 0, 129, 0,
+	0, 0, 285, 0, 0, 0, 0, 181, 0, 199,
+	132, 142, 161, 0, 128, 93, 0, 133, 203, 225,
+	92, 100, 0, 131, 168, 186, 190, 0, 0, 0,
+	114, 0, 188, 172, 215, 0, 187, 104, 149, 147,
+	207, 182, 214, 223, 224, 202, 222, 232, 94, 200,
+	213, 108, 191, 144, 111, 152, 113, 156, 110, 153,
+	137, 150, 206, 173, 120, 124, 201, 96, 211, 198,
+	158, 138, 139, 95, 0, 185, 117, 126, 116, 169,
+	208, 209, 115, 234, 101, 221, 98, 102, 220, 166,
+	205, 212, 159, 155, 97, 210, 157, 154, 141, 122,
+	134, 178, 151, 179, 135, 163, 162, 164, 0, 0,
+	0, 197, 218, 235, 105, 0, 193, 204, 226, 227,
+	228, 229, 230, 231, 0, 0, 106, 127, 121, 177,
+	165, 103, 136, 194, 140, 148, 184, 233, 171, 189,
+	109, 217, 195, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 91, 99, 145, 0, 183, 125, 0, 0,
+	0, 0, 192, 112, 170, 0, 174, 176, 167, 119,
+	123, 219, 0, 118, 0, 0, 0, 0, 0, 143,
+	0, 146, 0, 0, 196, 160, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1327, 89, 0, 0, 0, 0, 0, 0,
+	0, 0, 107, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 175,
+	0, 216, 180, 130, 0, 0, 0, 0, 0, 0,
+	0, 0, 129, 0, 0, 0, 285, 0, 0, 0,
+	0, 181, 0, 199, 132, 142, 161, 0, 128, 93,
+	0, 133, 203, 225, 92, 100, 0, 131, 168, 186,
+	190, 0, 0, 0, 114, 0, 188, 172, 215, 0,
+	187, 104, 149, 147, 207, 182, 214, 223, 224, 202,
+	222, 232, 94, 200, 213, 108, 191, 144, 111, 152,
+	113, 156, 110, 153, 137, 150, 206, 173, 120, 124,
+	201, 96, 211, 198, 158, 138, 139, 95, 0, 185,
+	117, 126, 116, 169, 208, 209, 115, 234, 101, 221,
+	98, 102, 220, 166, 205, 212, 159, 155, 97, 210,
+	157, 154, 141, 122, 134, 178, 151, 179, 135, 163,
+	162, 164, 0, 0, 0, 197, 218, 235, 105, 0,
+	193, 204, 226, 227, 228, 229, 230, 231, 0, 0,
+	106, 127, 121, 177, 165, 103, 136, 194, 140, 148,
+	184, 233, 171, 189, 109, 217, 195, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 91, 99, 145, 0,
+	183, 125, 0, 0, 0, 0, 192, 112, 170, 0,
+	174, 176, 167, 119, 123, 219, 0, 118, 0, 0,
+	0, 0, 0, 143, 0, 146, 0, 0, 196, 160,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 283, 0, 1065,
+	0, 0, 0, 0, 0, 0, 107, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 175, 0, 216, 180, 130, 0, 0,
+	0, 0, 0, 0, 0, 0, 129, 0, 0, 0,
+	285, 0, 0, 0, 0, 181, 0, 199, 132, 142,
+	161, 0, 128, 93, 0, 133, 203, 225, 92, 100,
+	0, 131, 168, 186, 190, 0, 0, 0, 114, 0,
+	188, 172, 215, 0, 187, 104, 149, 147, 207, 182,
+	214, 223, 224, 202, 222, 232, 94, 200, 213, 108,
+	191, 144, 111, 152, 113, 156, 110, 153, 137, 150,
+	206, 173, 120, 124, 201, 96, 211, 198, 158, 138,
+	139, 95, 0, 185, 117, 126, 116, 169, 208, 209,
+	115, 234, 101, 221, 98, 102, 220, 166, 205, 212,
+	159, 155, 97, 210, 157, 154, 141, 122, 134, 178,
+	151, 179, 135, 163, 162, 164, 0, 0, 0, 197,
+	218, 235, 105, 0, 193, 204, 226, 227, 228, 229,
+	230, 231, 0, 0, 106, 127, 121, 177, 165, 103,
+	136, 194, 140, 148, 184, 233, 171, 189, 109, 217,
+	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	91, 99, 145, 0, 183, 125, 0, 0, 0, 0,
+	192, 112, 170, 0, 174, 176, 167, 119, 123, 219,
+	0, 118, 0, 0, 0, 0, 0, 143, 0, 146,
+	0, 0, 196, 160, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 89, 0, 677, 0, 0, 0, 0, 0, 0,
+	107, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 175, 0, 216,
+	180, 130, 0, 0, 0, 0, 0, 0, 0, 0,
+	129, 0, 0, 0, 285, 0, 0, 0, 0, 181,
+	0, 199, 132, 142, 161, 0, 128, 93, 0, 133,
+	203, 225, 92, 100, 0, 131, 168, 186, 190, 0,
+	0, 0, 114, 0, 188, 172, 215, 0, 187, 104,
+	149, 147, 207, 182, 214, 223, 224, 202, 222, 232,
+	94, 200, 213, 108, 191, 144, 111, 152, 113, 156,
+	110, 153, 137, 150, 206, 173, 120, 124, 201, 96,
+	211, 198, 158, 138, 139, 95, 0, 185, 117, 126,
+	116, 169, 208, 209, 115, 234, 101, 221, 98, 102,
+	220, 166, 205, 212, 159, 155, 97, 210, 157, 154,
+	141, 122, 134, 178, 151, 179, 135, 163, 162, 164,
+	0, 0, 0, 197, 218, 235, 105, 0, 193, 204,
+	226, 227, 228, 229, 230, 231, 0, 0, 106, 127,
+	121, 177, 165, 103, 136, 194, 140, 148, 184, 233,
+	171, 189, 109, 217, 195, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 91, 99, 145, 0, 183, 125,
+	0, 0, 0, 0, 192, 112, 170, 0, 174, 176,
+	167, 119, 123, 219, 0, 118, 0, 0, 0, 0,
+	0, 143, 0, 146, 0, 0, 196, 160, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 857, 0, 0, 89, 0, 0, 0, 0,
+	0, 0, 0, 0, 107, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 175, 0, 216, 180, 130, 0, 0, 0, 0,
+	0, 0, 0, 0, 129, 0, 0, 0, 285, 0,
+	0, 0, 0, 181, 0, 199, 132, 142, 161, 0,
+	128, 93, 0, 133, 203, 225, 92, 100, 0, 131,
+	168, 186, 190, 0, 0, 0, 114, 0, 188, 172,
+	215, 0, 187, 104, 149, 147, 207, 182, 214, 223,
+	224, 202, 222, 232, 94, 200, 213, 108, 191, 144,
+	111, 152, 113, 156, 110, 153, 137, 150, 206, 173,
+	120, 124, 201, 96, 211, 198, 158, 138, 139, 95,
+	0, 185, 117, 126, 116, 169, 208, 209, 115, 234,
+	101, 221, 98, 102, 220, 166, 205, 212, 159, 155,
+	97, 210, 157, 154, 141, 122, 134, 178, 151, 179,
+	135, 163, 162, 164, 0, 0, 0, 197, 218, 235,
+	105, 0, 193, 204, 226, 227, 228, 229, 230, 231,
+	0, 0, 106, 127, 121, 177, 165, 103, 136, 194,
+	140, 148, 184, 233, 171, 189, 109, 217, 195, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 91, 99,
+	145, 0, 183, 125, 0, 0, 0, 0, 192, 112,
+	170, 0, 174, 176, 167, 119, 123, 219, 760, 118,
+	0, 0, 0, 0, 0, 143, 0, 146, 0, 0,
+	196, 160, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 283,
+	0, 0, 0, 0, 0, 0, 0, 0, 107, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 175, 0, 216, 180, 130,
+	0, 0, 0, 0, 0, 0, 0, 0, 129, 0,
+	0, 0, 285, 0, 0, 0, 0, 181, 0, 199,
+	132, 142, 161, 0, 128, 93, 0, 133, 203, 225,
+	92, 100, 0, 131, 168, 186, 190, 0, 0, 0,
+	114, 0, 188, 172, 215, 0, 187, 104, 149, 147,
+	207, 182, 214, 223, 224, 202, 222, 232, 94, 200,
+	213, 108, 191, 144, 111, 152, 113, 156, 110, 153,
+	137, 150, 206, 173, 120, 124, 201, 96, 211, 198,
+	158, 138, 139, 95, 0, 185, 117, 126, 116, 169,
+	208, 209, 115, 234, 101, 221, 98, 102, 220, 166,
+	205, 212, 159, 155, 97, 210, 157, 154, 141, 122,
+	134, 178, 151, 179, 135, 163, 162, 164, 0, 0,
+	0, 197, 218, 235, 105, 0, 193, 204, 226, 227,
+	228, 229, 230, 231, 0, 0, 106, 127, 121, 177,
+	165, 103, 136, 194, 140, 148, 184, 233, 171, 189,
+	109, 217, 195, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 91, 99, 145, 0, 183, 125, 0, 0,
+	0, 0, 192, 112, 170, 0, 174, 176, 167, 119,
+	123, 219, 0, 118, 0, 0, 0, 0, 0, 143,
+	0, 146, 0, 0, 196, 160, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 89, 0, 0, 0, 0, 0, 0,
+	0, 0, 107, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 532, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 175,
+	0, 216, 180, 130, 0, 0, 0, 0, 0, 0,
+	0, 0, 129, 0, 0, 0, 285, 0, 0, 0,
+	0, 181, 0, 199, 132, 142, 161, 0, 128, 93,
+	0, 133, 203, 225, 92, 100, 0, 131, 168, 186,
+	190, 0, 0, 0, 114, 0, 188, 172, 215, 0,
+	187, 104, 149, 147, 207, 182, 214, 223, 224, 202,
+	222, 232, 94, 200, 213, 108, 191, 144, 111, 152,
+	113, 156, 110, 153, 137, 150, 206, 173, 120, 124,
+	201, 96, 211, 198, 158, 138, 139, 95, 0, 185,
+	117, 126, 116, 169, 208, 209, 115, 234, 101, 221,
+	98, 102, 220, 166, 205, 212, 159, 155, 97, 210,
+	157, 154, 141, 122, 134, 178, 151, 179, 135, 163,
+	162, 164, 0, 0, 0, 197, 218, 235, 105, 0,
+	193, 204, 226, 227, 228, 229, 230, 231, 0, 0,
+	106, 127, 121, 177, 165, 103, 136, 194, 140, 148,
+	184, 233, 171, 189, 109, 217, 195, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 91, 99, 145, 0,
+	183, 125, 411, 0, 0, 0, 192, 112, 0, 170,
+	174, 176, 167, 119, 123, 219, 0, 0, 118, 0,
+	0, 0, 0, 0, 143, 0, 146, 0, 0, 196,
+	160, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 283, 0,
+	0, 0, 0, 0, 0, 0, 0, 107, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 175, 0, 216, 180, 130, 0,
+	0, 0, 0, 0, 0, 0, 0, 129, 0, 0,
+	0, 285, 0, 0, 0, 0, 181, 0, 199, 132,
+	142, 161, 0, 128, 93, 0, 133, 203, 225, 92,
+	100, 0, 131, 168, 186, 190, 0, 0, 0, 114,
+	0, 188, 172, 215, 0, 187, 104, 149, 147, 207,
+	182, 214, 223, 224, 202, 222, 232, 94, 200, 213,
+	108, 191, 144, 111, 152, 113, 156, 110, 153, 137,
+	150, 206, 173, 120, 124, 201, 96, 211, 198, 158,
+	138, 139, 95, 0, 185, 117, 126, 116, 169, 208,
+	209, 115, 234, 101, 221, 98, 102, 220, 166, 205,
+	212, 159, 155, 97, 210, 157, 154, 141, 122, 134,
+	178, 151, 179, 135, 163, 162, 164, 0, 0, 0,
+	197, 218, 235, 105, 0, 193, 204, 226, 227, 228,
+	229, 230, 231, 0, 0, 106, 127, 121, 177, 165,
+	103, 136, 194, 140, 148, 184, 233, 171, 189, 109,
+	217, 195, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 91, 99, 145, 0, 183, 125, 0, 0, 0,
+	0, 192, 112, 170, 0, 174, 176, 167, 119, 123,
+	219, 0, 118, 0, 0, 0, 0, 0, 143, 0,
+	146, 0, 0, 196, 160, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 283, 0, 0, 0, 0, 0, 0, 0,
+	0, 107, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 175, 0,
+	216, 180, 130, 0, 0, 0, 0, 0, 0, 0,
+	0, 129, 0, 0, 0, 285, 0, 0, 0, 0,
+	181, 0, 199, 132, 142, 161, 0, 128, 93, 0,
+	133, 203, 225, 92, 100, 0, 131, 168, 186, 190,
+	0, 0, 0, 114, 0, 188, 172, 215, 0, 187,
+	104, 149, 147, 207, 182, 214, 223, 224, 202, 222,
+	232, 94, 200, 213, 108, 191, 144, 111, 152, 113,
+	156, 110, 153, 137, 150, 206, 173, 120, 124, 201,
+	96, 211, 198, 158, 138, 139, 95, 0, 185, 117,
+	126, 116, 169, 208, 209, 115, 234, 101, 221, 98,
+	102, 220, 166, 205, 212, 159, 155, 97, 210, 157,
+	154, 141, 122, 134, 178, 151, 179, 135, 163, 162,
+	164, 0, 0, 0, 197, 218, 235, 105, 0, 193,
+	204, 226, 227, 228, 229, 230, 231, 0, 0, 106,
+	127, 121, 177, 165, 103, 136, 194, 140, 148, 184,
+	233, 171, 189, 109, 217, 195, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 91, 99, 145, 0, 183,
+	125, 0, 0, 0, 0, 192, 112, 328, 170, 174,
+	176, 167, 119, 123, 219, 0, 0, 118, 0, 0,
+	0, 0, 0, 143, 0, 146, 0, 0, 196, 160,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 283, 0, 0,
+	0, 0, 0, 0, 0, 0, 107, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 175, 0, 216, 180, 130, 0, 0,
+	0, 0, 0, 0, 0, 0, 129, 0, 280, 0,
+	285, 0, 0, 0, 0, 181, 0, 199, 132, 142,
+	161, 0, 128, 93, 0, 133, 203, 225, 92, 100,
+	0, 131, 168, 186, 190, 0, 0, 0, 114, 0,
+	188, 172, 215, 0, 187, 104, 149, 147, 207, 182,
+	214, 223, 224, 202, 222, 232, 94, 200, 213, 108,
+	191, 144, 111, 152, 113, 156, 110, 153, 137, 150,
+	206, 173, 120, 124, 201, 96, 211, 198, 158, 138,
+	139, 95, 0, 185, 117, 126, 116, 169, 208, 209,
+	115, 234, 101, 221, 98, 102, 220, 166, 205, 212,
+	159, 155, 97, 210, 157, 154, 141, 122, 134, 178,
+	151, 179, 135, 163, 162, 164, 0, 0, 0, 197,
+	218, 235, 105, 0, 193, 204, 226, 227, 228, 229,
+	230, 231, 0, 0, 106, 127, 121, 177, 165, 103,
+	136, 194, 140, 148, 184, 233, 171, 189, 109, 217,
+	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	91, 99, 145, 0, 183, 125, 0, 0, 0, 0,
+	192, 112, 170, 0, 174, 176, 167, 119, 123, 219,
+	0, 118, 0, 0, 0, 0, 0, 143, 0, 146,
+	0, 0, 196, 160, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 89, 0, 0, 0, 0, 0, 0, 0, 0,
+	107, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 175, 0, 216,
+	180, 130, 0, 0, 0, 0, 0, 0, 0, 0,
+	129, 0, 0, 0, 285, 0, 0, 0, 0, 181,
+	0, 199, 132, 142, 161, 0, 128, 93, 0, 133,
+	203, 225, 92, 100, 0, 131, 168, 186, 190, 0,
+	0, 0, 114, 0, 188, 172, 215, 0, 187, 104,
+	149, 147, 207, 182, 214, 223, 224, 202, 222, 232,
+	94, 200, 213, 108, 191, 144, 111, 152, 113, 156,
+	110, 153, 137, 150, 206, 173, 120, 124, 201, 96,
+	211, 198, 158, 138, 139, 95, 0, 185, 117, 126,
+	116, 169, 208, 209, 115, 234, 101, 221, 98, 102,
+	220, 166, 205, 212, 159, 155, 97, 210, 157, 154,
+	141, 122, 134, 178, 151, 179, 135, 163, 162, 164,
+	0, 0, 0, 197, 218, 235, 105, 0, 193, 204,
+	226, 227, 228, 229, 230, 231, 0, 0, 106, 127,
+	121, 177, 165, 103, 136, 194, 140, 148, 184, 233,
+	171, 189, 109, 217, 195, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 91, 99, 145, 0, 183, 125,
+	0, 0, 0, 0, 192, 112, 170, 0, 174, 176,
+	167, 119, 123, 219, 0, 118, 0, 0, 0, 0,
+	0, 143, 0, 146, 0, 0, 196, 160, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 89, 0, 0, 0, 0,
+	0, 0, 0, 0, 107, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1564, 0, 216, 180, 130, 0, 0, 0, 0,
+	0, 0, 0, 0, 129, 0, 0, 0, 285, 0,
+	0, 0, 0, 181, 0, 199, 132, 142, 161, 0,
+	128, 93, 0, 133, 203, 225, 92, 100, 0, 131,
+	168, 186, 190, 0, 0, 0, 114, 0, 188, 172,
+	215, 0, 187, 104, 149, 147, 207, 182, 214, 223,
+	224, 202, 222, 232, 94, 200, 213, 108, 191, 144,
+	111, 152, 113, 156, 110, 153, 137, 150, 206, 173,
+	120, 124, 201, 96, 211, 198, 158, 138, 139, 95,
+	0, 185, 117, 126, 116, 169, 208, 209, 115, 234,
+	101, 221, 98, 102, 220, 166, 205, 212, 159, 155,
+	97, 210, 157, 154, 141, 122, 134, 178, 151, 179,
+	135, 163, 162, 164, 0, 0, 0, 197, 218, 235,
+	105, 0, 193, 204, 226, 227, 228, 229, 230, 231,
+	0, 0, 106, 127, 121, 177, 165, 103, 136, 194,
+	140, 148, 184, 233, 171, 189, 109, 217, 195, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 91, 99,
+	145, 0, 183, 125, 0, 0, 0, 0, 192, 112,
+	170, 0, 174, 176, 167, 119, 123, 219, 0, 118,
+	0, 0, 0, 0, 0, 143, 0, 146, 0, 0,
+	196, 160, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 89,
+	0, 0, 0, 0, 0, 0, 0, 0, 107, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 175, 0, 216, 180, 130,
+	0, 0, 0, 0, 0, 0, 0, 0, 129, 0,
+	0, 0, 285, 0, 0, 0, 0, 181, 0, 199,
+	132, 142, 161, 0, 128, 93, 0, 133, 203, 225,
+	92, 100, 0, 131, 168, 186, 190, 0, 0, 0,
+	114, 0, 188, 172, 215, 0, 187, 104, 149, 147,
+	207, 182, 214, 223, 224, 202, 222, 232, 94, 200,
+	213, 108, 191, 144, 111, 152, 113, 156, 110, 153,
+	137, 150, 206, 173, 120, 124, 201, 96, 211, 198,
+	158, 138, 139, 95, 0, 185, 117, 126, 116, 169,
+	208, 209, 115, 234, 101, 221, 98, 102, 220, 166,
+	205, 212, 159, 155, 97, 210, 157, 154, 141, 122,
+	134, 178, 151, 179, 135, 163, 162, 164, 0, 0,
+	0, 197, 218, 235, 105, 0, 193, 204, 226, 227,
+	228, 229, 230, 231, 0, 0, 106, 127, 121, 177,
+	165, 103, 136, 194, 140, 148, 184, 233, 171, 189,
+	109, 217, 195, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 91, 99, 145, 0, 183, 125, 0, 0,
+	0, 0, 192, 112, 170, 0, 174, 176, 1679, 119,
+	123, 219, 0, 118, 0, 0, 0, 0, 0, 143,
+	0, 146, 0, 0, 196, 160, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 283, 0, 0, 0, 0, 0, 0,
+	0, 0, 107, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 175,
+	0, 216, 180, 130, 0, 0, 0, 0, 0, 0,
+	0, 0, 129, 0, 0, 0, 285, 0, 0, 0,
+	0, 181, 0, 199, 132, 142, 161, 0, 128, 93,
+	0, 133, 203, 225, 92, 100, 0, 131, 168, 186,
+	190, 0, 0, 0, 114, 0, 188, 172, 215, 0,
+	187, 104, 149, 147, 207, 182, 214, 223, 224, 202,
+	222, 232, 94, 200, 213, 108, 191, 144, 111, 152,
+	113, 156, 110, 153, 137, 150, 206, 173, 120, 124,
+	201, 96, 211, 198, 158, 138, 139, 95, 0, 185,
+	117, 126, 116, 169, 208, 209, 115, 234, 101, 221,
+	98, 102, 220, 166, 205, 212, 159, 155, 97, 210,
+	157, 154, 141, 122, 134, 178, 151, 179, 135, 163,
+	162, 164, 0, 0, 0, 197, 218, 235, 105, 0,
+	193, 204, 226, 227, 228, 229, 230, 231, 0, 0,
+	106, 127, 121, 177, 165, 103, 136, 194, 140, 148,
+	184, 233, 171, 189, 109, 217, 195, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 91, 99, 145, 0,
+	183, 125, 0, 0, 0, 0, 192, 112, 170, 0,
+	174, 176, 167, 119, 123, 219, 0, 118, 0, 0,
+	0, 0, 0, 143, 0, 146, 0, 0, 196, 160,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 352, 0, 0,
+	0, 0, 0, 0, 0, 0, 107, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 175, 0, 216, 180, 130, 0, 0,
+	0, 0, 0, 0, 0, 0, 129, 0, 0, 0,
+	285, 0, 0, 0, 0, 181, 0, 199, 132, 142,
+	161, 0, 128, 93, 0, 133, 203, 225, 92, 100,
+	0, 131, 168, 186, 190, 0, 0, 0, 114, 0,
+	188, 172, 215, 0, 187, 104, 149, 147, 207, 182,
+	214, 223, 224, 202, 222, 232, 94, 200, 213, 108,
+	191, 144, 111, 152, 113, 156, 110, 153, 137, 150,
+	206, 173, 120, 124, 201, 96, 211, 198, 158, 138,
+	139, 95, 0, 185, 117, 126, 116, 169, 208, 209,
+	115, 234, 101, 221, 98, 102, 220, 166, 205, 212,
+	159, 155, 97, 210, 157, 154, 141, 122, 134, 178,
+	151, 179, 135, 163, 162, 164, 0, 0, 0, 197,
+	218, 235, 105, 0, 193, 204, 226, 227, 228, 229,
+	230, 231, 0, 0, 106, 127, 121, 177, 165, 103,
+	136, 194, 140, 148, 184, 233, 171, 189, 109, 217,
+	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	91, 99, 145, 0, 183, 125, 0, 0, 0, 0,
+	192, 112, 170, 0, 174, 176, 167, 119, 123, 219,
+	0, 118, 0, 0, 0, 0, 0, 143, 0, 146,
+	0, 0, 196, 160, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 89, 0, 0, 0, 0, 0, 0, 0, 0,
+	107, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 216,
+	180, 130, 0, 0, 0, 0, 0, 0, 0, 0,
+	129, 0, 0, 0, 285, 0, 0, 0, 0, 181,
+	0, 199, 132, 142, 161, 0, 128, 93, 0, 133,
+	203, 225, 92, 100, 0, 131, 168, 186, 190, 0,
+	0, 0, 114, 0, 188, 172, 215, 0, 187, 104,
+	149, 147, 207, 182, 214, 223, 224, 202, 222, 232,
+	94, 200, 213, 108, 191, 144, 111, 152, 113, 156,
+	110, 153, 137, 150, 206, 173, 120, 124, 201, 96,
+	211, 198, 158, 138, 139, 95, 0, 185, 117, 126,
+	116, 169, 208, 209, 115, 234, 101, 221, 98, 102,
+	220, 166, 205, 212, 159, 155, 97, 210, 157, 154,
+	141, 122, 134, 178, 151, 179, 135, 163, 162, 164,
+	0, 0, 0, 197, 218, 235, 105, 0, 193, 204,
+	226, 227, 228, 229, 230, 231, 0, 0, 106, 127,
+	121, 177, 165, 103, 136, 194, 140, 148, 184, 233,
+	171, 189, 109, 217, 195, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 91, 99, 145, 0, 183, 125,
+	0, 0, 0, 0, 192, 112, 0, 0, 174, 176,
+	167, 119, 123, 219,
 }
 var yyPact = [...]int{
 
-	2221, -1000, -191, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	206, -1000, -195, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1263, 1284, -1000, 1022, -1000,
+	-1000, -1000, -1000, -1000, 423, 13690, 2348, 102, 251, 46,
+	19120, 248, 2004, 20256, -47, -1000, -1000, 94, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -22, -23, -1000, 1022, 18835,
+	-1000, -1000, -1000, -1000, -1000, 1229, 1261, 1035, 1219, 1134,
+	-1000, -1000, 9398, 187, 187, 18551, 7938, -1000, -1000, 18266,
+	20256, 235, 20256, -128, 161, 161, 161, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 917, 962, -1000, -1000, -1000, -1000, -1000, -1000,
-	268, 9010, 12, 111, -5, 11933, 109, 1766, 12417, -1000,
-	3, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -76, -77,
-	-1000, 711, -1000, -1000, -1000, -1000, -1000, 906, 914, 764,
-	899, 803, -1000, 6508, 60, 60, 11691, 5504, -1000, -1000,
-	372, 12417, 104, 12417, -153, 65, 65, 65, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2032,24 +3003,28 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 108, 12417, 197, -1000,
-	12417, 62, 566, 62, 62, 62, 12417, -1000, 164, -1000,
-	-1000, -1000, 12417, 552, 839, 3392, 59, 3392, 3392, -1000,
-	3392, 3392, -1000, 3392, 13, 3392, -81, 938, -1000, -1000,
-	-1000, -1000, -20, -1000, 3392, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 481, 861,
-	7513, 7513, 917, -1000, 711, -1000, -1000, -1000, 836, -1000,
-	-1000, 321, 948, -1000, 8768, 160, -1000, 7513, 1752, 714,
-	-1000, -1000, 714, -1000, -1000, 157, -1000, -1000, 8266, 8266,
-	8266, 8266, 8266, 8266, 8266, 8266, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	714, -1000, 7262, 714, 714, 714, 714, 714, 714, 714,
-	714, 7513, 714, 714, 714, 714, 714, 714, 714, 714,
-	714, 714, 714, 714, 714, 714, 714, 11449, 10722, 12417,
-	666, -1000, 708, 5240, -134, -1000, -1000, -1000, 251, 10480,
-	-1000, -1000, -1000, 837, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 366, -1000, -1000, -1000,
+	1260, 336, 12546, 13406, 331, 322, -1000, -1000, 268, 1254,
+	151, 151, 185, 327, 214, -1000, -1000, 4278, -1000, -1000,
+	-1000, -1000, -1000, 1178, -1000, 56, -1000, -1000, 864, 857,
+	20256, 339, 245, -1000, 20256, 156, 856, 156, 156, 156,
+	20256, -1000, 357, -1000, -1000, -1000, 20256, 851, 1177, 5193,
+	105, 5193, 5193, -1000, 5193, 5193, -1000, 5193, 103, 5193,
+	-28, 1273, -1000, -1000, -1000, -1000, 58, -1000, 5193, -1000,
+	-1000, -1000, -1000, 600, -1000, -1000, 86, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 739, 982, 20256, -1000,
+	1020, 1182, 10274, 10274, 1263, -1000, 1022, -1000, -1000, -1000,
+	1162, -1000, -1000, 509, 1282, -1000, 13122, 353, -1000, 10274,
+	899, 990, -1000, -1000, 990, -1000, -1000, 309, -1000, -1000,
+	11694, 11694, 11694, 11694, 11694, 11694, 11694, 11694, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 990, -1000, 8522, 990, 990, 990, 990, 990,
+	990, 990, 990, 10274, 990, 990, 990, 990, 990, 990,
+	990, 990, 990, 990, 990, 990, 990, 990, 990, 17982,
+	14842, 20256, 898, -1000, 979, 7633, -57, -1000, -1000, -1000,
+	422, 15994, -1000, -1000, -1000, 1172, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2057,284 +3032,356 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	619, 12417, -1000, 1516, -1000, 549, 3392, 85, 536, 290,
-	518, 12417, 12417, 3392, 20, 52, 107, 12417, 712, 69,
-	12417, 891, 759, 12417, 516, 509, -1000, 4976, -1000, 3392,
-	3392, -1000, -1000, -1000, 3392, 3392, 3392, 12417, 3392, 3392,
-	-1000, -1000, -1000, -1000, -1000, 3392, 3392, -1000, 947, 289,
-	-1000, -1000, -1000, -1000, 7513, -1000, 758, -1000, -1000, -1000,
-	-1000, -1000, -1000, 955, 213, 505, 154, 709, -1000, 311,
-	906, 481, 803, 10238, 784, -1000, -1000, 12417, -1000, 7513,
-	7513, 435, -1000, 11206, -1000, -1000, 3920, 226, 8266, 349,
-	287, 8266, 8266, 8266, 8266, 8266, 8266, 8266, 8266, 8266,
-	8266, 8266, 8266, 8266, 8266, 8266, 415, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 507, -1000, 711, 537, 537,
-	179, 179, 179, 179, 179, 179, 179, 8517, 6006, 481,
-	576, 252, 7262, 6508, 6508, 7513, 7513, 7010, 6759, 6508,
-	901, 279, 252, 12659, -1000, -1000, 8015, -1000, -1000, -1000,
-	-1000, -1000, 481, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	12175, 12175, 6508, 6508, 6508, 6508, 30, 12417, -1000, 685,
-	895, -1000, -1000, -1000, 893, 9745, 9996, 30, 675, 10722,
-	12417, -1000, -1000, 4712, 708, -134, 699, -1000, -105, -104,
-	5755, 176, -1000, -1000, -1000, -1000, 3128, 172, 620, 375,
-	-65, -1000, -1000, -1000, 726, -1000, 726, 726, 726, 726,
-	-37, -37, -37, -37, -1000, -1000, -1000, -1000, -1000, 748,
-	747, -1000, 726, 726, 726, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 746, 746, 746, 734, 734, 713, -1000,
-	12417, 3392, 878, 3392, -1000, 86, -1000, 12175, 12175, 12417,
-	12417, 131, 12417, 12417, 707, -1000, 12417, 3392, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 12417, 320, 12417, 12417, 252, 12417, -1000,
-	812, 7513, 7513, 4448, 7513, -1000, -1000, -1000, 861, -1000,
-	901, 935, -1000, 827, 826, 6508, -1000, -1000, 226, 286,
-	-1000, -1000, 443, -1000, -1000, -1000, -1000, 150, 714, -1000,
-	2222, -1000, -1000, -1000, -1000, 349, 8266, 8266, 8266, 323,
-	2222, 2076, 649, 337, 179, 248, 248, 173, 173, 173,
-	173, 173, 156, 156, -1000, -1000, -1000, 481, -1000, -1000,
-	-1000, 481, 6508, 706, -1000, -1000, 7513, -1000, 481, 572,
-	572, 393, 384, 231, 945, 572, 229, 944, 572, 572,
-	6508, 302, -1000, 7513, 481, -1000, 138, -1000, 395, 704,
-	703, 572, 481, 572, 572, 684, 714, -1000, 12659, 10722,
-	10722, 10722, 10722, 10722, -1000, 799, 798, -1000, 779, 778,
-	793, 12417, -1000, 574, 9745, 141, 714, -1000, 10964, -1000,
-	-1000, 936, 10722, 627, -1000, -1000, 699, -134, -107, -1000,
-	-1000, -1000, -1000, 252, -1000, 462, 688, 2864, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 743, 500, -1000, 862, 192,
-	168, 495, 856, -1000, -1000, -1000, 842, -1000, 293, -71,
-	-1000, -1000, 423, -37, -37, -1000, -1000, 176, 832, 176,
-	176, 176, 463, 463, -1000, -1000, -1000, -1000, 421, -1000,
-	-1000, -1000, 403, -1000, 757, 12175, 3392, -1000, -1000, -1000,
-	-1000, 236, 236, 195, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 29, 686, -1000, -1000, -1000,
-	19, 17, 68, -1000, 3392, -1000, 289, -1000, 461, 7513,
-	-1000, -1000, -1000, 809, 252, 252, 134, -1000, -1000, 12417,
-	-1000, -1000, -1000, -1000, 670, -1000, -1000, -1000, 3656, 6508,
-	-1000, 323, 2222, 2020, -1000, 8266, 8266, -1000, -1000, 572,
-	6508, 252, -1000, -1000, -1000, 63, 415, 63, 8266, 8266,
-	-1000, 8266, 8266, -1000, -166, 665, 237, -1000, 7513, 326,
-	-1000, 4448, -1000, 8266, 8266, -1000, -1000, -1000, -1000, 754,
-	12659, 714, -1000, 9503, 12175, 667, -1000, 245, 895, 742,
-	753, 733, -1000, -1000, -1000, -1000, 787, -1000, 780, -1000,
-	-1000, -1000, -1000, -1000, 103, 88, 87, 12175, -1000, 917,
-	7513, 627, -1000, -1000, -1000, -122, -137, -1000, -1000, -1000,
-	3128, -1000, 3128, 12175, 47, -1000, 495, 495, -1000, -1000,
-	-1000, 739, 752, 8266, -1000, -1000, -1000, 528, 176, 176,
-	-1000, 254, -1000, -1000, -1000, 563, -1000, 559, 680, 556,
-	12417, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 12417, -1000, -1000,
-	-1000, -1000, -1000, 12175, -178, 484, 12175, 12175, 12417, -1000,
-	320, -1000, 252, -1000, 4184, -1000, 936, 10722, -1000, -1000,
-	481, -1000, 8266, 2222, 2222, -1000, -1000, 481, 726, 726,
-	-1000, 726, 734, -1000, 726, -3, 726, -21, 481, 481,
-	2003, 1956, 1798, 1648, 714, -160, -1000, 252, 7513, -1000,
-	1551, 1471, -1000, 870, 581, 647, -1000, -1000, 6257, 481,
-	532, 132, 527, -1000, 917, 12659, 7513, -1000, -1000, 7513,
-	729, -1000, 7513, -1000, -1000, -1000, 714, 714, 714, 527,
-	906, 252, -1000, -1000, -1000, -1000, 2864, -1000, 524, -1000,
-	726, -1000, -1000, -1000, 12175, -61, 954, 2222, -1000, -1000,
-	-1000, -1000, -1000, -37, 449, -37, 400, -1000, 385, 3392,
-	-1000, -1000, -1000, -1000, 873, -1000, 4184, -1000, -1000, 720,
-	-1000, -1000, -1000, 934, 668, -1000, 2222, -1000, -1000, 92,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 8266, 8266,
-	8266, 8266, 8266, 481, 442, 252, 8266, 8266, 848, -1000,
-	714, -1000, -1000, 662, 12175, 12175, -1000, 12175, 906, -1000,
-	252, 252, 12175, 252, 12175, 12175, 12175, 9261, -1000, 133,
-	12175, -1000, 514, -1000, 177, -1000, -138, 176, -1000, 176,
-	515, 506, -1000, 714, 657, -1000, 241, 12175, 922, 911,
-	-1000, -1000, 395, 395, 395, 395, 61, -1000, -1000, 395,
-	395, 953, -1000, 714, -1000, 711, 124, -1000, -1000, -1000,
-	492, 489, 489, 489, 141, 133, -1000, 402, 238, 441,
-	-1000, 43, 12175, 319, 847, -1000, 838, -1000, -1000, -1000,
-	-1000, -1000, 28, 4184, 3128, 480, -1000, 7513, 7513, -1000,
-	-1000, -1000, -1000, 481, 45, -183, -1000, -1000, 12659, 647,
-	481, 12175, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 328,
-	-1000, -1000, 12417, -1000, -1000, 396, -1000, -1000, 472, -1000,
-	12175, -1000, -1000, 686, 252, 642, -1000, 808, -176, -186,
-	633, -1000, -1000, -1000, 715, -1000, -1000, 28, 825, -178,
-	-1000, 807, -1000, 12175, -1000, 25, -1000, -180, 470, 16,
-	-184, 751, 714, -187, 744, -1000, 942, 7764, -1000, -1000,
-	952, 158, 158, 395, 481, -1000, -1000, -1000, 54, 399,
-	-1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 846, 20256, -1000, 3319, -1000, 843, 5193, 201, 842,
+	450, 841, 20256, -1000, 1207, 10274, 19404, 19404, 17698, 170,
+	-1000, -1000, 990, 986, 840, -1000, 1196, 324, 324, 314,
+	790, 1190, -1000, -1000, -1000, 19404, 19404, 1195, 1191, 19404,
+	19404, 20824, 19404, 595, -1000, 100, 19404, -1000, 19404, 19404,
+	19404, 19404, 19404, 20256, -1000, -1000, -38, -1000, 4583, -1000,
+	-1000, -1000, -1000, -1000, 1173, -1000, -1000, -1000, 5193, 111,
+	153, 244, 20256, 20256, 983, 208, 20256, 1208, 1089, 20256,
+	838, 836, -1000, 7328, -1000, 5193, 5193, -1000, -1000, -1000,
+	5193, 5193, 5193, 20256, 5193, 5193, -1000, -1000, -1000, -1000,
+	-1000, 5193, 5193, -1000, 1281, 470, -1000, -1000, -1000, -1000,
+	10274, -1000, 1088, -1000, -1000, 83, -1000, -1000, -1000, -1000,
+	20256, 982, 990, 19404, -1000, 1290, 382, 645, 350, 977,
+	-1000, 589, 1229, 739, 1134, 15710, 1049, -1000, -1000, 20256,
+	-1000, 10274, 10274, 476, -1000, 17414, -1000, -1000, 6108, 389,
+	11694, 543, 544, 11694, 11694, 11694, 11694, 11694, 11694, 11694,
+	11694, 11694, 11694, 11694, 11694, 11694, 11694, 11694, 701, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 822, -1000, 139,
+	689, 689, 359, 359, 359, 359, 359, 359, 359, 11978,
+	8814, 739, 739, 834, 512, 8522, 9398, 9398, 10274, 10274,
+	9982, 9690, 9398, 1209, 436, 512, 20540, -1000, -1000, 11126,
+	-1000, -1000, -1000, -1000, -1000, 739, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 19404, 19404, 9398, 9398, 9398, 9398, 292,
+	20256, -1000, 974, 1036, -1000, -1000, -1000, 1212, 13982, 990,
+	15426, 292, 972, 14842, 20256, -1000, -1000, 7023, 979, -57,
+	923, -1000, -103, -97, 8230, 344, -1000, -1000, -1000, -1000,
+	5803, 14266, 869, 328, -12, -1000, -1000, -1000, 1032, -1000,
+	1032, 1032, 1032, 1032, 24, 24, 24, 24, -1000, -1000,
+	-1000, -1000, -1000, 1057, 1056, -1000, 1032, 1032, 1032, 1032,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1052, 1052, 1052,
+	1033, 1033, 1060, -1000, 20256, 5193, 1206, 5193, -1000, -1000,
+	-1000, 12262, 12830, 977, 1201, 826, -1000, 19404, 170, -1000,
+	-1000, 19404, 311, 19404, 806, 135, -1000, 790, 790, 790,
+	-1000, -1000, -1000, 1045, -1000, -1000, -1000, 19404, -1000, -1000,
+	976, -1000, 976, -1000, 769, 150, 744, 1044, 124, 170,
+	19404, 354, 757, 204, 202, -1000, 701, -1000, 1172, -1000,
+	-1000, -1000, -1000, 19404, 19404, 20256, 20256, 278, -1000, 20256,
+	20256, 975, -1000, 20256, 5193, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	20256, 473, 20256, 20256, 512, 20256, 67, -1000, -1000, -1000,
+	-1000, 732, -1000, 1139, 10274, 10274, 6718, 10274, -1000, -1000,
+	-1000, 1182, -1000, 1209, 1231, -1000, 1147, 1145, 9398, -1000,
+	-1000, 389, 514, -1000, -1000, 671, -1000, -1000, -1000, -1000,
+	345, 990, -1000, 1788, -1000, -1000, -1000, -1000, 543, 11694,
+	11694, 11694, 394, 1788, 1886, 433, 968, 359, 491, 491,
+	368, 368, 368, 368, 368, 457, 457, -1000, -1000, -1000,
+	739, -1000, -1000, -1000, 739, 9398, 969, -1000, -1000, -1000,
+	10274, -1000, 739, 804, 804, 555, 624, 407, 1280, 804,
+	384, 1279, 804, 804, 9398, 461, -1000, 10274, 739, -1000,
+	343, -1000, 738, 953, 950, 804, 739, 804, 804, 934,
+	990, -1000, 20540, 14842, 14842, 14842, 14842, 14842, -1000, 1118,
+	1116, -1000, 1112, 1110, 1121, 20256, -1000, 821, 13982, 10274,
+	287, 990, -1000, 17130, -1000, -1000, 1272, 14842, 912, -1000,
+	-1000, 923, -57, -61, -1000, -1000, -1000, -1000, 512, -1000,
+	711, 333, 4888, -1000, -1000, -1000, -1000, -1000, 1185, 990,
+	736, -1000, 475, -17, -1000, -1000, 590, 24, 24, -1000,
+	-1000, 344, 1154, 344, 344, 344, 710, 710, -1000, -1000,
+	-1000, -1000, -1000, 583, -1000, -1000, -1000, 577, -1000, 1079,
+	19404, 5193, -1000, -1000, -1000, 990, -1000, 19404, 812, -1000,
+	-1000, 756, -1000, 19404, 810, -1000, 1032, 1043, -1000, 1190,
+	-1000, -1000, -1000, 19404, -1000, 19404, 990, 571, 10274, 1040,
+	990, 1039, 16846, 10274, 1034, -1000, 170, 1183, 1181, -1000,
+	19404, 19404, 344, 901, -1000, -1000, -1000, 108, 107, 189,
+	-1000, 5193, -1000, 470, -1000, 706, 10274, -1000, -1000, -1000,
+	60, -1000, 1215, 1130, 512, 512, 342, -1000, -1000, 20256,
+	-1000, -1000, -1000, -1000, 954, -1000, -1000, -1000, 5498, 9398,
+	-1000, 394, 1788, 1828, -1000, 11694, 11694, -1000, -164, 804,
+	9398, 512, -1000, -1000, -1000, 200, 701, 200, 11694, 11694,
+	-1000, 11694, 11694, -1000, -141, 888, 435, -1000, 10274, 615,
+	-1000, 6718, -1000, 11694, 11694, -1000, -1000, -1000, -1000, 1075,
+	20540, 990, -1000, 15134, 19404, 921, -1000, 402, 1036, 1038,
+	1073, 874, -1000, -1000, -1000, -1000, 1108, -1000, 1101, -1000,
+	-1000, -1000, -1000, 617, -1000, 227, 225, 224, 19404, -1000,
+	1263, 10274, 912, -1000, -1000, -1000, -109, -110, -1000, -1000,
+	-1000, -1000, 4583, -1000, -1000, 4583, 1068, 11694, 10274, 1213,
+	-1000, -1000, -1000, 805, 344, 344, -1000, 443, -1000, -1000,
+	-1000, 801, -1000, 799, 922, 794, 20256, -1000, -1000, 311,
+	-1000, -1000, -1000, 311, 740, 270, 19404, -1000, 19404, 788,
+	-1000, 311, -1000, 568, 19404, 10274, 16562, -1000, 773, 568,
+	19404, -1000, 11694, -1000, -1000, -1000, -1000, -147, 733, 19404,
+	19404, 20256, -1000, 473, -1000, 512, 705, 990, -1000, 6413,
+	-1000, 1272, 14842, -1000, -1000, 739, -1000, 11694, 1788, 1788,
+	-1000, 16278, -1000, -1000, 739, 1032, 1032, -1000, 1032, 1033,
+	-1000, 1032, 42, 1032, 41, 739, 739, 1743, 1710, 1432,
+	1093, 990, -136, -1000, 512, 10274, -1000, 952, 919, -1000,
+	1184, 879, 900, -1000, -1000, 9106, 739, 724, 329, 765,
+	-1000, 1263, 20540, 10274, -1000, -1000, 10274, 1006, -1000, 10274,
+	-1000, -1000, -1000, 700, 990, 990, 990, 765, 1229, 512,
+	-1000, -1000, -1000, -1000, 4888, -1000, -8, 1289, 1788, 568,
+	990, -1000, -1000, -1000, -1000, -1000, 24, 687, 24, 552,
+	-1000, 549, 5193, 756, -1000, 192, -1000, 400, 270, -1000,
+	718, 400, 684, -1000, 763, 120, 756, -1000, 753, 568,
+	-1000, 749, -1000, -1000, 747, 1788, -1000, 6413, -1000, -1000,
+	1004, -1000, -1000, -1000, 22, -1000, 1234, 915, -1000, 1788,
+	-1000, 19688, -1000, -1000, 183, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 11694, 11694, 11694, 11694, 11694, 739, 677,
+	512, 11694, 11694, 1189, -1000, 990, -1000, -1000, 926, 19404,
+	19404, -1000, 19404, 1229, -1000, 512, 512, 19404, 512, -46,
+	19404, 19404, 19404, 14558, -1000, 279, -1000, -77, 158, 10274,
+	344, -1000, 344, 795, 766, -1000, -1000, 115, 990, 716,
+	-1000, -1000, -1000, 540, -1000, 270, 20256, -1000, -1000, -1000,
+	-1000, -1000, 904, -1000, 398, 19404, 1200, -1000, -1000, 1265,
+	1252, 739, 1263, 302, 1250, -1000, -1000, 738, 738, 738,
+	738, 88, -1000, -1000, 738, 738, 1288, -1000, 990, -1000,
+	1022, 280, -1000, -1000, -1000, 743, 739, 990, 732, 732,
+	732, 287, 486, 1188, -1000, 1187, -1000, 501, -1000, -1000,
+	568, -1000, -1000, -1000, -1000, 231, 714, 10274, -1000, -1000,
+	270, 1003, 6413, 4583, 730, 237, -166, 10274, 10274, -1000,
+	-169, 1263, 1250, 10274, -1000, -1000, -1000, -1000, 739, 99,
+	-156, -1000, -1000, 20540, 900, 739, 19404, -1000, 1212, 19972,
+	-1000, -1000, -1000, -1000, -1000, 674, -1000, -1000, 279, 158,
+	10842, 714, -1000, 19404, -1000, -1000, 901, 20256, -1000, 19404,
+	512, 895, -1000, 10558, -1000, -1000, -169, 895, -1000, 1128,
+	-145, -161, 889, -1000, -1000, 20256, 728, -1000, 3172, 64,
+	-1000, 486, 501, 738, 739, -1000, 726, -147, 292, 881,
+	-1000, 1211, -1000, 11410, -173, 317, 628, -1000, -1000, 1125,
+	-1000, -1000, -1000, 19972, -179, 96, -46, 660, -1000, 279,
+	714, 714, 1067, -1000, 24, 19404, 990, 469, -1000, -1000,
+	-1000, -1000, -1000, -154, -1000, -1000, 657, -181, -1000, -46,
+	486, -1000, -1000, 1066, -1000, 1278, -83, -1000, 19688, 11410,
+	-158, 85, 656, -1000, -1000, -1000, 1285, 271, 271, 78,
+	71, 739, -1000, -162, -1000, 1064, -1000, -1000, 652, -1000,
+	-1000, -1000, -1000, 140, 536, -1000, 159, 65, 59, 1249,
+	1247, 54, 1245, -1000, -1000, -186, -1000, -1000, -1000, -1000,
+	990, 516, 63, 1244, 1243, 1241, 1240, 53, 1239, 648,
+	644, 1237, 643, 85, -1000, -1000, 19404, 76, 1236, 1233,
+	640, 639, 636, 623, 1232, 616, -1000, -1000, 609, -1000,
+	1062, 724, -1000, 608, 556, -1000, -1000, -1000, -1000, 537,
+	-1000, -1000, -191, -1000, -1000, -1000, -1000, -1000,
 }
 var yyPgo = [...]int{
 
-	0, 1145, 76, 507, 1142, 1140, 1139, 1138, 1137, 1136,
-	1134, 1133, 1132, 1131, 1130, 1128, 1127, 1126, 1125, 1124,
-	1123, 1122, 1121, 1120, 1119, 1117, 97, 1116, 1114, 1113,
-	84, 1112, 61, 1111, 1110, 37, 131, 69, 42, 278,
-	1106, 47, 52, 62, 1105, 35, 1101, 1099, 71, 1097,
-	50, 1095, 1093, 1758, 1091, 1089, 14, 27, 1088, 1085,
-	1084, 1080, 66, 1016, 1079, 1078, 17, 1077, 1076, 93,
-	1074, 53, 8, 12, 21, 18, 1073, 73, 7, 1072,
-	46, 1071, 1070, 1067, 1066, 16, 1065, 54, 1064, 20,
-	51, 1062, 26, 60, 30, 19, 6, 70, 58, 1061,
-	23, 59, 55, 1060, 1059, 450, 1058, 1055, 41, 1052,
-	1050, 24, 166, 407, 1049, 1048, 1047, 1046, 38, 0,
-	760, 288, 65, 1042, 1041, 1037, 1461, 86, 49, 13,
-	1036, 44, 63, 34, 1035, 1034, 40, 1032, 1030, 1027,
-	1026, 1024, 1020, 1019, 22, 1007, 1006, 1005, 79, 33,
-	1004, 1003, 56, 25, 1002, 1001, 1000, 45, 57, 999,
-	998, 48, 29, 997, 996, 994, 993, 992, 31, 10,
-	991, 15, 990, 11, 989, 28, 987, 4, 985, 9,
-	983, 3, 977, 5, 43, 1, 975, 2, 972, 971,
-	337, 64, 970, 968, 75,
+	0, 1519, 37, 588, 1517, 1516, 1300, 1515, 113, 102,
+	8, 1514, 13, 1512, 2, 1509, 1508, 1504, 1503, 1502,
+	1501, 1500, 1499, 1498, 1497, 1496, 1495, 1492, 1487, 1486,
+	1485, 1483, 1481, 1479, 1478, 1476, 1475, 1474, 110, 1473,
+	1472, 1471, 93, 1470, 101, 1469, 1467, 70, 179, 67,
+	64, 2038, 1465, 49, 79, 87, 1455, 58, 1454, 1453,
+	103, 1452, 75, 1450, 1441, 35, 1438, 1436, 27, 60,
+	1432, 1431, 1430, 1426, 95, 1543, 1423, 1421, 39, 1419,
+	1418, 120, 1417, 82, 26, 33, 32, 42, 1416, 56,
+	30, 1415, 83, 1414, 1413, 1411, 1410, 25, 105, 85,
+	1408, 45, 1407, 4, 34, 18, 1406, 7, 1405, 1401,
+	11, 84, 1, 29, 17, 55, 47, 21, 106, 94,
+	104, 46, 97, 78, 1400, 1399, 618, 1398, 1397, 69,
+	1396, 5, 1394, 1393, 1392, 1390, 1388, 1387, 1386, 1385,
+	1384, 1383, 51, 151, 519, 1381, 218, 1380, 63, 1095,
+	0, 22, 100, 1379, 1378, 1373, 2738, 98, 73, 44,
+	23, 112, 48, 65, 1369, 1367, 62, 16, 1365, 1364,
+	1361, 1359, 1358, 1357, 68, 10, 1355, 1353, 9, 43,
+	1352, 1351, 89, 50, 24, 1349, 20, 12, 72, 81,
+	90, 92, 91, 126, 88, 41, 1343, 1337, 1335, 1334,
+	61, 59, 1326, 57, 52, 28, 40, 86, 53, 54,
+	1323, 74, 1322, 1321, 1320, 14, 1318, 108, 1316, 1315,
+	219, 107, 71, 1313, 31, 1312, 15, 1311, 19, 3,
+	1309, 6, 1307, 1304, 1565, 1544, 1303, 1302, 109,
 }
 var yyR1 = [...]int{
 
-	0, 188, 189, 189, 1, 1, 1, 1, 1, 1,
+	0, 232, 233, 233, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 2, 6, 3, 4,
-	4, 5, 5, 7, 7, 29, 29, 8, 9, 9,
-	9, 192, 192, 48, 48, 93, 93, 10, 10, 10,
-	10, 98, 98, 102, 102, 102, 103, 103, 103, 103,
-	134, 134, 11, 11, 11, 11, 11, 11, 11, 183,
-	183, 182, 181, 181, 180, 180, 179, 17, 164, 166,
-	166, 165, 165, 165, 165, 158, 137, 137, 137, 137,
-	140, 140, 138, 138, 138, 138, 138, 138, 138, 138,
-	138, 139, 139, 139, 139, 139, 141, 141, 141, 141,
-	141, 142, 142, 142, 142, 142, 142, 142, 142, 142,
-	142, 142, 142, 142, 142, 142, 143, 143, 143, 143,
-	143, 143, 143, 143, 157, 157, 144, 144, 152, 152,
-	153, 153, 153, 150, 150, 151, 151, 154, 154, 154,
-	146, 146, 147, 147, 155, 155, 148, 148, 148, 149,
-	149, 149, 156, 156, 156, 156, 156, 145, 145, 159,
-	159, 174, 174, 173, 173, 173, 163, 163, 170, 170,
-	170, 170, 170, 161, 161, 162, 162, 172, 172, 171,
-	160, 160, 175, 175, 175, 175, 186, 187, 185, 185,
-	185, 185, 185, 167, 167, 167, 168, 168, 168, 169,
-	169, 169, 12, 12, 12, 12, 12, 12, 12, 12,
-	12, 12, 12, 12, 12, 184, 184, 184, 184, 184,
-	184, 184, 184, 184, 184, 184, 178, 176, 176, 177,
-	177, 13, 18, 18, 14, 14, 14, 14, 14, 15,
-	15, 19, 20, 20, 20, 20, 20, 20, 20, 20,
-	20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
-	20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
-	20, 20, 20, 20, 109, 109, 107, 107, 110, 110,
-	108, 108, 108, 111, 111, 111, 135, 135, 135, 21,
-	21, 23, 23, 24, 25, 22, 22, 22, 22, 22,
-	22, 22, 16, 193, 26, 27, 27, 28, 28, 28,
-	32, 32, 32, 30, 30, 31, 31, 37, 37, 36,
-	36, 38, 38, 38, 38, 123, 123, 123, 122, 122,
-	40, 40, 41, 41, 42, 42, 43, 43, 43, 43,
-	55, 55, 92, 92, 94, 94, 44, 44, 44, 44,
-	45, 45, 46, 46, 47, 47, 130, 130, 129, 129,
-	129, 128, 128, 49, 49, 49, 51, 50, 50, 50,
-	50, 52, 52, 54, 54, 53, 53, 56, 56, 56,
-	56, 57, 57, 39, 39, 39, 39, 39, 39, 39,
-	106, 106, 59, 59, 58, 58, 58, 58, 58, 58,
-	58, 58, 58, 58, 70, 70, 70, 70, 70, 70,
-	60, 60, 60, 60, 60, 60, 60, 35, 35, 71,
-	71, 71, 77, 72, 72, 63, 63, 63, 63, 63,
-	63, 63, 63, 63, 63, 63, 63, 63, 63, 63,
-	63, 63, 63, 63, 63, 63, 63, 63, 63, 63,
-	63, 63, 63, 63, 63, 63, 63, 67, 67, 67,
-	65, 65, 65, 65, 65, 65, 65, 65, 65, 65,
-	65, 65, 65, 66, 66, 66, 66, 66, 66, 66,
-	66, 66, 66, 66, 66, 66, 66, 66, 66, 194,
-	194, 69, 68, 68, 68, 68, 68, 68, 33, 33,
-	33, 33, 33, 133, 133, 136, 136, 136, 136, 136,
-	136, 136, 136, 136, 136, 136, 136, 136, 81, 81,
-	34, 34, 79, 79, 80, 82, 82, 78, 78, 78,
-	62, 62, 62, 62, 62, 62, 62, 62, 64, 64,
-	64, 83, 83, 84, 84, 85, 85, 86, 86, 87,
-	88, 88, 88, 89, 89, 89, 89, 90, 90, 90,
-	61, 61, 61, 61, 61, 61, 91, 91, 91, 91,
-	95, 95, 73, 73, 75, 75, 74, 76, 96, 96,
-	100, 97, 97, 101, 101, 101, 101, 99, 99, 99,
-	125, 125, 125, 104, 104, 112, 112, 113, 113, 105,
-	105, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 115, 115, 115, 116, 116, 117, 117, 117, 124,
-	124, 120, 120, 121, 121, 126, 126, 127, 127, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 190,
-	191, 131, 132, 132, 132,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 2,
+	6, 7, 7, 8, 8, 9, 9, 15, 3, 4,
+	4, 5, 5, 16, 16, 41, 41, 17, 18, 18,
+	18, 236, 236, 60, 60, 114, 114, 19, 19, 19,
+	19, 119, 119, 123, 123, 123, 124, 124, 124, 124,
+	164, 164, 20, 20, 20, 20, 20, 20, 20, 228,
+	228, 227, 226, 226, 225, 225, 224, 26, 196, 198,
+	198, 197, 197, 197, 197, 189, 189, 189, 186, 186,
+	186, 167, 167, 167, 167, 170, 170, 168, 168, 168,
+	168, 168, 168, 168, 168, 168, 169, 169, 169, 169,
+	169, 171, 171, 171, 171, 171, 172, 172, 172, 172,
+	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
+	172, 173, 173, 173, 173, 173, 173, 173, 173, 188,
+	188, 174, 174, 182, 182, 183, 183, 183, 180, 180,
+	181, 181, 184, 184, 184, 176, 176, 177, 177, 185,
+	185, 178, 178, 178, 179, 179, 179, 187, 187, 187,
+	187, 187, 175, 175, 191, 191, 191, 191, 206, 206,
+	205, 205, 205, 195, 195, 202, 202, 202, 202, 202,
+	202, 193, 193, 194, 194, 204, 204, 203, 192, 192,
+	207, 207, 207, 207, 230, 231, 229, 229, 229, 229,
+	229, 199, 199, 199, 200, 200, 200, 201, 201, 201,
+	21, 21, 21, 21, 21, 21, 21, 21, 21, 21,
+	21, 21, 27, 216, 216, 217, 217, 217, 217, 190,
+	190, 219, 219, 219, 219, 219, 219, 219, 219, 219,
+	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
+	219, 219, 221, 222, 222, 222, 218, 218, 220, 220,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 210, 210, 210, 210, 210, 210,
+	213, 213, 211, 211, 212, 212, 212, 212, 212, 212,
+	212, 212, 212, 214, 214, 208, 208, 209, 209, 209,
+	209, 209, 215, 215, 22, 28, 28, 23, 23, 23,
+	23, 23, 24, 24, 29, 30, 30, 30, 30, 30,
+	30, 30, 30, 30, 30, 30, 30, 30, 30, 30,
+	30, 30, 30, 30, 30, 30, 30, 30, 30, 30,
+	30, 30, 30, 30, 30, 30, 30, 130, 130, 128,
+	128, 131, 131, 129, 129, 129, 142, 142, 142, 165,
+	165, 165, 31, 31, 32, 33, 133, 133, 133, 134,
+	134, 135, 135, 135, 136, 136, 137, 137, 137, 137,
+	137, 137, 137, 137, 138, 138, 139, 139, 139, 139,
+	140, 140, 141, 141, 132, 132, 132, 35, 35, 36,
+	37, 34, 34, 34, 34, 34, 34, 34, 25, 237,
+	38, 39, 39, 40, 40, 40, 44, 44, 44, 42,
+	42, 43, 43, 49, 49, 48, 48, 50, 50, 50,
+	50, 153, 153, 153, 152, 152, 52, 52, 53, 53,
+	54, 54, 55, 55, 55, 55, 55, 55, 10, 11,
+	11, 12, 12, 12, 12, 12, 13, 13, 13, 13,
+	14, 14, 14, 67, 67, 113, 113, 115, 115, 56,
+	56, 56, 56, 57, 57, 58, 58, 59, 59, 160,
+	160, 159, 159, 159, 158, 158, 61, 61, 61, 63,
+	62, 62, 62, 62, 64, 64, 66, 66, 65, 65,
+	68, 68, 68, 68, 69, 69, 51, 51, 51, 51,
+	51, 51, 51, 127, 127, 71, 71, 70, 70, 70,
+	70, 70, 70, 70, 70, 70, 70, 82, 82, 82,
+	82, 82, 82, 72, 72, 72, 72, 72, 72, 72,
+	47, 47, 83, 83, 83, 89, 89, 84, 84, 75,
+	75, 75, 75, 75, 75, 75, 75, 75, 75, 75,
+	75, 75, 75, 75, 75, 75, 75, 75, 75, 75,
+	75, 75, 75, 75, 75, 75, 75, 75, 75, 75,
+	75, 79, 79, 79, 77, 77, 77, 77, 77, 77,
+	77, 77, 77, 77, 77, 77, 77, 78, 78, 78,
+	78, 78, 78, 78, 78, 78, 78, 78, 78, 78,
+	78, 78, 78, 238, 238, 81, 80, 80, 80, 80,
+	80, 80, 102, 102, 102, 103, 103, 104, 104, 105,
+	105, 105, 106, 106, 107, 107, 107, 107, 107, 45,
+	45, 45, 45, 45, 163, 163, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 166, 166, 93,
+	93, 46, 46, 91, 91, 92, 94, 94, 90, 90,
+	90, 74, 74, 74, 74, 74, 74, 74, 74, 76,
+	76, 76, 95, 95, 96, 96, 108, 108, 109, 109,
+	110, 97, 97, 98, 98, 99, 100, 100, 100, 101,
+	101, 101, 101, 111, 111, 111, 73, 73, 73, 73,
+	73, 73, 112, 112, 112, 112, 116, 116, 85, 85,
+	87, 87, 86, 88, 117, 117, 121, 118, 118, 122,
+	122, 122, 122, 120, 120, 120, 155, 155, 155, 125,
+	125, 143, 143, 144, 144, 126, 126, 145, 145, 145,
+	146, 146, 147, 147, 147, 154, 154, 150, 150, 151,
+	151, 156, 156, 157, 157, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 234, 235, 161, 162, 162,
+	162,
 }
 var yyR2 = [...]int{
 
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 4, 6, 7, 5, 10, 1,
+	1, 1, 1, 1, 1, 1, 0, 4, 6, 7,
+	2, 2, 3, 1, 3, 3, 6, 5, 11, 1,
 	3, 1, 3, 7, 8, 1, 1, 9, 8, 7,
 	6, 1, 1, 1, 3, 0, 4, 3, 4, 5,
 	4, 1, 3, 3, 2, 2, 2, 2, 2, 1,
 	1, 1, 2, 2, 8, 4, 6, 5, 5, 0,
-	2, 1, 0, 2, 1, 3, 3, 4, 4, 2,
-	4, 1, 3, 3, 3, 8, 3, 1, 1, 1,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 2, 2, 2, 2, 2, 1, 2, 2, 2,
-	1, 4, 4, 2, 2, 3, 3, 3, 3, 1,
-	1, 1, 1, 1, 6, 6, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 3, 0, 3, 0, 5,
-	0, 3, 5, 0, 1, 0, 1, 0, 1, 2,
-	0, 2, 0, 3, 0, 1, 0, 3, 3, 0,
-	2, 2, 0, 2, 1, 2, 1, 0, 2, 5,
-	4, 1, 2, 2, 3, 2, 0, 1, 2, 3,
-	3, 2, 2, 1, 1, 0, 1, 1, 3, 2,
-	3, 1, 10, 11, 11, 12, 3, 3, 1, 1,
-	2, 2, 2, 0, 1, 3, 1, 2, 3, 1,
-	1, 1, 6, 7, 7, 7, 7, 4, 5, 7,
-	5, 5, 5, 12, 7, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 7, 1, 3, 8,
-	8, 3, 3, 5, 4, 6, 5, 4, 4, 3,
-	2, 3, 4, 4, 3, 4, 4, 4, 4, 4,
-	4, 3, 3, 2, 3, 3, 2, 3, 4, 3,
-	7, 5, 4, 2, 4, 2, 2, 2, 2, 3,
-	3, 5, 2, 3, 1, 1, 0, 1, 1, 1,
-	0, 2, 2, 0, 2, 2, 0, 1, 1, 2,
-	1, 1, 2, 1, 1, 2, 2, 2, 2, 2,
-	3, 3, 2, 0, 2, 0, 2, 1, 2, 2,
-	0, 1, 1, 0, 1, 0, 1, 0, 1, 1,
-	3, 1, 2, 3, 5, 0, 1, 2, 1, 1,
-	0, 2, 1, 3, 1, 1, 1, 3, 1, 3,
-	3, 7, 1, 3, 1, 3, 4, 4, 4, 3,
-	2, 4, 0, 1, 0, 2, 0, 1, 0, 1,
-	2, 1, 1, 1, 2, 2, 1, 2, 3, 2,
-	3, 2, 2, 2, 1, 1, 3, 0, 5, 5,
-	5, 0, 2, 1, 3, 3, 2, 3, 1, 2,
-	0, 3, 1, 1, 3, 3, 4, 4, 5, 3,
-	4, 5, 6, 2, 1, 2, 1, 2, 1, 2,
-	1, 1, 1, 1, 1, 1, 1, 0, 2, 1,
-	1, 1, 3, 1, 3, 1, 1, 1, 1, 1,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 2, 2, 2, 2, 2,
-	2, 2, 3, 1, 1, 1, 1, 4, 5, 6,
-	4, 4, 6, 6, 6, 8, 8, 8, 8, 9,
-	7, 5, 4, 2, 2, 2, 2, 2, 2, 2,
-	2, 2, 2, 2, 2, 2, 2, 8, 8, 0,
-	2, 3, 4, 4, 4, 4, 4, 4, 0, 3,
-	4, 7, 3, 1, 1, 2, 3, 3, 1, 2,
-	2, 1, 2, 1, 2, 2, 1, 2, 0, 1,
-	0, 2, 1, 2, 4, 0, 2, 1, 3, 5,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
-	2, 0, 3, 0, 2, 0, 3, 1, 3, 2,
-	0, 1, 1, 0, 2, 4, 4, 0, 2, 4,
-	2, 1, 3, 5, 4, 6, 1, 3, 3, 5,
-	0, 5, 1, 3, 1, 2, 3, 1, 1, 3,
-	3, 1, 3, 3, 3, 3, 3, 1, 2, 1,
-	1, 1, 1, 1, 1, 0, 2, 0, 3, 0,
+	2, 1, 0, 2, 1, 3, 3, 4, 5, 2,
+	4, 1, 3, 3, 3, 8, 10, 12, 0, 1,
+	1, 3, 1, 1, 1, 2, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 2, 2, 2, 2,
+	2, 1, 2, 2, 2, 2, 4, 4, 2, 2,
+	3, 3, 3, 3, 1, 1, 1, 1, 1, 6,
+	6, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	3, 0, 3, 0, 5, 0, 3, 5, 0, 1,
+	0, 1, 0, 1, 2, 0, 2, 0, 3, 0,
+	1, 0, 3, 3, 0, 2, 2, 0, 2, 1,
+	2, 1, 0, 2, 5, 4, 7, 6, 1, 2,
+	2, 3, 2, 0, 1, 2, 3, 3, 3, 2,
+	2, 1, 1, 0, 1, 1, 3, 2, 3, 1,
+	10, 11, 11, 12, 3, 3, 1, 1, 2, 2,
+	2, 0, 1, 3, 1, 2, 3, 1, 1, 1,
+	2, 3, 2, 4, 4, 2, 7, 5, 5, 5,
+	12, 7, 4, 1, 3, 1, 1, 4, 5, 1,
+	3, 3, 4, 2, 2, 2, 3, 3, 3, 4,
+	3, 3, 4, 5, 6, 5, 4, 5, 5, 3,
+	5, 1, 3, 0, 1, 2, 1, 2, 2, 3,
+	1, 1, 1, 1, 1, 2, 1, 2, 3, 2,
+	1, 1, 2, 2, 7, 5, 3, 3, 3, 1,
+	0, 1, 4, 7, 4, 5, 3, 4, 4, 5,
+	4, 5, 5, 0, 2, 1, 3, 9, 9, 7,
+	6, 3, 0, 3, 3, 3, 5, 4, 6, 5,
+	4, 4, 3, 2, 3, 4, 4, 3, 4, 4,
+	4, 4, 4, 4, 3, 3, 2, 3, 3, 2,
+	3, 4, 3, 7, 5, 4, 2, 4, 2, 2,
+	2, 2, 3, 3, 5, 2, 3, 1, 1, 0,
+	1, 1, 1, 0, 2, 2, 0, 2, 2, 0,
+	1, 1, 2, 1, 3, 17, 0, 1, 1, 0,
+	1, 0, 1, 1, 0, 2, 3, 3, 4, 3,
+	4, 4, 5, 4, 0, 2, 3, 3, 4, 4,
+	0, 3, 0, 3, 0, 1, 1, 1, 2, 1,
+	1, 2, 2, 2, 2, 2, 3, 3, 2, 0,
+	2, 0, 2, 1, 2, 2, 0, 1, 1, 0,
+	1, 0, 1, 0, 1, 1, 3, 1, 2, 3,
+	5, 0, 1, 2, 1, 1, 0, 2, 1, 3,
+	1, 1, 1, 3, 1, 3, 9, 7, 4, 1,
+	3, 3, 5, 5, 3, 4, 0, 3, 3, 6,
+	1, 1, 2, 3, 7, 1, 3, 1, 3, 4,
+	4, 4, 3, 2, 4, 0, 1, 0, 2, 0,
+	1, 0, 1, 2, 1, 1, 1, 2, 2, 1,
+	2, 3, 2, 3, 2, 2, 2, 1, 1, 3,
+	0, 5, 5, 5, 0, 2, 1, 3, 3, 2,
+	3, 1, 2, 0, 3, 1, 1, 3, 3, 4,
+	4, 5, 3, 4, 5, 6, 2, 1, 2, 1,
+	2, 1, 2, 1, 1, 1, 1, 1, 1, 1,
+	0, 2, 1, 1, 1, 3, 3, 1, 3, 1,
+	1, 1, 1, 1, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 2,
+	2, 2, 2, 2, 2, 2, 3, 1, 1, 1,
+	1, 5, 5, 6, 4, 4, 6, 6, 6, 8,
+	8, 8, 8, 9, 7, 5, 4, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 8, 8, 0, 2, 3, 4, 4, 4, 4,
+	4, 4, 0, 2, 4, 3, 4, 0, 3, 0,
+	2, 5, 1, 1, 2, 2, 2, 2, 2, 0,
+	3, 4, 7, 3, 1, 1, 2, 3, 3, 1,
+	2, 2, 1, 2, 1, 2, 2, 1, 2, 0,
+	1, 0, 2, 1, 2, 4, 0, 2, 1, 3,
+	5, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 2, 0, 3, 0, 2, 0, 2, 1, 3,
+	5, 0, 3, 1, 3, 2, 0, 1, 1, 0,
+	2, 4, 4, 0, 2, 4, 2, 1, 3, 5,
+	4, 6, 1, 3, 3, 5, 0, 5, 1, 3,
+	1, 2, 3, 1, 1, 3, 3, 1, 3, 3,
+	3, 3, 3, 1, 2, 1, 1, 1, 1, 1,
+	1, 0, 2, 0, 3, 0, 1, 0, 1, 1,
+	0, 1, 0, 1, 1, 0, 2, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 0, 1, 1, 1, 1, 0, 1, 1, 0,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -2356,299 +3403,383 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 0, 0, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 0, 0, 1,
+	1,
 }
 var yyChk = [...]int{
 
-	-1000, -188, -1, -2, -6, -7, -8, -9, -10, -11,
-	-12, -13, -14, -15, -19, -20, -21, -23, -24, -25,
-	-22, -16, -3, -4, 6, 7, -29, 9, 10, 30,
-	-17, 115, 116, 118, 117, 149, 119, 142, 50, 162,
-	163, 165, 166, 25, 143, 144, 147, 148, 31, 32,
-	121, -190, 8, 252, 54, -189, 269, -85, 15, -28,
-	5, -26, -193, -26, -26, -26, -26, -26, -164, -166,
-	54, 90, -117, 125, 72, 244, 122, 123, 129, -120,
-	57, -119, 262, 135, 162, 173, 167, 194, 186, 263,
-	136, 184, 187, 231, 214, 226, 66, 165, 240, 145,
-	182, 178, 176, 27, 228, 199, 267, 177, 227, 121,
-	138, 133, 200, 204, 232, 171, 172, 234, 198, 134,
-	33, 264, 35, 153, 235, 202, 197, 193, 196, 170,
-	192, 39, 206, 205, 207, 230, 189, 139, 179, 18,
-	238, 148, 151, 229, 201, 203, 130, 155, 266, 236,
-	175, 140, 152, 147, 239, 141, 166, 216, 233, 242,
-	38, 211, 169, 132, 163, 159, 217, 190, 154, 180,
-	181, 195, 168, 191, 164, 156, 149, 241, 212, 268,
-	188, 185, 160, 157, 158, 218, 219, 220, 221, 222,
-	223, 161, 265, 237, 183, 213, -105, 125, 221, 127,
-	123, 123, 124, 125, 244, 122, 123, -53, -126, 57,
-	-119, 125, 123, 108, 187, 231, 115, 215, 216, 228,
-	124, 33, 229, 155, -135, 123, -107, 214, 218, 219,
-	220, 223, 221, 161, 57, 233, 232, 224, -126, 164,
-	-131, -131, -131, -131, -131, 217, 217, -131, -2, -89,
-	17, 16, -5, -3, -190, 6, 20, 21, -32, 40,
-	41, -27, -38, 99, -39, -126, -58, 74, -63, 29,
-	57, -119, 23, -62, -59, -78, -76, -77, 108, 109,
-	110, 97, 98, 105, 75, 111, -67, -65, -66, -68,
-	59, 58, 67, 60, 61, 62, 63, 68, 69, 70,
-	-120, -74, -190, 44, 45, 253, 254, 255, 256, 261,
-	257, 77, 34, 243, 251, 250, 249, 247, 248, 245,
-	246, 259, 260, 128, 244, 103, 252, -105, -105, 11,
-	-48, -53, -97, -134, 164, -101, 233, 232, -121, -99,
-	-120, -118, 231, 187, 230, 120, 73, 22, 24, 209,
-	76, 108, 16, 77, 107, 253, 115, 48, 245, 246,
-	243, 255, 256, 244, 215, 29, 10, 25, 143, 21,
-	101, 117, 80, 81, 146, 23, 144, 70, 19, 51,
-	11, 13, 14, 128, 127, 92, 124, 46, 8, 111,
-	26, 89, 42, 28, 44, 90, 17, 247, 248, 31,
-	261, 150, 103, 49, 36, 74, 68, 71, 52, 72,
-	15, 47, 91, 118, 252, 45, 122, 6, 258, 30,
-	142, 43, 123, 79, 259, 260, 126, 69, 5, 129,
-	32, 9, 50, 53, 249, 250, 251, 34, 78, 12,
-	-165, 90, -158, 57, -53, 124, -53, 252, -113, 128,
-	-113, -113, 123, -53, 115, 117, 120, 52, -18, -53,
-	-112, 128, 57, -112, -112, -112, -53, 112, -53, 57,
-	30, -132, -190, -121, 244, 57, 155, 123, 156, 125,
-	-132, -132, -132, -132, -132, 159, 160, -132, -110, -109,
-	226, 227, 217, 225, 12, 217, 158, -132, -131, -131,
-	-191, 56, -90, 19, 31, -39, -126, -86, -87, -39,
-	-85, -2, -26, 36, -30, 21, 65, 11, -123, 73,
-	72, 89, -122, 22, -120, 59, 112, -39, -60, 92,
-	74, 90, 91, 76, 94, 93, 104, 97, 98, 99,
-	100, 101, 102, 103, 95, 96, 107, 82, 83, 84,
-	85, 86, 87, 88, -106, -190, -77, -190, 113, 114,
-	-63, -63, -63, -63, -63, -63, -63, -63, -190, -2,
-	-72, -39, -190, -190, -190, -190, -190, -190, -190, -190,
-	-190, -81, -39, -190, -194, -69, -190, -194, -69, -194,
-	-69, -194, -190, -194, -69, -194, -69, -194, -194, -69,
-	-190, -190, -190, -190, -190, -190, -54, 26, -53, -41,
-	-42, -43, -44, -55, -77, -190, -53, -53, -48, -192,
-	55, 11, 53, 55, -97, 164, -98, -102, 234, 236,
-	82, -125, -120, 59, 29, 30, 56, 55, -53, -137,
-	-140, -142, -141, -143, -138, -139, 184, 185, 108, 188,
-	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
-	30, 145, 180, 181, 182, 183, 200, 201, 202, 203,
-	204, 205, 206, 207, 167, 186, 263, 168, 169, 170,
-	171, 172, 173, 175, 176, 177, 178, 179, 57, -132,
-	125, 57, 74, 57, -53, -53, -132, 157, 157, 123,
-	123, -53, 55, 126, -48, 23, 52, -53, 57, 57,
-	-127, -126, -118, -132, -132, -132, -132, -132, -53, -132,
-	-132, -132, -132, 11, -108, 11, 92, -39, 52, 9,
-	92, 55, 18, 112, 55, -88, 24, 25, -89, -191,
-	-32, -64, -120, 60, 63, -31, 43, -53, -39, -39,
-	-70, 68, 74, 69, 70, -122, 99, -127, -121, -118,
-	-63, -71, -74, -77, 64, 92, 90, 91, 76, -63,
-	-63, -63, -63, -63, -63, -63, -63, -63, -63, -63,
-	-63, -63, -63, -63, -133, 57, 59, 57, -62, -62,
-	-120, -37, 21, -36, -38, -191, 55, -191, -2, -36,
-	-36, -39, -39, -78, 59, -36, -78, 59, -36, -36,
-	-30, -79, -80, 78, -78, -120, -126, -191, -63, -120,
-	-120, -36, -37, -36, -36, -93, 151, -53, 30, 55,
-	-49, -51, -50, -52, 42, 46, 48, 43, 44, 45,
-	49, -130, 22, -41, -190, -129, 151, -128, 22, -126,
-	59, -93, 53, -41, -53, -101, -98, 55, 235, 237,
-	238, 52, 71, -39, -149, 107, -167, -168, -169, -121,
-	59, 60, -158, -159, -160, -170, 137, -175, 130, 132,
-	129, -161, 138, 124, 28, 56, -154, 68, 74, -150,
-	212, -144, 54, -144, -144, -144, -144, -148, 187, -148,
-	-148, -148, 54, 54, -144, -144, -144, -152, 54, -152,
-	-152, -153, 54, -153, -124, 53, -53, -132, 23, -132,
-	-114, 120, 117, 118, -178, 116, 209, 187, 66, 29,
-	15, 253, 151, 268, 57, 152, -120, -120, -53, -53,
-	120, 117, -53, -53, -53, -132, -53, -111, 90, 12,
-	-126, -126, -53, 38, -39, -39, -127, -87, -90, -104,
-	19, 11, 34, 34, -36, 68, 69, 70, 112, -190,
-	-71, -63, -63, -63, -35, 146, 73, -191, -191, -36,
-	55, -39, -191, -191, -191, 55, 53, 22, 11, 11,
-	-191, 11, 11, -191, -191, -36, -82, -80, 80, -39,
-	-191, 112, -191, 55, 55, -191, -191, -191, -191, -61,
-	30, 34, -2, -190, -190, -96, -100, -78, -42, -43,
-	-43, -42, -43, 42, 42, 42, 47, 42, 47, 42,
-	-50, -126, -191, -56, 50, 127, 51, -190, -128, -57,
-	12, -41, -57, -102, -103, 239, 236, 242, 57, 59,
-	55, -169, 82, 54, 57, 28, -161, -161, -162, 57,
-	-162, 28, -146, 29, 68, -151, 213, 60, -148, -148,
-	-149, 30, -149, -149, -149, -157, 59, -157, 60, 60,
-	52, -120, -132, -131, -184, 131, 137, 138, 133, 57,
-	124, 28, 130, 132, 151, 129, -184, -115, -116, 126,
-	22, 124, 28, 151, -183, 53, 157, 157, 126, -132,
-	-108, 59, -39, 39, 112, -53, -40, 11, 99, -121,
-	-37, -35, 73, -63, -63, -191, -38, -136, 108, 184,
-	145, 182, 178, 198, 189, 211, 180, 212, -133, -136,
-	-63, -63, -63, -63, 262, -85, 81, -39, 79, -121,
-	-63, -63, -95, 52, -96, -73, -75, -74, -190, -2,
-	-91, -120, -94, -120, -57, 55, 82, -46, -45, 52,
-	53, -47, 52, -45, 42, 42, 124, 124, 124, -94,
-	-85, -39, -57, 236, 240, 241, -168, -169, -172, -171,
-	-120, -175, -162, -162, 54, -147, 52, -63, 56, -149,
-	-149, 57, 108, 56, 55, 56, 55, 56, 55, -53,
-	-131, -131, -53, -131, -120, -181, 265, -182, 57, -120,
-	-120, -53, -111, -57, -41, -191, -63, -191, -144, -144,
-	-144, -153, -144, 172, -144, 172, -191, -191, 19, 19,
-	19, 19, -190, -34, 258, -39, 55, 55, 27, -95,
-	55, -191, -191, -191, 55, 112, -191, 55, -85, -100,
-	-39, -39, 54, -39, -190, -190, -190, -191, -89, 56,
-	55, -144, -92, -120, -155, 209, 9, -148, 59, -148,
-	60, 60, -132, 26, -180, -179, -121, 54, -83, 13,
-	-148, 57, -63, -63, -63, -63, -63, -191, 59, -63,
-	-63, 28, -75, 34, -2, -190, -120, -120, -120, -89,
-	-92, -92, -92, -92, -129, -174, -173, 53, 134, 66,
-	-171, 56, 55, -156, 130, 28, 129, -66, -149, -149,
-	56, 56, -190, 55, 82, -92, -84, 14, 16, -191,
-	-191, -191, -191, -33, 92, 265, -191, -191, 9, -73,
-	-2, 112, 56, -191, -191, -191, -56, -173, 57, -163,
-	82, 59, 140, -120, -145, 66, 28, 28, -176, -177,
-	151, -179, -169, 56, -39, -72, -191, 263, 49, 266,
-	-96, -191, -120, 60, -53, 59, -191, 55, -120, -183,
-	39, 264, 267, 54, -177, 34, -181, 39, -92, 153,
-	265, 56, 154, 266, -186, -187, 52, -190, 267, -187,
-	52, 10, 9, -63, 150, -185, 141, 136, 139, 30,
-	-185, -191, -191, 135, 29, 68,
+	-1000, -232, -1, -2, -6, -15, -16, -17, -18, -19,
+	-20, -21, -22, -23, -24, -29, -30, -31, -32, -33,
+	-35, -36, -37, -34, -25, -3, -4, 6, -7, 7,
+	-41, 9, 10, 30, -26, 120, -27, 121, 123, 122,
+	162, 124, 155, 50, 181, 183, 176, 177, 179, 180,
+	25, 156, 157, 160, 161, 31, 32, 126, -234, 293,
+	8, 280, 54, -233, 310, -97, 15, -40, 5, -38,
+	-237, -2, -38, -38, -38, -38, -38, -196, -198, 54,
+	90, -147, 130, 72, 272, 127, 128, 134, -150, 57,
+	-149, 290, 148, 143, 176, 201, 195, 222, 214, 291,
+	149, 212, 215, 259, 165, 242, 254, 66, 179, 268,
+	186, 182, 301, 184, 158, 210, 206, 204, 27, 307,
+	192, 256, 227, 308, 193, 295, 205, 255, 142, 126,
+	117, 151, 138, 145, 228, 232, 260, 188, 199, 200,
+	262, 226, 139, 33, 181, 292, 35, 167, 263, 166,
+	189, 230, 183, 187, 225, 221, 185, 224, 198, 220,
+	39, 140, 234, 233, 235, 258, 217, 306, 152, 207,
+	18, 266, 161, 191, 304, 113, 305, 257, 229, 231,
+	116, 135, 169, 294, 264, 203, 153, 164, 160, 267,
+	154, 180, 300, 244, 261, 270, 38, 239, 197, 137,
+	177, 194, 173, 146, 245, 218, 190, 168, 208, 209,
+	223, 196, 219, 178, 170, 162, 115, 269, 240, 309,
+	216, 213, 174, 171, 172, 147, 246, 247, 248, 249,
+	250, 251, 175, 265, 211, 241, -216, -221, -210, -217,
+	15, 164, 125, 122, 162, 165, -211, -219, -218, 113,
+	140, 141, 121, 123, 281, 51, -220, -223, 57, 237,
+	66, 139, 259, 215, 107, 29, 31, 182, 184, 129,
+	130, 249, -126, 132, 128, 128, 129, 130, 272, 127,
+	128, -65, -156, 57, -149, 130, 128, 108, 215, 259,
+	120, 243, 244, 256, 129, 33, 257, 169, -165, 128,
+	-128, 242, 246, 247, 248, 251, 249, 175, 57, 261,
+	260, 252, -156, -132, 182, 294, -38, 178, -161, -161,
+	-161, -161, -161, 245, 245, -161, -2, -8, 302, -9,
+	-156, -101, 17, 16, -5, -3, -234, 6, 20, 21,
+	-44, 40, 41, -39, -50, 99, -51, -156, -70, 74,
+	-75, 29, 57, -149, 23, -74, -71, -90, -88, -89,
+	108, 109, 110, 97, 98, 105, 75, 111, -79, -77,
+	-78, -80, 59, 58, 67, 60, 61, 62, 63, 68,
+	69, 70, -150, -86, -234, 44, 45, 281, 282, 283,
+	284, 289, 285, 77, 34, 271, 279, 278, 277, 275,
+	276, 273, 274, 287, 288, 133, 272, 103, 280, -126,
+	-126, 11, -60, -65, -118, -164, 178, -122, 261, 260,
+	-151, -120, -150, -148, 259, 215, 258, 125, 73, 22,
+	24, 237, 76, 108, 16, 77, 141, 144, 107, 281,
+	120, 48, 273, 274, 271, 283, 284, 272, 243, 29,
+	10, 25, 156, 21, 101, 122, 80, 81, 159, 23,
+	157, 70, 19, 51, 11, 13, 14, 133, 132, 92,
+	129, 46, 8, 111, 26, 89, 42, 303, 28, 44,
+	90, 17, 275, 276, 31, 289, 163, 103, 49, 36,
+	74, 68, 71, 52, 72, 15, 47, 296, 299, 302,
+	91, 123, 280, 45, 298, 127, 6, 286, 30, 155,
+	43, 128, 79, 287, 288, 131, 69, 5, 134, 32,
+	9, 50, 53, 277, 278, 279, 34, 78, 12, 297,
+	293, -197, 90, -189, -150, -65, 129, -65, 280, -144,
+	133, -144, -144, -211, 55, 16, 113, 54, 136, -189,
+	-191, -192, 113, -202, 150, -207, 135, 137, 138, 134,
+	-193, 151, 129, 28, -150, 136, -193, 135, 151, 144,
+	150, 113, 113, 113, -220, 16, -146, 136, -146, -146,
+	129, -193, 136, -145, 131, 22, 131, -201, 82, -151,
+	59, 60, 30, 259, 215, 107, 57, 57, -65, 120,
+	122, 125, 52, 128, -28, -65, -143, 133, 57, -143,
+	-143, -143, -65, 112, -65, 57, 30, -162, -234, -151,
+	272, 57, 169, 128, 170, 130, -162, -162, -162, -162,
+	-162, 173, 174, -162, -131, -130, 254, 255, 245, 253,
+	12, 245, 172, -162, 60, 184, -161, -161, -235, 56,
+	55, -8, 22, -234, -111, 19, 31, -51, -156, -98,
+	-99, -51, -97, -2, -38, 36, -42, 21, 65, 11,
+	-153, 73, 72, 89, -152, 22, -150, 59, 112, -51,
+	-72, 92, 74, 90, 91, 76, 94, 93, 104, 97,
+	98, 99, 100, 101, 102, 103, 95, 96, 107, 82,
+	83, 84, 85, 86, 87, 88, -127, -234, -89, -234,
+	118, 119, -75, -75, -75, -75, -75, -75, -75, -75,
+	-234, -2, -6, -84, -51, -234, -234, -234, -234, -234,
+	-234, -234, -234, -234, -93, -51, -234, -238, -81, -234,
+	-238, -81, -238, -81, -238, -234, -238, -81, -238, -81,
+	-238, -238, -81, -234, -234, -234, -234, -234, -234, -66,
+	26, -65, -53, -54, -55, -56, -67, -89, -234, 303,
+	-65, -65, -60, -236, 55, 11, 53, 55, -118, 178,
+	-119, -123, 262, 264, 82, -155, -150, 59, 29, 30,
+	56, 55, -65, -167, -170, -172, -171, -173, -168, -169,
+	212, 213, 108, 216, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 30, 158, 208, 209, 210, 211,
+	228, 229, 230, 231, 232, 233, 234, 235, 195, 214,
+	291, 196, 197, 198, 199, 200, 201, 203, 204, 205,
+	206, 207, 57, -162, 130, 57, 74, 57, -65, -221,
+	-217, 125, 122, -98, -150, -190, -189, 54, -189, -222,
+	142, 143, -234, 54, 53, 57, 28, -193, -193, -193,
+	-194, 57, -194, 28, -150, -150, 28, 28, -150, -150,
+	-113, -150, -113, 60, -212, 57, 166, 28, 299, -189,
+	-150, -150, -150, -150, -150, -65, -120, 259, 215, 258,
+	-201, 30, -162, 171, 171, 128, 128, -65, -65, 55,
+	131, -60, 23, 52, -65, 57, 57, -157, -156, -148,
+	-162, -162, -162, -162, -162, -65, -162, -162, -162, -162,
+	11, -129, 11, 92, -51, 52, -133, 185, 186, -9,
+	-89, -113, 9, 92, 55, 18, 112, 55, -100, 24,
+	25, -101, -235, -44, -76, -150, 60, 63, -43, 43,
+	-65, -51, -51, -82, 68, 74, 69, 70, -152, 99,
+	-157, -151, -148, -75, -83, -86, -89, 64, 92, 90,
+	91, 76, -75, -75, -75, -75, -75, -75, -75, -75,
+	-75, -75, -75, -75, -75, -75, -75, -163, 57, 59,
+	57, -74, -74, -150, -49, 21, -48, -50, -235, -235,
+	55, -235, -2, -48, -48, -51, -51, -90, 59, -48,
+	-90, 59, -48, -48, -42, -91, -92, 78, -90, -150,
+	-156, -235, -75, -150, -150, -48, -49, -48, -48, -114,
+	113, -65, 30, 55, -61, -63, -62, -64, 42, 46,
+	48, 43, 44, 45, 49, -160, 22, -53, -234, -234,
+	-159, 113, -158, 22, -156, 59, -114, 53, -53, -65,
+	-122, -119, 55, 263, 265, 266, 52, 71, -51, -179,
+	107, -199, -200, -201, -189, -191, -192, 56, -184, 22,
+	145, 68, 74, -180, 240, -174, 54, -174, -174, -174,
+	-174, -178, 215, -178, -178, -178, 54, 54, -174, -174,
+	-174, -174, -182, 54, -182, -182, -183, 54, -183, -154,
+	53, -65, -162, 23, -162, 26, 56, 55, -190, -222,
+	-150, -208, -209, 113, -204, -203, -150, 57, -207, 151,
+	-194, -194, -194, 54, -150, 55, -214, 57, -234, 254,
+	57, 28, 54, -234, 254, -222, -189, 30, 122, 57,
+	131, 131, -163, -150, -150, -65, -65, 125, 122, -65,
+	-65, -65, -162, -65, -142, 90, 12, -156, -156, -65,
+	-134, 187, -235, 38, -51, -51, -157, -99, -111, -125,
+	19, 11, 34, 34, -48, 68, 69, 70, 112, -234,
+	-83, -75, -75, -75, -47, 159, 73, -235, -235, -48,
+	55, -51, -235, -235, -235, 55, 53, 22, 11, 11,
+	-235, 11, 11, -235, -235, -48, -94, -92, 80, -51,
+	-235, 112, -235, 55, 55, -235, -235, -235, -235, -73,
+	30, 34, -2, -234, -234, -117, -121, -90, -54, -55,
+	-55, -54, -55, 42, 42, 42, 47, 42, 47, 42,
+	-62, -156, -235, -51, -68, 50, 132, 51, -234, -158,
+	-69, 12, -53, -69, -123, -124, 267, 264, 270, 57,
+	59, -213, 55, -211, -201, 82, -176, 29, -234, 57,
+	68, -181, 241, 60, -178, -178, -179, 30, -179, -179,
+	-179, -188, 59, -188, 60, 60, 52, -150, -162, -234,
+	-189, 56, -235, 55, -150, 56, 55, -174, 54, -113,
+	-150, -234, 60, -51, 54, -234, 54, 56, -113, -51,
+	54, -222, 29, 29, -150, -150, -179, -228, 53, 171,
+	171, 131, -162, -129, 59, -51, 188, 22, 39, 112,
+	-65, -52, 11, 99, -151, -49, -47, 73, -75, -75,
+	-102, 296, -235, -50, -166, 108, 212, 158, 210, 206,
+	226, 217, 239, 208, 240, -163, -166, -75, -75, -75,
+	-75, 290, -97, 81, -51, 79, -151, -75, -75, -116,
+	52, -117, -85, -87, -86, -234, -2, -112, -150, -115,
+	-150, -69, 55, 82, -58, -57, 52, 53, -59, 52,
+	-57, 42, 42, 55, 129, 129, 129, -115, -97, -51,
+	-69, 264, 268, 269, -200, -201, -177, 52, -75, -51,
+	22, 56, -179, -179, 57, 108, 56, 55, 56, 55,
+	56, 55, -65, -208, -209, 34, -215, 57, -206, -205,
+	53, 139, 66, -203, -204, 56, -208, -235, -113, -51,
+	56, -113, 56, -235, -113, -75, -226, 293, -227, 57,
+	-150, -150, -65, -142, 59, -89, -69, -53, -235, -75,
+	-150, -234, -235, -174, -174, -174, -183, -174, 200, -174,
+	200, -235, -235, 19, 19, 19, 19, -234, -46, 286,
+	-51, 55, 55, 27, -116, 55, -235, -235, -235, 55,
+	112, -235, 55, -97, -121, -51, -51, 54, -51, 59,
+	-234, -234, -234, -235, -101, -185, 237, 9, -235, -234,
+	-178, 59, -178, 60, 60, -162, -235, 167, 92, -195,
+	82, -205, 57, -195, 59, 56, 153, -235, 56, -235,
+	56, 56, -225, -224, -151, 54, -135, 280, 132, -95,
+	13, -103, -104, -150, 113, -178, 57, -75, -75, -75,
+	-75, -75, -235, 59, -75, -75, 28, -87, 34, -2,
+	-234, -150, -150, -150, -101, -113, -10, 254, -113, -113,
+	-113, -159, -187, 135, 28, 134, -78, -186, 147, 146,
+	-51, -179, -179, 56, 56, 168, -86, -234, 57, 60,
+	-206, -65, 55, 82, -113, 26, -96, 14, 16, -235,
+	-97, -104, 113, 16, -235, -235, -235, -235, -45, 92,
+	293, -235, -235, 9, -85, -2, 112, 56, -235, -234,
+	-235, -235, -235, -68, -175, 66, 28, 28, -184, -235,
+	-234, 163, -215, 54, -224, -201, 56, 128, -108, 297,
+	-51, -84, -105, -106, 298, 299, -97, -84, -235, 291,
+	49, 294, -117, -235, -150, -160, -11, -12, -150, 306,
+	59, -187, -186, -75, 163, -215, -113, -228, -65, -109,
+	-110, -150, -107, 76, 301, 115, -75, -105, 39, 292,
+	295, -156, -235, 55, 19, -167, 59, 305, -175, -184,
+	-235, -235, 56, -226, -114, 55, 22, -107, 300, 116,
+	117, 116, 117, 39, -12, 304, 305, 23, -10, 59,
+	-187, -215, -215, -230, -231, 52, -178, -110, -234, 73,
+	293, 59, 305, -10, -175, -231, 52, 10, 9, -136,
+	-131, -103, -107, 294, -13, -14, 68, 308, 29, 59,
+	-229, 154, 149, 152, 30, -229, -138, 189, -137, 190,
+	192, 191, 193, -235, 295, 52, 59, 148, 29, 68,
+	-140, 132, -139, 194, 190, 190, 192, 191, 193, 16,
+	16, 192, 16, 307, 308, -141, -234, 60, 194, 190,
+	16, 16, 16, 16, 192, 16, 59, 59, 16, 59,
+	-14, -112, 189, 16, 16, 59, 59, 59, 59, 16,
+	59, 59, 52, -235, 59, 59, 59, 308,
 }
 var yyDef = [...]int{
 
-	23, -2, 2, -2, 5, 6, 7, 8, 9, 10,
+	26, -2, 2, -2, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	21, 22, 555, 0, 313, 313, 313, 313, 313, 313,
-	0, 626, 609, 0, 0, 0, 0, -2, 300, 301,
-	0, 303, 304, 851, 851, 851, 851, 851, 0, 0,
-	851, 0, 35, 36, 849, 1, 3, 563, 0, 0,
-	317, 320, 315, 0, 609, 609, 0, 0, 62, 63,
-	0, 0, 0, 835, 0, 607, 607, 607, 627, 628,
-	631, 632, 734, 735, 736, 737, 738, 739, 740, 741,
-	742, 743, 744, 745, 746, 747, 748, 749, 750, 751,
-	752, 753, 754, 755, 756, 757, 758, 759, 760, 761,
-	762, 763, 764, 765, 766, 767, 768, 769, 770, 771,
-	772, 773, 774, 775, 776, 777, 778, 779, 780, 781,
-	782, 783, 784, 785, 786, 787, 788, 789, 790, 791,
-	792, 793, 794, 795, 796, 797, 798, 799, 800, 801,
-	802, 803, 804, 805, 806, 807, 808, 809, 810, 811,
-	812, 813, 814, 815, 816, 817, 818, 819, 820, 821,
-	822, 823, 824, 825, 826, 827, 828, 829, 830, 831,
-	832, 833, 834, 836, 837, 838, 839, 840, 841, 842,
-	843, 844, 845, 846, 847, 848, 0, 0, 0, 610,
-	0, 605, 0, 605, 605, 605, 0, 250, 385, 635,
-	636, 835, 0, 0, 0, 852, 0, 852, 852, 263,
-	852, 852, 266, 852, 0, 852, 0, 273, 275, 276,
-	277, 278, 0, 282, 852, 297, 298, 287, 299, 302,
-	305, 306, 307, 308, 309, 851, 851, 312, 29, 567,
-	0, 0, 555, 31, 0, 313, 318, 319, 323, 321,
-	322, 314, 0, 331, 335, 0, 393, 0, 398, 400,
-	-2, -2, 0, 435, 436, 437, 438, 439, 0, 0,
-	0, 0, 0, 0, 0, 0, 463, 464, 465, 466,
-	540, 541, 542, 543, 544, 545, 546, 547, 402, 403,
-	537, 587, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 528, 0, 499, 499, 499, 499, 499, 499, 499,
-	499, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 43, 47, 0, 826, 591, -2, -2, 0, 0,
-	633, 634, -2, 744, -2, 639, 640, 641, 642, 643,
-	644, 645, 646, 647, 648, 649, 650, 651, 652, 653,
-	654, 655, 656, 657, 658, 659, 660, 661, 662, 663,
-	664, 665, 666, 667, 668, 669, 670, 671, 672, 673,
-	674, 675, 676, 677, 678, 679, 680, 681, 682, 683,
-	684, 685, 686, 687, 688, 689, 690, 691, 692, 693,
-	694, 695, 696, 697, 698, 699, 700, 701, 702, 703,
-	704, 705, 706, 707, 708, 709, 710, 711, 712, 713,
-	714, 715, 716, 717, 718, 719, 720, 721, 722, 723,
-	724, 725, 726, 727, 728, 729, 730, 731, 732, 733,
-	0, 0, 81, 0, 79, 0, 852, 0, 0, 0,
-	0, 0, 0, 852, 0, 0, 0, 0, 241, 0,
-	0, 0, 0, 0, 0, 0, 249, 0, 251, 852,
-	852, 254, 853, 854, 852, 852, 852, 0, 852, 852,
-	261, 262, 264, 265, 267, 852, 852, 269, 0, 290,
-	288, 289, 284, 285, 0, 279, 280, 283, 310, 311,
-	30, 850, 24, 0, 0, 564, 0, 556, 557, 560,
-	563, 29, 320, 0, 325, 324, 316, 0, 332, 0,
-	0, 0, 336, 0, 338, 339, 0, 396, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 420, 421, 422,
-	423, 424, 425, 426, 399, 0, 413, 0, 0, 0,
-	455, 456, 457, 458, 459, 460, 461, 0, 327, 29,
-	0, 433, 0, 0, 0, 0, 0, 0, 0, 0,
-	323, 0, 529, 0, 483, 491, 0, 484, 492, 485,
-	493, 486, 0, 487, 494, 488, 495, 489, 490, 496,
-	0, 0, 0, 327, 0, 0, 45, 0, 384, 0,
-	342, 344, 345, 346, -2, 0, 368, -2, 0, 0,
-	0, 41, 42, 0, 48, 826, 50, 51, 0, 0,
-	0, 159, 600, 601, 602, 598, 203, 0, 0, 147,
-	143, 87, 88, 89, 136, 91, 136, 136, 136, 136,
-	156, 156, 156, 156, 119, 120, 121, 122, 123, 0,
-	0, 106, 136, 136, 136, 110, 126, 127, 128, 129,
-	130, 131, 132, 133, 92, 93, 94, 95, 96, 97,
-	98, 99, 100, 138, 138, 138, 140, 140, 629, 65,
-	0, 852, 0, 852, 77, 0, 217, 0, 0, 0,
-	0, 0, 0, 0, 244, 606, 0, 852, 247, 248,
-	386, 637, 638, 252, 253, 255, 256, 257, 258, 259,
-	260, 268, 272, 0, 293, 0, 0, 274, 0, 568,
-	0, 0, 0, 0, 0, 559, 561, 562, 567, 32,
-	323, 0, 548, 0, 0, 0, 326, 27, 394, 395,
-	397, 414, 0, 416, 418, 337, 333, 0, 538, -2,
-	404, 405, 429, 430, 431, 0, 0, 0, 0, 427,
-	409, 0, 440, 441, 442, 443, 444, 445, 446, 447,
-	448, 449, 450, 451, 454, 513, 514, 0, 452, 453,
-	462, 0, 0, 328, 329, 432, 0, 586, 29, 0,
-	0, 0, 0, 437, 540, 0, 437, 540, 0, 0,
-	0, 535, 532, 0, 0, 537, 0, 500, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 383, 0, 0,
-	0, 0, 0, 0, 373, 0, 0, 376, 0, 0,
-	0, 0, 367, 0, 0, 387, 794, 369, 0, 371,
-	372, 391, 0, 391, 44, 592, 49, 0, 0, 54,
-	55, 593, 594, 595, 596, 0, 78, 204, 206, 209,
-	210, 211, 82, 83, 84, 0, 0, 191, 0, 0,
-	185, 185, 0, 183, 184, 80, 150, 148, 0, 145,
-	144, 90, 0, 156, 156, 113, 114, 159, 0, 159,
-	159, 159, 0, 0, 107, 108, 109, 101, 0, 102,
-	103, 104, 0, 105, 0, 0, 852, 67, 608, 68,
-	851, 0, 0, 621, 218, 611, 612, 613, 614, 615,
-	616, 617, 618, 619, 620, 0, 69, 220, 222, 221,
-	0, 0, 0, 242, 852, 246, 290, 271, 0, 0,
-	291, 292, 281, 0, 565, 566, 0, 558, 25, 0,
-	603, 604, 549, 550, 340, 415, 417, 419, 0, 327,
-	406, 427, 410, 0, 407, 0, 0, 401, 467, 0,
-	0, 434, -2, 470, 471, 0, 0, 0, 0, 0,
-	506, 0, 0, 507, 0, 555, 0, 533, 0, 0,
-	482, 0, 501, 0, 0, 502, 503, 504, 505, 580,
-	0, 0, -2, 0, 0, 391, 588, 0, 343, 362,
-	364, 0, 359, 374, 375, 377, 0, 379, 0, 381,
-	382, 347, 349, 350, 0, 0, 0, 0, 370, 555,
-	0, 391, 40, 52, 53, 0, 0, 59, 160, 161,
-	0, 207, 0, 0, 0, 178, 185, 185, 181, 186,
-	182, 0, 152, 0, 149, 86, 146, 0, 159, 159,
-	115, 0, 116, 117, 118, 0, 134, 0, 0, 0,
-	0, 630, 66, 212, 851, 225, 226, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 851, 0, 851, 622,
-	623, 624, 625, 0, 72, 0, 0, 0, 0, 245,
-	293, 294, 295, 569, 0, 26, 391, 0, 334, 539,
-	0, 408, 0, 428, 411, 468, 330, 0, 136, 136,
-	518, 136, 140, 521, 136, 523, 136, 526, 0, 0,
-	0, 0, 0, 0, 0, 530, 481, 536, 0, 538,
-	0, 0, 33, 0, 580, 570, 582, 584, 0, 29,
-	0, 576, 0, 354, 555, 0, 0, 356, 363, 0,
-	0, 357, 0, 358, 378, 380, 0, 0, 0, 0,
-	563, 392, 39, 56, 57, 58, 205, 208, 0, 187,
-	136, 190, 179, 180, 0, 154, 0, 151, 137, 111,
-	112, 157, 158, 156, 0, 156, 0, 141, 0, 852,
-	213, 214, 215, 216, 0, 219, 0, 70, 71, 0,
-	224, 243, 270, 551, 341, 469, 412, 472, 515, 156,
-	519, 520, 522, 524, 525, 527, 474, 473, 0, 0,
-	0, 0, 0, 0, 0, 534, 0, 0, 0, 34,
-	0, 585, -2, 0, 0, 0, 46, 0, 563, 589,
-	590, 360, 0, 365, 0, 0, 0, 368, 38, 170,
-	0, 189, 0, 352, 162, 155, 0, 159, 135, 159,
-	0, 0, 64, 0, 73, 74, 0, 0, 553, 0,
-	516, 517, 0, 0, 0, 0, 508, 480, 531, 0,
-	0, 0, 583, 0, -2, 0, 578, 577, 355, 37,
-	0, 0, 0, 0, 387, 169, 171, 0, 176, 0,
-	188, 0, 0, 167, 0, 164, 166, 153, 124, 125,
-	139, 142, 0, 0, 0, 0, 28, 0, 0, 475,
-	477, 476, 478, 0, 0, 0, 497, 498, 0, 573,
-	29, 0, 361, 388, 389, 390, 351, 172, 173, 0,
-	177, 175, 0, 353, 85, 0, 163, 165, 0, 237,
-	0, 75, 76, 69, 554, 552, 479, 0, 0, 0,
-	581, -2, 579, 174, 0, 168, 236, 0, 0, 72,
-	509, 0, 512, 0, 238, 0, 223, 510, 0, 0,
-	0, 192, 0, 0, 193, 194, 0, 0, 511, 195,
-	0, 0, 0, 0, 0, 196, 198, 199, 0, 0,
-	197, 239, 240, 200, 201, 202,
+	21, 22, 23, 24, 25, 711, 0, 429, 0, 429,
+	429, 429, 429, 429, 0, 772, 0, 765, 0, 0,
+	0, 0, -2, 383, 414, 429, 417, 0, 419, 420,
+	1037, 1037, 1037, 1037, 1037, 0, 0, 1037, 0, 0,
+	45, 46, 1035, 1, 3, 719, 0, 0, 433, 436,
+	431, -2, 0, 765, 765, 0, 0, 72, 73, 0,
+	0, 0, 1021, 0, 763, 763, 763, 773, 774, 777,
+	778, 889, 890, 891, 892, 893, 894, 895, 896, 897,
+	898, 899, 900, 901, 902, 903, 904, 905, 906, 907,
+	908, 909, 910, 911, 912, 913, 914, 915, 916, 917,
+	918, 919, 920, 921, 922, 923, 924, 925, 926, 927,
+	928, 929, 930, 931, 932, 933, 934, 935, 936, 937,
+	938, 939, 940, 941, 942, 943, 944, 945, 946, 947,
+	948, 949, 950, 951, 952, 953, 954, 955, 956, 957,
+	958, 959, 960, 961, 962, 963, 964, 965, 966, 967,
+	968, 969, 970, 971, 972, 973, 974, 975, 976, 977,
+	978, 979, 980, 981, 982, 983, 984, 985, 986, 987,
+	988, 989, 990, 991, 992, 993, 994, 995, 996, 997,
+	998, 999, 1000, 1001, 1002, 1003, 1004, 1005, 1006, 1007,
+	1008, 1009, 1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017,
+	1018, 1019, 1020, 1022, 1023, 1024, 1025, 1026, 1027, 1028,
+	1029, 1030, 1031, 1032, 1033, 1034, 230, 232, 235, 243,
+	0, 0, 0, 0, 0, 0, 299, 245, 246, 0,
+	770, 770, 770, 767, 0, 271, 276, 0, 280, 281,
+	282, 283, 284, 0, 286, 0, 290, 291, 0, 0,
+	0, 0, 0, 766, 0, 761, 0, 761, 761, 761,
+	0, 333, 518, 781, 782, 1021, 0, 0, 0, 1038,
+	0, 1038, 1038, 346, 1038, 1038, 349, 1038, 0, 1038,
+	0, 356, 358, 359, 360, 361, 0, 365, 1038, 380,
+	381, 370, 382, 0, 415, 416, 0, 418, 421, 422,
+	423, 424, 425, 1037, 1037, 428, 39, 31, 0, 33,
+	0, 723, 0, 0, 711, 41, 0, 429, 434, 435,
+	439, 437, 438, 430, 0, 447, 451, 0, 526, 0,
+	531, 533, -2, -2, 0, 569, 570, 571, 572, 573,
+	0, 0, 0, 0, 0, 0, 0, 0, 597, 598,
+	599, 600, 691, 692, 693, 694, 695, 696, 697, 698,
+	535, 536, 688, 743, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 679, 0, 633, 633, 633, 633, 633,
+	633, 633, 633, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 53, 57, 0, 1011, 747, -2, -2,
+	0, 0, 779, 780, -2, 900, -2, 785, 786, 787,
+	788, 789, 790, 791, 792, 793, 794, 795, 796, 797,
+	798, 799, 800, 801, 802, 803, 804, 805, 806, 807,
+	808, 809, 810, 811, 812, 813, 814, 815, 816, 817,
+	818, 819, 820, 821, 822, 823, 824, 825, 826, 827,
+	828, 829, 830, 831, 832, 833, 834, 835, 836, 837,
+	838, 839, 840, 841, 842, 843, 844, 845, 846, 847,
+	848, 849, 850, 851, 852, 853, 854, 855, 856, 857,
+	858, 859, 860, 861, 862, 863, 864, 865, 866, 867,
+	868, 869, 870, 871, 872, 873, 874, 875, 876, 877,
+	878, 879, 880, 881, 882, 883, 884, 885, 886, 887,
+	888, 0, 0, 91, 0, 89, 0, 1038, 0, 0,
+	0, 0, 0, 231, 0, 0, 0, 0, 0, 273,
+	253, 254, 973, 0, 0, 209, 979, 997, 930, 203,
+	203, 929, 201, 202, 255, 0, 0, 979, 929, 0,
+	0, -2, 0, 0, 277, 0, 0, 771, 0, 0,
+	0, 0, 0, 0, 768, 769, 0, 278, 0, 227,
+	228, 229, 285, 287, 0, 289, 292, 293, 1038, 0,
+	0, 0, 0, 0, 324, 0, 0, 0, 0, 0,
+	0, 0, 332, 0, 334, 1038, 1038, 337, 1039, 1040,
+	1038, 1038, 1038, 0, 1038, 1038, 344, 345, 347, 348,
+	350, 1038, 1038, 352, 0, 373, 371, 372, 367, 368,
+	0, 362, 363, 366, 384, 386, 426, 427, 40, 1036,
+	0, 32, 0, 0, 27, 0, 0, 720, 0, 712,
+	713, 716, 719, 39, 436, 0, 441, 440, 432, 0,
+	448, 0, 0, 0, 452, 0, 454, 455, 0, 529,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 553,
+	554, 555, 556, 557, 558, 559, 532, 0, 546, 0,
+	0, 0, 589, 590, 591, 592, 593, 594, 595, 0,
+	443, 39, 0, 0, 567, 0, 0, 0, 0, 0,
+	0, 0, 0, 439, 0, 680, 0, 617, 625, 0,
+	618, 626, 619, 627, 620, 0, 621, 628, 622, 629,
+	623, 624, 630, 0, 0, 0, 443, 0, 0, 55,
+	0, 517, 0, 458, 460, 461, 462, -2, 0, 0,
+	501, -2, 0, 0, 0, 51, 52, 0, 58, 1011,
+	60, 61, 0, 0, 0, 174, 756, 757, 758, 754,
+	221, 0, 0, 162, 158, 102, 103, 104, 151, 106,
+	151, 151, 151, 151, 171, 171, 171, 171, 134, 135,
+	136, 137, 138, 0, 0, 121, 151, 151, 151, 151,
+	141, 142, 143, 144, 145, 146, 147, 148, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 153, 153, 153,
+	155, 155, 775, 75, 0, 1038, 0, 1038, 87, 233,
+	244, 0, 0, 272, 0, 0, 249, 0, 273, 251,
+	274, 0, 0, 0, 0, 0, 195, 203, 203, 203,
+	199, 204, 200, 0, 256, 257, 258, 0, 260, 261,
+	296, 485, 297, 298, 313, 0, 0, 0, 0, 273,
+	0, 0, 0, 0, 0, 269, 0, 753, 0, 755,
+	279, 288, 234, 0, 0, 0, 0, 0, 242, 0,
+	0, 327, 762, 0, 1038, 330, 331, 519, 783, 784,
+	335, 336, 338, 339, 340, 341, 342, 343, 351, 355,
+	0, 376, 0, 0, 357, 0, 389, 387, 388, 34,
+	35, 0, 724, 0, 0, 0, 0, 0, 715, 717,
+	718, 723, 42, 439, 0, 699, 0, 0, 0, 442,
+	37, 527, 528, 530, 547, 0, 549, 551, 453, 449,
+	0, 689, -2, 537, 538, 562, 563, 564, 0, 0,
+	0, 0, 560, 542, 0, 574, 575, 576, 577, 578,
+	579, 580, 581, 582, 583, 584, 585, 588, 664, 665,
+	0, 586, 587, 596, 0, 0, 444, 445, 565, 566,
+	0, 742, 39, 0, 0, 0, 0, 571, 691, 0,
+	571, 691, 0, 0, 0, 686, 683, 0, 0, 688,
+	0, 634, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 516, 0, 0, 0, 0, 0, 0, 506, 0,
+	0, 509, 0, 0, 0, 0, 500, 0, 0, 0,
+	520, 973, 502, 0, 504, 505, 524, 0, 524, 54,
+	748, 59, 0, 0, 64, 65, 749, 750, 751, 752,
+	0, 300, 222, 224, 92, 93, 94, 90, 165, 0,
+	0, 163, 0, 160, 159, 105, 0, 171, 171, 128,
+	129, 174, 0, 174, 174, 174, 0, 0, 122, 123,
+	124, 125, 116, 0, 117, 118, 119, 0, 120, 0,
+	0, 1038, 77, 764, 78, 0, 247, 0, 0, 252,
+	275, 0, 315, 0, 0, 205, 151, 0, 208, 0,
+	196, 197, 198, 0, 259, 0, 302, 0, 0, 0,
+	0, 0, 0, 0, 0, 262, 273, 0, 0, 266,
+	0, 0, 174, 79, 237, 239, 238, 0, 0, 0,
+	325, 1038, 329, 373, 354, 0, 0, 374, 375, 364,
+	0, 390, 0, 0, 721, 722, 0, 714, 28, 0,
+	759, 760, 700, 701, 456, 548, 550, 552, 0, 443,
+	539, 560, 543, 0, 540, 0, 0, 534, 642, 0,
+	0, 568, -2, 604, 605, 0, 0, 0, 0, 0,
+	640, 0, 0, 641, 0, 711, 0, 684, 0, 0,
+	616, 0, 635, 0, 0, 636, 637, 638, 639, 736,
+	0, 0, -2, 0, 0, 524, 744, 0, 459, 495,
+	497, 0, 492, 507, 508, 510, 0, 512, 0, 514,
+	515, 463, 465, 0, 483, 0, 0, 0, 0, 503,
+	711, 0, 524, 50, 62, 63, 0, 0, 69, 175,
+	176, 88, 0, 301, 225, 0, 167, 0, 0, 0,
+	164, 101, 161, 0, 174, 174, 130, 0, 131, 132,
+	133, 0, 149, 0, 0, 0, 0, 776, 76, 0,
+	250, 248, 295, 0, 322, 185, 0, 207, 0, 0,
+	486, 0, 314, 0, 0, 0, 0, 306, 0, 0,
+	0, 263, 0, 265, 267, 268, 270, 82, 0, 0,
+	0, 0, 328, 376, 377, 378, 0, 0, 725, 0,
+	29, 524, 0, 450, 690, 0, 541, 0, 561, 544,
+	601, 0, 602, 446, 0, 151, 151, 669, 151, 155,
+	672, 151, 674, 151, 677, 0, 0, 0, 0, 0,
+	0, 0, 681, 615, 687, 0, 689, 0, 0, 43,
+	0, 736, 726, 738, 740, 0, 39, 0, 732, 0,
+	487, 711, 0, 0, 489, 496, 0, 0, 490, 0,
+	491, 511, 513, 0, 0, 0, 0, 0, 719, 525,
+	49, 66, 67, 68, 223, 226, 169, 0, 166, 0,
+	0, 152, 126, 127, 172, 173, 171, 0, 171, 0,
+	156, 0, 1038, 0, 316, 0, 321, 193, 184, 188,
+	0, 193, 0, 206, 0, 0, 0, 304, 0, 0,
+	308, 0, 307, 310, 0, 264, 236, 0, 80, 81,
+	0, 241, 326, 353, 391, 36, 702, 457, 603, 545,
+	643, 647, 606, 666, 171, 670, 671, 673, 675, 676,
+	678, 608, 607, 0, 0, 0, 0, 0, 0, 0,
+	685, 0, 0, 0, 44, 0, 741, -2, 0, 0,
+	0, 56, 0, 719, 745, 746, 493, 0, 498, 0,
+	0, 0, 0, 501, 48, 177, 170, 0, 98, 0,
+	174, 150, 174, 0, 0, 74, 294, 0, 0, 0,
+	194, 189, 190, 0, 192, 187, 0, 303, 312, 305,
+	309, 311, 83, 84, 0, 0, 0, 392, 393, 704,
+	0, 0, 711, 647, 973, 667, 668, 0, 0, 0,
+	0, 659, 614, 682, 0, 0, 0, 739, 0, -2,
+	0, 734, 733, 488, 47, 0, 0, 0, 0, 0,
+	0, 520, 182, 0, 179, 181, 168, 162, 99, 100,
+	0, 139, 140, 154, 157, 0, 322, 0, 323, 191,
+	186, 0, 0, 0, 0, 0, 706, 0, 0, 644,
+	649, 711, 0, 0, 609, 611, 610, 612, 0, 0,
+	0, 631, 632, 0, 729, 39, 0, 494, -2, 0,
+	521, 522, 523, 484, 95, 0, 178, 180, 177, 98,
+	0, 322, 320, 0, 85, 86, 79, 0, 38, 0,
+	705, 703, 645, 0, 652, 653, 649, 648, 613, 0,
+	0, 0, 737, -2, 735, 0, 0, 469, 0, 965,
+	183, 182, 162, 0, 0, 319, 0, 82, 55, 707,
+	708, 0, 650, 0, 910, 1014, 0, 646, 660, 0,
+	663, 466, 468, 0, 0, 0, 0, 0, 96, 177,
+	322, 322, 210, 240, 171, 0, 0, 0, 654, 655,
+	656, 657, 658, 661, 470, 471, 0, 0, 474, 0,
+	182, 317, 318, 211, 212, 0, 394, 709, 647, 0,
+	0, 476, 0, 475, 97, 213, 0, 0, 0, 404,
+	0, 0, 651, 0, 472, 0, 480, 481, 0, 473,
+	214, 216, 217, 0, 0, 215, 410, 0, 395, 0,
+	0, 0, 0, 710, 662, 0, 482, 218, 219, 220,
+	412, 0, 405, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 477, 478, 385, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 396, 397, 0, 399,
+	0, 0, 411, 0, 0, 406, 407, 400, 401, 0,
+	403, 398, 0, 413, 408, 409, 402, 479,
 }
 var yyTok1 = [...]int{
 
@@ -2657,7 +3788,7 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 75, 3, 3, 3, 102, 94, 3,
 	54, 56, 99, 97, 55, 98, 112, 100, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 269,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 310,
 	83, 82, 84, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -2693,8 +3824,16 @@ var yyTok2 = [...]int{
 	239, 240, 241, 242, 243, 244, 245, 246, 247, 248,
 	249, 250, 251, 252, 253, 254, 255, 256, 257, 258,
 	259, 260, 261, 262, 263, 264, 265, 266, 267, 268,
+	269, 270, 271, 272, 273, 274,
 }
 var yyTok3 = [...]int{
+	57600, 275, 57601, 276, 57602, 277, 57603, 278, 57604, 279,
+	57605, 280, 57606, 281, 57607, 282, 57608, 283, 57609, 284,
+	57610, 285, 57611, 286, 57612, 287, 57613, 288, 57614, 289,
+	57615, 290, 57616, 291, 57617, 292, 57618, 293, 57619, 294,
+	57620, 295, 57621, 296, 57622, 297, 57623, 298, 57624, 299,
+	57625, 300, 57626, 301, 57627, 302, 57628, 303, 57629, 304,
+	57630, 305, 57631, 306, 57632, 307, 57633, 308, 57634, 309,
 	0,
 }
 
@@ -3037,93 +4176,151 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:316
+//line sql.y:403
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:321
+//line sql.y:408
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:322
+//line sql.y:409
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:326
+//line sql.y:413
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 5:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:417
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
-	case 23:
+	case 26:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:348
+//line sql.y:441
 		{
 			setParseTree(yylex, nil)
 		}
-	case 24:
+	case 27:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:354
+//line sql.y:447
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
 			sel.Limit = yyDollar[3].limit
 			sel.Lock = yyDollar[4].str
 			yyVAL.selStmt = sel
+			setPos(yylex, yyVAL.selStmt, yyDollar[1].pos)
 		}
-	case 25:
+	case 28:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:362
+//line sql.y:456
 		{
 			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
+			setPos(yylex, yyVAL.selStmt, yyDollar[1].pos)
 		}
-	case 26:
+	case 29:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:366
+//line sql.y:461
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
+			setPos(yylex, yyVAL.selStmt, yyDollar[1].pos)
 		}
-	case 27:
+	case 30:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:471
+		{
+			switch sel := yyDollar[2].selStmt.(type) {
+			case *Select:
+				sel.With = yyDollar[1].with
+			case *Union:
+				sel.With = yyDollar[1].with
+			}
+			yyVAL.selStmt = yyDollar[2].selStmt
+		}
+	case 31:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:483
+		{
+			yyVAL.with = &With{CTEs: yyDollar[2].ctes}
+		}
+	case 32:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:487
+		{
+			yyVAL.with = &With{Recursive: true, CTEs: yyDollar[3].ctes}
+		}
+	case 33:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:493
+		{
+			yyVAL.ctes = []*CommonTableExpr{yyDollar[1].cte}
+		}
+	case 34:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:497
+		{
+			yyVAL.ctes = append(yyDollar[1].ctes, yyDollar[3].cte)
+		}
+	case 35:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:503
+		{
+			yyVAL.cte = &CommonTableExpr{Name: yyDollar[1].tableIdent, Subquery: yyDollar[3].subquery}
+		}
+	case 36:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:507
+		{
+			yyVAL.cte = &CommonTableExpr{Name: yyDollar[1].tableIdent, Columns: yyDollar[3].columns, Subquery: yyDollar[6].subquery}
+		}
+	case 37:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:372
+//line sql.y:513
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
-	case 28:
-		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:379
+	case 38:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:520
 		{
-			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr), Windows: yyDollar[11].windowDefs}
+			setPos(yylex, yyVAL.selStmt, yyDollar[1].pos)
 		}
-	case 29:
+	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:385
+//line sql.y:527
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 30:
+	case 40:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:389
+//line sql.y:531
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 31:
+	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:395
+//line sql.y:537
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 32:
+	case 42:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:399
+//line sql.y:541
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 33:
+	case 43:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:406
+//line sql.y:548
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -3135,9 +4332,9 @@ yydefault:
 			ins.OnDup = OnDup(yyDollar[7].updateExprs)
 			yyVAL.statement = ins
 		}
-	case 34:
+	case 44:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:418
+//line sql.y:560
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -3147,316 +4344,317 @@ yydefault:
 			}
 			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 35:
+	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:430
+//line sql.y:572
 		{
 			yyVAL.str = InsertStr
 		}
-	case 36:
+	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:434
+//line sql.y:576
 		{
 			yyVAL.str = ReplaceStr
 		}
-	case 37:
+	case 47:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:440
+//line sql.y:582
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].updateExprs, Where: NewWhere(WhereStr, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
-	case 38:
+	case 48:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:446
+//line sql.y:588
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
-	case 39:
+	case 49:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:450
+//line sql.y:592
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
 		}
-	case 40:
+	case 50:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:454
+//line sql.y:596
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 41:
+	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:459
+//line sql.y:601
 		{
 		}
-	case 42:
+	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:460
+//line sql.y:602
 		{
 		}
-	case 43:
+	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:464
+//line sql.y:606
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 44:
+	case 54:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:468
+//line sql.y:610
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 45:
+	case 55:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:473
+//line sql.y:615
 		{
 			yyVAL.partitions = nil
 		}
-	case 46:
+	case 56:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:477
+//line sql.y:619
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
-	case 47:
+	case 57:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:483
+//line sql.y:625
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
-	case 48:
+	case 58:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:487
+//line sql.y:629
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
 		}
-	case 49:
+	case 59:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:491
+//line sql.y:633
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[5].setExprs}
 		}
-	case 50:
+	case 60:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:495
+//line sql.y:637
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setExprs}
 		}
-	case 51:
+	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:501
+//line sql.y:643
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 52:
+	case 62:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:505
+//line sql.y:647
 		{
 			yyVAL.setExprs = append(yyVAL.setExprs, yyDollar[3].setExpr)
 		}
-	case 53:
+	case 63:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:511
+//line sql.y:653
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(yyDollar[3].str))}
 		}
-	case 54:
+	case 64:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:515
+//line sql.y:657
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadWrite))}
 		}
-	case 55:
+	case 65:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:519
+//line sql.y:661
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadOnly))}
 		}
-	case 56:
+	case 66:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:525
+//line sql.y:667
 		{
 			yyVAL.str = IsolationLevelRepeatableRead
 		}
-	case 57:
+	case 67:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:529
+//line sql.y:671
 		{
 			yyVAL.str = IsolationLevelReadCommitted
 		}
-	case 58:
+	case 68:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:533
+//line sql.y:675
 		{
 			yyVAL.str = IsolationLevelReadUncommitted
 		}
-	case 59:
+	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:537
+//line sql.y:679
 		{
 			yyVAL.str = IsolationLevelSerializable
 		}
-	case 60:
+	case 70:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:543
+//line sql.y:685
 		{
 			yyVAL.str = SessionStr
 		}
-	case 61:
+	case 71:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:547
+//line sql.y:689
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 62:
+	case 72:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:553
+//line sql.y:695
 		{
 			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 63:
+	case 73:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:558
+//line sql.y:700
 		{
 			// Create table [name] like [name]
 			yyDollar[1].ddl.OptLike = yyDollar[2].optLike
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 64:
+	case 74:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:564
+//line sql.y:706
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName}
 		}
-	case 65:
+	case 75:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:569
+//line sql.y:711
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 66:
+	case 76:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:573
+//line sql.y:715
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[5].tableName.ToViewName()}
 		}
-	case 67:
+	case 77:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:577
+//line sql.y:719
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 68:
+	case 78:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:581
+//line sql.y:723
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 69:
+	case 79:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:586
+//line sql.y:728
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 70:
+	case 80:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:590
+//line sql.y:732
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 71:
+	case 81:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:596
+//line sql.y:738
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 72:
+	case 82:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:601
+//line sql.y:743
 		{
 			var v []VindexParam
 			yyVAL.vindexParams = v
 		}
-	case 73:
+	case 83:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:606
+//line sql.y:748
 		{
 			yyVAL.vindexParams = yyDollar[2].vindexParams
 		}
-	case 74:
+	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:612
+//line sql.y:754
 		{
 			yyVAL.vindexParams = make([]VindexParam, 0, 4)
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
 		}
-	case 75:
+	case 85:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:617
+//line sql.y:759
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
-	case 76:
+	case 86:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:623
+//line sql.y:765
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 77:
+	case 87:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:629
+//line sql.y:771
 		{
 			yyVAL.ddl = &DDL{Action: CreateStr, Table: yyDollar[4].tableName}
 			setDDL(yylex, yyVAL.ddl)
 		}
-	case 78:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:636
+	case 88:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:778
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].str
+			yyVAL.TableSpec.PartitionBy = yyDollar[5].partBy
 		}
-	case 79:
+	case 89:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:643
+//line sql.y:786
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
-	case 80:
+	case 90:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:647
+//line sql.y:790
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
-	case 81:
+	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:653
+//line sql.y:796
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 82:
+	case 92:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:658
+//line sql.y:801
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 83:
+	case 93:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:662
+//line sql.y:805
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 84:
+	case 94:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:666
+//line sql.y:809
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
-	case 85:
+	case 95:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:672
+//line sql.y:815
 		{
 			yyDollar[2].columnType.NotNull = yyDollar[3].boolVal
 			yyDollar[2].columnType.Default = yyDollar[4].optVal
@@ -3464,816 +4662,877 @@ yydefault:
 			yyDollar[2].columnType.Autoincrement = yyDollar[6].boolVal
 			yyDollar[2].columnType.KeyOpt = yyDollar[7].colKeyOpt
 			yyDollar[2].columnType.Comment = yyDollar[8].sqlVal
-			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+			yyVAL.columnDefinition = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
-	case 86:
+	case 96:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:825
+		{
+			yyDollar[2].columnType.Generated = yyDollar[5].expr
+			yyDollar[2].columnType.Stored = yyDollar[7].boolVal
+			yyDollar[2].columnType.NotNull = yyDollar[8].boolVal
+			yyDollar[2].columnType.KeyOpt = yyDollar[9].colKeyOpt
+			yyDollar[2].columnType.Comment = yyDollar[10].sqlVal
+			yyVAL.columnDefinition = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
+		}
+	case 97:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:834
+		{
+			if strings.ToLower(string(yyDollar[4].bytes)) != "always" {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyDollar[2].columnType.Generated = yyDollar[7].expr
+			yyDollar[2].columnType.Stored = yyDollar[9].boolVal
+			yyDollar[2].columnType.NotNull = yyDollar[10].boolVal
+			yyDollar[2].columnType.KeyOpt = yyDollar[11].colKeyOpt
+			yyDollar[2].columnType.Comment = yyDollar[12].sqlVal
+			yyVAL.columnDefinition = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
+		}
+	case 98:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:848
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 99:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:852
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 100:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:856
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 101:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:683
+//line sql.y:862
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
 			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
 		}
-	case 90:
+	case 105:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:694
+//line sql.y:873
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].sqlVal
 		}
-	case 91:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:699
+//line sql.y:878
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 92:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:705
+//line sql.y:884
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 93:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:709
+//line sql.y:888
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 94:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:713
+//line sql.y:892
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 95:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:717
+//line sql.y:896
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 96:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:721
+//line sql.y:900
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 97:
+	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:725
+//line sql.y:904
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 98:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:729
+//line sql.y:908
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 99:
+	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:733
+//line sql.y:912
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 100:
+	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:737
+//line sql.y:916
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 101:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:743
+//line sql.y:922
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 102:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:749
+//line sql.y:928
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 103:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:755
+//line sql.y:934
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 104:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:761
+//line sql.y:940
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 105:
+	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:767
+//line sql.y:946
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 106:
+	case 121:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:775
+//line sql.y:954
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 107:
+	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:779
+//line sql.y:958
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 108:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:783
+//line sql.y:962
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 109:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:787
+//line sql.y:966
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 110:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:791
+	case 125:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:970
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 111:
+	case 126:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:797
+//line sql.y:976
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 112:
+	case 127:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:801
+//line sql.y:980
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 113:
+	case 128:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:805
+//line sql.y:984
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 114:
+	case 129:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:809
+//line sql.y:988
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 115:
+	case 130:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:813
+//line sql.y:992
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 116:
+	case 131:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:817
+//line sql.y:996
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 117:
+	case 132:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:821
+//line sql.y:1000
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 118:
+	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:825
+//line sql.y:1004
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 119:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:829
+//line sql.y:1008
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 120:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:833
+//line sql.y:1012
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 121:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:837
+//line sql.y:1016
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 122:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:841
+//line sql.y:1020
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 123:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:845
+//line sql.y:1024
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 124:
+	case 139:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:849
+//line sql.y:1028
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 125:
+	case 140:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:854
+//line sql.y:1033
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 126:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:860
+//line sql.y:1039
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 127:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:864
+//line sql.y:1043
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 128:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:868
+//line sql.y:1047
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 129:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:872
+//line sql.y:1051
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 130:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:876
+//line sql.y:1055
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 131:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:880
+//line sql.y:1059
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 132:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:884
+//line sql.y:1063
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 133:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:888
+//line sql.y:1067
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 134:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:894
+//line sql.y:1073
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 135:
+	case 150:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:899
+//line sql.y:1078
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 136:
+	case 151:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:904
+//line sql.y:1083
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 137:
+	case 152:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:908
+//line sql.y:1087
 		{
 			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 138:
+	case 153:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:913
+//line sql.y:1092
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 139:
+	case 154:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:917
+//line sql.y:1096
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 140:
+	case 155:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:925
+//line sql.y:1104
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 141:
+	case 156:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:929
+//line sql.y:1108
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 			}
 		}
-	case 142:
+	case 157:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:935
+//line sql.y:1114
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 143:
+	case 158:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:943
+//line sql.y:1122
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 144:
+	case 159:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:947
+//line sql.y:1126
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 145:
+	case 160:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:952
+//line sql.y:1131
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 146:
+	case 161:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:956
+//line sql.y:1135
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 147:
+	case 162:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:962
+//line sql.y:1141
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 148:
+	case 163:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:966
+//line sql.y:1145
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 149:
+	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:970
+//line sql.y:1149
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 150:
+	case 165:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:975
+//line sql.y:1154
 		{
 			yyVAL.optVal = nil
 		}
-	case 151:
+	case 166:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:979
+//line sql.y:1158
 		{
 			yyVAL.optVal = yyDollar[2].expr
 		}
-	case 152:
+	case 167:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:984
+//line sql.y:1163
 		{
 			yyVAL.optVal = nil
 		}
-	case 153:
+	case 168:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:988
+//line sql.y:1167
 		{
 			yyVAL.optVal = yyDollar[3].expr
 		}
-	case 154:
+	case 169:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:993
+//line sql.y:1172
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 155:
+	case 170:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:997
+//line sql.y:1176
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 156:
+	case 171:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1002
+//line sql.y:1181
 		{
 			yyVAL.str = ""
 		}
-	case 157:
+	case 172:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1006
+//line sql.y:1185
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 158:
+	case 173:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1010
+//line sql.y:1189
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 159:
+	case 174:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1015
+//line sql.y:1194
 		{
 			yyVAL.str = ""
 		}
-	case 160:
+	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1019
+//line sql.y:1198
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 161:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1023
+//line sql.y:1202
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 162:
+	case 177:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1028
+//line sql.y:1207
 		{
-			yyVAL.colKeyOpt = colKeyNone
+			yyVAL.colKeyOpt = ColKeyNone
 		}
-	case 163:
+	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1032
+//line sql.y:1211
 		{
-			yyVAL.colKeyOpt = colKeyPrimary
+			yyVAL.colKeyOpt = ColKeyPrimary
 		}
-	case 164:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1036
+//line sql.y:1215
 		{
-			yyVAL.colKeyOpt = colKey
+			yyVAL.colKeyOpt = ColKey
 		}
-	case 165:
+	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1040
+//line sql.y:1219
 		{
-			yyVAL.colKeyOpt = colKeyUniqueKey
+			yyVAL.colKeyOpt = ColKeyUniqueKey
 		}
-	case 166:
+	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1044
+//line sql.y:1223
 		{
-			yyVAL.colKeyOpt = colKeyUnique
+			yyVAL.colKeyOpt = ColKeyUnique
 		}
-	case 167:
+	case 182:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1049
+//line sql.y:1228
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 168:
+	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1053
+//line sql.y:1232
 		{
 			yyVAL.sqlVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 169:
+	case 184:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1059
+//line sql.y:1238
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
 		}
-	case 170:
+	case 185:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1063
+//line sql.y:1242
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
 		}
-	case 171:
+	case 186:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1246
+		{
+			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[5].indexColumns, Options: append([]*IndexOption{{Name: string(yyDollar[2].bytes), Using: string(yyDollar[3].bytes)}}, yyDollar[7].indexOptions...)}
+		}
+	case 187:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1250
+		{
+			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[5].indexColumns, Options: []*IndexOption{{Name: string(yyDollar[2].bytes), Using: string(yyDollar[3].bytes)}}}
+		}
+	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1069
+//line sql.y:1256
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 172:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1073
+//line sql.y:1260
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 173:
+	case 190:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1079
+//line sql.y:1266
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
 		}
-	case 174:
+	case 191:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1083
+//line sql.y:1270
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
 		}
-	case 175:
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1088
+//line sql.y:1275
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
 		}
-	case 176:
+	case 193:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1094
+//line sql.y:1281
 		{
 			yyVAL.str = ""
 		}
-	case 177:
+	case 194:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1098
+//line sql.y:1285
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 178:
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1104
+//line sql.y:1291
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 179:
+	case 196:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1108
+//line sql.y:1295
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
-	case 180:
+	case 197:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1299
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Fulltext: true, Unique: false}
+		}
+	case 198:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1112
+//line sql.y:1303
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Unique: true}
 		}
-	case 181:
+	case 199:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1116
+//line sql.y:1307
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(yyDollar[2].str), Unique: true}
 		}
-	case 182:
+	case 200:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1120
+//line sql.y:1311
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
-	case 183:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1126
+//line sql.y:1317
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 184:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1130
+//line sql.y:1321
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 185:
+	case 203:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1135
+//line sql.y:1326
 		{
 			yyVAL.str = ""
 		}
-	case 186:
+	case 204:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1139
+//line sql.y:1330
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 187:
+	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1145
+//line sql.y:1336
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 188:
+	case 206:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1149
+//line sql.y:1340
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 189:
+	case 207:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1155
+//line sql.y:1346
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].sqlVal}
 		}
-	case 190:
+	case 208:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1161
+//line sql.y:1352
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
 		}
-	case 191:
+	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1165
+//line sql.y:1356
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
-	case 192:
+	case 210:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:1172
+//line sql.y:1363
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
 		}
-	case 193:
+	case 211:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1176
+//line sql.y:1367
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
 		}
-	case 194:
+	case 212:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1180
+//line sql.y:1371
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
 		}
-	case 195:
+	case 213:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1184
+//line sql.y:1375
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
 		}
-	case 196:
+	case 214:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1190
+//line sql.y:1381
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 197:
+	case 215:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1196
+//line sql.y:1387
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 198:
+	case 216:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1202
+//line sql.y:1393
 		{
 			yyVAL.ReferenceAction = Restrict
 		}
-	case 199:
+	case 217:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1206
+//line sql.y:1397
 		{
 			yyVAL.ReferenceAction = Cascade
 		}
-	case 200:
+	case 218:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1210
+//line sql.y:1401
 		{
 			yyVAL.ReferenceAction = NoAction
 		}
-	case 201:
+	case 219:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1214
+//line sql.y:1405
 		{
 			yyVAL.ReferenceAction = SetDefault
 		}
-	case 202:
+	case 220:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1218
+//line sql.y:1409
 		{
 			yyVAL.ReferenceAction = SetNull
 		}
-	case 203:
+	case 221:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1223
+//line sql.y:1415
 		{
 			yyVAL.str = ""
 		}
-	case 204:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1227
+//line sql.y:1419
 		{
 			yyVAL.str = " " + string(yyDollar[1].str)
 		}
-	case 205:
+	case 223:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1231
+//line sql.y:1423
 		{
 			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
 		}
-	case 206:
+	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1239
+//line sql.y:1431
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 207:
+	case 225:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1243
+//line sql.y:1435
 		{
 			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 208:
+	case 226:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1247
+//line sql.y:1439
 		{
 			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
 		}
-	case 209:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1253
+//line sql.y:1445
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 210:
+	case 228:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1257
+//line sql.y:1449
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 211:
+	case 229:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1261
+//line sql.y:1453
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 212:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1267
-		{
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
-		}
-	case 213:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1271
+	case 230:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1459
 		{
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
+			// The partial DDL is not changed, because it's used if
+			// the statement fails to parse after the specs.
+			if len(yyDollar[2].alterSpecs) == 1 && yyDollar[2].alterSpecs[0].Action == RenameTableStr {
+				// Change this to a rename statement
+				yyVAL.statement = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[1].ddl.Table}, ToTables: TableNames{yyDollar[2].alterSpecs[0].NewTable}}
+			} else {
+				yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[1].ddl.Table, AlterSpecs: yyDollar[2].alterSpecs}
+			}
 		}
-	case 214:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1275
+	case 231:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1470
 		{
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[1].ddl.Table, AlterSpecs: yyDollar[2].alterSpecs, PartitionSpec: &PartitionSpec{Action: PartitionByStr, By: yyDollar[3].partBy}}
 		}
-	case 215:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1279
+	case 232:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1474
 		{
-			// Change this to a rename statement
-			yyVAL.statement = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[4].tableName}, ToTables: TableNames{yyDollar[7].tableName}}
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[1].ddl.Table, AlterSpecs: AlterSpecs{yyDollar[2].alterSpec}}
 		}
-	case 216:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1284
+	case 233:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1478
 		{
-			// Rename an index can just be an alter
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[1].ddl.Table, AlterSpecs: append(yyDollar[2].alterSpecs, yyDollar[4].alterSpec)}
 		}
-	case 217:
+	case 234:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1289
+//line sql.y:1482
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 218:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1293
+	case 235:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1486
 		{
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[1].ddl.Table, PartitionSpec: yyDollar[2].partSpec}
 		}
-	case 219:
+	case 236:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1297
+//line sql.y:1490
 		{
 			yyVAL.statement = &DDL{Action: CreateVindexStr, VindexSpec: &VindexSpec{
 				Name:   yyDollar[5].colIdent,
@@ -4281,29 +5540,29 @@ yydefault:
 				Params: yyDollar[7].vindexParams,
 			}}
 		}
-	case 220:
+	case 237:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1305
+//line sql.y:1498
 		{
 			yyVAL.statement = &DDL{Action: DropVindexStr, VindexSpec: &VindexSpec{
 				Name: yyDollar[5].colIdent,
 			}}
 		}
-	case 221:
+	case 238:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1311
+//line sql.y:1504
 		{
 			yyVAL.statement = &DDL{Action: AddVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 222:
+	case 239:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1315
+//line sql.y:1508
 		{
 			yyVAL.statement = &DDL{Action: DropVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 223:
+	case 240:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1319
+//line sql.y:1512
 		{
 			yyVAL.statement = &DDL{
 				Action: AddColVindexStr,
@@ -4316,9 +5575,9 @@ yydefault:
 				VindexCols: yyDollar[9].columns,
 			}
 		}
-	case 224:
+	case 241:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1332
+//line sql.y:1525
 		{
 			yyVAL.statement = &DDL{
 				Action: DropColVindexStr,
@@ -4328,884 +5587,1731 @@ yydefault:
 				},
 			}
 		}
-	case 236:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1357
-		{
-			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
-		}
-	case 237:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1363
-		{
-			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
-		}
-	case 238:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1367
-		{
-			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
-		}
-	case 239:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1373
-		{
-			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
-		}
-	case 240:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1377
-		{
-			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
-		}
-	case 241:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1383
-		{
-			yyVAL.statement = yyDollar[3].ddl
-		}
 	case 242:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1389
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1537
 		{
-			yyVAL.ddl = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[1].tableName}, ToTables: TableNames{yyDollar[3].tableName}}
+			yyVAL.ddl = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
+			setDDL(yylex, yyVAL.ddl)
 		}
 	case 243:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1393
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1544
 		{
-			yyVAL.ddl = yyDollar[1].ddl
-			yyVAL.ddl.FromTables = append(yyVAL.ddl.FromTables, yyDollar[3].tableName)
-			yyVAL.ddl.ToTables = append(yyVAL.ddl.ToTables, yyDollar[5].tableName)
+			yyVAL.alterSpecs = yyDollar[1].alterSpecs
 		}
 	case 244:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1401
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1548
 		{
-			var exists bool
-			if yyDollar[3].byt != 0 {
-				exists = true
-			}
-			yyVAL.statement = &DDL{Action: DropStr, FromTables: yyDollar[4].tableNames, IfExists: exists}
+			yyVAL.alterSpecs = append(yyDollar[1].alterSpecs, yyDollar[3].alterSpecs...)
 		}
 	case 245:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1409
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1556
 		{
-			// Change this to an alter statement
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName}
+			yyVAL.alterSpecs = AlterSpecs{yyDollar[1].alterSpec}
 		}
 	case 246:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1414
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1560
 		{
-			var exists bool
-			if yyDollar[3].byt != 0 {
-				exists = true
-			}
-			yyVAL.statement = &DDL{Action: DropStr, FromTables: TableNames{yyDollar[4].tableName.ToViewName()}, IfExists: exists}
+			yyVAL.alterSpecs = yyDollar[1].alterSpecs
 		}
 	case 247:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1422
+//line sql.y:1564
 		{
-			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
+			yyVAL.alterSpecs = make(AlterSpecs, 0, len(yyDollar[3].columnDefinitions))
+			for _, col := range yyDollar[3].columnDefinitions {
+				yyVAL.alterSpecs = append(yyVAL.alterSpecs, &AlterSpec{Action: AddColumnStr, Column: col})
+			}
 		}
 	case 248:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1426
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1571
 		{
-			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
+			yyVAL.alterSpecs = make(AlterSpecs, 0, len(yyDollar[4].columnDefinitions))
+			for _, col := range yyDollar[4].columnDefinitions {
+				yyVAL.alterSpecs = append(yyVAL.alterSpecs, &AlterSpec{Action: AddColumnStr, Column: col})
+			}
 		}
 	case 249:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1432
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1580
 		{
-			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
+			yyVAL.columnDefinitions = []*ColumnDefinition{yyDollar[1].columnDefinition}
 		}
 	case 250:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1436
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1584
 		{
-			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
+			yyVAL.columnDefinitions = append(yyDollar[1].columnDefinitions, yyDollar[3].columnDefinition)
 		}
 	case 251:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1441
+//line sql.y:1593
 		{
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName}
+			yyVAL.alterSpec = &AlterSpec{Action: AddColumnStr, Column: yyDollar[2].columnDefinition, Position: yyDollar[3].colPosition}
 		}
 	case 252:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1447
+//line sql.y:1597
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+			yyVAL.alterSpec = &AlterSpec{Action: AddColumnStr, Column: yyDollar[3].columnDefinition, Position: yyDollar[4].colPosition}
 		}
 	case 253:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1452
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1601
 		{
-			yyVAL.statement = &Show{Type: CharsetStr}
+			yyVAL.alterSpec = &AlterSpec{Action: AddIndexStr, Index: yyDollar[2].indexDefinition}
 		}
 	case 254:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1456
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1605
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.alterSpec = &AlterSpec{Action: AddConstraintStr, Constraint: yyDollar[2].constraintDefinition}
 		}
 	case 255:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1460
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1609
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+			yyVAL.alterSpec = &AlterSpec{Action: DropColumnStr, Name: yyDollar[2].colIdent}
 		}
 	case 256:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1465
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1613
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+			yyVAL.alterSpec = &AlterSpec{Action: DropColumnStr, Name: yyDollar[3].colIdent}
 		}
 	case 257:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1469
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1617
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+			yyVAL.alterSpec = &AlterSpec{Action: DropIndexStr, Name: yyDollar[3].colIdent}
 		}
 	case 258:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1473
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1621
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
+			yyVAL.alterSpec = &AlterSpec{Action: DropPrimaryKeyStr}
 		}
 	case 259:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1477
+//line sql.y:1625
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+			yyVAL.alterSpec = &AlterSpec{Action: DropForeignKeyStr, Name: yyDollar[4].colIdent}
 		}
 	case 260:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1481
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1629
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+			yyVAL.alterSpec = &AlterSpec{Action: DropCheckStr, Name: yyDollar[3].colIdent}
 		}
 	case 261:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1485
+//line sql.y:1633
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.alterSpec = &AlterSpec{Action: DropConstraintStr, Name: yyDollar[3].colIdent}
 		}
 	case 262:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1489
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1637
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.alterSpec = &AlterSpec{Action: ModifyColumnStr, Column: yyDollar[3].columnDefinition, Position: yyDollar[4].colPosition}
 		}
 	case 263:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1493
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1641
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.alterSpec = &AlterSpec{Action: ChangeColumnStr, Name: yyDollar[3].colIdent, Column: yyDollar[4].columnDefinition, Position: yyDollar[5].colPosition}
 		}
 	case 264:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1497
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1645
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.alterSpec = &AlterSpec{Action: AlterColumnStr, Name: yyDollar[3].colIdent, Default: yyDollar[6].expr}
 		}
 	case 265:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1501
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1649
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.alterSpec = &AlterSpec{Action: AlterColumnStr, Name: yyDollar[3].colIdent}
 		}
 	case 266:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1505
-		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1653
+		{
+			switch strings.ToLower(string(yyDollar[4].bytes)) {
+			case "visible":
+				yyVAL.alterSpec = &AlterSpec{Action: AlterIndexStr, Name: yyDollar[3].colIdent}
+			case "invisible":
+				yyVAL.alterSpec = &AlterSpec{Action: AlterIndexStr, Name: yyDollar[3].colIdent, Invisible: true}
+			default:
+				yylex.Error("syntax error")
+				return 1
+			}
 		}
 	case 267:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1509
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1665
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.alterSpec = &AlterSpec{Action: RenameIndexStr, Name: yyDollar[3].colIdent, NewName: yyDollar[5].colIdent}
 		}
 	case 268:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1513
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1669
 		{
-			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
+			yyVAL.alterSpec = &AlterSpec{Action: RenameColumnStr, Name: yyDollar[3].colIdent, NewName: yyDollar[5].colIdent}
 		}
 	case 269:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1517
+//line sql.y:1673
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.alterSpec = &AlterSpec{Action: RenameTableStr, NewTable: yyDollar[3].tableName}
 		}
 	case 270:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1521
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1677
 		{
-			showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
-			yyVAL.statement = &Show{Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName}
+			yyVAL.alterSpec = &AlterSpec{Action: ConvertStr, Charset: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
 	case 271:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1526
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1681
 		{
-			// this is ugly, but I couldn't find a better way for now
-			if yyDollar[3].str == "processlist" {
-				yyVAL.statement = &Show{Type: yyDollar[3].str}
-			} else {
-				showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[4].str, Filter: yyDollar[5].showFilter}
-				yyVAL.statement = &Show{Type: yyDollar[3].str, ShowTablesOpt: showTablesOpt}
-			}
+			yyVAL.alterSpec = &AlterSpec{Action: ForceAlterStr}
 		}
 	case 272:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1536
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1689
 		{
-			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
+			yyVAL.alterSpec = &AlterSpec{Action: OrderByStr, OrderBy: yyDollar[3].orderBy}
 		}
 	case 273:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1540
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1694
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.colPosition = nil
 		}
 	case 274:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1544
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1698
 		{
-			// Cannot dereference $4 directly, or else the parser stackcannot be pooled. See yyParsePooled
-			showCollationFilterOpt := yyDollar[4].expr
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: &showCollationFilterOpt}
+			yyVAL.colPosition = &ColumnPosition{First: true}
 		}
 	case 275:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1550
+//line sql.y:1702
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.colPosition = &ColumnPosition{After: yyDollar[2].colIdent}
 		}
 	case 276:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1554
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1711
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.alterSpecs = AlterSpecs{yyDollar[1].alterSpec}
 		}
 	case 277:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1558
+//line sql.y:1715
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.alterSpecs = append(yyDollar[1].alterSpecs, yyDollar[2].alterSpec)
 		}
 	case 278:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1562
+//line sql.y:1721
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			// The operations that are a name and a value, but not a
+			// table option, have their own actions.
+			switch action := strings.ToLower(yyDollar[1].str + " " + yyDollar[2].str); {
+			case action == EnableKeysStr, action == DisableKeysStr, action == DiscardTablespaceStr, action == ImportTablespaceStr:
+				yyVAL.alterSpec = &AlterSpec{Action: action}
+			case !isTableOption(yyDollar[1].str):
+				yylex.Error("syntax error")
+				return 1
+			default:
+				yyVAL.alterSpec = &AlterSpec{Action: TableOptionStr, Option: yyDollar[1].str, Value: yyDollar[2].str}
+			}
 		}
 	case 279:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1566
+//line sql.y:1735
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+			if !isTableOption(yyDollar[1].str) {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.alterSpec = &AlterSpec{Action: TableOptionStr, Option: yyDollar[1].str, Value: yyDollar[3].str}
 		}
 	case 280:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1570
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1745
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 281:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1574
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1749
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), OnTable: yyDollar[5].tableName}
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 282:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1578
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1753
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 283:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1588
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1757
 		{
-			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 284:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1594
+//line sql.y:1761
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 285:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1598
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1765
 		{
-			yyVAL.str = string(yyDollar[1].bytes)
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
 	case 286:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1604
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1769
 		{
-			yyVAL.str = ""
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 287:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1773
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
+		}
+	case 288:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1777
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)
+		}
+	case 289:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1781
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
+		}
+	case 290:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1785
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 291:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1789
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 292:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1793
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
+		}
+	case 293:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1797
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
+		}
+	case 294:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1803
+		{
+			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
+		}
+	case 295:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1807
+		{
+			yyVAL.partSpec = &PartitionSpec{Action: AddPartitionStr, Definitions: yyDollar[4].partDefs}
+		}
+	case 296:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1811
+		{
+			yyVAL.partSpec = &PartitionSpec{Action: DropPartitionStr, Names: yyDollar[3].columns}
+		}
+	case 297:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1815
+		{
+			yyVAL.partSpec = &PartitionSpec{Action: TruncatePartitionStr, Names: yyDollar[3].columns}
+		}
+	case 298:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1819
+		{
+			yyVAL.partSpec = &PartitionSpec{Action: CoalescePartitionStr, Number: NewIntVal(yyDollar[3].bytes)}
+		}
+	case 299:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1823
+		{
+			yyVAL.partSpec = &PartitionSpec{Action: PartitionByStr, By: yyDollar[1].partBy}
+		}
+	case 300:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1829
+		{
+			yyVAL.partBy = nil
+		}
+	case 301:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1833
+		{
+			yyVAL.partBy = yyDollar[1].partBy
+		}
+	case 302:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1839
+		{
+			yyVAL.partBy = yyDollar[3].partBy
+			yyVAL.partBy.Partitions = yyDollar[4].sqlVal
+		}
+	case 303:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1844
+		{
+			yyVAL.partBy = yyDollar[3].partBy
+			yyVAL.partBy.Partitions = yyDollar[4].sqlVal
+			yyVAL.partBy.Definitions = yyDollar[6].partDefs
+		}
+	case 304:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1852
+		{
+			typ := strings.ToLower(string(yyDollar[1].bytes))
+			if typ != HashPartitionStr && typ != ListPartitionStr {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.partBy = &PartitionBy{Type: typ, Expr: yyDollar[3].expr}
+		}
+	case 305:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1861
+		{
+			if strings.ToLower(string(yyDollar[2].bytes)) != HashPartitionStr {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.partBy = &PartitionBy{Type: HashPartitionStr, Linear: true, Expr: yyDollar[4].expr}
+		}
+	case 306:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1869
+		{
+			yyVAL.partBy = &PartitionBy{Type: KeyPartitionStr}
+		}
+	case 307:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1873
+		{
+			yyVAL.partBy = &PartitionBy{Type: KeyPartitionStr, Columns: yyDollar[3].columns}
+		}
+	case 308:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1877
+		{
+			yyVAL.partBy = &PartitionBy{Type: KeyPartitionStr, Linear: true}
+		}
+	case 309:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1881
+		{
+			yyVAL.partBy = &PartitionBy{Type: KeyPartitionStr, Linear: true, Columns: yyDollar[4].columns}
+		}
+	case 310:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1885
+		{
+			yyVAL.partBy = &PartitionBy{Type: RangePartitionStr, Expr: yyDollar[3].expr}
+		}
+	case 311:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1889
+		{
+			yyVAL.partBy = &PartitionBy{Type: RangePartitionStr, Columns: yyDollar[4].columns, ColumnList: true}
+		}
+	case 312:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1893
+		{
+			if strings.ToLower(string(yyDollar[1].bytes)) != ListPartitionStr {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.partBy = &PartitionBy{Type: ListPartitionStr, Columns: yyDollar[4].columns, ColumnList: true}
+		}
+	case 313:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1902
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 314:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1906
+		{
+			if strings.ToLower(string(yyDollar[1].bytes)) != "partitions" {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
+		}
+	case 315:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1916
+		{
+			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
+		}
+	case 316:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1920
+		{
+			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
+		}
+	case 317:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:1926
+		{
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr, Engine: yyDollar[9].str}
+		}
+	case 318:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:1930
+		{
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true, Engine: yyDollar[9].str}
+		}
+	case 319:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1934
+		{
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true, Engine: yyDollar[7].str}
+		}
+	case 320:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1938
+		{
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, In: yyDollar[5].valTuple, Engine: yyDollar[6].str}
+		}
+	case 321:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1942
+		{
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Engine: yyDollar[3].str}
+		}
+	case 322:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1947
+		{
+			yyVAL.str = ""
+		}
+	case 323:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1951
+		{
+			if strings.ToLower(string(yyDollar[1].bytes)) != "engine" {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 324:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1961
+		{
+			yyVAL.statement = yyDollar[3].ddl
+		}
+	case 325:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1967
+		{
+			yyVAL.ddl = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[1].tableName}, ToTables: TableNames{yyDollar[3].tableName}}
+		}
+	case 326:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1971
+		{
+			yyVAL.ddl = yyDollar[1].ddl
+			yyVAL.ddl.FromTables = append(yyVAL.ddl.FromTables, yyDollar[3].tableName)
+			yyVAL.ddl.ToTables = append(yyVAL.ddl.ToTables, yyDollar[5].tableName)
+		}
+	case 327:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1979
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, FromTables: yyDollar[4].tableNames, IfExists: exists}
+		}
+	case 328:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1987
+		{
+			// Change this to an alter statement
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName, AlterSpecs: AlterSpecs{{Action: DropIndexStr, Name: NewColIdent(string(yyDollar[3].bytes))}}}
+		}
+	case 329:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1992
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, FromTables: TableNames{yyDollar[4].tableName.ToViewName()}, IfExists: exists}
+		}
+	case 330:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2000
+		{
+			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
+		}
+	case 331:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2004
+		{
+			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
+		}
+	case 332:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2010
+		{
+			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
+		}
+	case 333:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2014
+		{
+			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
+		}
+	case 334:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2019
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName}
+		}
+	case 335:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2025
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 336:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2030
+		{
+			yyVAL.statement = &Show{Type: CharsetStr}
+		}
+	case 337:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2034
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 338:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2038
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 339:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2043
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 340:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2047
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 341:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2051
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
+		}
+	case 342:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2055
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 343:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2059
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 344:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2063
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 345:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2067
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 346:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2071
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 347:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2075
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 348:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2079
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 349:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2083
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 350:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2087
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 351:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2091
+		{
+			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
+		}
+	case 352:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2095
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 353:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2099
+		{
+			showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
+			yyVAL.statement = &Show{Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName}
+		}
+	case 354:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2104
+		{
+			// this is ugly, but I couldn't find a better way for now
+			if yyDollar[3].str == "processlist" {
+				yyVAL.statement = &Show{Type: yyDollar[3].str}
+			} else {
+				showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[4].str, Filter: yyDollar[5].showFilter}
+				yyVAL.statement = &Show{Type: yyDollar[3].str, ShowTablesOpt: showTablesOpt}
+			}
+		}
+	case 355:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2114
+		{
+			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
+		}
+	case 356:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2118
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 357:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2122
+		{
+			// Cannot dereference $4 directly, or else the parser stackcannot be pooled. See yyParsePooled
+			showCollationFilterOpt := yyDollar[4].expr
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: &showCollationFilterOpt}
+		}
+	case 358:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2128
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 359:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2132
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 360:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2136
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 361:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2140
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 362:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2144
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 363:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2148
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 364:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2152
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), OnTable: yyDollar[5].tableName}
+		}
+	case 365:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2156
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 287:
+	case 366:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2166
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 367:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2172
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 368:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2176
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 369:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2182
+		{
+			yyVAL.str = ""
+		}
+	case 370:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1608
+//line sql.y:2186
 		{
 			yyVAL.str = "full "
 		}
-	case 288:
+	case 371:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1614
+//line sql.y:2192
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 289:
+	case 372:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1618
+//line sql.y:2196
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 290:
+	case 373:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1624
+//line sql.y:2202
 		{
 			yyVAL.str = ""
 		}
-	case 291:
+	case 374:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1628
+//line sql.y:2206
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 292:
+	case 375:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1632
+//line sql.y:2210
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 293:
+	case 376:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1638
+//line sql.y:2216
 		{
 			yyVAL.showFilter = nil
 		}
-	case 294:
+	case 377:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1642
+//line sql.y:2220
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 295:
+	case 378:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1646
+//line sql.y:2224
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 296:
+	case 379:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1652
+//line sql.y:2230
 		{
 			yyVAL.str = ""
 		}
-	case 297:
+	case 380:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1656
+//line sql.y:2234
 		{
 			yyVAL.str = SessionStr
 		}
-	case 298:
+	case 381:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1660
+//line sql.y:2238
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 299:
+	case 382:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1666
+//line sql.y:2244
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 300:
+	case 383:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1670
+//line sql.y:2248
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 301:
+	case 384:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2254
+		{
+			id, err := strconv.ParseUint(string(yyDollar[3].bytes), 10, 64)
+			if err != nil {
+				yylex.Error("invalid connection id")
+				return 1
+			}
+			yyVAL.statement = &Kill{Type: yyDollar[2].str, ID: id}
+		}
+	case 385:
+		yyDollar = yyS[yypt-17 : yypt+1]
+//line sql.y:2265
+		{
+			yyVAL.statement = &Load{Comments: Comments(yyDollar[2].bytes2), Priority: yyDollar[4].str, Local: yyDollar[5].str, File: NewStrVal(yyDollar[7].bytes), Duplicate: yyDollar[8].str, Table: yyDollar[11].tableName, Partitions: yyDollar[12].partitions, Charset: yyDollar[13].str, Fields: yyDollar[14].loadFields, Lines: yyDollar[15].loadLines, IgnoreLines: yyDollar[16].sqlVal, Columns: yyDollar[17].columns}
+		}
+	case 386:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2270
+		{
+			yyVAL.str = ""
+		}
+	case 387:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2274
+		{
+			yyVAL.str = LowPriorityStr
+		}
+	case 388:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2278
+		{
+			yyVAL.str = ConcurrentStr
+		}
+	case 389:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2283
+		{
+			yyVAL.str = ""
+		}
+	case 390:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2287
+		{
+			yyVAL.str = LocalStr
+		}
+	case 391:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2292
+		{
+			yyVAL.str = ""
+		}
+	case 392:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2296
+		{
+			yyVAL.str = LoadReplaceStr
+		}
+	case 393:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2300
+		{
+			yyVAL.str = IgnoreStr
+		}
+	case 394:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2305
+		{
+			yyVAL.loadFields = nil
+		}
+	case 395:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2309
+		{
+			yyVAL.loadFields = yyDollar[2].loadFields
+		}
+	case 396:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2315
+		{
+			yyVAL.loadFields = &LoadFields{TerminatedBy: NewStrVal(yyDollar[3].bytes)}
+		}
+	case 397:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2319
+		{
+			yyVAL.loadFields = &LoadFields{EnclosedBy: NewStrVal(yyDollar[3].bytes)}
+		}
+	case 398:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2323
+		{
+			yyVAL.loadFields = &LoadFields{Optionally: true, EnclosedBy: NewStrVal(yyDollar[4].bytes)}
+		}
+	case 399:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2327
+		{
+			yyVAL.loadFields = &LoadFields{EscapedBy: NewStrVal(yyDollar[3].bytes)}
+		}
+	case 400:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2331
+		{
+			yyDollar[1].loadFields.TerminatedBy = NewStrVal(yyDollar[4].bytes)
+			yyVAL.loadFields = yyDollar[1].loadFields
+		}
+	case 401:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2336
+		{
+			yyDollar[1].loadFields.Optionally = false
+			yyDollar[1].loadFields.EnclosedBy = NewStrVal(yyDollar[4].bytes)
+			yyVAL.loadFields = yyDollar[1].loadFields
+		}
+	case 402:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2342
+		{
+			yyDollar[1].loadFields.Optionally = true
+			yyDollar[1].loadFields.EnclosedBy = NewStrVal(yyDollar[5].bytes)
+			yyVAL.loadFields = yyDollar[1].loadFields
+		}
+	case 403:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2348
+		{
+			yyDollar[1].loadFields.EscapedBy = NewStrVal(yyDollar[4].bytes)
+			yyVAL.loadFields = yyDollar[1].loadFields
+		}
+	case 404:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2354
+		{
+			yyVAL.loadLines = nil
+		}
+	case 405:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2358
+		{
+			yyVAL.loadLines = yyDollar[2].loadLines
+		}
+	case 406:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2364
+		{
+			yyVAL.loadLines = &LoadLines{StartingBy: NewStrVal(yyDollar[3].bytes)}
+		}
+	case 407:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2368
+		{
+			yyVAL.loadLines = &LoadLines{TerminatedBy: NewStrVal(yyDollar[3].bytes)}
+		}
+	case 408:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2372
+		{
+			yyDollar[1].loadLines.StartingBy = NewStrVal(yyDollar[4].bytes)
+			yyVAL.loadLines = yyDollar[1].loadLines
+		}
+	case 409:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2377
+		{
+			yyDollar[1].loadLines.TerminatedBy = NewStrVal(yyDollar[4].bytes)
+			yyVAL.loadLines = yyDollar[1].loadLines
+		}
+	case 410:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2383
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 411:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2387
+		{
+			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
+		}
+	case 412:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2392
+		{
+			yyVAL.columns = nil
+		}
+	case 413:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2396
+		{
+			yyVAL.columns = yyDollar[2].columns
+		}
+	case 414:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2401
+		{
+			yyVAL.str = KillConnectionStr
+		}
+	case 415:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2405
+		{
+			yyVAL.str = KillConnectionStr
+		}
+	case 416:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2409
+		{
+			yyVAL.str = KillQueryStr
+		}
+	case 417:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1676
+//line sql.y:2415
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 302:
+	case 418:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1680
+//line sql.y:2419
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 303:
+	case 419:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1686
+//line sql.y:2425
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 304:
+	case 420:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1692
+//line sql.y:2431
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 305:
+	case 421:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1698
+//line sql.y:2437
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 306:
+	case 422:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1702
+//line sql.y:2441
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 307:
+	case 423:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1706
+//line sql.y:2445
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 308:
+	case 424:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1710
+//line sql.y:2449
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 309:
+	case 425:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1714
+//line sql.y:2453
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 310:
+	case 426:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1718
+//line sql.y:2457
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 311:
+	case 427:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1722
+//line sql.y:2461
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 312:
+	case 428:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1728
+//line sql.y:2467
 		{
 			yyVAL.statement = &DDL{Action: FlushStr}
 		}
-	case 313:
+	case 429:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1732
+//line sql.y:2471
 		{
 			setAllowComments(yylex, true)
 		}
-	case 314:
+	case 430:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1736
+//line sql.y:2475
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 315:
+	case 431:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1742
+//line sql.y:2481
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 316:
+	case 432:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1746
+//line sql.y:2485
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 317:
+	case 433:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1752
+//line sql.y:2491
 		{
 			yyVAL.str = UnionStr
 		}
-	case 318:
+	case 434:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1756
+//line sql.y:2495
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 319:
+	case 435:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1760
+//line sql.y:2499
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 320:
+	case 436:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1765
+//line sql.y:2504
 		{
 			yyVAL.str = ""
 		}
-	case 321:
+	case 437:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1769
+//line sql.y:2508
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 322:
+	case 438:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1773
+//line sql.y:2512
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 323:
+	case 439:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1778
+//line sql.y:2517
 		{
 			yyVAL.str = ""
 		}
-	case 324:
+	case 440:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1782
+//line sql.y:2521
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 325:
+	case 441:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1787
+//line sql.y:2526
 		{
 			yyVAL.str = ""
 		}
-	case 326:
+	case 442:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1791
+//line sql.y:2530
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 327:
+	case 443:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1796
+//line sql.y:2535
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 328:
+	case 444:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1800
+//line sql.y:2539
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 329:
+	case 445:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1806
+//line sql.y:2545
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 330:
+	case 446:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1810
+//line sql.y:2549
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 331:
+	case 447:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1816
+//line sql.y:2555
 		{
 			yyVAL.selectExpr = &StarExpr{}
+			setPos(yylex, yyVAL.selectExpr, yyDollar[1].pos)
 		}
-	case 332:
+	case 448:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1820
+//line sql.y:2560
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
+			setPos(yylex, yyVAL.selectExpr, yyDollar[1].pos)
 		}
-	case 333:
+	case 449:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1824
+//line sql.y:2565
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
+			setPos(yylex, yyVAL.selectExpr, yyDollar[1].pos)
 		}
-	case 334:
+	case 450:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1828
+//line sql.y:2570
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
+			setPos(yylex, yyVAL.selectExpr, yyDollar[1].pos)
 		}
-	case 335:
+	case 451:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1833
+//line sql.y:2576
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 336:
+	case 452:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1837
+//line sql.y:2580
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 337:
+	case 453:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1841
+//line sql.y:2584
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 339:
+	case 455:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1848
+//line sql.y:2591
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 340:
+	case 456:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1853
+//line sql.y:2596
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 341:
+	case 457:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1857
+//line sql.y:2600
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 342:
+	case 458:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1863
+//line sql.y:2606
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 343:
+	case 459:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1867
+//line sql.y:2610
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 346:
+	case 462:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1877
+//line sql.y:2620
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
+			setPos(yylex, yyVAL.tableExpr, yyDollar[1].pos)
 		}
-	case 347:
+	case 463:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1881
+//line sql.y:2625
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
+			setPos(yylex, yyVAL.tableExpr, yyDollar[1].pos)
 		}
-	case 348:
+	case 464:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1885
+//line sql.y:2630
 		{
 			// missed alias for subquery
 			yylex.Error("Every derived table must have its own alias")
 			return 1
 		}
-	case 349:
+	case 465:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1891
+//line sql.y:2636
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
+			setPos(yylex, yyVAL.tableExpr, yyDollar[1].pos)
 		}
-	case 350:
+	case 466:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:2641
+		{
+			yyVAL.tableExpr = &JSONTableExpr{Expr: yyDollar[3].expr, Path: NewStrVal(yyDollar[5].bytes), Columns: yyDollar[6].jsonTableColumns, As: yyDollar[9].tableIdent}
+			setPos(yylex, yyVAL.tableExpr, yyDollar[1].pos)
+		}
+	case 467:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2646
+		{
+			yylex.Error("Every table function must have an alias")
+			return 1
+		}
+	case 468:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2653
+		{
+			yyVAL.jsonTableColumns = yyDollar[3].jsonTableColumns
+		}
+	case 469:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2659
+		{
+			yyVAL.jsonTableColumns = JSONTableColumns{yyDollar[1].jsonTableColumn}
+		}
+	case 470:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2663
+		{
+			yyVAL.jsonTableColumns = append(yyVAL.jsonTableColumns, yyDollar[3].jsonTableColumn)
+		}
+	case 471:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2669
+		{
+			yyVAL.jsonTableColumn = &JSONTableColumn{Kind: JSONTableOrdinalityStr, Name: yyDollar[1].colIdent}
+		}
+	case 472:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2673
+		{
+			yyVAL.jsonTableColumn = yyDollar[5].jsonTableColumn
+			yyVAL.jsonTableColumn.Kind = JSONTablePathStr
+			yyVAL.jsonTableColumn.Name = yyDollar[1].colIdent
+			yyVAL.jsonTableColumn.Type = yyDollar[2].columnType
+			yyVAL.jsonTableColumn.Path = NewStrVal(yyDollar[4].bytes)
+		}
+	case 473:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2681
+		{
+			yyVAL.jsonTableColumn = &JSONTableColumn{Kind: JSONTableExistsStr, Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType, Path: NewStrVal(yyDollar[5].bytes)}
+		}
+	case 474:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2685
+		{
+			yyVAL.jsonTableColumn = &JSONTableColumn{Kind: JSONTableNestedStr, Path: NewStrVal(yyDollar[2].bytes), Nested: yyDollar[3].jsonTableColumns}
+		}
+	case 475:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2689
+		{
+			yyVAL.jsonTableColumn = &JSONTableColumn{Kind: JSONTableNestedStr, Path: NewStrVal(yyDollar[3].bytes), Nested: yyDollar[4].jsonTableColumns}
+		}
+	case 476:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2694
+		{
+			yyVAL.jsonTableColumn = &JSONTableColumn{}
+		}
+	case 477:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1897
+//line sql.y:2698
+		{
+			yyVAL.jsonTableColumn = &JSONTableColumn{OnEmpty: yyDollar[1].jsonOnResponse}
+		}
+	case 478:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2702
+		{
+			yyVAL.jsonTableColumn = &JSONTableColumn{OnError: yyDollar[1].jsonOnResponse}
+		}
+	case 479:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2706
+		{
+			yyVAL.jsonTableColumn = &JSONTableColumn{OnEmpty: yyDollar[1].jsonOnResponse, OnError: yyDollar[4].jsonOnResponse}
+		}
+	case 480:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2712
+		{
+			yyVAL.jsonOnResponse = &JSONTableOnResponse{Type: JSONResponseNullStr}
+		}
+	case 481:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2716
+		{
+			yyVAL.jsonOnResponse = &JSONTableOnResponse{Type: JSONResponseErrorStr}
+		}
+	case 482:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2720
+		{
+			yyVAL.jsonOnResponse = &JSONTableOnResponse{Type: JSONResponseDefaultStr, Default: NewStrVal(yyDollar[2].bytes)}
+		}
+	case 483:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2726
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
+			setPos(yylex, yyVAL.aliasedTableName, yyDollar[1].pos)
 		}
-	case 351:
+	case 484:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1901
+//line sql.y:2731
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
+			setPos(yylex, yyVAL.aliasedTableName, yyDollar[1].pos)
 		}
-	case 352:
+	case 485:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1907
+//line sql.y:2738
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 353:
+	case 486:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1911
+//line sql.y:2742
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 354:
+	case 487:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1917
+//line sql.y:2748
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 355:
+	case 488:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1921
+//line sql.y:2752
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 356:
+	case 489:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1934
+//line sql.y:2765
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+			setPos(yylex, yyVAL.tableExpr, yyDollar[1].pos)
 		}
-	case 357:
+	case 490:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1938
+//line sql.y:2770
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+			setPos(yylex, yyVAL.tableExpr, yyDollar[1].pos)
 		}
-	case 358:
+	case 491:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1942
+//line sql.y:2775
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+			setPos(yylex, yyVAL.tableExpr, yyDollar[1].pos)
 		}
-	case 359:
+	case 492:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1946
+//line sql.y:2780
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
+			setPos(yylex, yyVAL.tableExpr, yyDollar[1].pos)
 		}
-	case 360:
+	case 493:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1952
+//line sql.y:2787
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 361:
+	case 494:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1954
+//line sql.y:2789
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 362:
+	case 495:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1958
+//line sql.y:2793
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 363:
+	case 496:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1960
+//line sql.y:2795
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 364:
+	case 497:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1964
+//line sql.y:2799
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 365:
+	case 498:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1966
+//line sql.y:2801
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 366:
+	case 499:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1969
+//line sql.y:2804
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 367:
+	case 500:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1971
+//line sql.y:2806
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 368:
+	case 501:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1974
+//line sql.y:2809
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 369:
+	case 502:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1978
+//line sql.y:2813
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 370:
+	case 503:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1982
+//line sql.y:2817
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 372:
+	case 505:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1989
+//line sql.y:2824
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 373:
+	case 506:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1995
+//line sql.y:2830
 		{
 			yyVAL.str = JoinStr
 		}
-	case 374:
+	case 507:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1999
+//line sql.y:2834
 		{
 			yyVAL.str = JoinStr
 		}
-	case 375:
+	case 508:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2003
+//line sql.y:2838
 		{
 			yyVAL.str = JoinStr
 		}
-	case 376:
+	case 509:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2009
+//line sql.y:2844
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 377:
+	case 510:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2015
+//line sql.y:2850
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 378:
+	case 511:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2019
+//line sql.y:2854
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 379:
+	case 512:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2023
+//line sql.y:2858
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 380:
+	case 513:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2027
+//line sql.y:2862
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 381:
+	case 514:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2033
+//line sql.y:2868
 		{
 			yyVAL.str = NaturalJoinStr
 		}
-	case 382:
+	case 515:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2037
+//line sql.y:2872
 		{
 			if yyDollar[2].str == LeftJoinStr {
 				yyVAL.str = NaturalLeftJoinStr
@@ -5213,469 +7319,518 @@ yydefault:
 				yyVAL.str = NaturalRightJoinStr
 			}
 		}
-	case 383:
+	case 516:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2047
+//line sql.y:2882
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 384:
+	case 517:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2051
+//line sql.y:2886
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 385:
+	case 518:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2057
+//line sql.y:2892
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 386:
+	case 519:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2061
+//line sql.y:2896
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 387:
+	case 520:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2066
+//line sql.y:2901
 		{
 			yyVAL.indexHints = nil
 		}
-	case 388:
+	case 521:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2070
+//line sql.y:2905
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
 		}
-	case 389:
+	case 522:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2074
+//line sql.y:2909
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
 		}
-	case 390:
+	case 523:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2078
+//line sql.y:2913
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
 		}
-	case 391:
+	case 524:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2083
+//line sql.y:2918
 		{
 			yyVAL.expr = nil
 		}
-	case 392:
+	case 525:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2087
+//line sql.y:2922
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 393:
+	case 526:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2093
+//line sql.y:2928
 		{
 			yyVAL.expr = yyDollar[1].expr
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 394:
+	case 527:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2097
+//line sql.y:2933
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 395:
+	case 528:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2101
+//line sql.y:2938
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 396:
+	case 529:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2105
+//line sql.y:2943
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 397:
+	case 530:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2109
+//line sql.y:2948
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 398:
+	case 531:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2113
+//line sql.y:2953
 		{
 			yyVAL.expr = yyDollar[1].expr
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 399:
+	case 532:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2117
+//line sql.y:2958
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 400:
+	case 533:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2123
+//line sql.y:2965
 		{
 			yyVAL.str = ""
 		}
-	case 401:
+	case 534:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2127
+//line sql.y:2969
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 402:
+	case 535:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2133
+//line sql.y:2975
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 403:
+	case 536:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2137
+//line sql.y:2979
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 404:
+	case 537:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2143
+//line sql.y:2985
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 405:
+	case 538:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2147
+//line sql.y:2990
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 406:
+	case 539:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2151
+//line sql.y:2995
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 407:
+	case 540:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2155
+//line sql.y:3000
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 408:
+	case 541:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2159
+//line sql.y:3005
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 409:
+	case 542:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2163
+//line sql.y:3010
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 410:
+	case 543:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2167
+//line sql.y:3015
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 411:
+	case 544:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2171
+//line sql.y:3020
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 412:
+	case 545:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2175
+//line sql.y:3025
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 413:
+	case 546:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2179
+//line sql.y:3030
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 414:
+	case 547:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2185
+//line sql.y:3037
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 415:
+	case 548:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2189
+//line sql.y:3041
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 416:
+	case 549:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2193
+//line sql.y:3045
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 417:
+	case 550:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2197
+//line sql.y:3049
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 418:
+	case 551:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2201
+//line sql.y:3053
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 419:
+	case 552:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2205
+//line sql.y:3057
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 420:
+	case 553:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2211
+//line sql.y:3063
 		{
 			yyVAL.str = EqualStr
 		}
-	case 421:
+	case 554:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2215
+//line sql.y:3067
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 422:
+	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2219
+//line sql.y:3071
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 423:
+	case 556:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2223
+//line sql.y:3075
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 424:
+	case 557:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2227
+//line sql.y:3079
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 425:
+	case 558:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2231
+//line sql.y:3083
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 426:
+	case 559:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2235
+//line sql.y:3087
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 427:
+	case 560:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2240
+//line sql.y:3092
 		{
 			yyVAL.expr = nil
 		}
-	case 428:
+	case 561:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2244
+//line sql.y:3096
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 429:
+	case 562:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2250
+//line sql.y:3102
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 430:
+	case 563:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2254
+//line sql.y:3106
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 431:
+	case 564:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2258
+//line sql.y:3110
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 432:
+	case 565:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2264
+//line sql.y:3116
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
+			setPos(yylex, yyVAL.subquery, yyDollar[1].pos)
 		}
-	case 433:
+	case 566:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3121
+		{
+			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
+			setPos(yylex, yyVAL.subquery, yyDollar[1].pos)
+		}
+	case 567:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2270
+//line sql.y:3128
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 434:
+	case 568:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2274
+//line sql.y:3132
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 435:
+	case 569:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2280
+//line sql.y:3138
 		{
 			yyVAL.expr = yyDollar[1].expr
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 436:
+	case 570:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2284
+//line sql.y:3143
 		{
 			yyVAL.expr = yyDollar[1].boolVal
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 437:
+	case 571:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2288
+//line sql.y:3148
 		{
 			yyVAL.expr = yyDollar[1].colName
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 438:
+	case 572:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2292
+//line sql.y:3153
 		{
 			yyVAL.expr = yyDollar[1].expr
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 439:
+	case 573:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2296
+//line sql.y:3158
 		{
 			yyVAL.expr = yyDollar[1].subquery
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 440:
+	case 574:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2300
+//line sql.y:3163
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 441:
+	case 575:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2304
+//line sql.y:3168
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 442:
+	case 576:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2308
+//line sql.y:3173
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 443:
+	case 577:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2312
+//line sql.y:3178
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 444:
+	case 578:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2316
+//line sql.y:3183
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 445:
+	case 579:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2320
+//line sql.y:3188
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 446:
+	case 580:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2324
+//line sql.y:3193
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 447:
+	case 581:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2328
+//line sql.y:3198
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 448:
+	case 582:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2332
+//line sql.y:3203
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 449:
+	case 583:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2336
+//line sql.y:3208
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 450:
+	case 584:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2340
+//line sql.y:3213
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 451:
+	case 585:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2344
+//line sql.y:3218
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 452:
+	case 586:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2348
+//line sql.y:3223
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &JSONExtractExpr{Column: yyDollar[1].colName, Operator: JSONExtractOp, Path: yyDollar[3].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 453:
+	case 587:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2352
+//line sql.y:3228
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &JSONExtractExpr{Column: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Path: yyDollar[3].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 454:
+	case 588:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2356
+//line sql.y:3233
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 455:
+	case 589:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2360
+//line sql.y:3238
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 456:
+	case 590:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2364
+//line sql.y:3243
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 457:
+	case 591:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2368
+//line sql.y:3248
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Str, Expr: yyDollar[2].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 458:
+	case 592:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2372
+//line sql.y:3253
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				yyVAL.expr = num
 			} else {
 				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
 			}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 459:
+	case 593:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2380
+//line sql.y:3262
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -5688,508 +7843,663 @@ yydefault:
 			} else {
 				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
 			}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 460:
+	case 594:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2394
+//line sql.y:3277
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 461:
+	case 595:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2398
+//line sql.y:3282
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 462:
+	case 596:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2402
+//line sql.y:3287
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
 			// we'll need to revisit this. The solution
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 467:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2420
+	case 601:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3306
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].overClause}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 468:
+	case 602:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2424
+//line sql.y:3311
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 469:
+	case 603:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2428
+//line sql.y:3316
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 470:
+	case 604:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2438
+//line sql.y:3327
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 471:
+	case 605:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2442
+//line sql.y:3332
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 472:
+	case 606:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2446
+//line sql.y:3337
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 473:
+	case 607:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2450
+//line sql.y:3342
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 474:
+	case 608:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2454
+//line sql.y:3347
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 475:
+	case 609:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2458
+//line sql.y:3352
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 476:
+	case 610:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2462
+//line sql.y:3357
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 477:
+	case 611:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2466
+//line sql.y:3362
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 478:
+	case 612:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2470
+//line sql.y:3367
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 479:
+	case 613:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2474
+//line sql.y:3372
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 480:
+	case 614:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2478
+//line sql.y:3377
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 481:
+	case 615:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2482
+//line sql.y:3382
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 482:
+	case 616:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2486
+//line sql.y:3387
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 483:
+	case 617:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2496
+//line sql.y:3398
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 484:
+	case 618:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2500
+//line sql.y:3403
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 485:
+	case 619:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2504
+//line sql.y:3408
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 486:
+	case 620:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2509
+//line sql.y:3414
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 487:
+	case 621:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2514
+//line sql.y:3420
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 488:
+	case 622:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2519
+//line sql.y:3426
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 489:
+	case 623:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2525
+//line sql.y:3433
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 490:
+	case 624:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2530
+//line sql.y:3439
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 491:
+	case 625:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2535
+//line sql.y:3445
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 492:
+	case 626:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2539
+//line sql.y:3450
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
+		}
+	case 627:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3455
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
+		}
+	case 628:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3461
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
+		}
+	case 629:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3467
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
+		}
+	case 630:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3473
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
+		}
+	case 631:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3478
+		{
+			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
+		}
+	case 632:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3483
+		{
+			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
+		}
+	case 635:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3494
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 636:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3504
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
+		}
+	case 637:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3509
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
+		}
+	case 638:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3514
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
+		}
+	case 639:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3519
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
+		}
+	case 640:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3524
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
+		}
+	case 641:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3529
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
+		}
+	case 642:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3535
+		{
+			yyVAL.overClause = nil
+		}
+	case 643:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3539
+		{
+			yyVAL.overClause = &OverClause{WindowName: yyDollar[2].colIdent}
+		}
+	case 644:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3543
+		{
+			yyVAL.overClause = &OverClause{WindowSpec: yyDollar[3].windowSpec}
+		}
+	case 645:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3549
+		{
+			yyVAL.windowSpec = &WindowSpec{PartitionBy: yyDollar[1].exprs, OrderBy: yyDollar[2].orderBy, Frame: yyDollar[3].frameClause}
 		}
-	case 493:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2543
+	case 646:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3553
 		{
-			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].expr}
+			yyVAL.windowSpec = &WindowSpec{Name: yyDollar[1].colIdent, PartitionBy: yyDollar[2].exprs, OrderBy: yyDollar[3].orderBy, Frame: yyDollar[4].frameClause}
 		}
-	case 494:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2548
+	case 647:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3558
 		{
-			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].expr}
+			yyVAL.exprs = nil
 		}
-	case 495:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2553
+	case 648:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3562
 		{
-			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].expr}
+			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 496:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2558
+	case 649:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3567
 		{
-			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].expr}
+			yyVAL.frameClause = nil
 		}
-	case 497:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2562
+	case 650:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3571
 		{
-			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
+			yyVAL.frameClause = &FrameClause{Unit: yyDollar[1].str, Start: yyDollar[2].framePoint}
 		}
-	case 498:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2566
+	case 651:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3575
 		{
-			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
+			yyVAL.frameClause = &FrameClause{Unit: yyDollar[1].str, Start: yyDollar[3].framePoint, End: yyDollar[5].framePoint}
 		}
-	case 501:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2576
+	case 652:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3581
 		{
-			yyVAL.expr = yyDollar[2].expr
+			yyVAL.str = RowsStr
 		}
-	case 502:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2586
+	case 653:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3585
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.str = RangeStr
 		}
-	case 503:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2590
+	case 654:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3591
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.framePoint = &FramePoint{Type: CurrentRowStr}
 		}
-	case 504:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2594
+	case 655:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3595
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.framePoint = &FramePoint{Type: UnboundedPrecedingStr}
 		}
-	case 505:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2598
+	case 656:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3599
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.framePoint = &FramePoint{Type: UnboundedFollowingStr}
 		}
-	case 506:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2602
+	case 657:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3603
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.framePoint = &FramePoint{Type: PrecedingStr, Expr: yyDollar[1].expr}
 		}
-	case 507:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2606
+	case 658:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3607
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.framePoint = &FramePoint{Type: FollowingStr, Expr: yyDollar[1].expr}
 		}
-	case 508:
+	case 659:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2612
+//line sql.y:3613
 		{
 			yyVAL.str = ""
 		}
-	case 509:
+	case 660:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2616
+//line sql.y:3617
 		{
 			yyVAL.str = BooleanModeStr
 		}
-	case 510:
+	case 661:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2620
+//line sql.y:3621
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
-	case 511:
+	case 662:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2624
+//line sql.y:3625
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
-	case 512:
+	case 663:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2628
+//line sql.y:3629
 		{
 			yyVAL.str = QueryExpansionStr
 		}
-	case 513:
+	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2634
+//line sql.y:3635
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 514:
+	case 665:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2638
+//line sql.y:3639
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 515:
+	case 666:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2644
+//line sql.y:3645
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 516:
+	case 667:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2648
+//line sql.y:3649
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
 		}
-	case 517:
+	case 668:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2652
+//line sql.y:3653
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: string(yyDollar[3].bytes)}
 		}
-	case 518:
+	case 669:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2656
+//line sql.y:3657
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 519:
+	case 670:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2660
+//line sql.y:3661
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 520:
+	case 671:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2664
+//line sql.y:3665
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 521:
+	case 672:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2670
+//line sql.y:3671
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 522:
+	case 673:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2674
+//line sql.y:3675
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 523:
+	case 674:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2678
+//line sql.y:3679
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 524:
+	case 675:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2682
+//line sql.y:3683
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 525:
+	case 676:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2686
+//line sql.y:3687
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 526:
+	case 677:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2690
+//line sql.y:3691
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 527:
+	case 678:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2694
+//line sql.y:3695
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 528:
+	case 679:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2699
+//line sql.y:3700
 		{
 			yyVAL.expr = nil
 		}
-	case 529:
+	case 680:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2703
+//line sql.y:3704
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 530:
+	case 681:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2708
+//line sql.y:3709
 		{
 			yyVAL.str = string("")
 		}
-	case 531:
+	case 682:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2712
+//line sql.y:3713
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 532:
+	case 683:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2718
+//line sql.y:3719
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 533:
+	case 684:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2722
+//line sql.y:3723
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 534:
+	case 685:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2728
+//line sql.y:3729
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 535:
+	case 686:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2733
+//line sql.y:3734
 		{
 			yyVAL.expr = nil
 		}
-	case 536:
+	case 687:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2737
+//line sql.y:3738
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 537:
+	case 688:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2743
+//line sql.y:3744
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
+			setPos(yylex, yyVAL.colName, yyDollar[1].pos)
 		}
-	case 538:
+	case 689:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2747
+//line sql.y:3749
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
+			setPos(yylex, yyVAL.colName, yyDollar[1].pos)
 		}
-	case 539:
+	case 690:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2751
+//line sql.y:3754
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
+			setPos(yylex, yyVAL.colName, yyDollar[1].pos)
 		}
-	case 540:
+	case 691:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2757
+//line sql.y:3761
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 541:
+	case 692:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2761
+//line sql.y:3766
 		{
 			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 542:
+	case 693:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2765
+//line sql.y:3771
 		{
 			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 543:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2769
+//line sql.y:3776
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 544:
+	case 695:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2773
+//line sql.y:3781
 		{
 			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 545:
+	case 696:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2777
+//line sql.y:3786
 		{
 			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 546:
+	case 697:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2781
+//line sql.y:3791
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 547:
+	case 698:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2785
+//line sql.y:3796
 		{
 			yyVAL.expr = &NullVal{}
+			setPos(yylex, yyVAL.expr, yyDollar[1].pos)
 		}
-	case 548:
+	case 699:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2791
+//line sql.y:3803
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -6198,239 +8508,269 @@ yydefault:
 			}
 			yyVAL.expr = NewIntVal([]byte("1"))
 		}
-	case 549:
+	case 700:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2800
+//line sql.y:3812
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 550:
+	case 701:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2804
+//line sql.y:3816
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 551:
+	case 702:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2809
+//line sql.y:3821
 		{
 			yyVAL.exprs = nil
 		}
-	case 552:
+	case 703:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2813
+//line sql.y:3825
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 553:
+	case 704:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2818
+//line sql.y:3830
 		{
 			yyVAL.expr = nil
 		}
-	case 554:
+	case 705:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2822
+//line sql.y:3834
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 555:
+	case 706:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3839
+		{
+			yyVAL.windowDefs = nil
+		}
+	case 707:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3843
+		{
+			yyVAL.windowDefs = yyDollar[2].windowDefs
+		}
+	case 708:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3849
+		{
+			yyVAL.windowDefs = WindowDefs{yyDollar[1].windowDef}
+		}
+	case 709:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3853
+		{
+			yyVAL.windowDefs = append(yyDollar[1].windowDefs, yyDollar[3].windowDef)
+		}
+	case 710:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3859
+		{
+			yyVAL.windowDef = &WindowDef{Name: yyDollar[1].colIdent, WindowSpec: yyDollar[4].windowSpec}
+		}
+	case 711:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2827
+//line sql.y:3864
 		{
 			yyVAL.orderBy = nil
 		}
-	case 556:
+	case 712:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2831
+//line sql.y:3868
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 557:
+	case 713:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2837
+//line sql.y:3874
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 558:
+	case 714:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2841
+//line sql.y:3878
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 559:
+	case 715:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2847
+//line sql.y:3884
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
-	case 560:
+	case 716:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2852
+//line sql.y:3889
 		{
 			yyVAL.str = AscScr
 		}
-	case 561:
+	case 717:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2856
+//line sql.y:3893
 		{
 			yyVAL.str = AscScr
 		}
-	case 562:
+	case 718:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2860
+//line sql.y:3897
 		{
 			yyVAL.str = DescScr
 		}
-	case 563:
+	case 719:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2865
+//line sql.y:3902
 		{
 			yyVAL.limit = nil
 		}
-	case 564:
+	case 720:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2869
+//line sql.y:3906
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 565:
+	case 721:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2873
+//line sql.y:3910
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 566:
+	case 722:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2877
+//line sql.y:3914
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 567:
+	case 723:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2882
+//line sql.y:3919
 		{
 			yyVAL.str = ""
 		}
-	case 568:
+	case 724:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2886
+//line sql.y:3923
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 569:
+	case 725:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2890
+//line sql.y:3927
 		{
 			yyVAL.str = ShareModeStr
 		}
-	case 570:
+	case 726:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2903
+//line sql.y:3940
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 571:
+	case 727:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2907
+//line sql.y:3944
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 572:
+	case 728:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2911
+//line sql.y:3948
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
 		}
-	case 573:
+	case 729:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2916
+//line sql.y:3953
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 574:
+	case 730:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2920
+//line sql.y:3957
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 575:
+	case 731:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2924
+//line sql.y:3961
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
 		}
-	case 576:
+	case 732:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2931
+//line sql.y:3968
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 577:
+	case 733:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2935
+//line sql.y:3972
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 578:
+	case 734:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2939
+//line sql.y:3976
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 579:
+	case 735:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2943
+//line sql.y:3980
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 580:
+	case 736:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2948
+//line sql.y:3985
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 581:
+	case 737:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2952
+//line sql.y:3989
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 582:
+	case 738:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2958
+//line sql.y:3995
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 583:
+	case 739:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2962
+//line sql.y:3999
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 584:
+	case 740:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2968
+//line sql.y:4005
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 585:
+	case 741:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2972
+//line sql.y:4009
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 586:
+	case 742:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2978
+//line sql.y:4015
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 587:
+	case 743:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2984
+//line sql.y:4021
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
@@ -6438,312 +8778,252 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 588:
+	case 744:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2994
+//line sql.y:4031
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 589:
+	case 745:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2998
+//line sql.y:4035
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 590:
+	case 746:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3004
+//line sql.y:4041
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 591:
+	case 747:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3010
+//line sql.y:4047
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 592:
+	case 748:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3014
+//line sql.y:4051
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 593:
+	case 749:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3020
+//line sql.y:4057
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("on"))}
 		}
-	case 594:
+	case 750:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3024
+//line sql.y:4061
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("off"))}
 		}
-	case 595:
+	case 751:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3028
+//line sql.y:4065
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].expr}
 		}
-	case 596:
+	case 752:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3032
+//line sql.y:4069
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
 		}
-	case 598:
+	case 754:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3039
+//line sql.y:4076
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 600:
+	case 756:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3046
+//line sql.y:4083
 		{
 			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 601:
+	case 757:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3050
+//line sql.y:4087
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 602:
+	case 758:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3054
+//line sql.y:4091
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 605:
+	case 761:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3063
+//line sql.y:4100
 		{
 			yyVAL.byt = 0
 		}
-	case 606:
+	case 762:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3065
+//line sql.y:4102
 		{
 			yyVAL.byt = 1
 		}
-	case 607:
+	case 763:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3068
+//line sql.y:4105
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 608:
+	case 764:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3070
+//line sql.y:4107
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 609:
+	case 765:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3073
+//line sql.y:4110
 		{
 			yyVAL.str = ""
 		}
-	case 610:
+	case 766:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3075
+//line sql.y:4112
 		{
 			yyVAL.str = IgnoreStr
 		}
-	case 611:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3079
-		{
-			yyVAL.empty = struct{}{}
-		}
-	case 612:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3081
-		{
-			yyVAL.empty = struct{}{}
-		}
-	case 613:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3083
-		{
-			yyVAL.empty = struct{}{}
-		}
-	case 614:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3085
-		{
-			yyVAL.empty = struct{}{}
-		}
-	case 615:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3087
-		{
-			yyVAL.empty = struct{}{}
-		}
-	case 616:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3089
-		{
-			yyVAL.empty = struct{}{}
-		}
-	case 617:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3091
-		{
-			yyVAL.empty = struct{}{}
-		}
-	case 618:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3093
-		{
-			yyVAL.empty = struct{}{}
-		}
-	case 619:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3095
-		{
-			yyVAL.empty = struct{}{}
-		}
-	case 620:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3097
-		{
-			yyVAL.empty = struct{}{}
-		}
-	case 621:
+	case 767:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3100
+//line sql.y:4115
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 622:
+	case 768:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3102
+//line sql.y:4117
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 623:
+	case 769:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3104
+//line sql.y:4119
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 624:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3108
+	case 770:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4122
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 625:
+	case 771:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3110
+//line sql.y:4124
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 626:
+	case 772:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3113
+//line sql.y:4127
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 627:
+	case 773:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3115
+//line sql.y:4129
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 628:
+	case 774:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3117
+//line sql.y:4131
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 629:
+	case 775:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3120
+//line sql.y:4134
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 630:
+	case 776:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3122
+//line sql.y:4136
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 631:
+	case 777:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3126
+//line sql.y:4140
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 632:
+	case 778:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3130
+//line sql.y:4144
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 634:
+	case 780:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3137
+//line sql.y:4151
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 635:
+	case 781:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3143
+//line sql.y:4157
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 636:
+	case 782:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3147
+//line sql.y:4161
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 638:
+	case 784:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3154
+//line sql.y:4168
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 849:
+	case 1035:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3390
+//line sql.y:4444
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 850:
+	case 1036:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3399
+//line sql.y:4453
 		{
 			decNesting(yylex)
 		}
-	case 851:
+	case 1037:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3404
+//line sql.y:4458
 		{
 			skipToEnd(yylex)
 		}
-	case 852:
+	case 1038:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3409
+//line sql.y:4463
 		{
 			skipToEnd(yylex)
 		}
-	case 853:
+	case 1039:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3413
+//line sql.y:4467
 		{
 			skipToEnd(yylex)
 		}
-	case 854:
+	case 1040:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3417
+//line sql.y:4471
 		{
 			skipToEnd(yylex)
 		}
@@ -17,6 +17,11 @@ limitations under the License.
 %{
 package sqlparser
 
+import (
+  "strconv"
+  "strings"
+)
+
 func setParseTree(yylex interface{}, stmt Statement) {
   yylex.(*Tokenizer).ParseTree = stmt
 }
@@ -41,6 +46,14 @@ func decNesting(yylex interface{}) {
   yylex.(*Tokenizer).nesting--
 }
 
+// setPos records the span of node if the tokenizer tracks positions.
+// start is the offset of the first token of the node.
+func setPos(yylex interface{}, node SQLNode, start int) {
+  if yylex.(*Tokenizer).positions != nil {
+    yylex.(*Tokenizer).setPos(node, start)
+  }
+}
+
 // skipToEnd forces the lexer to end prematurely. Not all SQL statements
 // are supported by the Parser, thus calling skipToEnd will make the lexer
 // return EOF early.
@@ -51,6 +64,8 @@ func skipToEnd(yylex interface{}) {
 %}
 
 %union {
+  // pos is the offset of the first token of the symbol.
+  pos           int
   empty         struct{}
   statement     Statement
   selStmt       SelectStatement
@@ -99,6 +114,7 @@ func skipToEnd(yylex interface{}) {
   optVal        Expr
   LengthScaleOption LengthScaleOption
   columnDefinition *ColumnDefinition
+  columnDefinitions []*ColumnDefinition
   indexDefinition *IndexDefinition
   indexInfo     *IndexInfo
   indexOption   *IndexOption
@@ -111,10 +127,28 @@ func skipToEnd(yylex interface{}) {
   partDefs      []*PartitionDefinition
   partDef       *PartitionDefinition
   partSpec      *PartitionSpec
+  partBy        *PartitionBy
+  alterSpec     *AlterSpec
+  alterSpecs    AlterSpecs
+  colPosition   *ColumnPosition
   vindexParam   VindexParam
   vindexParams  []VindexParam
   showFilter    *ShowFilter
   optLike       *OptLike
+  loadFields    *LoadFields
+  loadLines     *LoadLines
+  overClause    *OverClause
+  windowSpec    *WindowSpec
+  frameClause   *FrameClause
+  framePoint    *FramePoint
+  windowDefs    WindowDefs
+  windowDef     *WindowDef
+  with          *With
+  ctes          []*CommonTableExpr
+  cte           *CommonTableExpr
+  jsonTableColumns JSONTableColumns
+  jsonTableColumn  *JSONTableColumn
+  jsonOnResponse   *JSONTableOnResponse
 }
 
 %token LEX_ERROR
@@ -150,6 +184,17 @@ func skipToEnd(yylex interface{}) {
 %right <bytes> BINARY UNDERSCORE_BINARY UNDERSCORE_UTF8MB4
 %right <bytes> INTERVAL
 %nonassoc <bytes> '.'
+// The table options of a create table are a free-form list, so a
+// PARTITION that follows them could also be one of them. Giving
+// the table_option_list rules a higher precedence than PARTITION
+// makes it start the partition clause instead.
+%nonassoc <bytes> PARTITION
+%nonassoc <bytes> TABLE_OPTIONS
+// UNBOUNDED is not reserved, so in a window frame it could also be
+// a column followed by PRECEDING or FOLLOWING. Giving these a higher
+// precedence than UNBOUNDED makes them end the frame bound instead.
+%nonassoc <bytes> UNBOUNDED
+%nonassoc <bytes> PRECEDING FOLLOWING
 
 // There is no need to define precedence for the JSON
 // operators because the syntax is restricted enough that
@@ -159,15 +204,22 @@ func skipToEnd(yylex interface{}) {
 // DDL Tokens
 %token <bytes> CREATE ALTER DROP RENAME ANALYZE ADD FLUSH
 %token <bytes> SCHEMA TABLE INDEX VIEW TO IGNORE IF UNIQUE PRIMARY COLUMN  SPATIAL FULLTEXT KEY_BLOCK_SIZE
+%token <bytes> MODIFY CHANGE FIRST AFTER CHECK GENERATED STORED VIRTUAL
 %token <bytes> ACTION CASCADE CONSTRAINT FOREIGN NO REFERENCES RESTRICT
 %token <bytes> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
-%token <bytes> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
+%token <bytes> MAXVALUE REORGANIZE COALESCE LINEAR LESS THAN PROCEDURE TRIGGER
 %token <bytes> VINDEX VINDEXES
 %token <bytes> STATUS VARIABLES WARNINGS
 
 // Transaction Tokens
 %token <bytes> BEGIN START TRANSACTION COMMIT ROLLBACK
 
+// Kill Tokens
+%token <bytes> KILL CONNECTION
+
+// Load Tokens
+%token <bytes> LOAD DATA LOW_PRIORITY CONCURRENT LOCAL INFILE LINES TERMINATED OPTIONALLY ENCLOSED ESCAPED STARTING
+
 // Type Tokens
 %token <bytes> BIT TINYINT SMALLINT MEDIUMINT INT INTEGER BIGINT INTNUM
 %token <bytes> REAL DOUBLE FLOAT_TYPE DECIMAL NUMERIC
@@ -199,15 +251,30 @@ func skipToEnd(yylex interface{}) {
 // Match
 %token <bytes> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION
 
+// Window functions
+%token <bytes> OVER WINDOW ROWS RANGE ROW CURRENT
+
+// Common table expressions
+%token <bytes> RECURSIVE
+
+// JSON_TABLE
+%token <bytes> JSON_TABLE ORDINALITY PATH NESTED EMPTY ERROR
+
 // MySQL reserved words that are unused by this grammar will map to this token.
 %token <bytes> UNUSED
 
 %type <statement> command
-%type <selStmt> select_statement base_select union_lhs union_rhs
+%type <selStmt> select_statement base_select union_lhs union_rhs with_select_statement
+%type <with> with_clause
+%type <ctes> cte_list
+%type <cte> cte
+%type <jsonTableColumns> json_table_columns json_table_column_list
+%type <jsonTableColumn> json_table_column json_table_on_opt
+%type <jsonOnResponse> json_table_on_response
 %type <statement> stream_statement insert_statement update_statement delete_statement set_statement
 %type <statement> create_statement alter_statement rename_statement drop_statement truncate_statement flush_statement
-%type <ddl> create_table_prefix rename_list
-%type <statement> analyze_statement show_statement use_statement other_statement
+%type <ddl> create_table_prefix alter_table_prefix rename_list
+%type <statement> analyze_statement show_statement use_statement kill_statement load_statement other_statement
 %type <statement> begin_statement commit_statement rollback_statement
 %type <bytes2> comment_opt comment_list
 %type <str> union_op insert_or_replace
@@ -248,6 +315,14 @@ func skipToEnd(yylex interface{}) {
 %type <order> order
 %type <str> asc_desc_opt
 %type <limit> limit_opt
+%type <overClause> over_clause_opt
+%type <windowSpec> window_spec
+%type <exprs> partition_by_opt
+%type <frameClause> frame_clause_opt
+%type <str> frame_unit
+%type <framePoint> frame_point
+%type <windowDefs> window_opt window_definition_list
+%type <windowDef> window_definition
 %type <str> lock_opt
 %type <columns> ins_column_list column_list
 %type <partitions> opt_partition_clause partition_list
@@ -260,10 +335,15 @@ func skipToEnd(yylex interface{}) {
 %type <str> isolation_level
 %type <bytes> for_from
 %type <str> ignore_opt default_opt
-%type <str> full_opt from_database_opt tables_or_processlist columns_or_fields
+%type <str> full_opt from_database_opt tables_or_processlist columns_or_fields kill_type_opt
+%type <str> load_priority_opt local_opt load_duplicate_opt
+%type <loadFields> load_fields_opt load_field_list
+%type <loadLines> load_lines_opt load_line_list
+%type <sqlVal> load_ignore_lines_opt
+%type <columns> load_columns_opt
 %type <showFilter> like_or_where_opt
 %type <byt> exists_opt
-%type <empty> not_exists_opt non_add_drop_or_rename_operation to_opt index_opt constraint_opt
+%type <empty> not_exists_opt to_opt column_opt constraint_opt
 %type <bytes> reserved_keyword non_reserved_keyword
 %type <colIdent> sql_id reserved_sql_id col_alias as_ci_opt using_opt
 %type <expr> charset_value
@@ -280,10 +360,11 @@ func skipToEnd(yylex interface{}) {
 %type <str> charset_opt collate_opt
 %type <boolVal> unsigned_opt zero_fill_opt
 %type <LengthScaleOption> float_length_opt decimal_length_opt
-%type <boolVal> null_opt auto_increment_opt
+%type <boolVal> null_opt auto_increment_opt stored_opt
 %type <colKeyOpt> column_key_opt
 %type <strs> enum_values
 %type <columnDefinition> column_definition
+%type <columnDefinitions> column_definition_list
 %type <indexDefinition> index_definition
 %type <constraintDefinition> constraint_definition
 %type <str> index_or_key
@@ -301,10 +382,16 @@ func skipToEnd(yylex interface{}) {
 %type <partDefs> partition_definitions
 %type <partDef> partition_definition
 %type <partSpec> partition_operation
+%type <partBy> partition_by partition_method table_partition_opt
+%type <sqlVal> partitions_opt
+%type <str> partition_engine_opt
+%type <alterSpecs> alter_spec_list alter_spec_item alter_table_option_list
+%type <alterSpec> alter_spec alter_table_option alter_order
+%type <colPosition> column_position_opt
+%type <str> table_option_name
 %type <vindexParam> vindex_param
 %type <vindexParams> vindex_param_list vindex_params_opt
 %type <colIdent> vindex_type vindex_type_opt
-%type <bytes> alter_object_type
 %type <ReferenceAction> fk_reference_action fk_on_delete fk_on_update
 
 %start any_command
@@ -326,6 +413,10 @@ command:
   {
     $$ = $1
   }
+| with_select_statement
+  {
+    $$ = $1
+  }
 | stream_statement
 | insert_statement
 | update_statement
@@ -339,6 +430,8 @@ command:
 | analyze_statement
 | show_statement
 | use_statement
+| kill_statement
+| load_statement
 | begin_statement
 | commit_statement
 | rollback_statement
@@ -357,14 +450,62 @@ select_statement:
     sel.Limit = $3
     sel.Lock = $4
     $$ = sel
+    setPos(yylex, $$, $<pos>1)
   }
 | union_lhs union_op union_rhs order_by_opt limit_opt lock_opt
   {
     $$ = &Union{Type: $2, Left: $1, Right: $3, OrderBy: $4, Limit: $5, Lock: $6}
+    setPos(yylex, $$, $<pos>1)
   }
 | SELECT comment_opt cache_opt NEXT num_val for_from table_name
   {
     $$ = &Select{Comments: Comments($2), Cache: $3, SelectExprs: SelectExprs{Nextval{Expr: $5}}, From: TableExprs{&AliasedTableExpr{Expr: $7}}}
+    setPos(yylex, $$, $<pos>1)
+  }
+
+// with_select_statement is a SELECT statement with common table expressions.
+// It's not a select_statement because WITH applies to the whole statement,
+// and it would otherwise be ambiguous with the left side of a UNION.
+with_select_statement:
+  with_clause select_statement
+  {
+    switch sel := $2.(type) {
+    case *Select:
+      sel.With = $1
+    case *Union:
+      sel.With = $1
+    }
+    $$ = $2
+  }
+
+with_clause:
+  WITH cte_list
+  {
+    $$ = &With{CTEs: $2}
+  }
+| WITH RECURSIVE cte_list
+  {
+    $$ = &With{Recursive: true, CTEs: $3}
+  }
+
+cte_list:
+  cte
+  {
+    $$ = []*CommonTableExpr{$1}
+  }
+| cte_list ',' cte
+  {
+    $$ = append($1, $3)
+  }
+
+cte:
+  table_id AS subquery
+  {
+    $$ = &CommonTableExpr{Name: $1, Subquery: $3}
+  }
+| table_id openb column_list closeb AS subquery
+  {
+    $$ = &CommonTableExpr{Name: $1, Columns: $3, Subquery: $6}
   }
 
 stream_statement:
@@ -375,9 +516,10 @@ stream_statement:
 
 // base_select is an unparenthesized SELECT with no order by clause or beyond.
 base_select:
-  SELECT comment_opt cache_opt distinct_opt straight_join_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
+  SELECT comment_opt cache_opt distinct_opt straight_join_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt window_opt
   {
-    $$ = &Select{Comments: Comments($2), Cache: $3, Distinct: $4, Hints: $5, SelectExprs: $6, From: $7, Where: NewWhere(WhereStr, $8), GroupBy: GroupBy($9), Having: NewWhere(HavingStr, $10)}
+    $$ = &Select{Comments: Comments($2), Cache: $3, Distinct: $4, Hints: $5, SelectExprs: $6, From: $7, Where: NewWhere(WhereStr, $8), GroupBy: GroupBy($9), Having: NewWhere(HavingStr, $10), Windows: $11}
+    setPos(yylex, $$, $<pos>1)
   }
 
 union_lhs:
@@ -632,10 +774,11 @@ create_table_prefix:
   }
 
 table_spec:
-  '(' table_column_list ')' table_option_list
+  '(' table_column_list ')' table_option_list table_partition_opt
   {
     $$ = $2
     $$.Options = $4
+    $$.PartitionBy = $5
   }
 
 create_like:
@@ -668,7 +811,7 @@ table_column_list:
   }
 
 column_definition:
-  ID column_type null_opt column_default_opt on_update_opt auto_increment_opt column_key_opt column_comment_opt
+  sql_id column_type null_opt column_default_opt on_update_opt auto_increment_opt column_key_opt column_comment_opt
   {
     $2.NotNull = $3
     $2.Default = $4
@@ -676,8 +819,44 @@ column_definition:
     $2.Autoincrement = $6
     $2.KeyOpt = $7
     $2.Comment = $8
-    $$ = &ColumnDefinition{Name: NewColIdent(string($1)), Type: $2}
+    $$ = &ColumnDefinition{Name: $1, Type: $2}
+  }
+| sql_id column_type AS openb expression closeb stored_opt null_opt column_key_opt column_comment_opt
+  {
+    $2.Generated = $5
+    $2.Stored = $7
+    $2.NotNull = $8
+    $2.KeyOpt = $9
+    $2.Comment = $10
+    $$ = &ColumnDefinition{Name: $1, Type: $2}
   }
+| sql_id column_type GENERATED ID AS openb expression closeb stored_opt null_opt column_key_opt column_comment_opt
+  {
+    if strings.ToLower(string($4)) != "always" {
+      yylex.Error("syntax error")
+      return 1
+    }
+    $2.Generated = $7
+    $2.Stored = $9
+    $2.NotNull = $10
+    $2.KeyOpt = $11
+    $2.Comment = $12
+    $$ = &ColumnDefinition{Name: $1, Type: $2}
+  }
+
+stored_opt:
+  {
+    $$ = BoolVal(false)
+  }
+| VIRTUAL
+  {
+    $$ = BoolVal(false)
+  }
+| STORED
+  {
+    $$ = BoolVal(true)
+  }
+
 column_type:
   numeric_type unsigned_opt zero_fill_opt
   {
@@ -787,9 +966,9 @@ time_type:
   {
     $$ = ColumnType{Type: string($1), Length: $2}
   }
-| YEAR
+| YEAR length_opt
   {
-    $$ = ColumnType{Type: string($1)}
+    $$ = ColumnType{Type: string($1), Length: $2}
   }
 
 char_type:
@@ -1026,23 +1205,23 @@ collate_opt:
 
 column_key_opt:
   {
-    $$ = colKeyNone
+    $$ = ColKeyNone
   }
 | PRIMARY KEY
   {
-    $$ = colKeyPrimary
+    $$ = ColKeyPrimary
   }
 | KEY
   {
-    $$ = colKey
+    $$ = ColKey
   }
 | UNIQUE KEY
   {
-    $$ = colKeyUniqueKey
+    $$ = ColKeyUniqueKey
   }
 | UNIQUE
   {
-    $$ = colKeyUnique
+    $$ = ColKeyUnique
   }
 
 column_comment_opt:
@@ -1063,6 +1242,14 @@ index_definition:
   {
     $$ = &IndexDefinition{Info: $1, Columns: $3}
   }
+| index_info USING ID '(' index_column_list ')' index_option_list
+  {
+    $$ = &IndexDefinition{Info: $1, Columns: $5, Options: append([]*IndexOption{{Name: string($2), Using: string($3)}}, $7...)}
+  }
+| index_info USING ID '(' index_column_list ')'
+  {
+    $$ = &IndexDefinition{Info: $1, Columns: $5, Options: []*IndexOption{{Name: string($2), Using: string($3)}}}
+  }
 
 index_option_list:
   index_option
@@ -1108,6 +1295,10 @@ index_info:
   {
     $$ = &IndexInfo{Type: string($1) + " " + string($2), Name: NewColIdent($3), Spatial: true, Unique: false}
   }
+| FULLTEXT index_or_key name_opt
+  {
+    $$ = &IndexInfo{Type: string($1) + " " + string($2), Name: NewColIdent($3), Fulltext: true, Unique: false}
+  }
 | UNIQUE index_or_key name_opt
   {
     $$ = &IndexInfo{Type: string($1) + " " + string($2), Name: NewColIdent($3), Unique: true}
@@ -1220,14 +1411,15 @@ fk_reference_action:
   }
 
 table_option_list:
+  %prec TABLE_OPTIONS
   {
     $$ = ""
   }
-| table_option
+| table_option %prec TABLE_OPTIONS
   {
     $$ = " " + string($1)
   }
-| table_option_list ',' table_option
+| table_option_list ',' table_option %prec TABLE_OPTIONS
   {
     $$ = string($1) + ", " + string($3)
   }
@@ -1263,35 +1455,36 @@ table_opt_value:
   }
 
 alter_statement:
-  ALTER ignore_opt TABLE table_name non_add_drop_or_rename_operation skip_to_end
+  alter_table_prefix alter_spec_list
   {
-    $$ = &DDL{Action: AlterStr, Table: $4}
-  }
-| ALTER ignore_opt TABLE table_name ADD alter_object_type skip_to_end
-  {
-    $$ = &DDL{Action: AlterStr, Table: $4}
+    // The partial DDL is not changed, because it's used if
+    // the statement fails to parse after the specs.
+    if len($2) == 1 && $2[0].Action == RenameTableStr {
+      // Change this to a rename statement
+      $$ = &DDL{Action: RenameStr, FromTables: TableNames{$1.Table}, ToTables: TableNames{$2[0].NewTable}}
+    } else {
+      $$ = &DDL{Action: AlterStr, Table: $1.Table, AlterSpecs: $2}
+    }
   }
-| ALTER ignore_opt TABLE table_name DROP alter_object_type skip_to_end
+| alter_table_prefix alter_spec_list partition_by
   {
-    $$ = &DDL{Action: AlterStr, Table: $4}
+    $$ = &DDL{Action: AlterStr, Table: $1.Table, AlterSpecs: $2, PartitionSpec: &PartitionSpec{Action: PartitionByStr, By: $3}}
   }
-| ALTER ignore_opt TABLE table_name RENAME to_opt table_name
+| alter_table_prefix alter_order
   {
-    // Change this to a rename statement
-    $$ = &DDL{Action: RenameStr, FromTables: TableNames{$4}, ToTables: TableNames{$7}}
+    $$ = &DDL{Action: AlterStr, Table: $1.Table, AlterSpecs: AlterSpecs{$2}}
   }
-| ALTER ignore_opt TABLE table_name RENAME index_opt skip_to_end
+| alter_table_prefix alter_spec_list ',' alter_order
   {
-    // Rename an index can just be an alter
-    $$ = &DDL{Action: AlterStr, Table: $4}
+    $$ = &DDL{Action: AlterStr, Table: $1.Table, AlterSpecs: append($2, $4)}
   }
 | ALTER VIEW table_name ddl_skip_to_end
   {
     $$ = &DDL{Action: AlterStr, Table: $3.ToViewName()}
   }
-| ALTER ignore_opt TABLE table_name partition_operation
+| alter_table_prefix partition_operation
   {
-    $$ = &DDL{Action: AlterStr, Table: $4, PartitionSpec: $5}
+    $$ = &DDL{Action: AlterStr, Table: $1.Table, PartitionSpec: $2}
   }
 | ALTER VSCHEMA CREATE VINDEX sql_id vindex_type_opt vindex_params_opt
   {
@@ -1339,23 +1532,383 @@ alter_statement:
       }
   }
 
-alter_object_type:
-  COLUMN
-| CONSTRAINT
-| FOREIGN
-| FULLTEXT
-| ID
-| INDEX
-| KEY
-| PRIMARY
-| SPATIAL
-| PARTITION
-| UNIQUE
+alter_table_prefix:
+  ALTER ignore_opt TABLE table_name
+  {
+    $$ = &DDL{Action: AlterStr, Table: $4}
+    setDDL(yylex, $$)
+  }
+
+alter_spec_list:
+  alter_spec_item
+  {
+    $$ = $1
+  }
+| alter_spec_list ',' alter_spec_item
+  {
+    $$ = append($1, $3...)
+  }
+
+// An alter_spec_item is a list, because a table option list and
+// a parenthesized list of columns both make several specs.
+alter_spec_item:
+  alter_spec
+  {
+    $$ = AlterSpecs{$1}
+  }
+| alter_table_option_list
+  {
+    $$ = $1
+  }
+| ADD '(' column_definition_list ')'
+  {
+    $$ = make(AlterSpecs, 0, len($3))
+    for _, col := range $3 {
+      $$ = append($$, &AlterSpec{Action: AddColumnStr, Column: col})
+    }
+  }
+| ADD COLUMN '(' column_definition_list ')'
+  {
+    $$ = make(AlterSpecs, 0, len($4))
+    for _, col := range $4 {
+      $$ = append($$, &AlterSpec{Action: AddColumnStr, Column: col})
+    }
+  }
+
+column_definition_list:
+  column_definition
+  {
+    $$ = []*ColumnDefinition{$1}
+  }
+| column_definition_list ',' column_definition
+  {
+    $$ = append($1, $3)
+  }
+
+// The ADD rules spell out the optional COLUMN, because otherwise
+// the parser would have to decide it's missing before reading the
+// column name, which can be a keyword that starts an index definition.
+alter_spec:
+  ADD column_definition column_position_opt
+  {
+    $$ = &AlterSpec{Action: AddColumnStr, Column: $2, Position: $3}
+  }
+| ADD COLUMN column_definition column_position_opt
+  {
+    $$ = &AlterSpec{Action: AddColumnStr, Column: $3, Position: $4}
+  }
+| ADD index_definition
+  {
+    $$ = &AlterSpec{Action: AddIndexStr, Index: $2}
+  }
+| ADD constraint_definition
+  {
+    $$ = &AlterSpec{Action: AddConstraintStr, Constraint: $2}
+  }
+| DROP sql_id
+  {
+    $$ = &AlterSpec{Action: DropColumnStr, Name: $2}
+  }
+| DROP COLUMN sql_id
+  {
+    $$ = &AlterSpec{Action: DropColumnStr, Name: $3}
+  }
+| DROP index_or_key sql_id
+  {
+    $$ = &AlterSpec{Action: DropIndexStr, Name: $3}
+  }
+| DROP PRIMARY KEY
+  {
+    $$ = &AlterSpec{Action: DropPrimaryKeyStr}
+  }
+| DROP FOREIGN KEY sql_id
+  {
+    $$ = &AlterSpec{Action: DropForeignKeyStr, Name: $4}
+  }
+| DROP CHECK sql_id
+  {
+    $$ = &AlterSpec{Action: DropCheckStr, Name: $3}
+  }
+| DROP CONSTRAINT sql_id
+  {
+    $$ = &AlterSpec{Action: DropConstraintStr, Name: $3}
+  }
+| MODIFY column_opt column_definition column_position_opt
+  {
+    $$ = &AlterSpec{Action: ModifyColumnStr, Column: $3, Position: $4}
+  }
+| CHANGE column_opt sql_id column_definition column_position_opt
+  {
+    $$ = &AlterSpec{Action: ChangeColumnStr, Name: $3, Column: $4, Position: $5}
+  }
+| ALTER column_opt sql_id SET DEFAULT value_expression
+  {
+    $$ = &AlterSpec{Action: AlterColumnStr, Name: $3, Default: $6}
+  }
+| ALTER column_opt sql_id DROP DEFAULT
+  {
+    $$ = &AlterSpec{Action: AlterColumnStr, Name: $3}
+  }
+| ALTER INDEX sql_id ID
+  {
+    switch strings.ToLower(string($4)) {
+    case "visible":
+      $$ = &AlterSpec{Action: AlterIndexStr, Name: $3}
+    case "invisible":
+      $$ = &AlterSpec{Action: AlterIndexStr, Name: $3, Invisible: true}
+    default:
+      yylex.Error("syntax error")
+      return 1
+    }
+  }
+| RENAME index_or_key sql_id TO sql_id
+  {
+    $$ = &AlterSpec{Action: RenameIndexStr, Name: $3, NewName: $5}
+  }
+| RENAME COLUMN sql_id TO sql_id
+  {
+    $$ = &AlterSpec{Action: RenameColumnStr, Name: $3, NewName: $5}
+  }
+| RENAME to_opt table_name
+  {
+    $$ = &AlterSpec{Action: RenameTableStr, NewTable: $3}
+  }
+| CONVERT TO charset_or_character_set charset collate_opt
+  {
+    $$ = &AlterSpec{Action: ConvertStr, Charset: $4, Collate: $5}
+  }
+| FORCE
+  {
+    $$ = &AlterSpec{Action: ForceAlterStr}
+  }
+
+// ORDER BY can only be the last operation, because its list
+// of columns is also separated by commas.
+alter_order:
+  ORDER BY order_list
+  {
+    $$ = &AlterSpec{Action: OrderByStr, OrderBy: $3}
+  }
+
+column_position_opt:
+  {
+    $$ = nil
+  }
+| FIRST
+  {
+    $$ = &ColumnPosition{First: true}
+  }
+| AFTER sql_id
+  {
+    $$ = &ColumnPosition{After: $2}
+  }
+
+// The table options of alter statements are restricted to the
+// ones that start with a name, because the keywords that start
+// the other operations are also values of table_option.
+alter_table_option_list:
+  alter_table_option
+  {
+    $$ = AlterSpecs{$1}
+  }
+| alter_table_option_list alter_table_option
+  {
+    $$ = append($1, $2)
+  }
+
+alter_table_option:
+  table_option_name table_opt_value
+  {
+    // The operations that are a name and a value, but not a
+    // table option, have their own actions.
+    switch action := strings.ToLower($1 + " " + $2); {
+    case action == EnableKeysStr, action == DisableKeysStr, action == DiscardTablespaceStr, action == ImportTablespaceStr:
+      $$ = &AlterSpec{Action: action}
+    case !isTableOption($1):
+      yylex.Error("syntax error")
+      return 1
+    default:
+      $$ = &AlterSpec{Action: TableOptionStr, Option: $1, Value: $2}
+    }
+  }
+| table_option_name '=' table_opt_value
+  {
+    if !isTableOption($1) {
+      yylex.Error("syntax error")
+      return 1
+    }
+    $$ = &AlterSpec{Action: TableOptionStr, Option: $1, Value: $3}
+  }
+
+table_option_name:
+  ID
+  {
+    $$ = string($1)
+  }
+| AUTO_INCREMENT
+  {
+    $$ = string($1)
+  }
+| COMMENT_KEYWORD
+  {
+    $$ = string($1)
+  }
+| KEY_BLOCK_SIZE
+  {
+    $$ = string($1)
+  }
+| CHARSET
+  {
+    $$ = string($1)
+  }
+| CHARACTER SET
+  {
+    $$ = string($1) + " " + string($2)
+  }
+| COLLATE
+  {
+    $$ = string($1)
+  }
+| DEFAULT CHARSET
+  {
+    $$ = string($1) + " " + string($2)
+  }
+| DEFAULT CHARACTER SET
+  {
+    $$ = string($1) + " " + string($2) + " " + string($3)
+  }
+| DEFAULT COLLATE
+  {
+    $$ = string($1) + " " + string($2)
+  }
+| LOCK
+  {
+    $$ = string($1)
+  }
+| CONNECTION
+  {
+    $$ = string($1)
+  }
+| DATA ID
+  {
+    $$ = string($1) + " " + string($2)
+  }
+| INDEX ID
+  {
+    $$ = string($1) + " " + string($2)
+  }
+
+partition_operation:
+  REORGANIZE PARTITION sql_id INTO openb partition_definitions closeb
+  {
+    $$ = &PartitionSpec{Action: ReorganizeStr, Name: $3, Definitions: $6}
+  }
+| ADD PARTITION openb partition_definitions closeb
+  {
+    $$ = &PartitionSpec{Action: AddPartitionStr, Definitions: $4}
+  }
+| DROP PARTITION column_list
+  {
+    $$ = &PartitionSpec{Action: DropPartitionStr, Names: $3}
+  }
+| TRUNCATE PARTITION column_list
+  {
+    $$ = &PartitionSpec{Action: TruncatePartitionStr, Names: $3}
+  }
+| COALESCE PARTITION INTEGRAL
+  {
+    $$ = &PartitionSpec{Action: CoalescePartitionStr, Number: NewIntVal($3)}
+  }
+| partition_by
+  {
+    $$ = &PartitionSpec{Action: PartitionByStr, By: $1}
+  }
+
+table_partition_opt:
+  %prec TABLE_OPTIONS
+  {
+    $$ = nil
+  }
+| partition_by
+  {
+    $$ = $1
+  }
+
+partition_by:
+  PARTITION BY partition_method partitions_opt
+  {
+    $$ = $3
+    $$.Partitions = $4
+  }
+| PARTITION BY partition_method partitions_opt openb partition_definitions closeb
+  {
+    $$ = $3
+    $$.Partitions = $4
+    $$.Definitions = $6
+  }
+
+partition_method:
+  ID openb expression closeb
+  {
+    typ := strings.ToLower(string($1))
+    if typ != HashPartitionStr && typ != ListPartitionStr {
+      yylex.Error("syntax error")
+      return 1
+    }
+    $$ = &PartitionBy{Type: typ, Expr: $3}
+  }
+| LINEAR ID openb expression closeb
+  {
+    if strings.ToLower(string($2)) != HashPartitionStr {
+      yylex.Error("syntax error")
+      return 1
+    }
+    $$ = &PartitionBy{Type: HashPartitionStr, Linear: true, Expr: $4}
+  }
+| KEY '(' ')'
+  {
+    $$ = &PartitionBy{Type: KeyPartitionStr}
+  }
+| KEY '(' column_list ')'
+  {
+    $$ = &PartitionBy{Type: KeyPartitionStr, Columns: $3}
+  }
+| LINEAR KEY '(' ')'
+  {
+    $$ = &PartitionBy{Type: KeyPartitionStr, Linear: true}
+  }
+| LINEAR KEY '(' column_list ')'
+  {
+    $$ = &PartitionBy{Type: KeyPartitionStr, Linear: true, Columns: $4}
+  }
+| RANGE openb expression closeb
+  {
+    $$ = &PartitionBy{Type: RangePartitionStr, Expr: $3}
+  }
+| RANGE COLUMNS '(' column_list ')'
+  {
+    $$ = &PartitionBy{Type: RangePartitionStr, Columns: $4, ColumnList: true}
+  }
+| ID COLUMNS '(' column_list ')'
+  {
+    if strings.ToLower(string($1)) != ListPartitionStr {
+      yylex.Error("syntax error")
+      return 1
+    }
+    $$ = &PartitionBy{Type: ListPartitionStr, Columns: $4, ColumnList: true}
+  }
 
-partition_operation:
-  REORGANIZE PARTITION sql_id INTO openb partition_definitions closeb
+partitions_opt:
   {
-    $$ = &PartitionSpec{Action: ReorganizeStr, Name: $3, Definitions: $6}
+    $$ = nil
+  }
+| ID INTEGRAL
+  {
+    if strings.ToLower(string($1)) != "partitions" {
+      yylex.Error("syntax error")
+      return 1
+    }
+    $$ = NewIntVal($2)
   }
 
 partition_definitions:
@@ -1369,13 +1922,38 @@ partition_definitions:
   }
 
 partition_definition:
-  PARTITION sql_id VALUES LESS THAN openb value_expression closeb
+  PARTITION sql_id VALUES LESS THAN openb value_expression closeb partition_engine_opt
+  {
+    $$ = &PartitionDefinition{Name: $2, Limit: $7, Engine: $9}
+  }
+| PARTITION sql_id VALUES LESS THAN openb MAXVALUE closeb partition_engine_opt
+  {
+    $$ = &PartitionDefinition{Name: $2, Maxvalue: true, Engine: $9}
+  }
+| PARTITION sql_id VALUES LESS THAN MAXVALUE partition_engine_opt
+  {
+    $$ = &PartitionDefinition{Name: $2, Maxvalue: true, Engine: $7}
+  }
+| PARTITION sql_id VALUES IN row_tuple partition_engine_opt
+  {
+    $$ = &PartitionDefinition{Name: $2, In: $5, Engine: $6}
+  }
+| PARTITION sql_id partition_engine_opt
+  {
+    $$ = &PartitionDefinition{Name: $2, Engine: $3}
+  }
+
+partition_engine_opt:
   {
-    $$ = &PartitionDefinition{Name: $2, Limit: $7}
+    $$ = ""
   }
-| PARTITION sql_id VALUES LESS THAN openb MAXVALUE closeb
+| ID equal_opt ID
   {
-    $$ = &PartitionDefinition{Name: $2, Maxvalue: true}
+    if strings.ToLower(string($1)) != "engine" {
+      yylex.Error("syntax error")
+      return 1
+    }
+    $$ = string($3)
   }
 
 rename_statement:
@@ -1408,7 +1986,7 @@ drop_statement:
 | DROP INDEX ID ON table_name ddl_skip_to_end
   {
     // Change this to an alter statement
-    $$ = &DDL{Action: AlterStr, Table: $5}
+    $$ = &DDL{Action: AlterStr, Table: $5, AlterSpecs: AlterSpecs{{Action: DropIndexStr, Name: NewColIdent(string($3))}}}
   }
 | DROP VIEW exists_opt table_name ddl_skip_to_end
   {
@@ -1671,6 +2249,167 @@ use_statement:
     $$ = &Use{DBName:TableIdent{v:""}}
   }
 
+kill_statement:
+  KILL kill_type_opt INTEGRAL
+  {
+    id, err := strconv.ParseUint(string($3), 10, 64)
+    if err != nil {
+      yylex.Error("invalid connection id")
+      return 1
+    }
+    $$ = &Kill{Type: $2, ID: id}
+  }
+
+load_statement:
+  LOAD comment_opt DATA load_priority_opt local_opt INFILE STRING load_duplicate_opt INTO TABLE table_name opt_partition_clause charset_opt load_fields_opt load_lines_opt load_ignore_lines_opt load_columns_opt
+  {
+    $$ = &Load{Comments: Comments($2), Priority: $4, Local: $5, File: NewStrVal($7), Duplicate: $8, Table: $11, Partitions: $12, Charset: $13, Fields: $14, Lines: $15, IgnoreLines: $16, Columns: $17}
+  }
+
+load_priority_opt:
+  {
+    $$ = ""
+  }
+| LOW_PRIORITY
+  {
+    $$ = LowPriorityStr
+  }
+| CONCURRENT
+  {
+    $$ = ConcurrentStr
+  }
+
+local_opt:
+  {
+    $$ = ""
+  }
+| LOCAL
+  {
+    $$ = LocalStr
+  }
+
+load_duplicate_opt:
+  {
+    $$ = ""
+  }
+| REPLACE
+  {
+    $$ = LoadReplaceStr
+  }
+| IGNORE
+  {
+    $$ = IgnoreStr
+  }
+
+load_fields_opt:
+  {
+    $$ = nil
+  }
+| columns_or_fields load_field_list
+  {
+    $$ = $2
+  }
+
+load_field_list:
+  TERMINATED BY STRING
+  {
+    $$ = &LoadFields{TerminatedBy: NewStrVal($3)}
+  }
+| ENCLOSED BY STRING
+  {
+    $$ = &LoadFields{EnclosedBy: NewStrVal($3)}
+  }
+| OPTIONALLY ENCLOSED BY STRING
+  {
+    $$ = &LoadFields{Optionally: true, EnclosedBy: NewStrVal($4)}
+  }
+| ESCAPED BY STRING
+  {
+    $$ = &LoadFields{EscapedBy: NewStrVal($3)}
+  }
+| load_field_list TERMINATED BY STRING
+  {
+    $1.TerminatedBy = NewStrVal($4)
+    $$ = $1
+  }
+| load_field_list ENCLOSED BY STRING
+  {
+    $1.Optionally = false
+    $1.EnclosedBy = NewStrVal($4)
+    $$ = $1
+  }
+| load_field_list OPTIONALLY ENCLOSED BY STRING
+  {
+    $1.Optionally = true
+    $1.EnclosedBy = NewStrVal($5)
+    $$ = $1
+  }
+| load_field_list ESCAPED BY STRING
+  {
+    $1.EscapedBy = NewStrVal($4)
+    $$ = $1
+  }
+
+load_lines_opt:
+  {
+    $$ = nil
+  }
+| LINES load_line_list
+  {
+    $$ = $2
+  }
+
+load_line_list:
+  STARTING BY STRING
+  {
+    $$ = &LoadLines{StartingBy: NewStrVal($3)}
+  }
+| TERMINATED BY STRING
+  {
+    $$ = &LoadLines{TerminatedBy: NewStrVal($3)}
+  }
+| load_line_list STARTING BY STRING
+  {
+    $1.StartingBy = NewStrVal($4)
+    $$ = $1
+  }
+| load_line_list TERMINATED BY STRING
+  {
+    $1.TerminatedBy = NewStrVal($4)
+    $$ = $1
+  }
+
+load_ignore_lines_opt:
+  {
+    $$ = nil
+  }
+| IGNORE INTEGRAL LINES
+  {
+    $$ = NewIntVal($2)
+  }
+
+load_columns_opt:
+  {
+    $$ = nil
+  }
+| openb ins_column_list closeb
+  {
+    $$ = $2
+  }
+
+kill_type_opt:
+  {
+    $$ = KillConnectionStr
+  }
+| CONNECTION
+  {
+    $$ = KillConnectionStr
+  }
+| QUERY
+  {
+    $$ = KillQueryStr
+  }
+
 begin_statement:
   BEGIN
   {
@@ -1815,18 +2554,22 @@ select_expression:
   '*'
   {
     $$ = &StarExpr{}
+    setPos(yylex, $$, $<pos>1)
   }
 | expression as_ci_opt
   {
     $$ = &AliasedExpr{Expr: $1, As: $2}
+    setPos(yylex, $$, $<pos>1)
   }
 | table_id '.' '*'
   {
     $$ = &StarExpr{TableName: TableName{Name: $1}}
+    setPos(yylex, $$, $<pos>1)
   }
 | table_id '.' reserved_table_id '.' '*'
   {
     $$ = &StarExpr{TableName: TableName{Qualifier: $1, Name: $3}}
+    setPos(yylex, $$, $<pos>1)
   }
 
 as_ci_opt:
@@ -1876,10 +2619,12 @@ table_factor:
   aliased_table_name
   {
     $$ = $1
+    setPos(yylex, $$, $<pos>1)
   }
 | subquery as_opt table_id
   {
     $$ = &AliasedTableExpr{Expr:$1, As: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | subquery
   {
@@ -1890,16 +2635,102 @@ table_factor:
 | openb table_references closeb
   {
     $$ = &ParenTableExpr{Exprs: $2}
+    setPos(yylex, $$, $<pos>1)
+  }
+| JSON_TABLE openb expression ',' STRING json_table_columns closeb as_opt table_id
+  {
+    $$ = &JSONTableExpr{Expr: $3, Path: NewStrVal($5), Columns: $6, As: $9}
+    setPos(yylex, $$, $<pos>1)
+  }
+| JSON_TABLE openb expression ',' STRING json_table_columns closeb
+  {
+    yylex.Error("Every table function must have an alias")
+    return 1
+  }
+
+json_table_columns:
+  COLUMNS openb json_table_column_list closeb
+  {
+    $$ = $3
+  }
+
+json_table_column_list:
+  json_table_column
+  {
+    $$ = JSONTableColumns{$1}
+  }
+| json_table_column_list ',' json_table_column
+  {
+    $$ = append($$, $3)
+  }
+
+json_table_column:
+  sql_id FOR ORDINALITY
+  {
+    $$ = &JSONTableColumn{Kind: JSONTableOrdinalityStr, Name: $1}
+  }
+| sql_id column_type PATH STRING json_table_on_opt
+  {
+    $$ = $5
+    $$.Kind = JSONTablePathStr
+    $$.Name = $1
+    $$.Type = $2
+    $$.Path = NewStrVal($4)
+  }
+| sql_id column_type EXISTS PATH STRING
+  {
+    $$ = &JSONTableColumn{Kind: JSONTableExistsStr, Name: $1, Type: $2, Path: NewStrVal($5)}
+  }
+| NESTED STRING json_table_columns
+  {
+    $$ = &JSONTableColumn{Kind: JSONTableNestedStr, Path: NewStrVal($2), Nested: $3}
+  }
+| NESTED PATH STRING json_table_columns
+  {
+    $$ = &JSONTableColumn{Kind: JSONTableNestedStr, Path: NewStrVal($3), Nested: $4}
+  }
+
+json_table_on_opt:
+  {
+    $$ = &JSONTableColumn{}
+  }
+| json_table_on_response ON EMPTY
+  {
+    $$ = &JSONTableColumn{OnEmpty: $1}
+  }
+| json_table_on_response ON ERROR
+  {
+    $$ = &JSONTableColumn{OnError: $1}
+  }
+| json_table_on_response ON EMPTY json_table_on_response ON ERROR
+  {
+    $$ = &JSONTableColumn{OnEmpty: $1, OnError: $4}
+  }
+
+json_table_on_response:
+  NULL
+  {
+    $$ = &JSONTableOnResponse{Type: JSONResponseNullStr}
+  }
+| ERROR
+  {
+    $$ = &JSONTableOnResponse{Type: JSONResponseErrorStr}
+  }
+| DEFAULT STRING
+  {
+    $$ = &JSONTableOnResponse{Type: JSONResponseDefaultStr, Default: NewStrVal($2)}
   }
 
 aliased_table_name:
 table_name as_opt_id index_hint_list
   {
     $$ = &AliasedTableExpr{Expr:$1, As: $2, Hints: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | table_name PARTITION openb partition_list closeb as_opt_id index_hint_list
   {
     $$ = &AliasedTableExpr{Expr:$1, Partitions: $4, As: $6, Hints: $7}
+    setPos(yylex, $$, $<pos>1)
   }
 
 column_list:
@@ -1933,18 +2764,22 @@ join_table:
   table_reference inner_join table_factor join_condition_opt
   {
     $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3, Condition: $4}
+    setPos(yylex, $$, $<pos>1)
   }
 | table_reference straight_join table_factor on_expression_opt
   {
     $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3, Condition: $4}
+    setPos(yylex, $$, $<pos>1)
   }
 | table_reference outer_join table_reference join_condition
   {
     $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3, Condition: $4}
+    setPos(yylex, $$, $<pos>1)
   }
 | table_reference natural_join table_factor
   {
     $$ = &JoinTableExpr{LeftExpr: $1, Join: $2, RightExpr: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 
 join_condition:
@@ -2092,30 +2927,37 @@ expression:
   condition
   {
     $$ = $1
+    setPos(yylex, $$, $<pos>1)
   }
 | expression AND expression
   {
     $$ = &AndExpr{Left: $1, Right: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | expression OR expression
   {
     $$ = &OrExpr{Left: $1, Right: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | NOT expression
   {
     $$ = &NotExpr{Expr: $2}
+    setPos(yylex, $$, $<pos>1)
   }
 | expression IS is_suffix
   {
     $$ = &IsExpr{Operator: $3, Expr: $1}
+    setPos(yylex, $$, $<pos>1)
   }
 | value_expression
   {
     $$ = $1
+    setPos(yylex, $$, $<pos>1)
   }
 | DEFAULT default_opt
   {
     $$ = &Default{ColName: $2}
+    setPos(yylex, $$, $<pos>1)
   }
 
 default_opt:
@@ -2142,42 +2984,52 @@ condition:
   value_expression compare value_expression
   {
     $$ = &ComparisonExpr{Left: $1, Operator: $2, Right: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | value_expression IN col_tuple
   {
     $$ = &ComparisonExpr{Left: $1, Operator: InStr, Right: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | value_expression NOT IN col_tuple
   {
     $$ = &ComparisonExpr{Left: $1, Operator: NotInStr, Right: $4}
+    setPos(yylex, $$, $<pos>1)
   }
 | value_expression LIKE value_expression like_escape_opt
   {
     $$ = &ComparisonExpr{Left: $1, Operator: LikeStr, Right: $3, Escape: $4}
+    setPos(yylex, $$, $<pos>1)
   }
 | value_expression NOT LIKE value_expression like_escape_opt
   {
     $$ = &ComparisonExpr{Left: $1, Operator: NotLikeStr, Right: $4, Escape: $5}
+    setPos(yylex, $$, $<pos>1)
   }
 | value_expression REGEXP value_expression
   {
     $$ = &ComparisonExpr{Left: $1, Operator: RegexpStr, Right: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | value_expression NOT REGEXP value_expression
   {
     $$ = &ComparisonExpr{Left: $1, Operator: NotRegexpStr, Right: $4}
+    setPos(yylex, $$, $<pos>1)
   }
 | value_expression BETWEEN value_expression AND value_expression
   {
     $$ = &RangeCond{Left: $1, Operator: BetweenStr, From: $3, To: $5}
+    setPos(yylex, $$, $<pos>1)
   }
 | value_expression NOT BETWEEN value_expression AND value_expression
   {
     $$ = &RangeCond{Left: $1, Operator: NotBetweenStr, From: $4, To: $6}
+    setPos(yylex, $$, $<pos>1)
   }
 | EXISTS subquery
   {
     $$ = &ExistsExpr{Subquery: $2}
+    setPos(yylex, $$, $<pos>1)
   }
 
 is_suffix:
@@ -2263,6 +3115,12 @@ subquery:
   openb select_statement closeb
   {
     $$ = &Subquery{$2}
+    setPos(yylex, $$, $<pos>1)
+  }
+| openb with_select_statement closeb
+  {
+    $$ = &Subquery{$2}
+    setPos(yylex, $$, $<pos>1)
   }
 
 expression_list:
@@ -2279,94 +3137,117 @@ value_expression:
   value
   {
     $$ = $1
+    setPos(yylex, $$, $<pos>1)
   }
 | boolean_value
   {
     $$ = $1
+    setPos(yylex, $$, $<pos>1)
   }
 | column_name
   {
     $$ = $1
+    setPos(yylex, $$, $<pos>1)
   }
 | tuple_expression
   {
     $$ = $1
+    setPos(yylex, $$, $<pos>1)
   }
 | subquery
   {
     $$ = $1
+    setPos(yylex, $$, $<pos>1)
   }
 | value_expression '&' value_expression
   {
     $$ = &BinaryExpr{Left: $1, Operator: BitAndStr, Right: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | value_expression '|' value_expression
   {
     $$ = &BinaryExpr{Left: $1, Operator: BitOrStr, Right: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | value_expression '^' value_expression
   {
     $$ = &BinaryExpr{Left: $1, Operator: BitXorStr, Right: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | value_expression '+' value_expression
   {
     $$ = &BinaryExpr{Left: $1, Operator: PlusStr, Right: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | value_expression '-' value_expression
   {
     $$ = &BinaryExpr{Left: $1, Operator: MinusStr, Right: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | value_expression '*' value_expression
   {
     $$ = &BinaryExpr{Left: $1, Operator: MultStr, Right: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | value_expression '/' value_expression
   {
     $$ = &BinaryExpr{Left: $1, Operator: DivStr, Right: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | value_expression DIV value_expression
   {
     $$ = &BinaryExpr{Left: $1, Operator: IntDivStr, Right: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | value_expression '%' value_expression
   {
     $$ = &BinaryExpr{Left: $1, Operator: ModStr, Right: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | value_expression MOD value_expression
   {
     $$ = &BinaryExpr{Left: $1, Operator: ModStr, Right: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | value_expression SHIFT_LEFT value_expression
   {
     $$ = &BinaryExpr{Left: $1, Operator: ShiftLeftStr, Right: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | value_expression SHIFT_RIGHT value_expression
   {
     $$ = &BinaryExpr{Left: $1, Operator: ShiftRightStr, Right: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | column_name JSON_EXTRACT_OP value
   {
-    $$ = &BinaryExpr{Left: $1, Operator: JSONExtractOp, Right: $3}
+    $$ = &JSONExtractExpr{Column: $1, Operator: JSONExtractOp, Path: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | column_name JSON_UNQUOTE_EXTRACT_OP value
   {
-    $$ = &BinaryExpr{Left: $1, Operator: JSONUnquoteExtractOp, Right: $3}
+    $$ = &JSONExtractExpr{Column: $1, Operator: JSONUnquoteExtractOp, Path: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | value_expression COLLATE charset
   {
     $$ = &CollateExpr{Expr: $1, Charset: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | BINARY value_expression %prec UNARY
   {
     $$ = &UnaryExpr{Operator: BinaryStr, Expr: $2}
+    setPos(yylex, $$, $<pos>1)
   }
 | UNDERSCORE_BINARY value_expression %prec UNARY
   {
     $$ = &UnaryExpr{Operator: UBinaryStr, Expr: $2}
+    setPos(yylex, $$, $<pos>1)
   }
 | UNDERSCORE_UTF8MB4 value_expression %prec UNARY
   {
     $$ = &UnaryExpr{Operator: Utf8mb4Str, Expr: $2}
+    setPos(yylex, $$, $<pos>1)
   }
 | '+'  value_expression %prec UNARY
   {
@@ -2375,6 +3256,7 @@ value_expression:
     } else {
       $$ = &UnaryExpr{Operator: UPlusStr, Expr: $2}
     }
+    setPos(yylex, $$, $<pos>1)
   }
 | '-'  value_expression %prec UNARY
   {
@@ -2389,14 +3271,17 @@ value_expression:
     } else {
       $$ = &UnaryExpr{Operator: UMinusStr, Expr: $2}
     }
+    setPos(yylex, $$, $<pos>1)
   }
 | '~'  value_expression
   {
     $$ = &UnaryExpr{Operator: TildaStr, Expr: $2}
+    setPos(yylex, $$, $<pos>1)
   }
 | '!' value_expression %prec UNARY
   {
     $$ = &UnaryExpr{Operator: BangStr, Expr: $2}
+    setPos(yylex, $$, $<pos>1)
   }
 | INTERVAL value_expression sql_id
   {
@@ -2405,6 +3290,7 @@ value_expression:
     // we'll need to revisit this. The solution
     // will be non-trivial because of grammar conflicts.
     $$ = &IntervalExpr{Expr: $2, Unit: $3.String()}
+    setPos(yylex, $$, $<pos>1)
   }
 | function_call_generic
 | function_call_keyword
@@ -2416,17 +3302,20 @@ value_expression:
   introduce side effects due to being a simple identifier
 */
 function_call_generic:
-  sql_id openb select_expression_list_opt closeb
+  sql_id openb select_expression_list_opt closeb over_clause_opt
   {
-    $$ = &FuncExpr{Name: $1, Exprs: $3}
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $5}
+    setPos(yylex, $$, $<pos>1)
   }
 | sql_id openb DISTINCT select_expression_list closeb
   {
     $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4}
+    setPos(yylex, $$, $<pos>1)
   }
 | table_id '.' reserved_sql_id openb select_expression_list_opt closeb
   {
     $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
+    setPos(yylex, $$, $<pos>1)
   }
 
 /*
@@ -2437,54 +3326,67 @@ function_call_keyword:
   LEFT openb select_expression_list closeb
   {
     $$ = &FuncExpr{Name: NewColIdent("left"), Exprs: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | RIGHT openb select_expression_list closeb
   {
     $$ = &FuncExpr{Name: NewColIdent("right"), Exprs: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | CONVERT openb expression ',' convert_type closeb
   {
     $$ = &ConvertExpr{Expr: $3, Type: $5}
+    setPos(yylex, $$, $<pos>1)
   }
 | CAST openb expression AS convert_type closeb
   {
     $$ = &ConvertExpr{Expr: $3, Type: $5}
+    setPos(yylex, $$, $<pos>1)
   }
 | CONVERT openb expression USING charset closeb
   {
     $$ = &ConvertUsingExpr{Expr: $3, Type: $5}
+    setPos(yylex, $$, $<pos>1)
   }
 | SUBSTR openb column_name FROM value_expression FOR value_expression closeb
   {
     $$ = &SubstrExpr{Name: $3, From: $5, To: $7}
+    setPos(yylex, $$, $<pos>1)
   }
 | SUBSTRING openb column_name FROM value_expression FOR value_expression closeb
   {
     $$ = &SubstrExpr{Name: $3, From: $5, To: $7}
+    setPos(yylex, $$, $<pos>1)
   }
 | SUBSTR openb STRING FROM value_expression FOR value_expression closeb
   {
     $$ = &SubstrExpr{StrVal: NewStrVal($3), From: $5, To: $7}
+    setPos(yylex, $$, $<pos>1)
   }
 | SUBSTRING openb STRING FROM value_expression FOR value_expression closeb
   {
     $$ = &SubstrExpr{StrVal: NewStrVal($3), From: $5, To: $7}
+    setPos(yylex, $$, $<pos>1)
   }
 | MATCH openb select_expression_list closeb AGAINST openb value_expression match_option closeb
   {
   $$ = &MatchExpr{Columns: $3, Expr: $7, Option: $8}
+    setPos(yylex, $$, $<pos>1)
   }
 | GROUP_CONCAT openb distinct_opt select_expression_list order_by_opt separator_opt closeb
   {
     $$ = &GroupConcatExpr{Distinct: $3, Exprs: $4, OrderBy: $5, Separator: $6}
+    setPos(yylex, $$, $<pos>1)
   }
 | CASE expression_opt when_expression_list else_expression_opt END
   {
     $$ = &CaseExpr{Expr: $2, Whens: $3, Else: $4}
+    setPos(yylex, $$, $<pos>1)
   }
 | VALUES openb column_name closeb
   {
     $$ = &ValuesFuncExpr{Name: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 
 /*
@@ -2495,76 +3397,92 @@ function_call_nonkeyword:
   CURRENT_TIMESTAMP func_datetime_opt
   {
     $$ = &FuncExpr{Name:NewColIdent("current_timestamp")}
+    setPos(yylex, $$, $<pos>1)
   }
 | UTC_TIMESTAMP func_datetime_opt
   {
     $$ = &FuncExpr{Name:NewColIdent("utc_timestamp")}
+    setPos(yylex, $$, $<pos>1)
   }
 | UTC_TIME func_datetime_opt
   {
     $$ = &FuncExpr{Name:NewColIdent("utc_time")}
+    setPos(yylex, $$, $<pos>1)
   }
 /* doesn't support fsp */
 | UTC_DATE func_datetime_opt
   {
     $$ = &FuncExpr{Name:NewColIdent("utc_date")}
+    setPos(yylex, $$, $<pos>1)
   }
   // now
 | LOCALTIME func_datetime_opt
   {
     $$ = &FuncExpr{Name:NewColIdent("localtime")}
+    setPos(yylex, $$, $<pos>1)
   }
   // now
 | LOCALTIMESTAMP func_datetime_opt
   {
     $$ = &FuncExpr{Name:NewColIdent("localtimestamp")}
+    setPos(yylex, $$, $<pos>1)
   }
   // curdate
 /* doesn't support fsp */
 | CURRENT_DATE func_datetime_opt
   {
     $$ = &FuncExpr{Name:NewColIdent("current_date")}
+    setPos(yylex, $$, $<pos>1)
   }
   // curtime
 | CURRENT_TIME func_datetime_opt
   {
     $$ = &FuncExpr{Name:NewColIdent("current_time")}
+    setPos(yylex, $$, $<pos>1)
   }
 // these functions can also be called with an optional argument
 |  CURRENT_TIMESTAMP func_datetime_precision
   {
     $$ = &CurTimeFuncExpr{Name:NewColIdent("current_timestamp"), Fsp:$2}
+    setPos(yylex, $$, $<pos>1)
   }
 | UTC_TIMESTAMP func_datetime_precision
   {
     $$ = &CurTimeFuncExpr{Name:NewColIdent("utc_timestamp"), Fsp:$2}
+    setPos(yylex, $$, $<pos>1)
   }
 | UTC_TIME func_datetime_precision
   {
     $$ = &CurTimeFuncExpr{Name:NewColIdent("utc_time"), Fsp:$2}
+    setPos(yylex, $$, $<pos>1)
   }
   // now
 | LOCALTIME func_datetime_precision
   {
     $$ = &CurTimeFuncExpr{Name:NewColIdent("localtime"), Fsp:$2}
+    setPos(yylex, $$, $<pos>1)
   }
   // now
 | LOCALTIMESTAMP func_datetime_precision
   {
     $$ = &CurTimeFuncExpr{Name:NewColIdent("localtimestamp"), Fsp:$2}
+    setPos(yylex, $$, $<pos>1)
   }
   // curtime
 | CURRENT_TIME func_datetime_precision
   {
     $$ = &CurTimeFuncExpr{Name:NewColIdent("current_time"), Fsp:$2}
+    setPos(yylex, $$, $<pos>1)
   }
 | TIMESTAMPADD openb sql_id ',' value_expression ',' value_expression closeb
   {
     $$ = &TimestampFuncExpr{Name:string("timestampadd"), Unit:$3.String(), Expr1:$5, Expr2:$7}
+    setPos(yylex, $$, $<pos>1)
   }
 | TIMESTAMPDIFF openb sql_id ',' value_expression ',' value_expression closeb
   {
     $$ = &TimestampFuncExpr{Name:string("timestampdiff"), Unit:$3.String(), Expr1:$5, Expr2:$7}
+    setPos(yylex, $$, $<pos>1)
   }
 
 func_datetime_opt:
@@ -2585,26 +3503,109 @@ function_call_conflict:
   IF openb select_expression_list closeb
   {
     $$ = &FuncExpr{Name: NewColIdent("if"), Exprs: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | DATABASE openb select_expression_list_opt closeb
   {
     $$ = &FuncExpr{Name: NewColIdent("database"), Exprs: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | MOD openb select_expression_list closeb
   {
     $$ = &FuncExpr{Name: NewColIdent("mod"), Exprs: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | REPLACE openb select_expression_list closeb
   {
     $$ = &FuncExpr{Name: NewColIdent("replace"), Exprs: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | SUBSTR openb select_expression_list closeb
   {
     $$ = &FuncExpr{Name: NewColIdent("substr"), Exprs: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | SUBSTRING openb select_expression_list closeb
   {
     $$ = &FuncExpr{Name: NewColIdent("substr"), Exprs: $3}
+    setPos(yylex, $$, $<pos>1)
+  }
+
+over_clause_opt:
+  {
+    $$ = nil
+  }
+| OVER sql_id
+  {
+    $$ = &OverClause{WindowName: $2}
+  }
+| OVER openb window_spec closeb
+  {
+    $$ = &OverClause{WindowSpec: $3}
+  }
+
+window_spec:
+  partition_by_opt order_by_opt frame_clause_opt
+  {
+    $$ = &WindowSpec{PartitionBy: $1, OrderBy: $2, Frame: $3}
+  }
+| sql_id partition_by_opt order_by_opt frame_clause_opt
+  {
+    $$ = &WindowSpec{Name: $1, PartitionBy: $2, OrderBy: $3, Frame: $4}
+  }
+
+partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
+frame_clause_opt:
+  {
+    $$ = nil
+  }
+| frame_unit frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $2}
+  }
+| frame_unit BETWEEN frame_point AND frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $3, End: $5}
+  }
+
+frame_unit:
+  ROWS
+  {
+    $$ = RowsStr
+  }
+| RANGE
+  {
+    $$ = RangeStr
+  }
+
+frame_point:
+  CURRENT ROW
+  {
+    $$ = &FramePoint{Type: CurrentRowStr}
+  }
+| UNBOUNDED PRECEDING
+  {
+    $$ = &FramePoint{Type: UnboundedPrecedingStr}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$ = &FramePoint{Type: UnboundedFollowingStr}
+  }
+| value_expression PRECEDING
+  {
+    $$ = &FramePoint{Type: PrecedingStr, Expr: $1}
+  }
+| value_expression FOLLOWING
+  {
+    $$ = &FramePoint{Type: FollowingStr, Expr: $1}
   }
 
 match_option:
@@ -2742,48 +3743,59 @@ column_name:
   sql_id
   {
     $$ = &ColName{Name: $1}
+    setPos(yylex, $$, $<pos>1)
   }
 | table_id '.' reserved_sql_id
   {
     $$ = &ColName{Qualifier: TableName{Name: $1}, Name: $3}
+    setPos(yylex, $$, $<pos>1)
   }
 | table_id '.' reserved_table_id '.' reserved_sql_id
   {
     $$ = &ColName{Qualifier: TableName{Qualifier: $1, Name: $3}, Name: $5}
+    setPos(yylex, $$, $<pos>1)
   }
 
 value:
   STRING
   {
     $$ = NewStrVal($1)
+    setPos(yylex, $$, $<pos>1)
   }
 | HEX
   {
     $$ = NewHexVal($1)
+    setPos(yylex, $$, $<pos>1)
   }
 | BIT_LITERAL
   {
     $$ = NewBitVal($1)
+    setPos(yylex, $$, $<pos>1)
   }
 | INTEGRAL
   {
     $$ = NewIntVal($1)
+    setPos(yylex, $$, $<pos>1)
   }
 | FLOAT
   {
     $$ = NewFloatVal($1)
+    setPos(yylex, $$, $<pos>1)
   }
 | HEXNUM
   {
     $$ = NewHexNum($1)
+    setPos(yylex, $$, $<pos>1)
   }
 | VALUE_ARG
   {
     $$ = NewValArg($1)
+    setPos(yylex, $$, $<pos>1)
   }
 | NULL
   {
     $$ = &NullVal{}
+    setPos(yylex, $$, $<pos>1)
   }
 
 num_val:
@@ -2823,6 +3835,31 @@ having_opt:
     $$ = $2
   }
 
+window_opt:
+  {
+    $$ = nil
+  }
+| WINDOW window_definition_list
+  {
+    $$ = $2
+  }
+
+window_definition_list:
+  window_definition
+  {
+    $$ = WindowDefs{$1}
+  }
+| window_definition_list ',' window_definition
+  {
+    $$ = append($1, $3)
+  }
+
+window_definition:
+  sql_id AS openb window_spec closeb
+  {
+    $$ = &WindowDef{Name: $1, WindowSpec: $4}
+  }
+
 order_by_opt:
   {
     $$ = nil
@@ -3074,28 +4111,6 @@ ignore_opt:
 | IGNORE
   { $$ = IgnoreStr }
 
-non_add_drop_or_rename_operation:
-  ALTER
-  { $$ = struct{}{} }
-| AUTO_INCREMENT
-  { $$ = struct{}{} }
-| CHARACTER
-  { $$ = struct{}{} }
-| COMMENT_KEYWORD
-  { $$ = struct{}{} }
-| DEFAULT
-  { $$ = struct{}{} }
-| ORDER
-  { $$ = struct{}{} }
-| CONVERT
-  { $$ = struct{}{} }
-| PARTITION
-  { $$ = struct{}{} }
-| UNUSED
-  { $$ = struct{}{} }
-| ID
-  { $$ = struct{}{} }
-
 to_opt:
   { $$ = struct{}{} }
 | TO
@@ -3103,10 +4118,9 @@ to_opt:
 | AS
   { $$ = struct{}{} }
 
-index_opt:
-  INDEX
+column_opt:
   { $$ = struct{}{} }
-| KEY
+| COLUMN
   { $$ = struct{}{} }
 
 constraint_opt:
@@ -3174,6 +4188,8 @@ reserved_keyword:
 | BINARY
 | BY
 | CASE
+| CHANGE
+| CHECK
 | COLLATE
 | CONVERT
 | CREATE
@@ -3213,6 +4229,7 @@ reserved_keyword:
 | INTO
 | IS
 | JOIN
+| JSON_TABLE
 | KEY
 | LEFT
 | LIKE
@@ -3232,10 +4249,14 @@ reserved_keyword:
 | OR
 | ORDER
 | OUTER
+| OVER
+| RANGE
+| RECURSIVE
 | REGEXP
 | RENAME
 | REPLACE
 | RIGHT
+| ROWS
 | SCHEMA
 | SELECT
 | SEPARATOR
@@ -3260,6 +4281,8 @@ reserved_keyword:
 | VALUES
 | WHEN
 | WHERE
+| WINDOW
+| WITH
 
 /*
   These are non-reserved Vitess, because they don't cause conflicts in the grammar.
@@ -3271,6 +4294,7 @@ reserved_keyword:
 non_reserved_keyword:
   AGAINST
 | ACTION
+| AFTER
 | BEGIN
 | BIGINT
 | BIT
@@ -3281,58 +4305,83 @@ non_reserved_keyword:
 | CHAR
 | CHARACTER
 | CHARSET
+| COALESCE
 | COLLATION
 | COLUMNS
 | COMMENT_KEYWORD
 | COMMIT
 | COMMITTED
+| CONCURRENT
+| CONNECTION
+| CURRENT
+| DATA
 | DATE
 | DATETIME
 | DECIMAL
 | DOUBLE
 | DUPLICATE
+| EMPTY
+| ENCLOSED
 | ENGINES
 | ENUM
+| ERROR
+| ESCAPED
 | EXPANSION
 | FLOAT_TYPE
 | FIELDS
+| FIRST
 | FLUSH
+| FOLLOWING
 | FOREIGN
 | FULLTEXT
+| GENERATED
 | GEOMETRY
 | GEOMETRYCOLLECTION
 | GLOBAL
+| INFILE
 | INT
 | INTEGER
 | ISOLATION
 | JSON
 | KEY_BLOCK_SIZE
 | KEYS
+| KILL
 | LANGUAGE
 | LAST_INSERT_ID
 | LESS
 | LEVEL
+| LINEAR
+| LINES
 | LINESTRING
+| LOAD
+| LOCAL
 | LONGBLOB
 | LONGTEXT
+| LOW_PRIORITY
 | MEDIUMBLOB
 | MEDIUMINT
 | MEDIUMTEXT
 | MODE
+| MODIFY
 | MULTILINESTRING
 | MULTIPOINT
 | MULTIPOLYGON
 | NAMES
 | NCHAR
+| NESTED
 | NO
 | NUMERIC
 | OFFSET
 | ONLY
 | OPTIMIZE
+| OPTIONALLY
+| ORDINALITY
 | PARTITION
+| PATH
 | PLUGINS
 | POINT
 | POLYGON
+| PRECEDING
 | PRIMARY
 | PROCEDURE
 | QUERY
@@ -3344,6 +4393,7 @@ non_reserved_keyword:
 | REPEATABLE
 | RESTRICT
 | ROLLBACK
+| ROW
 | SCHEMAS
 | SESSION
 | SERIALIZABLE
@@ -3352,9 +4402,12 @@ non_reserved_keyword:
 | SMALLINT
 | SPATIAL
 | START
+| STARTING
 | STATUS
+| STORED
 | TABLES
 | TEXT
+| TERMINATED
 | THAN
 | TIME
 | TIMESTAMP
@@ -3364,6 +4417,7 @@ non_reserved_keyword:
 | TRANSACTION
 | TRIGGER
 | TRUNCATE
+| UNBOUNDED
 | UNCOMMITTED
 | UNSIGNED
 | UNUSED
@@ -3373,6 +4427,7 @@ non_reserved_keyword:
 | VIEW
 | VINDEX
 | VINDEXES
+| VIRTUAL
 | VITESS_KEYSPACES
 | VITESS_SHARDS
 | VITESS_TABLETS
@@ -3380,7 +4435,6 @@ non_reserved_keyword:
 | VSCHEMA_TABLES
 | VITESS_TARGET
 | WARNINGS
-| WITH
 | WRITE
 | YEAR
 | ZEROFILL
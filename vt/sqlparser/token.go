@@ -49,6 +49,15 @@ type Tokenizer struct {
 	multi               bool
 	specialComment      *Tokenizer
 
+	// positions is set to track the positions of the nodes.
+	positions Positions
+	parser    *yyParserImpl
+	// tokenStart is the offset of the last token, and lastEnd and
+	// prevEnd are the offsets after the last two tokens.
+	tokenStart int
+	lastEnd    int
+	prevEnd    int
+
 	buf     []byte
 	bufPos  int
 	bufSize int
@@ -87,6 +96,7 @@ var keywords = map[string]int{
 	"accessible":          UNUSED,
 	"action":              ACTION,
 	"add":                 ADD,
+	"after":               AFTER,
 	"against":             AGAINST,
 	"all":                 ALL,
 	"alter":               ALTER,
@@ -113,11 +123,12 @@ var keywords = map[string]int{
 	"cascade":             CASCADE,
 	"case":                CASE,
 	"cast":                CAST,
-	"change":              UNUSED,
+	"change":              CHANGE,
 	"char":                CHAR,
 	"character":           CHARACTER,
 	"charset":             CHARSET,
-	"check":               UNUSED,
+	"check":               CHECK,
+	"coalesce":            COALESCE,
 	"collate":             COLLATE,
 	"collation":           COLLATION,
 	"column":              COLUMN,
@@ -125,7 +136,9 @@ var keywords = map[string]int{
 	"comment":             COMMENT_KEYWORD,
 	"committed":           COMMITTED,
 	"commit":              COMMIT,
+	"concurrent":          CONCURRENT,
 	"condition":           UNUSED,
+	"connection":          CONNECTION,
 	"constraint":          CONSTRAINT,
 	"continue":            UNUSED,
 	"convert":             CONVERT,
@@ -133,11 +146,13 @@ var keywords = map[string]int{
 	"substring":           SUBSTRING,
 	"create":              CREATE,
 	"cross":               CROSS,
+	"current":             CURRENT,
 	"current_date":        CURRENT_DATE,
 	"current_time":        CURRENT_TIME,
 	"current_timestamp":   CURRENT_TIMESTAMP,
 	"current_user":        UNUSED,
 	"cursor":              UNUSED,
+	"data":                DATA,
 	"database":            DATABASE,
 	"databases":           DATABASES,
 	"day_hour":            UNUSED,
@@ -164,12 +179,14 @@ var keywords = map[string]int{
 	"each":                UNUSED,
 	"else":                ELSE,
 	"elseif":              UNUSED,
-	"enclosed":            UNUSED,
+	"empty":               EMPTY,
+	"enclosed":            ENCLOSED,
 	"end":                 END,
 	"engines":             ENGINES,
 	"enum":                ENUM,
+	"error":               ERROR,
 	"escape":              ESCAPE,
-	"escaped":             UNUSED,
+	"escaped":             ESCAPED,
 	"exists":              EXISTS,
 	"exit":                UNUSED,
 	"explain":             EXPLAIN,
@@ -177,17 +194,19 @@ var keywords = map[string]int{
 	"false":               FALSE,
 	"fetch":               UNUSED,
 	"fields":              FIELDS,
+	"first":               FIRST,
 	"float":               FLOAT_TYPE,
 	"float4":              UNUSED,
 	"float8":              UNUSED,
 	"flush":               FLUSH,
+	"following":           FOLLOWING,
 	"for":                 FOR,
 	"force":               FORCE,
 	"foreign":             FOREIGN,
 	"from":                FROM,
 	"full":                FULL,
 	"fulltext":            FULLTEXT,
-	"generated":           UNUSED,
+	"generated":           GENERATED,
 	"geometry":            GEOMETRY,
 	"geometrycollection":  GEOMETRYCOLLECTION,
 	"get":                 UNUSED,
@@ -204,7 +223,7 @@ var keywords = map[string]int{
 	"ignore":              IGNORE,
 	"in":                  IN,
 	"index":               INDEX,
-	"infile":              UNUSED,
+	"infile":              INFILE,
 	"inout":               UNUSED,
 	"inner":               INNER,
 	"insensitive":         UNUSED,
@@ -224,10 +243,11 @@ var keywords = map[string]int{
 	"iterate":             UNUSED,
 	"join":                JOIN,
 	"json":                JSON,
+	"json_table":          JSON_TABLE,
 	"key":                 KEY,
 	"keys":                KEYS,
 	"key_block_size":      KEY_BLOCK_SIZE,
-	"kill":                UNUSED,
+	"kill":                KILL,
 	"language":            LANGUAGE,
 	"last_insert_id":      LAST_INSERT_ID,
 	"leading":             UNUSED,
@@ -237,10 +257,11 @@ var keywords = map[string]int{
 	"level":               LEVEL,
 	"like":                LIKE,
 	"limit":               LIMIT,
-	"linear":              UNUSED,
-	"lines":               UNUSED,
+	"linear":              LINEAR,
+	"lines":               LINES,
 	"linestring":          LINESTRING,
-	"load":                UNUSED,
+	"load":                LOAD,
+	"local":               LOCAL,
 	"localtime":           LOCALTIME,
 	"localtimestamp":      LOCALTIMESTAMP,
 	"lock":                LOCK,
@@ -248,7 +269,7 @@ var keywords = map[string]int{
 	"longblob":            LONGBLOB,
 	"longtext":            LONGTEXT,
 	"loop":                UNUSED,
-	"low_priority":        UNUSED,
+	"low_priority":        LOW_PRIORITY,
 	"master_bind":         UNUSED,
 	"match":               MATCH,
 	"maxvalue":            MAXVALUE,
@@ -261,12 +282,14 @@ var keywords = map[string]int{
 	"mod":                 MOD,
 	"mode":                MODE,
 	"modifies":            UNUSED,
+	"modify":              MODIFY,
 	"multilinestring":     MULTILINESTRING,
 	"multipoint":          MULTIPOINT,
 	"multipolygon":        MULTIPOLYGON,
 	"names":               NAMES,
 	"natural":             NATURAL,
 	"nchar":               NCHAR,
+	"nested":              NESTED,
 	"next":                NEXT,
 	"no":                  NO,
 	"not":                 NOT,
@@ -280,26 +303,31 @@ var keywords = map[string]int{
 	"optimize":            OPTIMIZE,
 	"optimizer_costs":     UNUSED,
 	"option":              UNUSED,
-	"optionally":          UNUSED,
+	"optionally":          OPTIONALLY,
 	"or":                  OR,
 	"order":               ORDER,
+	"ordinality":          ORDINALITY,
 	"out":                 UNUSED,
 	"outer":               OUTER,
 	"outfile":             UNUSED,
+	"over":                OVER,
 	"partition":           PARTITION,
+	"path":                PATH,
 	"plugins":             PLUGINS,
 	"point":               POINT,
 	"polygon":             POLYGON,
+	"preceding":           PRECEDING,
 	"precision":           UNUSED,
 	"primary":             PRIMARY,
 	"processlist":         PROCESSLIST,
 	"procedure":           PROCEDURE,
 	"query":               QUERY,
-	"range":               UNUSED,
+	"range":               RANGE,
 	"read":                READ,
 	"reads":               UNUSED,
 	"read_write":          UNUSED,
 	"real":                REAL,
+	"recursive":           RECURSIVE,
 	"references":          REFERENCES,
 	"regexp":              REGEXP,
 	"release":             UNUSED,
@@ -317,6 +345,8 @@ var keywords = map[string]int{
 	"right":               RIGHT,
 	"rlike":               REGEXP,
 	"rollback":            ROLLBACK,
+	"row":                 ROW,
+	"rows":                ROWS,
 	"schema":              SCHEMA,
 	"schemas":             SCHEMAS,
 	"second_microsecond":  UNUSED,
@@ -344,14 +374,14 @@ var keywords = map[string]int{
 	"sql_small_result":    UNUSED,
 	"ssl":                 UNUSED,
 	"start":               START,
-	"starting":            UNUSED,
+	"starting":            STARTING,
 	"status":              STATUS,
-	"stored":              UNUSED,
+	"stored":              STORED,
 	"straight_join":       STRAIGHT_JOIN,
 	"stream":              STREAM,
 	"table":               TABLE,
 	"tables":              TABLES,
-	"terminated":          UNUSED,
+	"terminated":          TERMINATED,
 	"text":                TEXT,
 	"than":                THAN,
 	"then":                THEN,
@@ -368,6 +398,7 @@ var keywords = map[string]int{
 	"trigger":             TRIGGER,
 	"true":                TRUE,
 	"truncate":            TRUNCATE,
+	"unbounded":           UNBOUNDED,
 	"uncommitted":         UNCOMMITTED,
 	"undo":                UNUSED,
 	"union":               UNION,
@@ -387,7 +418,7 @@ var keywords = map[string]int{
 	"varchar":             VARCHAR,
 	"varcharacter":        UNUSED,
 	"varying":             UNUSED,
-	"virtual":             UNUSED,
+	"virtual":             VIRTUAL,
 	"vindex":              VINDEX,
 	"vindexes":            VINDEXES,
 	"view":                VIEW,
@@ -400,6 +431,7 @@ var keywords = map[string]int{
 	"warnings":            WARNINGS,
 	"when":                WHEN,
 	"where":               WHERE,
+	"window":              WINDOW,
 	"while":               UNUSED,
 	"with":                WITH,
 	"write":               WRITE,
@@ -444,18 +476,26 @@ func (tkn *Tokenizer) Lex(lval *yySymType) int {
 		}
 		typ, val = tkn.Scan()
 	}
-	if typ == 0 || typ == ';' || typ == LEX_ERROR {
+	if typ == LEX_ERROR || (typ == 0 || typ == ';') && !tkn.partialAlter() {
 		// If encounter end of statement or invalid token,
 		// we should not accept partially parsed DDLs. They
 		// should instead result in parser errors. See the
 		// Parse function to see how this is handled.
+		// An alter statement that ends early is accepted,
+		// because it may use operations that are not parsed.
 		tkn.partialDDL = nil
 	}
 	lval.bytes = val
+	lval.pos = tkn.tokenStart
 	tkn.lastToken = val
+	tkn.prevEnd, tkn.lastEnd = tkn.lastEnd, tkn.Position-1
 	return typ
 }
 
+func (tkn *Tokenizer) partialAlter() bool {
+	return tkn.partialDDL != nil && tkn.partialDDL.Action == AlterStr
+}
+
 // Error is called by go yacc if there's a parsing error.
 func (tkn *Tokenizer) Error(err string) {
 	buf := &bytes2.Buffer{}
@@ -464,6 +504,10 @@ func (tkn *Tokenizer) Error(err string) {
 	} else {
 		fmt.Fprintf(buf, "%s at position %v", err, tkn.Position)
 	}
+	if tkn.InStream == nil {
+		// The whole SQL is in the buffer.
+		fmt.Fprintf(buf, "%s", describeLocation(string(tkn.buf), tkn.tokenStart))
+	}
 	tkn.LastError = errors.New(buf.String())
 
 	// Try and re-sync to the next statement
@@ -490,6 +534,7 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 	}
 
 	tkn.skipBlank()
+	tkn.tokenStart = tkn.Position - 1
 	switch ch := tkn.lastChar; {
 	case isLetter(ch):
 		tkn.next()
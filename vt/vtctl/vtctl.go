@@ -374,6 +374,9 @@ var commands = []commandGroup{
 			{"ApplySchema", commandApplySchema,
 				"[-allow_long_unavailability] [-wait_slave_timeout=10s] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
 				"Applies the schema change to the specified keyspace on every master, running in parallel on all shards. The changes are then propagated to slaves via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected."},
+			{"ApplyDesiredSchema", commandApplyDesiredSchema,
+				"[-allow_long_unavailability] [-wait_slave_timeout=10s] [-dry_run] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
+				"Applies the schema changes that turn the tables of the specified keyspace into the ones of the desired schema, which is a list of semicolon-delimited CREATE TABLE statements. The tables of the keyspace that are not part of the desired schema are dropped. The current schema is read from the master of the first shard, and the changes are applied like with ApplySchema. If -dry_run is set, the changes are only printed."},
 			{"CopySchemaShard", commandCopySchemaShard,
 				"[-tables=<table1>,<table2>,...] [-exclude_tables=<table1>,<table2>,...] [-include-views] [-wait_slave_timeout=10s] {<source keyspace/shard> || <source tablet alias>} <destination keyspace/shard>",
 				"Copies the schema from a source shard's master (or a specific tablet) to a destination shard. The schema is applied directly on the master of the destination shard, and it is propagated to the replicas through binlogs."},
@@ -2059,6 +2062,48 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	)
 }
 
+func commandApplyDesiredSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	allowLongUnavailability := subFlags.Bool("allow_long_unavailability", false, "Allow large schema changes which incur a longer unavailability of the database.")
+	sql := subFlags.String("sql", "", "A list of semicolon-delimited CREATE TABLE statements")
+	sqlFile := subFlags.String("sql-file", "", "Identifies the file that contains the CREATE TABLE statements")
+	waitSlaveTimeout := subFlags.Duration("wait_slave_timeout", wrangler.DefaultWaitSlaveTimeout, "The amount of time to wait for slaves to receive the schema change via replication.")
+	dryRun := subFlags.Bool("dry_run", false, "Only prints the schema changes, without applying them.")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the ApplyDesiredSchema command")
+	}
+
+	keyspace := subFlags.Arg(0)
+	schema, err := getFileParam(*sql, *sqlFile, "sql")
+	if err != nil {
+		return err
+	}
+
+	controller := schemamanager.NewDesiredSchemaController(wr, schema, keyspace)
+	if *dryRun {
+		if err := controller.Open(ctx); err != nil {
+			return err
+		}
+		defer controller.Close()
+		changes, err := controller.Read(ctx)
+		if err != nil {
+			return err
+		}
+		for _, change := range changes {
+			wr.Logger().Printf("%s;\n", change)
+		}
+		return nil
+	}
+
+	executor := schemamanager.NewTabletExecutor(wr, *waitSlaveTimeout)
+	if *allowLongUnavailability {
+		executor.AllowBigSchemaChange()
+	}
+	return schemamanager.Run(ctx, controller, executor)
+}
+
 func commandCopySchemaShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	tables := subFlags.String("tables", "", "Specifies a comma-separated list of tables to copy. Each is either an exact match, or a regular expression of the form /regexp/")
 	excludeTables := subFlags.String("exclude_tables", "", "Specifies a comma-separated list of tables to exclude. Each is either an exact match, or a regular expression of the form /regexp/")
@@ -76,6 +76,9 @@ type VCursor interface {
 type Plan struct {
 	// Original is the original query.
 	Original string `json:",omitempty"`
+	// Fingerprint is the fingerprint of the query, that identifies
+	// the queries of the same shape.
+	Fingerprint string `json:",omitempty"`
 	// Instructions contains the instructions needed to
 	// fulfil the query.
 	Instructions Primitive `json:",omitempty"`
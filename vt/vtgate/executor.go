@@ -227,6 +227,11 @@ func (e *Executor) execute(ctx context.Context, safeSession *SafeSession, sql st
 		return e.handleShow(ctx, safeSession, sql, bindVars, dest, destKeyspace, destTabletType, logStats)
 	case sqlparser.StmtUse:
 		return e.handleUse(ctx, safeSession, sql, bindVars)
+	case sqlparser.StmtKill:
+		return e.handleKill(ctx, sql)
+	case sqlparser.StmtLoad:
+		// The file can only be sent over the mysql protocol, see LoadData.
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: LOAD DATA outside of the mysql protocol: %s", sql)
 	case sqlparser.StmtOther:
 		return e.handleOther(ctx, safeSession, sql, bindVars, dest, destKeyspace, destTabletType, logStats)
 	case sqlparser.StmtComment:
@@ -979,6 +984,46 @@ func (e *Executor) handleShow(ctx context.Context, safeSession *SafeSession, sql
 			Fields: fields,
 			Rows:   rows,
 		}, nil
+	// for PROCESSLIST, list the connections of the MySQL server that the
+	// caller can kill
+	case sqlparser.KeywordString(sqlparser.PROCESSLIST):
+		fields := []*querypb.Field{
+			{Name: "Id", Type: sqltypes.Uint32},
+			{Name: "User", Type: sqltypes.VarChar},
+			{Name: "Host", Type: sqltypes.VarChar},
+			{Name: "db", Type: sqltypes.VarChar},
+			{Name: "Command", Type: sqltypes.VarChar},
+			{Name: "Time", Type: sqltypes.Int64},
+			{Name: "State", Type: sqltypes.VarChar},
+			{Name: "Info", Type: sqltypes.VarChar},
+		}
+		rows := make([][]sqltypes.Value, 0)
+		now := time.Now()
+		for _, c := range mysqlConns() {
+			if !canAccessMySQLConn(ctx, c) {
+				continue
+			}
+			info := c.ProcessInfo()
+			query := sqltypes.NULL
+			if info.Query != "" {
+				query = sqltypes.NewVarChar(info.Query)
+			}
+			rows = append(rows, []sqltypes.Value{
+				sqltypes.NewUint32(info.ID),
+				sqltypes.NewVarChar(info.User),
+				sqltypes.NewVarChar(info.Host),
+				sqltypes.NewVarChar(info.DB),
+				sqltypes.NewVarChar(info.Command),
+				sqltypes.NewInt64(int64(now.Sub(info.Since) / time.Second)),
+				sqltypes.NewVarChar(""),
+				query,
+			})
+		}
+		return &sqltypes.Result{
+			Fields:       fields,
+			Rows:         rows,
+			RowsAffected: uint64(len(rows)),
+		}, nil
 	}
 
 	// Any other show statement is passed through
@@ -1042,6 +1087,34 @@ func (e *Executor) handleOther(ctx context.Context, safeSession *SafeSession, sq
 	return result, err
 }
 
+// handleKill kills a connection of the MySQL server, or just the query
+// it is running. Killing the query cancels its context, which aborts
+// the queries sent to the tablets on its behalf.
+func (e *Executor) handleKill(ctx context.Context, sql string) (*sqltypes.Result, error) {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	kill, ok := stmt.(*sqlparser.Kill)
+	if !ok {
+		// This code is unreachable.
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unrecognized KILL statement: %v", sql)
+	}
+	c := findMySQLConn(kill.ID)
+	if c == nil {
+		return nil, mysql.NewSQLError(mysql.ERNoSuchThread, mysql.SSUnknownSQLState, "Unknown thread id: %v", kill.ID)
+	}
+	if !canAccessMySQLConn(ctx, c) {
+		return nil, mysql.NewSQLError(mysql.ERKillDenied, mysql.SSUnknownSQLState, "You are not owner of thread %v", kill.ID)
+	}
+	if kill.Type == sqlparser.KillQueryStr {
+		c.KillQuery()
+	} else {
+		c.Kill()
+	}
+	return &sqltypes.Result{}, nil
+}
+
 func (e *Executor) handleComment(sql string) (*sqltypes.Result, error) {
 	_, _ = sqlparser.ExtractMysqlComment(sql)
 	// Not sure if this is a good idea.
@@ -1288,14 +1361,23 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 	if result, ok := e.plans.Get(planKey); ok {
 		return result.(*engine.Plan), nil
 	}
-	stmt, err := sqlparser.Parse(sql)
+	// Errors are only located in multi-line statements, so
+	// positions aren't worth tracking for the others.
+	var stmt sqlparser.Statement
+	var positions sqlparser.Positions
+	var err error
+	if strings.Contains(sql, "\n") {
+		stmt, positions, err = sqlparser.ParseWithPositions(sql)
+	} else {
+		stmt, err = sqlparser.Parse(sql)
+	}
 	if err != nil {
 		return nil, err
 	}
 	if !e.normalize {
 		plan, err := planbuilder.BuildFromStmt(sql, stmt, vcursor)
 		if err != nil {
-			return nil, err
+			return nil, positions.Locate(sql, err)
 		}
 		if !skipQueryPlanCache && !sqlparser.SkipQueryPlanCacheDirective(stmt) {
 			e.plans.Set(planKey, plan)
@@ -1318,7 +1400,8 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 	}
 	plan, err := planbuilder.BuildFromStmt(normalized, stmt, vcursor)
 	if err != nil {
-		return nil, err
+		// The nodes that Normalize keeps are located in the original sql.
+		return nil, positions.Locate(sql, err)
 	}
 	if !skipQueryPlanCache && !sqlparser.SkipQueryPlanCacheDirective(stmt) {
 		e.plans.Set(planKey, plan)
@@ -2222,6 +2222,13 @@ func TestGetPlanNormalized(t *testing.T) {
 	if err == nil || err.Error() != wantErr {
 		t.Errorf("getPlan(syntax): %v, want %s", err, wantErr)
 	}
+	// Errors in multi-line statements are located in the original sql.
+	logStats9 := NewLogStats(context.Background(), "Test", "", nil)
+	_, err = r.getPlan(emptyvc, "select id from user\norder by id + 1", makeComments(""), map[string]*querypb.BindVariable{}, false, logStats9)
+	wantErr = "unsupported: in scatter query: complex order by expression: id + 1 (line 2, column 10):\norder by id + 1\n         ^"
+	if err == nil || err.Error() != wantErr {
+		t.Errorf("getPlan(multi-line): %v, want %s", err, wantErr)
+	}
 	if keys := r.plans.Keys(); !reflect.DeepEqual(keys, want) {
 		t.Errorf("Plan keys: %s, want %s", keys, want)
 	}
@@ -0,0 +1,455 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/src-d/go-vitess.v1/sqltypes"
+	"gopkg.in/src-d/go-vitess.v1/trace"
+	"gopkg.in/src-d/go-vitess.v1/vt/sqlparser"
+	"gopkg.in/src-d/go-vitess.v1/vt/vterrors"
+
+	querypb "gopkg.in/src-d/go-vitess.v1/vt/proto/query"
+	topodatapb "gopkg.in/src-d/go-vitess.v1/vt/proto/topodata"
+	vtrpcpb "gopkg.in/src-d/go-vitess.v1/vt/proto/vtrpc"
+)
+
+var loadDataBatchSize = flag.Int("load_data_batch_size", 1000, "the number of rows of a LOAD DATA LOCAL INFILE statement inserted by each INSERT statement.")
+var loadDataMaxBindVars = flag.Int("load_data_max_bind_vars", 10000, "the maximum number of values of a LOAD DATA LOCAL INFILE statement inserted by each INSERT statement. Every shard of a batch receives all its bind variables, so this bounds the size of the queries.")
+
+// LoadData executes a LOAD DATA LOCAL INFILE statement, with data being
+// the content of the file. The rows are inserted in batches, by INSERT
+// statements that are routed like any other: by primary vindex, with the
+// rows of the owned lookup vindexes created as well. All the batches
+// run in the same transaction.
+func (e *Executor) LoadData(ctx context.Context, method string, safeSession *SafeSession, sql string, load *sqlparser.Load, data io.Reader) (result *sqltypes.Result, err error) {
+	span, ctx := trace.NewSpan(ctx, "executor.LoadData")
+	span.Annotate("method", method)
+	trace.AnnotateSQL(span, sql)
+	defer span.Finish()
+
+	logStats := NewLogStats(ctx, method, sql, nil)
+	result, err = e.loadData(ctx, safeSession, load, data, logStats)
+	logStats.SQL = sql
+	logStats.BindVariables = nil
+	logStats.Error = err
+	logStats.Send()
+	return result, err
+}
+
+func (e *Executor) loadData(ctx context.Context, safeSession *SafeSession, load *sqlparser.Load, data io.Reader, logStats *LogStats) (*sqltypes.Result, error) {
+	logStats.StmtType = sqlparser.StmtType(sqlparser.StmtLoad)
+	safeSession.ClearWarnings()
+
+	destKeyspace, destTabletType, dest, err := e.ParseDestinationTarget(safeSession.TargetString)
+	if err != nil {
+		return nil, err
+	}
+	if destTabletType != topodatapb.TabletType_MASTER {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "LOAD DATA is supported only for master tablet types, current type: %v", destTabletType)
+	}
+	columns := load.Columns
+	if len(columns) == 0 {
+		if columns, err = e.loadDataColumns(load.Table, destKeyspace); err != nil {
+			return nil, err
+		}
+	}
+	reader, err := newLoadDataReader(load, data)
+	if err != nil {
+		return nil, err
+	}
+	reader.columns = len(columns)
+
+	// Like for a DML, start a transaction if needed. It stays open if
+	// autocommit is off.
+	mustCommit := false
+	if !safeSession.InTransaction() {
+		if err := e.txConn.Begin(ctx, safeSession); err != nil {
+			return nil, err
+		}
+		if safeSession.Autocommit {
+			mustCommit = true
+			// The defer acts as a failsafe. If commit was successful,
+			// the rollback will be a no-op.
+			defer e.txConn.Rollback(ctx, safeSession)
+		}
+	}
+	// Each batch is only a part of the statement, so none of them
+	// can be autocommitted.
+	safeSession.SetAutocommittable(false)
+
+	insert := &sqlparser.Insert{
+		Action:     sqlparser.InsertStr,
+		Comments:   load.Comments,
+		Table:      load.Table,
+		Partitions: load.Partitions,
+		Columns:    columns,
+	}
+	switch load.Duplicate {
+	case sqlparser.LoadReplaceStr:
+		insert.Action = sqlparser.ReplaceStr
+	case sqlparser.IgnoreStr:
+		insert.Ignore = sqlparser.IgnoreStr
+	}
+
+	result := &sqltypes.Result{}
+	batchSize := loadDataBatchRows(len(columns))
+	for {
+		rows, err := reader.readRows(batchSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 {
+			break
+		}
+
+		// The values are sent as bind variables, so all the full
+		// batches share the same plan.
+		bindVars := make(map[string]*querypb.BindVariable)
+		values := make(sqlparser.Values, len(rows))
+		for i, row := range rows {
+			tuple := make(sqlparser.ValTuple, len(row))
+			for j, value := range row {
+				name := fmt.Sprintf("v%d", len(bindVars)+1)
+				bindVars[name] = sqltypes.ValueBindVariable(value)
+				tuple[j] = sqlparser.NewValArg([]byte(":" + name))
+			}
+			values[i] = tuple
+		}
+		insert.Rows = values
+		qr, err := e.handleExec(ctx, safeSession, sqlparser.String(insert), bindVars, destKeyspace, destTabletType, dest, logStats)
+		if err != nil {
+			return nil, err
+		}
+		result.RowsAffected += qr.RowsAffected
+	}
+	logStats.RowsAffected = result.RowsAffected
+
+	if mustCommit {
+		commitStart := time.Now()
+		if err := e.txConn.Commit(ctx, safeSession); err != nil {
+			return nil, err
+		}
+		logStats.CommitTime = time.Since(commitStart)
+	}
+	return result, nil
+}
+
+// loadDataColumns returns the columns of the table of a LOAD DATA
+// statement without a column list. Like MySQL, the fields of the rows
+// are then all the columns of the table, which vtgate only knows if the
+// vschema has an authoritative column list for it.
+func (e *Executor) loadDataColumns(table sqlparser.TableName, destKeyspace string) (sqlparser.Columns, error) {
+	keyspace := destKeyspace
+	if !table.Qualifier.IsEmpty() {
+		keyspace = table.Qualifier.String()
+	}
+	t, err := e.VSchema().FindTable(keyspace, table.Name.String())
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "LOAD DATA without a column list: %v", err)
+	}
+	if !t.ColumnListAuthoritative || len(t.Columns) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "LOAD DATA without a column list needs an authoritative column list in the vschema for table %s", table.Name.String())
+	}
+	columns := make(sqlparser.Columns, 0, len(t.Columns))
+	for _, col := range t.Columns {
+		columns = append(columns, col.Name)
+	}
+	return columns, nil
+}
+
+// loadDataReader splits the content of a LOAD DATA file into rows,
+// following the FIELDS and LINES clauses of the statement, with the
+// same defaults as MySQL.
+type loadDataReader struct {
+	r *bufio.Reader
+
+	fieldsTerminatedBy []byte
+	enclosedBy         byte // 0 if fields are not enclosed
+	escapedBy          byte // 0 if there is no escape character
+	linesStartingBy    []byte
+	linesTerminatedBy  []byte
+
+	// columns is the number of fields in each row, or 0 if it is
+	// not known.
+	columns int
+
+	// ignoreLines is the number of rows still to skip.
+	ignoreLines uint64
+
+	// line is the number of rows read so far, for errors.
+	line int
+}
+
+func newLoadDataReader(load *sqlparser.Load, data io.Reader) (*loadDataReader, error) {
+	r := &loadDataReader{
+		r:                  bufio.NewReader(data),
+		fieldsTerminatedBy: []byte("\t"),
+		escapedBy:          '\\',
+		linesTerminatedBy:  []byte("\n"),
+		columns:            len(load.Columns),
+	}
+
+	var err error
+	if f := load.Fields; f != nil {
+		if f.TerminatedBy != nil {
+			r.fieldsTerminatedBy = f.TerminatedBy.Val
+		}
+		if f.EnclosedBy != nil {
+			if r.enclosedBy, err = loadDataChar("FIELDS ENCLOSED BY", f.EnclosedBy); err != nil {
+				return nil, err
+			}
+		}
+		if f.EscapedBy != nil {
+			if r.escapedBy, err = loadDataChar("FIELDS ESCAPED BY", f.EscapedBy); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if l := load.Lines; l != nil {
+		if l.StartingBy != nil {
+			r.linesStartingBy = l.StartingBy.Val
+		}
+		if l.TerminatedBy != nil {
+			r.linesTerminatedBy = l.TerminatedBy.Val
+		}
+	}
+	if len(r.fieldsTerminatedBy) == 0 || len(r.linesTerminatedBy) == 0 {
+		// MySQL would use a fixed-row format.
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: LOAD DATA with an empty FIELDS or LINES TERMINATED BY")
+	}
+	if load.IgnoreLines != nil {
+		r.ignoreLines, err = strconv.ParseUint(string(load.IgnoreLines.Val), 10, 64)
+		if err != nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid IGNORE LINES: %v", err)
+		}
+	}
+	return r, nil
+}
+
+// loadDataChar returns the character of an ENCLOSED BY or ESCAPED BY
+// option, or 0 if it is empty.
+func loadDataChar(option string, val *sqlparser.SQLVal) (byte, error) {
+	switch len(val.Val) {
+	case 0:
+		return 0, nil
+	case 1:
+		return val.Val[0], nil
+	}
+	return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s must be a single character: '%s'", option, val.Val)
+}
+
+// readRows returns up to n rows, or no rows at the end of the data.
+func (r *loadDataReader) readRows(n int) ([][]sqltypes.Value, error) {
+	var rows [][]sqltypes.Value
+	for len(rows) < n {
+		row, err := r.readRow()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if r.ignoreLines > 0 {
+			r.ignoreLines--
+			continue
+		}
+		if r.columns != 0 && len(row) != r.columns {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "row %d has %d fields, expected %d", r.line, len(row), r.columns)
+		}
+		if len(rows) > 0 && len(row) != len(rows[0]) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "row %d has %d fields, expected %d", r.line, len(row), len(rows[0]))
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// readRow returns the fields of the next row, or io.EOF at the end of
+// the data.
+func (r *loadDataReader) readRow() ([]sqltypes.Value, error) {
+	if len(r.linesStartingBy) != 0 {
+		// Skip everything up to the prefix, which includes the
+		// lines without it.
+		var skipped []byte
+		for !bytes.HasSuffix(skipped, r.linesStartingBy) {
+			b, err := r.r.ReadByte()
+			if err != nil {
+				return nil, err
+			}
+			skipped = append(skipped, b)
+		}
+	}
+	if _, err := r.r.Peek(1); err != nil {
+		return nil, err
+	}
+
+	r.line++
+	var row []sqltypes.Value
+	for {
+		value, endOfLine, err := r.readField()
+		if err != nil {
+			return nil, err
+		}
+		row = append(row, value)
+		if endOfLine {
+			return row, nil
+		}
+	}
+}
+
+// readField returns the next field, and whether it ends the row.
+func (r *loadDataReader) readField() (value sqltypes.Value, endOfLine bool, err error) {
+	var buf []byte
+	enclosed := false
+	if r.enclosedBy != 0 {
+		if b, err := r.r.Peek(1); err == nil && b[0] == r.enclosedBy {
+			r.r.Discard(1)
+			enclosed = true
+		}
+	}
+
+	// escaped is the length of buf after the last escaped
+	// character: terminators can only come after it.
+	escaped := 0
+	// escapedN is set if the field starts with \N.
+	escapedN := false
+	for {
+		b, err := r.r.ReadByte()
+		if err == io.EOF {
+			// The last line has no terminator.
+			return r.fieldValue(buf, enclosed, escapedN), true, nil
+		}
+		if err != nil {
+			return sqltypes.Value{}, false, err
+		}
+
+		if r.escapedBy != 0 && b == r.escapedBy {
+			next, err := r.r.ReadByte()
+			if err == io.EOF {
+				buf = append(buf, b)
+				continue
+			}
+			if err != nil {
+				return sqltypes.Value{}, false, err
+			}
+			if next == 'N' && len(buf) == 0 && !enclosed {
+				escapedN = true
+			}
+			buf = append(buf, loadDataUnescape(next))
+			escaped = len(buf)
+			continue
+		}
+
+		if enclosed {
+			if b != r.enclosedBy {
+				buf = append(buf, b)
+				continue
+			}
+			// A doubled quote is a quote, otherwise the value
+			// ends if a terminator follows.
+			if r.skip([]byte{r.enclosedBy}) {
+				buf = append(buf, b)
+				continue
+			}
+			if r.skip(r.fieldsTerminatedBy) {
+				return r.fieldValue(buf, enclosed, false), false, nil
+			}
+			if r.skip(r.linesTerminatedBy) {
+				return r.fieldValue(buf, enclosed, false), true, nil
+			}
+			if _, err := r.r.Peek(1); err == io.EOF {
+				return r.fieldValue(buf, enclosed, false), true, nil
+			}
+			// Otherwise the quote is part of the value.
+			buf = append(buf, b)
+			continue
+		}
+
+		buf = append(buf, b)
+		if len(buf)-len(r.fieldsTerminatedBy) >= escaped && bytes.HasSuffix(buf, r.fieldsTerminatedBy) {
+			return r.fieldValue(buf[:len(buf)-len(r.fieldsTerminatedBy)], enclosed, escapedN), false, nil
+		}
+		if len(buf)-len(r.linesTerminatedBy) >= escaped && bytes.HasSuffix(buf, r.linesTerminatedBy) {
+			return r.fieldValue(buf[:len(buf)-len(r.linesTerminatedBy)], enclosed, escapedN), true, nil
+		}
+	}
+}
+
+// fieldValue returns the value of a field. Like in MySQL, \N is NULL,
+// and so is an unenclosed NULL if fields can be enclosed.
+func (r *loadDataReader) fieldValue(buf []byte, enclosed, escapedN bool) sqltypes.Value {
+	switch {
+	case escapedN && len(buf) == 1:
+		return sqltypes.NULL
+	case r.enclosedBy != 0 && !enclosed && string(buf) == "NULL":
+		return sqltypes.NULL
+	}
+	return sqltypes.MakeTrusted(sqltypes.VarBinary, buf)
+}
+
+// skip consumes prefix if the data continues with it.
+func (r *loadDataReader) skip(prefix []byte) bool {
+	b, err := r.r.Peek(len(prefix))
+	if err != nil || !bytes.Equal(b, prefix) {
+		return false
+	}
+	r.r.Discard(len(prefix))
+	return true
+}
+
+// loadDataUnescape returns the character for an escape sequence.
+func loadDataUnescape(b byte) byte {
+	switch b {
+	case '0':
+		return 0
+	case 'b':
+		return '\b'
+	case 'n':
+		return '\n'
+	case 'r':
+		return '\r'
+	case 't':
+		return '\t'
+	case 'Z':
+		return 26
+	}
+	return b
+}
+
+// loadDataBatchRows returns the number of rows inserted by each batch:
+// load_data_batch_size, lowered so that a batch of rows with the given
+// number of columns has at most load_data_max_bind_vars values.
+func loadDataBatchRows(columns int) int {
+	rows := *loadDataBatchSize
+	if columns > 0 && rows*columns > *loadDataMaxBindVars {
+		rows = *loadDataMaxBindVars / columns
+	}
+	if rows < 1 {
+		rows = 1
+	}
+	return rows
+}
@@ -0,0 +1,277 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/src-d/go-vitess.v1/sqltypes"
+	"gopkg.in/src-d/go-vitess.v1/vt/sqlparser"
+	"gopkg.in/src-d/go-vitess.v1/vt/vtgate/vindexes"
+
+	querypb "gopkg.in/src-d/go-vitess.v1/vt/proto/query"
+	vtgatepb "gopkg.in/src-d/go-vitess.v1/vt/proto/vtgate"
+)
+
+func TestLoadDataReader(t *testing.T) {
+	testcases := []struct {
+		options string
+		data    string
+		want    [][]string
+		err     string
+	}{{
+		// The defaults.
+		data: "1\ta\n2\tb\\tc\n3\t\\N\n",
+		want: [][]string{{"1", "a"}, {"2", "b\tc"}, {"3", "<nil>"}},
+	}, {
+		// The last line has no terminator.
+		data: "1\ta\n2\tb",
+		want: [][]string{{"1", "a"}, {"2", "b"}},
+	}, {
+		data: "",
+		want: nil,
+	}, {
+		options: "fields terminated by ',' enclosed by '\"' lines terminated by '\\r\\n' ignore 1 lines",
+		data:    "id,name\r\n1,\"a,b\"\r\n2,\"say \"\"hi\"\"\"\r\n3,NULL\r\n4,\"NULL\"\r\n",
+		want:    [][]string{{"1", "a,b"}, {"2", "say \"hi\""}, {"3", "<nil>"}, {"4", "NULL"}},
+	}, {
+		// Escaped terminators are part of the value.
+		options: "fields terminated by ','",
+		data:    "1,a\\,b\n2,c\\\nd\n",
+		want:    [][]string{{"1", "a,b"}, {"2", "c\nd"}},
+	}, {
+		options: "fields terminated by '||' escaped by '' lines starting by 'row:'",
+		data:    "row:1||a\\b\nskipped\nrow:2||\n",
+		want:    [][]string{{"1", "a\\b"}, {"2", ""}},
+	}, {
+		options: "fields terminated by ''",
+		err:     "unsupported: LOAD DATA with an empty FIELDS or LINES TERMINATED BY",
+	}, {
+		options: "fields enclosed by 'ab'",
+		err:     "FIELDS ENCLOSED BY must be a single character: 'ab'",
+	}, {
+		options: "(id, name)",
+		data:    "1\ta\n2\n",
+		err:     "row 2 has 1 fields, expected 2",
+	}, {
+		data: "1\ta\n2\n",
+		err:  "row 2 has 1 fields, expected 2",
+	}}
+	for _, tcase := range testcases {
+		sql := "load data local infile 'f' into table t " + tcase.options
+		stmt, err := sqlparser.Parse(sql)
+		if err != nil {
+			t.Fatalf("Parse(%s) failed: %v", sql, err)
+		}
+		r, err := newLoadDataReader(stmt.(*sqlparser.Load), strings.NewReader(tcase.data))
+		var rows [][]sqltypes.Value
+		if err == nil {
+			rows, err = r.readRows(100)
+		}
+		if err != nil {
+			if err.Error() != tcase.err {
+				t.Errorf("%s: %v, want %s", tcase.options, err, tcase.err)
+			}
+			continue
+		}
+		if tcase.err != "" {
+			t.Errorf("%s: no error, want %s", tcase.options, tcase.err)
+			continue
+		}
+		var got [][]string
+		for _, row := range rows {
+			var values []string
+			for _, value := range row {
+				if value.IsNull() {
+					values = append(values, "<nil>")
+				} else {
+					values = append(values, value.ToString())
+				}
+			}
+			got = append(got, values)
+		}
+		if !reflect.DeepEqual(got, tcase.want) {
+			t.Errorf("%s: rows %q, want %q", tcase.options, got, tcase.want)
+		}
+	}
+}
+
+func TestLoadData(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createExecutorEnv()
+	logChan := QueryLogger.Subscribe("Test")
+	defer QueryLogger.Unsubscribe(logChan)
+	defer func(size int) { *loadDataBatchSize = size }(*loadDataBatchSize)
+	*loadDataBatchSize = 2
+
+	sql := "load data local infile 'users.csv' into table user (id, name)"
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		t.Fatal(err)
+	}
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true, TransactionMode: vtgatepb.TransactionMode_MULTI})
+	data := strings.NewReader("1\tmyname\n3\tmyname2\n4\tmyname3\n")
+	qr, err := executor.LoadData(context.Background(), "TestLoadData", session, sql, stmt.(*sqlparser.Load), data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.InTransaction() {
+		t.Errorf("LoadData left a transaction open")
+	}
+
+	// The rows go to their shard, and the owned lookup vindex is
+	// updated, one batch at a time.
+	wantQueries := []*querypb.BoundQuery{{
+		Sql: "insert into user(id, name) values (:_Id0, :_name0) /* vtgate:: keyspace_id:166b40b44aba4bd6 */",
+		BindVariables: map[string]*querypb.BindVariable{
+			"v1":     sqltypes.BytesBindVariable([]byte("1")),
+			"v2":     sqltypes.BytesBindVariable([]byte("myname")),
+			"v3":     sqltypes.BytesBindVariable([]byte("3")),
+			"v4":     sqltypes.BytesBindVariable([]byte("myname2")),
+			"_Id0":   sqltypes.BytesBindVariable([]byte("1")),
+			"_Id1":   sqltypes.BytesBindVariable([]byte("3")),
+			"_name0": sqltypes.BytesBindVariable([]byte("myname")),
+			"_name1": sqltypes.BytesBindVariable([]byte("myname2")),
+			"__seq0": sqltypes.BytesBindVariable([]byte("1")),
+			"__seq1": sqltypes.BytesBindVariable([]byte("3")),
+		},
+	}}
+	if !reflect.DeepEqual(sbc1.Queries, wantQueries) {
+		t.Errorf("sbc1.Queries:\n%+v, want\n%+v\n", sbc1.Queries, wantQueries)
+	}
+	wantQueries[0].Sql = "insert into user(id, name) values (:_Id1, :_name1) /* vtgate:: keyspace_id:4eb190c9a2fa169c */"
+	if !reflect.DeepEqual(sbc2.Queries, wantQueries) {
+		t.Errorf("sbc2.Queries:\n%+v, want\n%+v\n", sbc2.Queries, wantQueries)
+	}
+	wantQueries = []*querypb.BoundQuery{{
+		Sql: "insert into name_user_map(name, user_id) values (:name0, :user_id0), (:name1, :user_id1)",
+		BindVariables: map[string]*querypb.BindVariable{
+			"name0":    sqltypes.BytesBindVariable([]byte("myname")),
+			"user_id0": sqltypes.Uint64BindVariable(1),
+			"name1":    sqltypes.BytesBindVariable([]byte("myname2")),
+			"user_id1": sqltypes.Uint64BindVariable(3),
+		},
+	}, {
+		Sql: "insert into name_user_map(name, user_id) values (:name0, :user_id0)",
+		BindVariables: map[string]*querypb.BindVariable{
+			"name0":    sqltypes.BytesBindVariable([]byte("myname3")),
+			"user_id0": sqltypes.Uint64BindVariable(4),
+		},
+	}}
+	if !reflect.DeepEqual(sbclookup.Queries, wantQueries) {
+		t.Errorf("sbclookup.Queries:\n%+v, want\n%+v\n", sbclookup.Queries, wantQueries)
+	}
+	if commitCount := sbc1.CommitCount.Get(); commitCount != 1 {
+		t.Errorf("sbc1.CommitCount: %d, want 1", commitCount)
+	}
+	if qr.RowsAffected != 3 {
+		t.Errorf("RowsAffected: %d, want 3", qr.RowsAffected)
+	}
+	testQueryLog(t, logChan, "VindexCreate", "INSERT", "insert into name_user_map(name, user_id) values(:name0, :user_id0), (:name1, :user_id1)", 1)
+	testQueryLog(t, logChan, "VindexCreate", "INSERT", "insert into name_user_map(name, user_id) values(:name0, :user_id0)", 1)
+	testQueryLog(t, logChan, "TestLoadData", "LOAD", sql, 3)
+
+	// Without the mysql protocol, there is no file to read.
+	_, err = executorExec(executor, sql, nil)
+	want := "unsupported: LOAD DATA outside of the mysql protocol: " + sql
+	if err == nil || err.Error() != want {
+		t.Errorf("Execute(%s): %v, want %s", sql, err, want)
+	}
+}
+
+func TestLoadDataWithoutColumns(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	loadData := func(sql, data string) error {
+		t.Helper()
+		stmt, err := sqlparser.Parse(sql)
+		if err != nil {
+			t.Fatal(err)
+		}
+		session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+		_, err = executor.LoadData(context.Background(), "TestLoadData", session, sql, stmt.(*sqlparser.Load), strings.NewReader(data))
+		return err
+	}
+
+	// The columns of the table are needed to build the INSERT.
+	err := loadData("load data local infile 'f' into table user_extra", "1\t2\n")
+	want := "LOAD DATA without a column list needs an authoritative column list in the vschema for table user_extra"
+	if err == nil || err.Error() != want {
+		t.Errorf("LoadData: %v, want %s", err, want)
+	}
+	if len(sbc1.Queries) != 0 {
+		t.Errorf("sbc1.Queries: %+v, want none", sbc1.Queries)
+	}
+
+	// They come from the vschema if its column list is authoritative.
+	table, err := executor.VSchema().FindTable("", "user_extra")
+	if err != nil {
+		t.Fatal(err)
+	}
+	table.Columns = []vindexes.Column{
+		{Name: sqlparser.NewColIdent("user_id"), Type: sqltypes.Int64},
+		{Name: sqlparser.NewColIdent("v"), Type: sqltypes.Int64},
+	}
+	table.ColumnListAuthoritative = true
+	if err := loadData("load data local infile 'f' into table user_extra", "1\t2\n"); err != nil {
+		t.Fatal(err)
+	}
+	wantQueries := []*querypb.BoundQuery{{
+		Sql: "insert into user_extra(user_id, v) values (:_user_id0, :v2) /* vtgate:: keyspace_id:166b40b44aba4bd6 */",
+		BindVariables: map[string]*querypb.BindVariable{
+			"v1":        sqltypes.BytesBindVariable([]byte("1")),
+			"v2":        sqltypes.BytesBindVariable([]byte("2")),
+			"_user_id0": sqltypes.BytesBindVariable([]byte("1")),
+		},
+	}}
+	if !reflect.DeepEqual(sbc1.Queries, wantQueries) {
+		t.Errorf("sbc1.Queries:\n%+v, want\n%+v\n", sbc1.Queries, wantQueries)
+	}
+
+	// The rows must have a field for each column.
+	err = loadData("load data local infile 'f' into table user_extra", "1\t2\t3\n")
+	want = "row 1 has 3 fields, expected 2"
+	if err == nil || err.Error() != want {
+		t.Errorf("LoadData: %v, want %s", err, want)
+	}
+}
+
+func TestLoadDataBatchRows(t *testing.T) {
+	defer func(size, max int) {
+		*loadDataBatchSize = size
+		*loadDataMaxBindVars = max
+	}(*loadDataBatchSize, *loadDataMaxBindVars)
+	*loadDataBatchSize = 100
+	*loadDataMaxBindVars = 1000
+
+	testcases := []struct {
+		columns int
+		want    int
+	}{
+		{columns: 0, want: 100},
+		{columns: 10, want: 100},
+		{columns: 20, want: 50},
+		{columns: 3000, want: 1},
+	}
+	for _, tc := range testcases {
+		if got := loadDataBatchRows(tc.columns); got != tc.want {
+			t.Errorf("loadDataBatchRows(%d): %d, want %d", tc.columns, got, tc.want)
+		}
+	}
+}
@@ -84,6 +84,13 @@ func (stats *LogStats) EffectiveCaller() string {
 	return callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(stats.Ctx))
 }
 
+// EffectiveCallerComponent returns the component of the effective
+// caller stored in LogStats.Ctx, which is the client program for the
+// MySQL protocol, when it is known.
+func (stats *LogStats) EffectiveCallerComponent() string {
+	return callerid.GetComponent(callerid.EffectiveCallerIDFromContext(stats.Ctx))
+}
+
 // EventTime returns the time the event was created.
 func (stats *LogStats) EventTime() time.Time {
 	return stats.EndTime
@@ -141,9 +148,9 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 	var fmtString string
 	switch *streamlog.QueryLogFormat {
 	case streamlog.QueryLogFormatText:
-		fmtString = "%v\t%v\t%v\t'%v'\t'%v'\t%v\t%v\t%.6f\t%.6f\t%.6f\t%.6f\t%v\t%q\t%v\t%v\t%v\t%q\t\n"
+		fmtString = "%v\t%v\t%v\t'%v'\t'%v'\t%v\t%v\t%.6f\t%.6f\t%.6f\t%.6f\t%v\t%q\t%v\t%v\t%v\t%q\t'%v'\t\n"
 	case streamlog.QueryLogFormatJSON:
-		fmtString = "{\"Method\": %q, \"RemoteAddr\": %q, \"Username\": %q, \"ImmediateCaller\": %q, \"Effective Caller\": %q, \"Start\": \"%v\", \"End\": \"%v\", \"TotalTime\": %.6f, \"PlanTime\": %v, \"ExecuteTime\": %v, \"CommitTime\": %v, \"StmtType\": %q, \"SQL\": %q, \"BindVars\": %v, \"ShardQueries\": %v, \"RowsAffected\": %v, \"Error\": %q}\n"
+		fmtString = "{\"Method\": %q, \"RemoteAddr\": %q, \"Username\": %q, \"ImmediateCaller\": %q, \"Effective Caller\": %q, \"Start\": \"%v\", \"End\": \"%v\", \"TotalTime\": %.6f, \"PlanTime\": %v, \"ExecuteTime\": %v, \"CommitTime\": %v, \"StmtType\": %q, \"SQL\": %q, \"BindVars\": %v, \"ShardQueries\": %v, \"RowsAffected\": %v, \"Error\": %q, \"Effective Caller Component\": %q}\n"
 	}
 
 	_, err := fmt.Fprintf(
@@ -166,6 +173,7 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 		stats.ShardQueries,
 		stats.RowsAffected,
 		stats.ErrorStr(),
+		stats.EffectiveCallerComponent(),
 	)
 	return err
 }
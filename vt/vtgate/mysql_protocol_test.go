@@ -17,6 +17,7 @@ limitations under the License.
 package vtgate
 
 import (
+	"fmt"
 	"net"
 	"reflect"
 	"strconv"
@@ -26,6 +27,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"gopkg.in/src-d/go-vitess.v1/mysql"
+	"gopkg.in/src-d/go-vitess.v1/sqltypes"
 	"gopkg.in/src-d/go-vitess.v1/vt/vttablet/sandboxconn"
 
 	querypb "gopkg.in/src-d/go-vitess.v1/vt/proto/query"
@@ -107,6 +109,37 @@ func TestMysqlProtocolInvalidDB(t *testing.T) {
 	}
 }
 
+func TestMySQLProtocolUseKeepsTarget(t *testing.T) {
+	createSandbox(KsTestUnsharded)
+	hcVTGateTest.Reset()
+	hcVTGateTest.AddTestTablet("aa", "1.1.1.1", 1001, KsTestUnsharded, "0", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	c, err := mysqlConnect(&mysql.ConnParams{DbName: KsTestUnsharded})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	target := KsTestUnsharded + "@replica"
+	if _, err := c.ExecuteFetch("use `"+target+"`", 1, false); err != nil {
+		t.Fatal(err)
+	}
+	qr, err := c.ExecuteFetch("show vitess_target", 1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(qr.Rows) != 1 || qr.Rows[0][0].ToString() != target {
+		t.Errorf("got target %v, want %v", qr.Rows, target)
+	}
+
+	// The connection still has the database of the handshake.
+	for _, conn := range mysqlConns() {
+		if conn.ConnectionID == c.ConnectionID && conn.SchemaName != KsTestUnsharded {
+			t.Errorf("got SchemaName %v, want %v", conn.SchemaName, KsTestUnsharded)
+		}
+	}
+}
+
 func TestMySQLProtocolClientFoundRows(t *testing.T) {
 	createSandbox(KsTestUnsharded)
 	hcVTGateTest.Reset()
@@ -147,3 +180,87 @@ func mysqlConnect(params *mysql.ConnParams) (*mysql.Conn, error) {
 	params.Port = portnum
 	return mysql.Connect(context.Background(), params)
 }
+
+func TestMySQLProtocolProcessListAndKill(t *testing.T) {
+	c1, err := mysqlConnect(&mysql.ConnParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c1.Close()
+	c2, err := mysqlConnect(&mysql.ConnParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c2.Close()
+
+	// Both connections are listed, with the query c2 is running,
+	// in the 8 columns of MySQL.
+	qr, err := c2.ExecuteFetch("show processlist", 100, true /* wantfields */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(qr.Fields) != 8 {
+		t.Errorf("show processlist returned %v columns, want 8", len(qr.Fields))
+	}
+	var c1Row, c2Row []sqltypes.Value
+	for _, row := range qr.Rows {
+		switch row[0].ToString() {
+		case strconv.FormatUint(uint64(c1.ConnectionID), 10):
+			c1Row = row
+		case strconv.FormatUint(uint64(c2.ConnectionID), 10):
+			c2Row = row
+		}
+	}
+	if c1Row == nil || c2Row == nil {
+		t.Fatalf("show processlist didn't list connections %v and %v: %v", c1.ConnectionID, c2.ConnectionID, qr.Rows)
+	}
+	if got := c1Row[4].ToString(); got != mysql.ProcessCommandSleep {
+		t.Errorf("Command of %v = %v, want %v", c1.ConnectionID, got, mysql.ProcessCommandSleep)
+	}
+	if !c1Row[7].IsNull() {
+		t.Errorf("Info of %v = %v, want NULL", c1.ConnectionID, c1Row[7])
+	}
+	if got := c2Row[7].ToString(); got != "show processlist" {
+		t.Errorf("Info of %v = %v, want show processlist", c2.ConnectionID, got)
+	}
+
+	// The other users only see and kill their own connections.
+	c3, err := mysqlConnect(&mysql.ConnParams{Uname: "user2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c3.Close()
+	qr, err = c3.ExecuteFetch("show processlist", 100, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(qr.Rows) != 1 || qr.Rows[0][0].ToString() != strconv.FormatUint(uint64(c3.ConnectionID), 10) {
+		t.Errorf("show processlist of another user returned %v, want only connection %v", qr.Rows, c3.ConnectionID)
+	}
+	_, err = c3.ExecuteFetch(fmt.Sprintf("kill %v", c1.ConnectionID), 1, false)
+	if sqlErr, ok := err.(*mysql.SQLError); !ok || sqlErr.Number() != mysql.ERKillDenied {
+		t.Errorf("kill of the connection of another user returned %v, want ERKillDenied", err)
+	}
+
+	// Killing an unknown connection fails.
+	_, err = c2.ExecuteFetch("kill 4294967296", 1, false)
+	if sqlErr, ok := err.(*mysql.SQLError); !ok || sqlErr.Number() != mysql.ERNoSuchThread {
+		t.Errorf("kill of unknown connection returned %v, want ERNoSuchThread", err)
+	}
+
+	// Killing the query of an idle connection does nothing.
+	if _, err := c2.ExecuteFetch(fmt.Sprintf("kill query %v", c1.ConnectionID), 1, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c1.ExecuteFetch("select id from t1", 10, false); err != nil {
+		t.Errorf("query after kill query failed: %v", err)
+	}
+
+	// Killing the connection closes it.
+	if _, err := c2.ExecuteFetch(fmt.Sprintf("kill %v", c1.ConnectionID), 1, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c1.ExecuteFetch("select id from t1", 10, false); err == nil {
+		t.Errorf("query after kill succeeded")
+	}
+}
@@ -119,11 +119,15 @@ type ContextVSchema interface {
 // Build builds a plan for a query based on the specified vschema.
 // It's the main entry point for this package.
 func Build(query string, vschema ContextVSchema) (*engine.Plan, error) {
-	stmt, err := sqlparser.Parse(query)
+	stmt, positions, err := sqlparser.ParseWithPositions(query)
 	if err != nil {
 		return nil, err
 	}
-	return BuildFromStmt(query, stmt, vschema)
+	plan, err := BuildFromStmt(query, stmt, vschema)
+	if err != nil {
+		return nil, positions.Locate(query, err)
+	}
+	return plan, nil
 }
 
 // BuildFromStmt builds a plan based on the AST provided.
@@ -132,8 +136,10 @@ func Build(query string, vschema ContextVSchema) (*engine.Plan, error) {
 // and engine.Plan can be built by the caller.
 func BuildFromStmt(query string, stmt sqlparser.Statement, vschema ContextVSchema) (*engine.Plan, error) {
 	var err error
+	// The fingerprint is computed before the builders change stmt.
 	plan := &engine.Plan{
-		Original: query,
+		Original:    query,
+		Fingerprint: sqlparser.Fingerprint(stmt),
 	}
 	switch stmt := stmt.(type) {
 	case *sqlparser.Select:
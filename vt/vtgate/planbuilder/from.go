@@ -44,6 +44,97 @@ func (pb *primitiveBuilder) processDMLTable(tableExprs sqlparser.TableExprs) (*r
 	return ro, nil
 }
 
+// expandCTEs replaces the references to the common table expressions
+// of stmt by derived tables, so they're planned like them: merged into
+// the route that uses them if possible, and built as subqueries otherwise.
+// The WITH clauses are then removed, because routes build their own
+// queries.
+func expandCTEs(stmt sqlparser.SelectStatement) error {
+	hasCTEs := false
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if with, ok := node.(*sqlparser.With); ok && with != nil {
+			if with.Recursive {
+				return false, errors.New("unsupported: recursive common table expression")
+			}
+			hasCTEs = true
+		}
+		return true, nil
+	}, stmt)
+	if err != nil || !hasCTEs {
+		return err
+	}
+
+	err = sqlparser.VisitCTERefs(stmt, func(ref *sqlparser.AliasedTableExpr, cte *sqlparser.CommonTableExpr) error {
+		subquery, err := cteSubquery(cte)
+		if err != nil {
+			return err
+		}
+		ref.Expr = subquery
+		if ref.As.IsEmpty() {
+			ref.As = cte.Name
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.Select:
+			node.With = nil
+		case *sqlparser.Union:
+			node.With = nil
+		}
+		return true, nil
+	}, stmt)
+}
+
+// cteSubquery returns a copy of the query of cte, as a derived table.
+// Every reference needs its own copy because the AST is annotated while
+// it's planned, and parsing the query again is the simplest way to make
+// a deep copy.
+func cteSubquery(cte *sqlparser.CommonTableExpr) (*sqlparser.Subquery, error) {
+	stmt, err := sqlparser.Parse(sqlparser.String(cte.Subquery.Select))
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(sqlparser.SelectStatement)
+	if !ok {
+		return nil, fmt.Errorf("BUG: unexpected statement type: %T", stmt)
+	}
+	if len(cte.Columns) == 0 {
+		return &sqlparser.Subquery{Select: sel}, nil
+	}
+
+	// Derived tables have no column list. Their columns are named after
+	// the select expressions of the first SELECT instead.
+	first := sel
+	for {
+		if union, ok := first.(*sqlparser.Union); ok {
+			first = union.Left
+			continue
+		}
+		if paren, ok := first.(*sqlparser.ParenSelect); ok {
+			first = paren.Select
+			continue
+		}
+		break
+	}
+	selectExprs := first.(*sqlparser.Select).SelectExprs
+	for _, selectExpr := range selectExprs {
+		if _, ok := selectExpr.(*sqlparser.AliasedExpr); !ok {
+			return nil, fmt.Errorf("unsupported: column list of common table expression %v with %s", cte.Name.String(), sqlparser.String(selectExpr))
+		}
+	}
+	if len(selectExprs) != len(cte.Columns) {
+		return nil, fmt.Errorf("common table expression %v has %d columns, but its query returns %d", cte.Name.String(), len(cte.Columns), len(selectExprs))
+	}
+	for i, selectExpr := range selectExprs {
+		selectExpr.(*sqlparser.AliasedExpr).As = cte.Columns[i]
+	}
+	return &sqlparser.Subquery{Select: sel}, nil
+}
+
 // processTableExprs analyzes the FROM clause. It produces a builder
 // with all the routes identified.
 func (pb *primitiveBuilder) processTableExprs(tableExprs sqlparser.TableExprs) error {
@@ -54,8 +145,23 @@ func (pb *primitiveBuilder) processTableExprs(tableExprs sqlparser.TableExprs) e
 	if err := pb.processTableExpr(tableExprs[0]); err != nil {
 		return err
 	}
+	tableExprs = tableExprs[1:]
+	// A JSON_TABLE can refer to the tables that precede it.
+	for len(tableExprs) != 0 {
+		jt, ok := tableExprs[0].(*sqlparser.JSONTableExpr)
+		if !ok {
+			break
+		}
+		if err := pb.pushJSONTable(jt, nil); err != nil {
+			return err
+		}
+		tableExprs = tableExprs[1:]
+	}
+	if len(tableExprs) == 0 {
+		return nil
+	}
 	rpb := newPrimitiveBuilder(pb.vschema, pb.jt)
-	if err := rpb.processTableExprs(tableExprs[1:]); err != nil {
+	if err := rpb.processTableExprs(tableExprs); err != nil {
 		return err
 	}
 	return pb.join(rpb, nil)
@@ -78,10 +184,76 @@ func (pb *primitiveBuilder) processTableExpr(tableExpr sqlparser.TableExpr) erro
 		return err
 	case *sqlparser.JoinTableExpr:
 		return pb.processJoin(tableExpr)
+	case *sqlparser.JSONTableExpr:
+		return pb.processJSONTable(tableExpr)
 	}
 	panic(fmt.Sprintf("BUG: unexpected table expression type: %T", tableExpr))
 }
 
+// processJSONTable produces a route for a JSON_TABLE that is not
+// preceded by any table. It doesn't read any table, so it can be sent
+// to any shard of the default keyspace.
+func (pb *primitiveBuilder) processJSONTable(jt *sqlparser.JSONTableExpr) error {
+	ks, err := pb.vschema.DefaultKeyspace()
+	if err != nil {
+		return err
+	}
+	rb, st := newRoute(&sqlparser.Select{})
+	rb.routeOptions = []*routeOption{newSimpleRouteOption(rb, engine.NewSimpleRoute(engine.SelectReference, ks))}
+	pb.bldr, pb.st = rb, st
+	return pb.pushJSONTable(jt, nil)
+}
+
+// pushJSONTable pushes a JSON_TABLE into the route of the tables
+// that precede it, because its document can refer to their columns.
+// If ajoin is not nil, the JSON_TABLE is the right side of the join.
+func (pb *primitiveBuilder) pushJSONTable(jt *sqlparser.JSONTableExpr, ajoin *sqlparser.JoinTableExpr) error {
+	rb, ok := pb.bldr.(*route)
+	if !ok {
+		return sqlparser.NewNodeError(jt, "unsupported: JSON_TABLE over a cross-shard join")
+	}
+	pullouts, _, expr, err := pb.findOrigin(jt.Expr)
+	if err != nil {
+		return err
+	}
+	if len(pullouts) != 0 {
+		return errors.New("unsupported: cross-shard subquery in JSON_TABLE")
+	}
+	jt.Expr = expr
+
+	t := &table{
+		alias:  sqlparser.TableName{Name: jt.As},
+		origin: rb,
+	}
+	for _, name := range jt.ColumnNames() {
+		t.addColumn(name, &column{origin: rb})
+	}
+	t.isAuthoritative = true
+	if err := pb.st.AddTable(t); err != nil {
+		return err
+	}
+
+	sel := rb.Select.(*sqlparser.Select)
+	if ajoin == nil {
+		sel.From = append(sel.From, jt)
+		return nil
+	}
+	sel.From = sqlparser.TableExprs{ajoin}
+	pullouts, _, expr, err = pb.findOrigin(ajoin.Condition.On)
+	if err != nil {
+		return err
+	}
+	ajoin.Condition.On = expr
+	pb.addPullouts(pullouts)
+	if ajoin.Join == sqlparser.LeftJoinStr {
+		return nil
+	}
+	for _, filter := range splitAndExpression(nil, ajoin.Condition.On) {
+		rb.UpdatePlans(pb, filter)
+	}
+	return nil
+}
+
 // processAliasedTable produces a builder subtree for the given AliasedTableExpr.
 // If the expression is a subquery, then the primitive will create a table
 // for it in the symtab. If the subquery is a route, then we build a route
@@ -261,11 +433,14 @@ func (pb *primitiveBuilder) processJoin(ajoin *sqlparser.JoinTableExpr) error {
 	case sqlparser.RightJoinStr:
 		convertToLeftJoin(ajoin)
 	default:
-		return fmt.Errorf("unsupported: %s", ajoin.Join)
+		return sqlparser.NewNodeError(ajoin, "unsupported: %s", ajoin.Join)
 	}
 	if err := pb.processTableExpr(ajoin.LeftExpr); err != nil {
 		return err
 	}
+	if jt, ok := ajoin.RightExpr.(*sqlparser.JSONTableExpr); ok {
+		return pb.pushJSONTable(jt, ajoin)
+	}
 	rpb := newPrimitiveBuilder(pb.vschema, pb.jt)
 	if err := rpb.processTableExpr(ajoin.RightExpr); err != nil {
 		return err
@@ -164,7 +164,7 @@ func (jb *join) PushSelect(expr *sqlparser.AliasedExpr, origin builder) (rc *res
 	} else {
 		// Pushing of non-trivial expressions not allowed for RHS of left joins.
 		if _, ok := expr.Expr.(*sqlparser.ColName); !ok && jb.ejoin.Opcode == engine.LeftJoin {
-			return nil, 0, errors.New("unsupported: cross-shard left join and column expressions")
+			return nil, 0, sqlparser.NewNodeError(expr, "unsupported: cross-shard left join and column expressions")
 		}
 
 		rc, colnum, err = jb.Right.PushSelect(expr, origin)
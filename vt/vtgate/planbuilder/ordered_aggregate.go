@@ -302,7 +302,7 @@ func (oa *orderedAggregate) PushSelect(expr *sqlparser.AliasedExpr, origin build
 
 	// Ensure that there are no aggregates in the expression.
 	if nodeHasAggregates(expr.Expr) {
-		return nil, 0, errors.New("unsupported: in scatter query: complex aggregate expression")
+		return nil, 0, sqlparser.NewNodeError(expr, "unsupported: in scatter query: complex aggregate expression")
 	}
 
 	innerRC, _, _ := oa.input.PushSelect(expr, origin)
@@ -394,7 +394,7 @@ func (oa *orderedAggregate) PushOrderBy(orderBy sqlparser.OrderBy) (builder, err
 		case *sqlparser.ColName:
 			orderByCol = expr.Metadata.(*column)
 		default:
-			return nil, fmt.Errorf("unsupported: in scatter query: complex order by expression: %v", sqlparser.String(expr))
+			return nil, sqlparser.NewNodeError(expr, "unsupported: in scatter query: complex order by expression: %v", sqlparser.String(expr))
 		}
 
 		// Match orderByCol against the group by columns.
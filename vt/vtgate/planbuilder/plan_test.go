@@ -164,6 +164,39 @@ func TestOne(t *testing.T) {
 	testFile(t, "onecase.txt", vschema)
 }
 
+func TestBuildErrorLocation(t *testing.T) {
+	vschema := loadSchema(t, "schema_test.json")
+	testcases := []struct {
+		query, want string
+	}{{
+		query: "select id from user u\nwhere u.col in (select col from user_extra where user_extra.col = u.col)",
+		want:  "unsupported: cross-shard correlated subquery (line 2, column 16):\nwhere u.col in (select col from user_extra where user_extra.col = u.col)\n               ^",
+	}, {
+		query: "select *\n  from user join user_extra",
+		want:  "unsupported: '*' expression in cross-shard query (line 1, column 8):\nselect *\n       ^",
+	}, {
+		query: "select id from user\n\torder by col + 1",
+		want:  "unsupported: in scatter query: complex order by expression: col + 1 (line 2, column 11):\n\torder by col + 1\n\t         ^",
+	}, {
+		// Single line statements only report the error.
+		query: "select * from user join user_extra",
+		want:  "unsupported: '*' expression in cross-shard query",
+	}, {
+		// Nodes that are not pointers have no position.
+		query: "select id from user order by (col, id)",
+		want:  "unsupported: in scatter query: complex order by expression: (col, id)",
+	}, {
+		query: "select id from user\norder by (col, id)",
+		want:  "unsupported: in scatter query: complex order by expression: (col, id)",
+	}}
+	for _, tcase := range testcases {
+		_, err := Build(tcase.query, &vschemaWrapper{v: vschema})
+		if err == nil || err.Error() != tcase.want {
+			t.Errorf("Build(%q):\n%v\nwant:\n%s", tcase.query, err, tcase.want)
+		}
+	}
+}
+
 func loadSchema(t *testing.T, filename string) *vindexes.VSchema {
 	formal, err := vindexes.LoadFormal(locateFile(filename))
 	if err != nil {
@@ -206,12 +206,12 @@ func (rb *route) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
 				}
 			}
 		default:
-			return nil, fmt.Errorf("unsupported: in scatter query: complex order by expression: %s", sqlparser.String(expr))
+			return nil, sqlparser.NewNodeError(expr, "unsupported: in scatter query: complex order by expression: %s", sqlparser.String(expr))
 		}
 		// If column is not found, then the order by is referencing
 		// a column that's not on the select list.
 		if colnum == -1 {
-			return nil, fmt.Errorf("unsupported: in scatter query: order by must reference a column in the select list: %s", sqlparser.String(order))
+			return nil, sqlparser.NewNodeError(order.Expr, "unsupported: in scatter query: order by must reference a column in the select list: %s", sqlparser.String(order))
 		}
 		ob := engine.OrderbyParams{
 			Col:  colnum,
@@ -26,6 +26,9 @@ import (
 
 // buildSelectPlan is the new function to build a Select plan.
 func buildSelectPlan(sel *sqlparser.Select, vschema ContextVSchema) (primitive engine.Primitive, err error) {
+	if err := expandCTEs(sel); err != nil {
+		return nil, err
+	}
 	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(sel)))
 	if err := pb.processSelect(sel, nil); err != nil {
 		return nil, err
@@ -102,6 +105,9 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab)
 	if err := pb.checkAggregates(sel); err != nil {
 		return err
 	}
+	if err := pb.checkWindowFuncs(sel); err != nil {
+		return err
+	}
 	if err := pb.pushSelectExprs(sel); err != nil {
 		return err
 	}
@@ -120,6 +126,43 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab)
 	return nil
 }
 
+// checkWindowFuncs verifies that the window functions and the named
+// windows of the query can be handled. The rows of a window can come
+// from any shard, so they can only be pushed down into a route that
+// goes to a single shard.
+func (pb *primitiveBuilder) checkWindowFuncs(sel *sqlparser.Select) error {
+	if len(sel.Windows) == 0 && !nodeHasWindowFuncs(sel.SelectExprs) && !nodeHasWindowFuncs(sel.OrderBy) {
+		return nil
+	}
+	rb, isRoute := pb.bldr.(*route)
+	if !isRoute || !rb.removeMultishardOptions() {
+		return errors.New("unsupported: cross-shard query with window functions")
+	}
+	if err := pb.st.ResolveSymbols(sel.Windows); err != nil {
+		return err
+	}
+	rb.Select.(*sqlparser.Select).Windows = sel.Windows
+	return nil
+}
+
+func nodeHasWindowFuncs(node sqlparser.SQLNode) bool {
+	hasWindowFuncs := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if node.IsWindowFunc() {
+				hasWindowFuncs = true
+				return false, errors.New("unused error")
+			}
+		case *sqlparser.Subquery:
+			// Subqueries are analyzed by themselves.
+			return false, nil
+		}
+		return true, nil
+	}, node)
+	return hasWindowFuncs
+}
+
 // pushFilter identifies the target route for the specified bool expr,
 // pushes it down, and updates the route info if the new constraint improves
 // the primitive. This function can push to a WHERE or HAVING clause.
@@ -213,7 +256,7 @@ func (pb *primitiveBuilder) pushSelectRoutes(selectExprs sqlparser.SelectExprs)
 			// We'll allow select * for simple routes.
 			rb, ok := pb.bldr.(*route)
 			if !ok {
-				return nil, errors.New("unsupported: '*' expression in cross-shard query")
+				return nil, sqlparser.NewNodeError(node, "unsupported: '*' expression in cross-shard query")
 			}
 			// Validate keyspace reference if any.
 			if !node.TableName.IsEmpty() {
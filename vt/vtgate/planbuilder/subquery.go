@@ -105,7 +105,7 @@ func (sq *subquery) PushFilter(_ *primitiveBuilder, _ sqlparser.Expr, whereType
 func (sq *subquery) PushSelect(expr *sqlparser.AliasedExpr, _ builder) (rc *resultColumn, colnum int, err error) {
 	col, ok := expr.Expr.(*sqlparser.ColName)
 	if !ok {
-		return nil, 0, errors.New("unsupported: expression on results of a cross-shard subquery")
+		return nil, 0, sqlparser.NewNodeError(expr, "unsupported: expression on results of a cross-shard subquery")
 	}
 
 	// colnum should already be set for subquery columns.
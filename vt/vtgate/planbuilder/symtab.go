@@ -297,14 +297,14 @@ func (st *symtab) Find(col *sqlparser.ColName) (origin builder, isLocal bool, er
 	}
 
 	if st.Outer == nil {
-		return nil, false, fmt.Errorf("symbol %s not found", sqlparser.String(col))
+		return nil, false, sqlparser.NewNodeError(col, "symbol %s not found", sqlparser.String(col))
 	}
 	// Search is not continued if ResultColumns already has values:
 	// select a ... having ... (select b ... having a...). In this case,
 	// a (in having) should not match the outer-most 'a'. This is to
 	// match MySQL's behavior.
 	if len(st.ResultColumns) != 0 {
-		return nil, false, fmt.Errorf("symbol %s not found in subquery", sqlparser.String(col))
+		return nil, false, sqlparser.NewNodeError(col, "symbol %s not found in subquery", sqlparser.String(col))
 	}
 
 	if origin, _, err = st.Outer.Find(col); err != nil {
@@ -358,7 +358,7 @@ func (st *symtab) searchTables(col *sqlparser.ColName) (*column, error) {
 			return &column{origin: st.singleRoute, st: st}, nil
 		default:
 			// If none of the above, the symbol is unresolvable.
-			return nil, fmt.Errorf("symbol %s not found", sqlparser.String(col))
+			return nil, sqlparser.NewNodeError(col, "symbol %s not found", sqlparser.String(col))
 		}
 	} else {
 		var ok bool
@@ -373,7 +373,7 @@ func (st *symtab) searchTables(col *sqlparser.ColName) (*column, error) {
 	if !ok {
 		// We know all the column names of a subquery. Might as well return an error if it's not found.
 		if t.isAuthoritative {
-			return nil, fmt.Errorf("symbol %s not found in table or subquery", sqlparser.String(col))
+			return nil, sqlparser.NewNodeError(col, "symbol %s not found in table or subquery", sqlparser.String(col))
 		}
 		c = &column{
 			origin: t.Origin(),
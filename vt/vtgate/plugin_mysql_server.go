@@ -19,8 +19,12 @@ package vtgate
 import (
 	"flag"
 	"fmt"
+	"math"
 	"net"
 	"os"
+	"sort"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"syscall"
 	"time"
@@ -34,9 +38,12 @@ import (
 	"gopkg.in/src-d/go-vitess.v1/vt/callinfo"
 	"gopkg.in/src-d/go-vitess.v1/vt/log"
 	"gopkg.in/src-d/go-vitess.v1/vt/servenv"
+	"gopkg.in/src-d/go-vitess.v1/vt/sqlparser"
+	"gopkg.in/src-d/go-vitess.v1/vt/topo/topoproto"
 	"gopkg.in/src-d/go-vitess.v1/vt/vttls"
 
 	querypb "gopkg.in/src-d/go-vitess.v1/vt/proto/query"
+	topodatapb "gopkg.in/src-d/go-vitess.v1/vt/proto/topodata"
 	vtgatepb "gopkg.in/src-d/go-vitess.v1/vt/proto/vtgate"
 )
 
@@ -47,7 +54,13 @@ var (
 	mysqlTCPVersion               = flag.String("mysql_tcp_version", "tcp", "Select tcp, tcp4, or tcp6 to control the socket type.")
 	mysqlAuthServerImpl           = flag.String("mysql_auth_server_impl", "static", "Which auth server implementation to use.")
 	mysqlAllowClearTextWithoutTLS = flag.Bool("mysql_allow_clear_text_without_tls", false, "If set, the server will allow the use of a clear text password over non-SSL connections.")
+	mysqlAllowCompression         = flag.Bool("mysql_allow_compression", false, "If set, the server will advertise the compressed protocol, trading CPU for bandwidth with the clients that use it.")
 	mysqlServerVersion            = flag.String("mysql_server_version", mysql.DefaultServerVersion, "MySQL server version to advertise.")
+	mysqlAllowLocalInfile         = flag.Bool("mysql_allow_local_infile", false, "If set, the server will accept LOAD DATA LOCAL INFILE statements, from the clients that support them.")
+
+	mysqlProxyProtocolTrustedNetworks = flag.String("mysql_proxy_protocol_trusted_networks", "", "Comma separated list of CIDRs of the load balancers that send a PROXY protocol header (v1 or v2) at the start of each connection. The client address in that header is then used for the connection.")
+
+	mysqlServerProcessAdminGroups = flag.String("mysql_server_process_admin_groups", "", "Comma separated list of the groups whose users can list and kill the connections of the other users, with SHOW PROCESSLIST and KILL. The other users only see and kill their own connections.")
 
 	mysqlServerRequireSecureTransport = flag.Bool("mysql_server_require_secure_transport", false, "Reject insecure connections but only if mysql_server_ssl_cert and mysql_server_ssl_key are provided")
 
@@ -55,6 +68,8 @@ var (
 	mysqlSslKey  = flag.String("mysql_server_ssl_key", "", "Path to ssl key for mysql server plugin SSL")
 	mysqlSslCa   = flag.String("mysql_server_ssl_ca", "", "Path to ssl CA for mysql server plugin SSL. If specified, server will require and validate client certs.")
 
+	mysqlServerRSAKey = flag.String("mysql_server_rsa_key", "", "Path to the PEM RSA private key used by caching_sha2_password to exchange passwords over non-SSL connections.")
+
 	mysqlSlowConnectWarnThreshold = flag.Duration("mysql_slow_connect_warn_threshold", 0, "Warn if it takes more than the given threshold for a mysql connection to establish")
 
 	mysqlConnReadTimeout  = flag.Duration("mysql_server_read_timeout", 0, "connection read timeout")
@@ -69,11 +84,19 @@ var (
 // is in progress.
 type vtgateHandler struct {
 	vtg *VTGate
+
+	// mu protects schemaNames.
+	mu sync.Mutex
+	// schemaNames is the SchemaName of each connection the last time
+	// it was used as the target of its session. A USE statement then
+	// changes the target until the client changes its database again.
+	schemaNames map[*mysql.Conn]string
 }
 
 func newVtgateHandler(vtg *VTGate) *vtgateHandler {
 	return &vtgateHandler{
-		vtg: vtg,
+		vtg:         vtg,
+		schemaNames: make(map[*mysql.Conn]string),
 	}
 }
 
@@ -81,6 +104,46 @@ func (vh *vtgateHandler) NewConnection(c *mysql.Conn) {
 }
 
 func (vh *vtgateHandler) ConnectionClosed(c *mysql.Conn) {
+	vh.rollback(c)
+	vh.forgetSchemaName(c)
+}
+
+// ResetConnection is part of the mysql.Handler interface. It rolls
+// back any ongoing transaction and drops the session, so the target,
+// autocommit and other session settings go back to their defaults.
+func (vh *vtgateHandler) ResetConnection(c *mysql.Conn) {
+	vh.rollback(c)
+	c.ClientData = nil
+	vh.forgetSchemaName(c)
+}
+
+// applySchemaName sets the target of the session to the SchemaName of
+// the connection, if it changed since the last query.
+func (vh *vtgateHandler) applySchemaName(c *mysql.Conn, session *vtgatepb.Session) {
+	vh.mu.Lock()
+	defer vh.mu.Unlock()
+	if vh.schemaNames == nil {
+		vh.schemaNames = make(map[*mysql.Conn]string)
+	}
+	if previous, ok := vh.schemaNames[c]; ok && previous == c.SchemaName {
+		return
+	}
+	vh.schemaNames[c] = c.SchemaName
+	if c.SchemaName != "" {
+		session.TargetString = c.SchemaName
+	}
+}
+
+// forgetSchemaName drops the SchemaName recorded for the connection,
+// so it is used again as the target of the next session.
+func (vh *vtgateHandler) forgetSchemaName(c *mysql.Conn) {
+	vh.mu.Lock()
+	defer vh.mu.Unlock()
+	delete(vh.schemaNames, c)
+}
+
+// rollback rolls back the ongoing transaction of the connection, if any.
+func (vh *vtgateHandler) rollback(c *mysql.Conn) {
 	// Rollback if there is an ongoing transaction. Ignore error.
 	var ctx context.Context
 	var cancel context.CancelFunc
@@ -100,13 +163,38 @@ func (vh *vtgateHandler) ConnectionClosed(c *mysql.Conn) {
 }
 
 func (vh *vtgateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sqltypes.Result) error) error {
+	return vh.execute(c, "vtgateHandler.ComQuery", query, make(map[string]*querypb.BindVariable), callback)
+}
+
+// ComPrepare is part of the mysql.Handler interface. The statement is
+// only checked for syntax errors: the fields are sent with the result
+// of each execution.
+func (vh *vtgateHandler) ComPrepare(c *mysql.Conn, query string, bindVars map[string]*querypb.BindVariable) ([]*querypb.Field, error) {
+	if _, err := sqlparser.Parse(query); err != nil {
+		return nil, mysql.NewSQLError(mysql.ERParseError, mysql.SSSyntaxErrorOrAccessViolation, "%v", err)
+	}
+	return nil, nil
+}
+
+// ComStmtExecute is part of the mysql.Handler interface. The prepared
+// statement is executed as a bound query.
+func (vh *vtgateHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
+	return vh.execute(c, "vtgateHandler.ComStmtExecute", prepare.PrepareStmt, prepare.BindVars, callback)
+}
+
+// execute runs a query for ComQuery and ComStmtExecute.
+func (vh *vtgateHandler) execute(c *mysql.Conn, spanName, query string, bindVars map[string]*querypb.BindVariable, callback func(*sqltypes.Result) error) error {
 	ctx := context.Background()
 	var cancel context.CancelFunc
 	if *mysqlQueryTimeout != 0 {
 		ctx, cancel = context.WithTimeout(ctx, *mysqlQueryTimeout)
-		defer cancel()
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
 	}
-	span, ctx := trace.NewSpan(ctx, "vtgateHandler.ComQuery")
+	defer cancel()
+	// KILL QUERY cancels the context, and the queries on the tablets.
+	c.SetCancelFunc(cancel)
+	span, ctx := trace.NewSpan(ctx, spanName)
 	trace.AnnotateSQL(span, query)
 	defer span.Finish()
 
@@ -117,10 +205,16 @@ func (vh *vtgateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sq
 	// returned, use the User. This lets the plugin map a MySQL
 	// user used for authentication to a Vitess User used for
 	// Table ACLs and Vitess authentication in general.
+	// The component is the client program if it sent its name in
+	// the connection attributes, and its address otherwise.
 	im := c.UserData.Get()
+	component := mysql.ConnAttrsProgram(c.ConnAttrs)
+	if component == "" {
+		component = c.RemoteAddr().String()
+	}
 	ef := callerid.NewEffectiveCallerID(
-		c.User,                  /* principal: who */
-		c.RemoteAddr().String(), /* component: running client process */
+		c.User,    /* principal: who */
+		component, /* component: running client process */
 		"VTGate MySQL Connector" /* subcomponent: part of the client */)
 	ctx = callerid.NewContext(ctx, ef, im)
 
@@ -146,22 +240,86 @@ func (vh *vtgateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sq
 		}
 	}()
 
-	if c.SchemaName != "" {
-		session.TargetString = c.SchemaName
-	}
+	vh.applySchemaName(c, session)
+	target, autocommit, inTransaction := session.TargetString, session.Autocommit, session.InTransaction
 	if session.Options.Workload == querypb.ExecuteOptions_OLAP {
-		err := vh.vtg.StreamExecute(ctx, session, query, make(map[string]*querypb.BindVariable), callback)
-		return mysql.NewSQLErrorFromError(err)
+		err := queryError(ctx, vh.vtg.StreamExecute(ctx, session, query, bindVars, callback))
+		if err != nil {
+			return err
+		}
+		trackSessionState(c, session, target, autocommit, inTransaction)
+		return nil
+	}
+	var result *sqltypes.Result
+	var err error
+	if sqlparser.Preview(query) == sqlparser.StmtLoad {
+		session, result, err = vh.loadData(ctx, c, session, query)
+	} else {
+		session, result, err = vh.vtg.Execute(ctx, session, query, bindVars)
 	}
-	session, result, err := vh.vtg.Execute(ctx, session, query, make(map[string]*querypb.BindVariable))
 	c.ClientData = session
-	err = mysql.NewSQLErrorFromError(err)
+	err = queryError(ctx, err)
 	if err != nil {
 		return err
 	}
+	trackSessionState(c, session, target, autocommit, inTransaction)
 	return callback(result)
 }
 
+// loadData runs a LOAD DATA LOCAL INFILE statement, with the file sent
+// by the client.
+func (vh *vtgateHandler) loadData(ctx context.Context, c *mysql.Conn, session *vtgatepb.Session, query string) (*vtgatepb.Session, *sqltypes.Result, error) {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return session, nil, mysql.NewSQLError(mysql.ERParseError, mysql.SSSyntaxErrorOrAccessViolation, "%v", err)
+	}
+	load := stmt.(*sqlparser.Load)
+	if load.Local == "" {
+		return session, nil, mysql.NewSQLError(mysql.ERNotSupportedYet, mysql.SSUnknownSQLState, "LOAD DATA without LOCAL is not supported")
+	}
+	data, err := c.RequestLocalInfile(string(load.File.Val))
+	if err != nil {
+		return session, nil, err
+	}
+	defer data.Close()
+	return vh.vtg.LoadData(ctx, session, query, load, data)
+}
+
+// queryError converts the error of a query to a mysql.SQLError. If the
+// query was killed, whatever the error is, it reports the interruption.
+func queryError(ctx context.Context, err error) error {
+	if err != nil && ctx.Err() == context.Canceled {
+		return mysql.NewSQLError(mysql.ERQueryInterrupted, mysql.SSQueryInterrupted, "Query execution was interrupted")
+	}
+	return mysql.NewSQLErrorFromError(err)
+}
+
+// trackSessionState reports to the client the changes a query made to
+// the session, given the values before the query: the target, the
+// autocommit setting, and whether a transaction is in progress.
+func trackSessionState(c *mysql.Conn, session *vtgatepb.Session, target string, autocommit, inTransaction bool) {
+	if session.TargetString != target {
+		// The client only knows about keyspaces, not about the shard
+		// and tablet type parts of the target.
+		keyspace, _, _, _ := topoproto.ParseDestination(session.TargetString, topodatapb.TabletType_MASTER)
+		c.TrackSchema(keyspace)
+	}
+	if session.Autocommit != autocommit {
+		value := "OFF"
+		if session.Autocommit {
+			value = "ON"
+		}
+		c.TrackSystemVariable("autocommit", value)
+	}
+	if session.InTransaction != inTransaction {
+		state := "________"
+		if session.InTransaction {
+			state = "T_______"
+		}
+		c.TrackTransactionState(state)
+	}
+}
+
 func (vh *vtgateHandler) WarningCount(c *mysql.Conn) uint16 {
 	session, _ := c.ClientData.(*vtgatepb.Session)
 	if session != nil {
@@ -173,6 +331,61 @@ func (vh *vtgateHandler) WarningCount(c *mysql.Conn) uint16 {
 var mysqlListener *mysql.Listener
 var mysqlUnixListener *mysql.Listener
 
+// mysqlConns returns the open connections of the MySQL listeners,
+// sorted by connection ID.
+func mysqlConns() []*mysql.Conn {
+	var conns []*mysql.Conn
+	for _, l := range []*mysql.Listener{mysqlListener, mysqlUnixListener} {
+		if l != nil {
+			conns = append(conns, l.Conns()...)
+		}
+	}
+	sort.Slice(conns, func(i, j int) bool {
+		return conns[i].ConnectionID < conns[j].ConnectionID
+	})
+	return conns
+}
+
+// findMySQLConn returns the open connection of the MySQL listeners with
+// the given ID, or nil if there is none.
+func findMySQLConn(connectionID uint64) *mysql.Conn {
+	if connectionID > math.MaxUint32 {
+		return nil
+	}
+	for _, l := range []*mysql.Listener{mysqlListener, mysqlUnixListener} {
+		if l == nil {
+			continue
+		}
+		if c := l.Conn(uint32(connectionID)); c != nil {
+			return c
+		}
+	}
+	return nil
+}
+
+// canAccessMySQLConn returns true if the caller of ctx can list and
+// kill the connection c of the MySQL listeners: it must be one of its
+// own connections, unless the caller is in one of the
+// -mysql_server_process_admin_groups.
+func canAccessMySQLConn(ctx context.Context, c *mysql.Conn) bool {
+	if c.ProcessInfo().User == callerid.EffectiveCallerIDFromContext(ctx).GetPrincipal() {
+		return true
+	}
+	return mysql.IsProcessAdmin(callerid.ImmediateCallerIDFromContext(ctx).GetGroups(), processAdminGroups())
+}
+
+// processAdminGroups returns the groups of -mysql_server_process_admin_groups.
+func processAdminGroups() []string {
+	if *mysqlServerProcessAdminGroups == "" {
+		return nil
+	}
+	var groups []string
+	for _, group := range strings.Split(*mysqlServerProcessAdminGroups, ",") {
+		groups = append(groups, strings.TrimSpace(group))
+	}
+	return groups
+}
+
 // initiMySQLProtocol starts the mysql protocol.
 // It should be called only once in a process.
 func initMySQLProtocol() {
@@ -219,6 +432,21 @@ func initMySQLProtocol() {
 			mysqlListener.RequireSecureTransport = *mysqlServerRequireSecureTransport
 		}
 		mysqlListener.AllowClearTextWithoutTLS = *mysqlAllowClearTextWithoutTLS
+		mysqlListener.AllowCompression = *mysqlAllowCompression
+		mysqlListener.AllowLocalInfile = *mysqlAllowLocalInfile
+		mysqlListener.ProcessAdminGroups = processAdminGroups()
+		if *mysqlProxyProtocolTrustedNetworks != "" {
+			mysqlListener.ProxyProtocolTrustedNetworks, err = parseTrustedNetworks(*mysqlProxyProtocolTrustedNetworks)
+			if err != nil {
+				log.Exitf("-mysql_proxy_protocol_trusted_networks: %v", err)
+			}
+		}
+		if *mysqlServerRSAKey != "" {
+			mysqlListener.CachingSha2PrivateKey, err = mysql.LoadRSAPrivateKey(*mysqlServerRSAKey)
+			if err != nil {
+				log.Exitf("mysql.LoadRSAPrivateKey failed: %v", err)
+			}
+		}
 		// Check for the connection threshold
 		if *mysqlSlowConnectWarnThreshold != 0 {
 			log.Infof("setting mysql slow connection threshold to %v", mysqlSlowConnectWarnThreshold)
@@ -238,11 +466,26 @@ func initMySQLProtocol() {
 			log.Exitf("mysql.NewListener failed: %v", err)
 			return
 		}
+		mysqlUnixListener.AllowLocalInfile = *mysqlAllowLocalInfile
+		mysqlUnixListener.ProcessAdminGroups = processAdminGroups()
 		// Listen for unix socket
 		go mysqlUnixListener.Accept()
 	}
 }
 
+// parseTrustedNetworks parses a comma separated list of CIDRs.
+func parseTrustedNetworks(value string) ([]*net.IPNet, error) {
+	var networks []*net.IPNet
+	for _, cidr := range strings.Split(value, ",") {
+		_, network, err := net.ParseCIDR(strings.TrimSpace(cidr))
+		if err != nil {
+			return nil, err
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
 // newMysqlUnixSocket creates a new unix socket mysql listener. If a socket file already exists, attempts
 // to clean it up.
 func newMysqlUnixSocket(address string, authServer mysql.AuthServer, handler mysql.Handler) (*mysql.Listener, error) {
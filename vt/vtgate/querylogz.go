@@ -38,6 +38,7 @@ var (
 				<th>Method</th>
 				<th>Context</th>
 				<th>Effective Caller</th>
+				<th>Program</th>
 				<th>Immediate Caller</th>
 				<th>Start</th>
 				<th>End</th>
@@ -64,6 +65,7 @@ var (
 			<td>{{.Method}}</td>
 			<td>{{.ContextHTML}}</td>
 			<td>{{.EffectiveCaller}}</td>
+			<td>{{.EffectiveCallerComponent}}</td>
 			<td>{{.ImmediateCaller}}</td>
 			<td>{{.StartTime | stampMicro}}</td>
 			<td>{{.EndTime | stampMicro}}</td>
@@ -64,6 +64,7 @@ func TestQuerylogzHandlerFormatting(t *testing.T) {
 		`<td>Execute</td>`,
 		`<td></td>`,
 		`<td>effective-caller</td>`,
+		`<td>component</td>`,
 		`<td>immediate-caller</td>`,
 		`<td>Nov 29 13:33:09.000000</td>`,
 		`<td>Nov 29 13:33:09.001000</td>`,
@@ -93,6 +94,7 @@ func TestQuerylogzHandlerFormatting(t *testing.T) {
 		`<td>Execute</td>`,
 		`<td></td>`,
 		`<td>effective-caller</td>`,
+		`<td>component</td>`,
 		`<td>immediate-caller</td>`,
 		`<td>Nov 29 13:33:09.000000</td>`,
 		`<td>Nov 29 13:33:09.020000</td>`,
@@ -122,6 +124,7 @@ func TestQuerylogzHandlerFormatting(t *testing.T) {
 		`<td>Execute</td>`,
 		`<td></td>`,
 		`<td>effective-caller</td>`,
+		`<td>component</td>`,
 		`<td>immediate-caller</td>`,
 		`<td>Nov 29 13:33:09.000000</td>`,
 		`<td>Nov 29 13:33:09.500000</td>`,
@@ -131,16 +131,31 @@ func queryzHandler(e *Executor, w http.ResponseWriter, r *http.Request) {
 			return row1.timePQ() > row2.timePQ()
 		},
 	}
+	// The plans of the queries of the same shape, like the ones that
+	// only differ by the length of their IN lists, share a row.
+	rows := make(map[string]*queryzRow)
 	for _, v := range e.plans.Keys() {
 		result, ok := e.plans.Get(v)
 		if !ok {
 			continue
 		}
 		plan := result.(*engine.Plan)
-		Value := &queryzRow{
-			Query: logz.Wrappable(sqlparser.TruncateForUI(plan.Original)),
+		Value, ok := rows[plan.Fingerprint]
+		if !ok {
+			Value = &queryzRow{
+				Query: logz.Wrappable(sqlparser.TruncateForUI(plan.Fingerprint)),
+			}
+			rows[plan.Fingerprint] = Value
+			sorter.rows = append(sorter.rows, Value)
 		}
-		Value.Count, Value.tm, Value.ShardQueries, Value.Rows, Value.Errors = plan.Stats()
+		count, tm, shardQueries, rowCount, errors := plan.Stats()
+		Value.Count += count
+		Value.tm += tm
+		Value.ShardQueries += shardQueries
+		Value.Rows += rowCount
+		Value.Errors += errors
+	}
+	for _, Value := range sorter.rows {
 		var timepq time.Duration
 		if Value.Count != 0 {
 			timepq = time.Duration(uint64(Value.tm) / Value.Count)
@@ -152,7 +167,6 @@ func queryzHandler(e *Executor, w http.ResponseWriter, r *http.Request) {
 		} else {
 			Value.Color = "high"
 		}
-		sorter.rows = append(sorter.rows, Value)
 	}
 	sort.Sort(&sorter)
 	for _, Value := range sorter.rows {
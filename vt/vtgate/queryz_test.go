@@ -63,6 +63,22 @@ func TestQueryzHandler(t *testing.T) {
 	plan2 := result.(*engine.Plan)
 	plan2.ExecTime = time.Duration(1 * time.Second)
 
+	// queries that only differ by the length of their IN lists
+	// share a row
+	for _, sql := range []string{
+		"select id from user where id in (1, 2)",
+		"select id from user where id in (1, 2, 3)",
+	} {
+		if _, err := executorExec(executor, sql, nil); err != nil {
+			t.Error(err)
+		}
+	}
+	result, ok = executor.plans.Get("@master:" + "select id from user where id in (1, 2)")
+	if !ok {
+		t.Fatalf("couldn't get plan from cache")
+	}
+	plan5 := result.(*engine.Plan)
+
 	sql = "insert into user (id, name) values (:id, :name)"
 	_, err = executorExec(executor, sql, map[string]*querypb.BindVariable{
 		"id":   sqltypes.Uint64BindVariable(1),
@@ -102,7 +118,7 @@ func TestQueryzHandler(t *testing.T) {
 	body, _ := ioutil.ReadAll(resp.Body)
 	planPattern1 := []string{
 		`<tr class="low">`,
-		`<td>select id from user where id = 1</td>`,
+		`<td>select id from user where id = \?</td>`,
 		`<td>1</td>`,
 		`<td>0.001000</td>`,
 		`<td>1</td>`,
@@ -160,6 +176,15 @@ func TestQueryzHandler(t *testing.T) {
 		`</tr>`,
 	}
 	checkQueryzHasPlan(t, planPattern4, plan4, body)
+	planPattern5 := []string{
+		`<tr class="low">`,
+		`<td>select id from user where id in \(\.\.\.\).*</td>`,
+		`<td>2</td>`,
+	}
+	checkQueryzHasPlan(t, planPattern5, plan5, body)
+	if got := strings.Count(string(body), "select id from user where id in"); got != 1 {
+		t.Errorf("queryz page has %d rows for the IN queries, want 1", got)
+	}
 }
 
 func checkQueryzHasPlan(t *testing.T, planPattern []string, plan *engine.Plan, page []byte) {
@@ -21,6 +21,7 @@ package vtgate
 import (
 	"flag"
 	"fmt"
+	"io"
 	"math"
 	"net/http"
 	"time"
@@ -299,6 +300,27 @@ handleError:
 	return session, nil, err
 }
 
+// LoadData executes a LOAD DATA LOCAL INFILE statement, with data being
+// the content of the file. This is a V3 function.
+func (vtg *VTGate) LoadData(ctx context.Context, session *vtgatepb.Session, sql string, load *sqlparser.Load, data io.Reader) (newSession *vtgatepb.Session, qr *sqltypes.Result, err error) {
+	// In this context, we don't care if we can't fully parse destination
+	destKeyspace, destTabletType, _, _ := vtg.executor.ParseDestinationTarget(session.TargetString)
+	statsKey := []string{"LoadData", destKeyspace, topoproto.TabletTypeLString(destTabletType)}
+	defer vtg.timings.Record(statsKey, time.Now())
+
+	qr, err = vtg.executor.LoadData(ctx, "LoadData", NewSafeSession(session), sql, load, data)
+	if err == nil {
+		return session, qr, nil
+	}
+
+	query := map[string]interface{}{
+		"Sql":     sql,
+		"Session": session,
+	}
+	err = recordAndAnnotateError(err, statsKey, query, vtg.logExecute)
+	return session, nil, err
+}
+
 // ExecuteBatch executes a batch of queries. This is a V3 function.
 func (vtg *VTGate) ExecuteBatch(ctx context.Context, session *vtgatepb.Session, sqlList []string, bindVariablesList []map[string]*querypb.BindVariable) (*vtgatepb.Session, []sqltypes.QueryResponse, error) {
 	// In this context, we don't care if we can't fully parse destination
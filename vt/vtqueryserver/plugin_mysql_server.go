@@ -31,6 +31,7 @@ import (
 	"gopkg.in/src-d/go-vitess.v1/vt/log"
 	"gopkg.in/src-d/go-vitess.v1/vt/mysqlproxy"
 	"gopkg.in/src-d/go-vitess.v1/vt/servenv"
+	"gopkg.in/src-d/go-vitess.v1/vt/sqlparser"
 	"gopkg.in/src-d/go-vitess.v1/vt/vttls"
 
 	querypb "gopkg.in/src-d/go-vitess.v1/vt/proto/query"
@@ -71,6 +72,18 @@ func (mh *proxyHandler) NewConnection(c *mysql.Conn) {
 }
 
 func (mh *proxyHandler) ConnectionClosed(c *mysql.Conn) {
+	mh.rollback(c)
+}
+
+// ResetConnection is part of the mysql.Handler interface. It rolls
+// back any ongoing transaction and drops the session.
+func (mh *proxyHandler) ResetConnection(c *mysql.Conn) {
+	mh.rollback(c)
+	c.ClientData = nil
+}
+
+// rollback rolls back the ongoing transaction of the connection, if any.
+func (mh *proxyHandler) rollback(c *mysql.Conn) {
 	// Rollback if there is an ongoing transaction. Ignore error.
 	var ctx context.Context
 	var cancel context.CancelFunc
@@ -87,6 +100,27 @@ func (mh *proxyHandler) ConnectionClosed(c *mysql.Conn) {
 }
 
 func (mh *proxyHandler) ComQuery(c *mysql.Conn, query string, callback func(*sqltypes.Result) error) error {
+	return mh.execute(c, query, make(map[string]*querypb.BindVariable), callback)
+}
+
+// ComPrepare is part of the mysql.Handler interface. The statement is
+// only checked for syntax errors: the fields are sent with the result
+// of each execution.
+func (mh *proxyHandler) ComPrepare(c *mysql.Conn, query string, bindVars map[string]*querypb.BindVariable) ([]*querypb.Field, error) {
+	if _, err := sqlparser.Parse(query); err != nil {
+		return nil, mysql.NewSQLError(mysql.ERParseError, mysql.SSSyntaxErrorOrAccessViolation, "%v", err)
+	}
+	return nil, nil
+}
+
+// ComStmtExecute is part of the mysql.Handler interface. The prepared
+// statement is executed as a bound query.
+func (mh *proxyHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
+	return mh.execute(c, prepare.PrepareStmt, prepare.BindVars, callback)
+}
+
+// execute runs a query for ComQuery and ComStmtExecute.
+func (mh *proxyHandler) execute(c *mysql.Conn, query string, bindVars map[string]*querypb.BindVariable, callback func(*sqltypes.Result) error) error {
 	var ctx context.Context
 	var cancel context.CancelFunc
 	if *mysqlQueryTimeout != 0 {
@@ -122,7 +156,7 @@ func (mh *proxyHandler) ComQuery(c *mysql.Conn, query string, callback func(*sql
 	if c.SchemaName != "" {
 		session.TargetString = c.SchemaName
 	}
-	session, result, err := mh.mp.Execute(ctx, session, query, make(map[string]*querypb.BindVariable))
+	session, result, err := mh.mp.Execute(ctx, session, query, bindVars)
 	c.ClientData = session
 	err = mysql.NewSQLErrorFromError(err)
 	if err != nil {
@@ -193,6 +193,11 @@ func analyzeSelect(sel *sqlparser.Select, tables map[string]*schema.Table) (plan
 		plan.PlanID = PlanSelectLock
 	}
 
+	// The FROM clause of a select with common table expressions
+	// can refer to them instead of tables.
+	if sel.With != nil {
+		return plan, nil
+	}
 	tableName := analyzeFrom(sel.From)
 	if tableName.IsEmpty() {
 		return plan, nil
@@ -228,11 +233,22 @@ func analyzeSelect(sel *sqlparser.Select, tables map[string]*schema.Table) (plan
 }
 
 func analyzeFrom(tableExprs sqlparser.TableExprs) sqlparser.TableIdent {
-	if len(tableExprs) > 1 {
-		return sqlparser.NewTableIdent("")
+	// A JSON_TABLE doesn't read any table, so the query still
+	// reads a single table if the others are JSON tables.
+	var node *sqlparser.AliasedTableExpr
+	for _, tableExpr := range tableExprs {
+		switch tableExpr := tableExpr.(type) {
+		case *sqlparser.JSONTableExpr:
+		case *sqlparser.AliasedTableExpr:
+			if node != nil {
+				return sqlparser.NewTableIdent("")
+			}
+			node = tableExpr
+		default:
+			return sqlparser.NewTableIdent("")
+		}
 	}
-	node, ok := tableExprs[0].(*sqlparser.AliasedTableExpr)
-	if !ok {
+	if node == nil {
 		return sqlparser.NewTableIdent("")
 	}
 	return sqlparser.GetTableName(node.Expr)
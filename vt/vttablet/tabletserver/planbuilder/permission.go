@@ -34,19 +34,20 @@ type Permission struct {
 // tables referenced in a query.
 func BuildPermissions(stmt sqlparser.Statement) []Permission {
 	var permissions []Permission
+	ctes := cteRefs(stmt)
 	// All Statement types myst be covered here.
 	switch node := stmt.(type) {
 	case *sqlparser.Union, *sqlparser.Select:
-		permissions = buildSubqueryPermissions(node, tableacl.READER, permissions)
+		permissions = buildSubqueryPermissions(node, ctes, tableacl.READER, permissions)
 	case *sqlparser.Insert:
 		permissions = buildTableNamePermissions(node.Table, tableacl.WRITER, permissions)
-		permissions = buildSubqueryPermissions(node, tableacl.READER, permissions)
+		permissions = buildSubqueryPermissions(node, ctes, tableacl.READER, permissions)
 	case *sqlparser.Update:
-		permissions = buildTableExprsPermissions(node.TableExprs, tableacl.WRITER, permissions)
-		permissions = buildSubqueryPermissions(node, tableacl.READER, permissions)
+		permissions = buildTableExprsPermissions(node.TableExprs, ctes, tableacl.WRITER, permissions)
+		permissions = buildSubqueryPermissions(node, ctes, tableacl.READER, permissions)
 	case *sqlparser.Delete:
-		permissions = buildTableExprsPermissions(node.TableExprs, tableacl.WRITER, permissions)
-		permissions = buildSubqueryPermissions(node, tableacl.READER, permissions)
+		permissions = buildTableExprsPermissions(node.TableExprs, ctes, tableacl.WRITER, permissions)
+		permissions = buildSubqueryPermissions(node, ctes, tableacl.READER, permissions)
 	case *sqlparser.Set, *sqlparser.Show, *sqlparser.OtherRead:
 		// no-op
 	case *sqlparser.DDL:
@@ -63,11 +64,23 @@ func BuildPermissions(stmt sqlparser.Statement) []Permission {
 	return permissions
 }
 
-func buildSubqueryPermissions(stmt sqlparser.Statement, role tableacl.Role, permissions []Permission) []Permission {
+// cteRefs returns the tables of stmt that reference common table
+// expressions. They don't need any permission, the tables of the
+// common table expressions do.
+func cteRefs(stmt sqlparser.Statement) map[*sqlparser.AliasedTableExpr]bool {
+	refs := make(map[*sqlparser.AliasedTableExpr]bool)
+	_ = sqlparser.VisitCTERefs(stmt, func(ref *sqlparser.AliasedTableExpr, _ *sqlparser.CommonTableExpr) error {
+		refs[ref] = true
+		return nil
+	})
+	return refs
+}
+
+func buildSubqueryPermissions(stmt sqlparser.Statement, ctes map[*sqlparser.AliasedTableExpr]bool, role tableacl.Role, permissions []Permission) []Permission {
 	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
 		switch node := node.(type) {
 		case *sqlparser.Select:
-			permissions = buildTableExprsPermissions(node.From, role, permissions)
+			permissions = buildTableExprsPermissions(node.From, ctes, role, permissions)
 		case sqlparser.TableExprs:
 			return false, nil
 		}
@@ -76,16 +89,19 @@ func buildSubqueryPermissions(stmt sqlparser.Statement, role tableacl.Role, perm
 	return permissions
 }
 
-func buildTableExprsPermissions(node sqlparser.TableExprs, role tableacl.Role, permissions []Permission) []Permission {
+func buildTableExprsPermissions(node sqlparser.TableExprs, ctes map[*sqlparser.AliasedTableExpr]bool, role tableacl.Role, permissions []Permission) []Permission {
 	for _, node := range node {
-		permissions = buildTableExprPermissions(node, role, permissions)
+		permissions = buildTableExprPermissions(node, ctes, role, permissions)
 	}
 	return permissions
 }
 
-func buildTableExprPermissions(node sqlparser.TableExpr, role tableacl.Role, permissions []Permission) []Permission {
+func buildTableExprPermissions(node sqlparser.TableExpr, ctes map[*sqlparser.AliasedTableExpr]bool, role tableacl.Role, permissions []Permission) []Permission {
 	switch node := node.(type) {
 	case *sqlparser.AliasedTableExpr:
+		if ctes[node] {
+			break
+		}
 		// An AliasedTableExpr can also be a subquery, but we should skip them here
 		// because the buildSubQueryPermissions walker will catch them and extract
 		// the corresponding table names.
@@ -93,13 +109,13 @@ func buildTableExprPermissions(node sqlparser.TableExpr, role tableacl.Role, per
 		case sqlparser.TableName:
 			permissions = buildTableNamePermissions(node, role, permissions)
 		case *sqlparser.Subquery:
-			permissions = buildSubqueryPermissions(node.Select, role, permissions)
+			permissions = buildSubqueryPermissions(node.Select, ctes, role, permissions)
 		}
 	case *sqlparser.ParenTableExpr:
-		permissions = buildTableExprsPermissions(node.Exprs, role, permissions)
+		permissions = buildTableExprsPermissions(node.Exprs, ctes, role, permissions)
 	case *sqlparser.JoinTableExpr:
-		permissions = buildTableExprPermissions(node.LeftExpr, role, permissions)
-		permissions = buildTableExprPermissions(node.RightExpr, role, permissions)
+		permissions = buildTableExprPermissions(node.LeftExpr, ctes, role, permissions)
+		permissions = buildTableExprPermissions(node.RightExpr, ctes, role, permissions)
 	}
 	return permissions
 }
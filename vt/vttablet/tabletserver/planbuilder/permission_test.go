@@ -43,6 +43,21 @@ func TestBuildPermissions(t *testing.T) {
 			TableName: "t2",
 			Role:      tableacl.READER,
 		}},
+	}, {
+		input: "with c as (select * from t) select * from c",
+		output: []Permission{{
+			TableName: "t",
+			Role:      tableacl.READER,
+		}},
+	}, {
+		input: "select * from (with c as (select * from t1) select * from c) as d join c",
+		output: []Permission{{
+			TableName: "t1",
+			Role:      tableacl.READER,
+		}, {
+			TableName: "c",
+			Role:      tableacl.READER,
+		}},
 	}, {
 		input: "insert into t values()",
 		output: []Permission{{
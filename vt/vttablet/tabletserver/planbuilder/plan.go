@@ -309,7 +309,7 @@ func BuildStreaming(sql string, tables map[string]*schema.Table) (*Plan, error)
 		if stmt.Lock != "" {
 			return nil, vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "select with lock not allowed for streaming")
 		}
-		if tableName := analyzeFrom(stmt.From); !tableName.IsEmpty() {
+		if tableName := analyzeFrom(stmt.From); !tableName.IsEmpty() && stmt.With == nil {
 			plan.setTable(tableName, tables)
 		}
 	case *sqlparser.OtherRead, *sqlparser.Show, *sqlparser.Union:
@@ -61,6 +61,9 @@ type TabletPlan struct {
 	Fields     []*querypb.Field
 	Rules      *rules.Rules
 	Authorized []*tableacl.ACLResult
+	// Fingerprint identifies the queries of the same shape,
+	// whose stats are aggregated by queryz.
+	Fingerprint string
 
 	mu         sync.Mutex
 	QueryCount int64
@@ -340,7 +343,7 @@ func (qe *QueryEngine) GetPlan(ctx context.Context, logStats *tabletenv.LogStats
 	if err != nil {
 		return nil, err
 	}
-	plan := &TabletPlan{Plan: splan}
+	plan := &TabletPlan{Plan: splan, Fingerprint: sqlparser.Fingerprint(statement)}
 	plan.Rules = qe.queryRuleSources.FilterByPlan(sql, plan.PlanID, plan.TableName().String())
 	plan.buildAuthorized()
 	if plan.PlanID.IsSelect() {
@@ -172,6 +172,9 @@ func TestQueryPlanCache(t *testing.T) {
 	if firstPlan == nil {
 		t.Fatalf("plan should not be nil")
 	}
+	if want := "select * from test_table_01"; firstPlan.Fingerprint != want {
+		t.Errorf("Fingerprint: %s, want %s", firstPlan.Fingerprint, want)
+	}
 	secondPlan, err := qe.GetPlan(ctx, logStats, secondQuery, false)
 	if err != nil {
 		t.Fatal(err)
@@ -144,18 +144,37 @@ func queryzHandler(qe *QueryEngine, w http.ResponseWriter, r *http.Request) {
 			return row1.timePQ() > row2.timePQ()
 		},
 	}
+	// The plans of the queries of the same shape, like the ones that
+	// only differ by the length of their IN lists, share a row.
+	rows := make(map[string]*queryzRow)
 	for _, v := range qe.plans.Keys() {
 		plan := qe.peekQuery(v)
 		if plan == nil {
 			continue
 		}
-		Value := &queryzRow{
-			Query:  logz.Wrappable(sqlparser.TruncateForUI(v)),
-			Table:  plan.TableName().String(),
-			Plan:   plan.PlanID,
-			Reason: plan.Reason,
+		query := plan.Fingerprint
+		if query == "" {
+			query = v
 		}
-		Value.Count, Value.tm, Value.mysqlTime, Value.Rows, Value.Errors = plan.Stats()
+		Value, ok := rows[query]
+		if !ok {
+			Value = &queryzRow{
+				Query:  logz.Wrappable(sqlparser.TruncateForUI(query)),
+				Table:  plan.TableName().String(),
+				Plan:   plan.PlanID,
+				Reason: plan.Reason,
+			}
+			rows[query] = Value
+			sorter.rows = append(sorter.rows, Value)
+		}
+		count, tm, mysqlTime, rowCount, errors := plan.Stats()
+		Value.Count += count
+		Value.tm += tm
+		Value.mysqlTime += mysqlTime
+		Value.Rows += rowCount
+		Value.Errors += errors
+	}
+	for _, Value := range sorter.rows {
 		var timepq time.Duration
 		if Value.Count != 0 {
 			timepq = time.Duration(int64(Value.tm) / Value.Count)
@@ -167,7 +186,6 @@ func queryzHandler(qe *QueryEngine, w http.ResponseWriter, r *http.Request) {
 		} else {
 			Value.Color = "high"
 		}
-		sorter.rows = append(sorter.rows, Value)
 	}
 	sort.Sort(&sorter)
 	for _, Value := range sorter.rows {
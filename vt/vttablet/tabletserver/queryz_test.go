@@ -83,6 +83,23 @@ func TestQueryzHandler(t *testing.T) {
 	qe.plans.Set(hugeInsert, plan4)
 	qe.plans.Set("", (*TabletPlan)(nil))
 
+	// The queries of the same shape share a row.
+	for _, sql := range []string{
+		"select name from test_table where pk in (1, 2)",
+		"select name from test_table where pk in (1, 2, 3)",
+	} {
+		plan := &TabletPlan{
+			Plan: &planbuilder.Plan{
+				Table:  &schema.Table{Name: sqlparser.NewTableIdent("test_table")},
+				PlanID: planbuilder.PlanPassSelect,
+				Reason: planbuilder.ReasonTable,
+			},
+			Fingerprint: "select name from test_table where pk in (...)",
+		}
+		plan.AddStats(1, 1*time.Millisecond, 1*time.Millisecond, 1, 0)
+		qe.plans.Set(sql, plan)
+	}
+
 	queryzHandler(qe, resp, req)
 	body, _ := ioutil.ReadAll(resp.Body)
 	planPattern1 := []string{
@@ -153,6 +170,26 @@ func TestQueryzHandler(t *testing.T) {
 		`<td>0.000000</td>`,
 	}
 	checkQueryzHasPlan(t, planPattern4, plan4, body)
+	planPattern5 := []string{
+		`<tr class="low">`,
+		`<td>select name from test_table where pk in \(\.\.\.\).*</td>`,
+		`<td>test_table</td>`,
+		`<td>PASS_SELECT</td>`,
+		`<td>TABLE</td>`,
+		`<td>2</td>`,
+		`<td>0.002000</td>`,
+		`<td>0.002000</td>`,
+		`<td>2</td>`,
+		`<td>0</td>`,
+		`<td>0.001000</td>`,
+		`<td>0.001000</td>`,
+		`<td>1.000000</td>`,
+		`<td>0.000000</td>`,
+	}
+	checkQueryzHasPlan(t, planPattern5, nil, body)
+	if got := strings.Count(string(body), "select name from test_table where pk in"); got != 1 {
+		t.Errorf("queryz page has %d rows for the IN queries, want 1", got)
+	}
 }
 
 func checkQueryzHasPlan(t *testing.T, planPattern []string, plan *TabletPlan, page []byte) {